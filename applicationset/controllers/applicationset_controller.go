@@ -91,6 +91,8 @@ type ApplicationSetReconciler struct {
 	GlobalPreservedAnnotations []string
 	GlobalPreservedLabels      []string
 	Metrics                    *metrics.ApplicationsetMetrics
+	// PullRequestFeedback posts feedback about the generated applications to their pull requests, disabled if nil
+	PullRequestFeedback *PullRequestFeedback
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -193,6 +195,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	appMap := map[string]argov1alpha1.Application{}
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	// rolloutRequeueAfter is the time after which the soak of a RollingSync step ends, if the rollout is soaking.
+	var rolloutRequeueAfter time.Duration
 
 	if r.EnableProgressiveSyncs {
 		if applicationSetInfo.Spec.Strategy == nil && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
@@ -209,7 +213,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				appMap[app.Name] = app
 			}
 
-			appSyncMap, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, desiredApplications, appMap)
+			appSyncMap, rolloutRequeueAfter, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, desiredApplications, appMap)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
@@ -297,6 +301,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	if r.PullRequestFeedback != nil {
+		r.PullRequestFeedback.Post(ctx, logCtx, &applicationSetInfo, currentApplications, r.Generators)
+	}
+
 	if applicationSetInfo.RefreshRequired() {
 		delete(applicationSetInfo.Annotations, common.AnnotationApplicationSetRefresh)
 		err := r.Client.Update(ctx, &applicationSetInfo)
@@ -316,6 +324,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
 
 	if len(validateErrors) == 0 {
 		if err := r.setApplicationSetStatusCondition(ctx,
@@ -463,6 +474,12 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 // validateGeneratedApplications uses the Argo CD validation functions to verify the correctness of the
 // generated applications.
 func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[int]error, error) {
+	return ValidateGeneratedApplications(ctx, r.ArgoAppClientset, r.KubeClientset, r.ArgoCDNamespace, desiredApplications, applicationSetInfo)
+}
+
+// ValidateGeneratedApplications returns the validation errors of the generated applications by their index: duplicate
+// names, missing projects and invalid destinations.
+func ValidateGeneratedApplications(ctx context.Context, argoAppClientset appclientset.Interface, kubeClientset kubernetes.Interface, argoCDNamespace string, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[int]error, error) {
 	errorsByIndex := map[int]error{}
 	namesSet := map[string]bool{}
 	for i, app := range desiredApplications {
@@ -472,7 +489,7 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			errorsByIndex[i] = fmt.Errorf("ApplicationSet %s contains applications with duplicate name: %s", applicationSetInfo.Name, app.Name)
 			continue
 		}
-		_, err := r.ArgoAppClientset.ArgoprojV1alpha1().AppProjects(r.ArgoCDNamespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+		_, err := argoAppClientset.ArgoprojV1alpha1().AppProjects(argoCDNamespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
 		if err != nil {
 			if apierr.IsNotFound(err) {
 				errorsByIndex[i] = fmt.Errorf("application references project %s which does not exist", app.Spec.Project)
@@ -481,7 +498,7 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			return nil, err
 		}
 
-		if err := utils.ValidateDestination(ctx, &app.Spec.Destination, r.KubeClientset, r.ArgoCDNamespace); err != nil {
+		if err := utils.ValidateDestination(ctx, &app.Spec.Destination, kubeClientset, argoCDNamespace); err != nil {
 			errorsByIndex[i] = fmt.Errorf("application destination spec is invalid: %s", err.Error())
 			continue
 		}
@@ -580,68 +597,8 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 		}
 
 		action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
-			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
-			found.Spec = generatedApp.Spec
-
-			// allow setting the Operation field to trigger a sync operation on an Application
-			if generatedApp.Operation != nil {
-				found.Operation = generatedApp.Operation
-			}
-
-			preservedAnnotations := make([]string, 0)
-			preservedLabels := make([]string, 0)
-
-			if applicationSet.Spec.PreservedFields != nil {
-				preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
-				preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
-			}
-
-			if len(r.GlobalPreservedAnnotations) > 0 {
-				preservedAnnotations = append(preservedAnnotations, r.GlobalPreservedAnnotations...)
-			}
-
-			if len(r.GlobalPreservedLabels) > 0 {
-				preservedLabels = append(preservedLabels, r.GlobalPreservedLabels...)
-			}
-
-			// Preserve specially treated argo cd annotations:
-			// * https://github.com/argoproj/applicationset/issues/180
-			// * https://github.com/argoproj/argo-cd/issues/10500
-			preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
-
-			for _, key := range preservedAnnotations {
-				if state, exists := found.ObjectMeta.Annotations[key]; exists {
-					if generatedApp.Annotations == nil {
-						generatedApp.Annotations = map[string]string{}
-					}
-					generatedApp.Annotations[key] = state
-				}
-			}
-
-			for _, key := range preservedLabels {
-				if state, exists := found.ObjectMeta.Labels[key]; exists {
-					if generatedApp.Labels == nil {
-						generatedApp.Labels = map[string]string{}
-					}
-					generatedApp.Labels[key] = state
-				}
-			}
-
-			// Preserve post-delete finalizers:
-			//   https://github.com/argoproj/argo-cd/issues/17181
-			for _, finalizer := range found.ObjectMeta.Finalizers {
-				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
-					if generatedApp.Finalizers == nil {
-						generatedApp.Finalizers = []string{}
-					}
-					generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
-				}
-			}
-
-			found.ObjectMeta.Annotations = generatedApp.Annotations
-
-			found.ObjectMeta.Finalizers = generatedApp.Finalizers
-			found.ObjectMeta.Labels = generatedApp.Labels
+			preservedAnnotations, preservedLabels := preservedFields(applicationSet, r.GlobalPreservedAnnotations, r.GlobalPreservedLabels)
+			applyGeneratedApplication(found, generatedApp, preservedAnnotations, preservedLabels)
 
 			return controllerutil.SetControllerReference(&applicationSet, found, r.Scheme)
 		})
@@ -666,6 +623,79 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 	return firstError
 }
 
+// preservedFields returns the annotations and labels whose values are preserved when the applications of the
+// application set are updated.
+func preservedFields(applicationSet argov1alpha1.ApplicationSet, globalPreservedAnnotations []string, globalPreservedLabels []string) ([]string, []string) {
+	preservedAnnotations := make([]string, 0)
+	preservedLabels := make([]string, 0)
+
+	if applicationSet.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
+		preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
+	}
+
+	if len(globalPreservedAnnotations) > 0 {
+		preservedAnnotations = append(preservedAnnotations, globalPreservedAnnotations...)
+	}
+
+	if len(globalPreservedLabels) > 0 {
+		preservedLabels = append(preservedLabels, globalPreservedLabels...)
+	}
+
+	// Preserve specially treated argo cd annotations:
+	// * https://github.com/argoproj/applicationset/issues/180
+	// * https://github.com/argoproj/argo-cd/issues/10500
+	preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
+
+	return preservedAnnotations, preservedLabels
+}
+
+// applyGeneratedApplication copies the significant fields of the generated application into the found application,
+// keeping the values of the preserved annotations and labels, and the post-delete finalizers, of the found application.
+func applyGeneratedApplication(found *argov1alpha1.Application, generatedApp argov1alpha1.Application, preservedAnnotations []string, preservedLabels []string) {
+	// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
+	found.Spec = generatedApp.Spec
+
+	// allow setting the Operation field to trigger a sync operation on an Application
+	if generatedApp.Operation != nil {
+		found.Operation = generatedApp.Operation
+	}
+
+	for _, key := range preservedAnnotations {
+		if state, exists := found.ObjectMeta.Annotations[key]; exists {
+			if generatedApp.Annotations == nil {
+				generatedApp.Annotations = map[string]string{}
+			}
+			generatedApp.Annotations[key] = state
+		}
+	}
+
+	for _, key := range preservedLabels {
+		if state, exists := found.ObjectMeta.Labels[key]; exists {
+			if generatedApp.Labels == nil {
+				generatedApp.Labels = map[string]string{}
+			}
+			generatedApp.Labels[key] = state
+		}
+	}
+
+	// Preserve post-delete finalizers:
+	//   https://github.com/argoproj/argo-cd/issues/17181
+	for _, finalizer := range found.ObjectMeta.Finalizers {
+		if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
+			if generatedApp.Finalizers == nil {
+				generatedApp.Finalizers = []string{}
+			}
+			generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
+		}
+	}
+
+	found.ObjectMeta.Annotations = generatedApp.Annotations
+
+	found.ObjectMeta.Finalizers = generatedApp.Finalizers
+	found.ObjectMeta.Labels = generatedApp.Labels
+}
+
 // createInCluster will filter from the desiredApplications only the application that needs to be created
 // Then it will call createOrUpdateInCluster to do the actual create
 func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
@@ -846,12 +876,12 @@ func (r *ApplicationSetReconciler) removeOwnerReferencesOnDeleteAppSet(ctx conte
 	return nil
 }
 
-func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, error) {
+func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, time.Duration, error) {
 	appDependencyList, appStepMap := r.buildAppDependencyList(logCtx, appset, desiredApplications)
 
 	_, err := r.updateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset app status: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -859,17 +889,22 @@ func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context,
 		logCtx.Infof("step %v: %+v", i+1, step)
 	}
 
+	requeueAfter, err := r.updateApplicationSetRolloutStatus(ctx, logCtx, &appset, appDependencyList, appStepMap, appMap)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout status: %w", err)
+	}
+
 	appSyncMap := r.buildAppSyncMap(appset, appDependencyList, appMap)
 	logCtx.Infof("Application allowed to sync before maxUpdate?: %+v", appSyncMap)
 
 	_, err = r.updateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appSyncMap, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
 	_ = r.updateApplicationSetApplicationStatusConditions(ctx, &appset)
 
-	return appSyncMap, nil
+	return appSyncMap, requeueAfter, nil
 }
 
 // this list tracks which Applications belong to each RollingUpdate step
@@ -951,10 +986,19 @@ func (r *ApplicationSetReconciler) buildAppSyncMap(applicationSet argov1alpha1.A
 	// healthy stages and the first non-healthy stage should have sync enabled
 	// every stage after should have sync disabled
 
+	// an aborted rollout should have sync disabled for every stage until it is promoted, and a stage waiting for an
+	// approval or for the previous stage to soak should have sync disabled, as every stage after
+	halted := applicationSet.Status.Rollout != nil && applicationSet.Status.Rollout.Aborted
+	gatedStep, _, _ := rolloutGate(&applicationSet, appDependencyList, appMap, time.Now())
+
 	for i := range appDependencyList {
+		if i == gatedStep {
+			halted = true
+		}
+
 		// set the syncEnabled boolean for every Application in the current step
 		for _, appName := range appDependencyList[i] {
-			appSyncMap[appName] = syncEnabled
+			appSyncMap[appName] = syncEnabled && !halted
 		}
 
 		// detect if we need to halt before progressing to the next step
@@ -1006,6 +1050,15 @@ func isApplicationHealthy(app argov1alpha1.Application) bool {
 	return false
 }
 
+// lastDeploymentID returns the ID of the history entry of the last deployment of the Application, or 0 if it was never
+// deployed
+func lastDeploymentID(app argov1alpha1.Application) int64 {
+	if len(app.Status.History) == 0 {
+		return 0
+	}
+	return app.Status.History.LastRevisionHistory().ID
+}
+
 func statusStrings(app argov1alpha1.Application) (string, string, string) {
 	healthStatusString := string(app.Status.Health.Status)
 	syncStatusString := string(app.Status.Sync.Status)
@@ -1032,12 +1085,13 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 		if idx == -1 {
 			// AppStatus not found, set default status of "Waiting"
 			currentAppStatus = argov1alpha1.ApplicationSetApplicationStatus{
-				Application:        app.Name,
-				LastTransitionTime: &now,
-				Message:            "No Application status found, defaulting status to Waiting.",
-				Status:             "Waiting",
-				Step:               fmt.Sprint(appStepMap[app.Name] + 1),
-				TargetRevisions:    app.Status.GetRevisions(),
+				Application:          app.Name,
+				LastTransitionTime:   &now,
+				Message:              "No Application status found, defaulting status to Waiting.",
+				Status:               "Waiting",
+				Step:                 fmt.Sprint(appStepMap[app.Name] + 1),
+				TargetRevisions:      app.Status.GetRevisions(),
+				PreviousDeploymentID: lastDeploymentID(app),
 			}
 		} else {
 			// we have an existing AppStatus
@@ -1063,6 +1117,7 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 			currentAppStatus.Message = "Application has pending changes, setting status to Waiting."
 			currentAppStatus.Step = fmt.Sprint(appStepMap[currentAppStatus.Application] + 1)
 			currentAppStatus.TargetRevisions = app.Status.GetRevisions()
+			currentAppStatus.PreviousDeploymentID = lastDeploymentID(app)
 		}
 
 		if currentAppStatus.Status == "Pending" {
@@ -1237,6 +1292,384 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 	return applicationSet.Status.Conditions
 }
 
+// rolloutGate returns the first step of the RollingSync rollout which waits for an approval or for the previous step to
+// soak, or -1 if none does, with the reason it waits and how long the previous step still has to soak. The steps whose
+// Applications are all Healthy have nothing to roll out and are not gated, nor are the steps the rollout was promoted to.
+func rolloutGate(applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appMap map[string]argov1alpha1.Application, now time.Time) (int, string, time.Duration) {
+	if !progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
+		return -1, "", 0
+	}
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+
+	promotedStep := 0
+	if applicationSet.Status.Rollout != nil {
+		promotedStep = int(applicationSet.Status.Rollout.PromotedStep)
+	}
+
+	for i := range appDependencyList {
+		if i >= len(steps) {
+			break
+		}
+		if isStepHealthy(applicationSet, appDependencyList[i], appMap) {
+			continue
+		}
+		// the previous steps are all Healthy, this step is the one being rolled out
+		if i+1 <= promotedStep {
+			return -1, "", 0
+		}
+		if i > 0 && steps[i-1].SoakDuration != nil {
+			soakEnd := stepLastTransitionTime(applicationSet, appDependencyList[i-1]).Add(steps[i-1].SoakDuration.Duration)
+			if now.Before(soakEnd) {
+				return i, fmt.Sprintf("waiting until %s for the Applications of step %d to soak", soakEnd.UTC().Format(time.RFC3339), i), soakEnd.Sub(now)
+			}
+		}
+		if steps[i].RequireApproval {
+			return i, fmt.Sprintf("waiting for the approval of step %d", i+1), 0
+		}
+		return -1, "", 0
+	}
+	return -1, "", 0
+}
+
+// isStepHealthy returns true if the Applications of the step are all Healthy and have no pending changes
+func isStepHealthy(applicationSet *argov1alpha1.ApplicationSet, appNames []string, appMap map[string]argov1alpha1.Application) bool {
+	for _, appName := range appNames {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			return false
+		}
+		app, ok := appMap[appName]
+		if !ok || !appSyncEnabledForNextStep(applicationSet, app, applicationSet.Status.ApplicationStatus[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// stepLastTransitionTime returns the last time an Application of the step changed status, i.e. the time the step
+// became Healthy if all its Applications are Healthy
+func stepLastTransitionTime(applicationSet *argov1alpha1.ApplicationSet, appNames []string) time.Time {
+	var last time.Time
+	for _, appName := range appNames {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			continue
+		}
+		if t := applicationSet.Status.ApplicationStatus[idx].LastTransitionTime; t != nil && t.Time.After(last) {
+			last = t.Time
+		}
+	}
+	return last
+}
+
+// isApplicationFailed returns true if the Application is Degraded or its last sync failed
+func isApplicationFailed(app argov1alpha1.Application) bool {
+	healthStatusString, _, operationPhaseString := statusStrings(app)
+
+	return healthStatusString == "Degraded" || operationPhaseString == "Failed" || operationPhaseString == "Error"
+}
+
+// rolloutFailure returns the first step of the RollingSync rollout after the accepted failed step of which more
+// Applications failed than its failure threshold allows, or -1 if there is none, with a message describing the failure.
+func rolloutFailure(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appMap map[string]argov1alpha1.Application, acceptedFailedStep int) (int, string) {
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+
+	for i := acceptedFailedStep; i < len(appDependencyList); i++ {
+		if i >= len(steps) || steps[i].FailureThreshold == nil {
+			continue
+		}
+
+		failed := 0
+		for _, appName := range appDependencyList[i] {
+			idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+			app, ok := appMap[appName]
+			// only the Applications the rollout updates can fail it
+			if idx == -1 || !ok || applicationSet.Status.ApplicationStatus[idx].Status == "Waiting" {
+				continue
+			}
+			if isApplicationFailed(app) {
+				failed += 1
+			}
+		}
+
+		failureThreshold, err := intstr.GetScaledValueFromIntOrPercent(steps[i].FailureThreshold, len(appDependencyList[i]), false)
+		if err != nil {
+			logCtx.Warnf("AppSet '%v' has a invalid failureThreshold value '%+v', ignoring failureThreshold logic for this step: %v", applicationSet.Name, steps[i].FailureThreshold, err)
+			continue
+		}
+
+		if failed > failureThreshold {
+			return i, fmt.Sprintf("Rollout was aborted as %d/%d Applications of step %d failed, exceeding the failure threshold of %s.", failed, len(appDependencyList[i]), i+1, steps[i].FailureThreshold.String())
+		}
+	}
+	return -1, ""
+}
+
+// updateApplicationSetRolloutStatus promotes or aborts the RollingSync rollout as requested by the rollout annotation,
+// aborts the rollout once more Applications of a step failed than the failure threshold of the step allows, and
+// reflects the gates and the abort of the rollout in the Application statuses. It returns how long the rollout waits
+// for a step to soak, if it does.
+func (r *ApplicationSetReconciler) updateApplicationSetRolloutStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appStepMap map[string]int, appMap map[string]argov1alpha1.Application) (time.Duration, error) {
+	if !progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
+		return 0, nil
+	}
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+	now := metav1.Now()
+
+	previousRollout := applicationSet.Status.Rollout
+	rollout := argov1alpha1.ApplicationSetRolloutStatus{}
+	if previousRollout != nil {
+		rollout = *previousRollout
+	}
+
+	rolloutComplete := true
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Status != "Healthy" {
+			rolloutComplete = false
+			break
+		}
+	}
+	if rolloutComplete {
+		// the approvals given to and the abort of a complete rollout do not apply to the next one
+		rollout = argov1alpha1.ApplicationSetRolloutStatus{}
+	}
+
+	abortedNow := false
+	switch action := applicationSet.RolloutAction(); action {
+	case "":
+	case common.ApplicationSetRolloutActionPromote:
+		if rollout.Aborted {
+			logCtx.Infof("Resuming the aborted rollout of AppSet %v", applicationSet.Name)
+			rollout.Aborted = false
+			// the failures which aborted the rollout are accepted, they must not abort it again
+			for {
+				failedStep, _ := rolloutFailure(logCtx, applicationSet, appDependencyList, appMap, int(rollout.AcceptedFailedStep))
+				if failedStep < 0 {
+					break
+				}
+				rollout.AcceptedFailedStep = int64(failedStep + 1)
+			}
+		} else if gatedStep, _, _ := rolloutGate(applicationSet, appDependencyList, appMap, now.Time); gatedStep >= 0 {
+			logCtx.Infof("Promoting the rollout of AppSet %v to step %v", applicationSet.Name, gatedStep+1)
+			rollout.PromotedStep = int64(gatedStep + 1)
+		} else {
+			logCtx.Infof("Ignoring the promotion of the rollout of AppSet %v, which is neither paused nor aborted", applicationSet.Name)
+		}
+	case common.ApplicationSetRolloutActionAbort:
+		if !rollout.Aborted {
+			logCtx.Infof("Aborting the rollout of AppSet %v", applicationSet.Name)
+			rollout.Aborted = true
+			rollout.Message = "Rollout was aborted manually."
+			abortedNow = true
+		}
+	default:
+		logCtx.Warnf("AppSet '%v' has an invalid rollout action %q, ignoring it", applicationSet.Name, action)
+	}
+
+	if !rollout.Aborted {
+		if failedStep, message := rolloutFailure(logCtx, applicationSet, appDependencyList, appMap, int(rollout.AcceptedFailedStep)); failedStep >= 0 {
+			logCtx.Infof("Aborting the rollout of AppSet %v, step %v exceeded its failure threshold", applicationSet.Name, failedStep+1)
+			rollout.Aborted = true
+			rollout.Message = message
+			abortedNow = true
+		}
+	}
+
+	// the gates depend on the steps the rollout was promoted to
+	applicationSet.Status.Rollout = &rollout
+	gatedStep, gateReason, requeueAfter := -1, "", time.Duration(0)
+	if !rollout.Aborted {
+		gatedStep, gateReason, requeueAfter = rolloutGate(applicationSet, appDependencyList, appMap, now.Time)
+		rollout.Message = ""
+		if gatedStep >= 0 {
+			rollout.Message = fmt.Sprintf("Rollout is %s.", gateReason)
+		}
+	}
+	applicationSet.Status.Rollout = previousRollout
+
+	var newRollout *argov1alpha1.ApplicationSetRolloutStatus
+	if rollout != (argov1alpha1.ApplicationSetRolloutStatus{}) {
+		newRollout = &rollout
+	}
+	if !reflect.DeepEqual(previousRollout, newRollout) {
+		if err := r.setAppSetRolloutStatus(ctx, logCtx, applicationSet, newRollout); err != nil {
+			return 0, err
+		}
+	}
+
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		step := appStepMap[appStatus.Application]
+		updating := appStatus.Status == "Pending" || appStatus.Status == "Progressing"
+
+		switch {
+		case abortedNow && updating && step < len(steps) && steps[step].RollbackOnFailure:
+			revision, err := r.rollbackApplication(ctx, appMap[appStatus.Application], appStatus.PreviousDeploymentID)
+			if err != nil {
+				logCtx.Warnf("Failed to roll back Application %v: %v", appStatus.Application, err)
+				appStatus.Message = fmt.Sprintf("Application was not rolled back as the rollout was aborted: %v", err)
+			} else {
+				logCtx.Infof("Application %v rolled back to revision %v, updating its ApplicationSet status to Waiting", appStatus.Application, revision)
+				appStatus.Message = fmt.Sprintf("Application was rolled back to revision %s as the rollout was aborted.", revision)
+			}
+			appStatus.LastTransitionTime = &now
+			appStatus.Status = "Waiting"
+			appStatus.Step = fmt.Sprint(step + 1)
+		case rollout.Aborted && appStatus.Status == "Pending":
+			logCtx.Infof("Application %v is not updated as the rollout was aborted, updating its ApplicationSet status to Waiting", appStatus.Application)
+			appStatus.LastTransitionTime = &now
+			appStatus.Status = "Waiting"
+			appStatus.Message = "Application was not updated as the rollout was aborted."
+			appStatus.Step = fmt.Sprint(step + 1)
+		case rollout.Aborted && appStatus.Status == "Waiting":
+			if !strings.HasPrefix(appStatus.Message, "Application was ") {
+				appStatus.Message = "Application is waiting for the aborted rollout to be promoted."
+			}
+		case gatedStep >= 0 && step == gatedStep && appStatus.Status == "Waiting":
+			appStatus.Message = fmt.Sprintf("Application is %s.", gateReason)
+		case appStatus.Status == "Waiting" && strings.HasPrefix(appStatus.Message, "Application is waiting "):
+			// the gate the Application was waiting for is lifted
+			appStatus.Message = "Application has pending changes, setting status to Waiting."
+		}
+
+		appStatuses = append(appStatuses, appStatus)
+	}
+
+	err := r.setAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set AppSet application statuses: %w", err)
+	}
+
+	if applicationSet.RolloutAction() != "" {
+		if err := r.removeRolloutAnnotation(ctx, applicationSet); err != nil {
+			return 0, err
+		}
+	}
+
+	return requeueAfter, nil
+}
+
+// rollbackApplication syncs the Application to the deployment it was deployed to before the rollout updated it, as a
+// rollback does, and returns the revision of that deployment.
+func (r *ApplicationSetReconciler) rollbackApplication(ctx context.Context, application argov1alpha1.Application, previousDeploymentID int64) (string, error) {
+	if application.Name == "" {
+		return "", fmt.Errorf("application not found")
+	}
+	if application.Operation != nil {
+		return "", fmt.Errorf("another operation is already in progress")
+	}
+	if previousDeploymentID == 0 {
+		return "", fmt.Errorf("the application was not deployed before the rollout")
+	}
+
+	var deployment *argov1alpha1.RevisionHistory
+	for i := range application.Status.History {
+		if application.Status.History[i].ID == previousDeploymentID {
+			deployment = &application.Status.History[i]
+			break
+		}
+	}
+	if deployment == nil {
+		return "", fmt.Errorf("deployment %d not found in the history of the application", previousDeploymentID)
+	}
+
+	operation := argov1alpha1.Operation{
+		InitiatedBy: argov1alpha1.OperationInitiator{
+			Username:  "applicationset-controller",
+			Automated: true,
+		},
+		Info: []*argov1alpha1.Info{
+			{
+				Name:  "Reason",
+				Value: "ApplicationSet RollingSync rolled back this Application resource as the rollout was aborted.",
+			},
+		},
+		Sync: &argov1alpha1.SyncOperation{
+			Revision:     deployment.Revision,
+			Revisions:    deployment.Revisions,
+			SyncStrategy: &argov1alpha1.SyncStrategy{Apply: &argov1alpha1.SyncStrategyApply{}},
+			Source:       &deployment.Source,
+			Sources:      deployment.Sources,
+		},
+	}
+	if application.Spec.SyncPolicy != nil {
+		if application.Spec.SyncPolicy.Retry != nil {
+			operation.Retry = *application.Spec.SyncPolicy.Retry
+		}
+		operation.Sync.SyncOptions = application.Spec.SyncPolicy.SyncOptions
+	}
+
+	updated := application.DeepCopy()
+	updated.Operation = &operation
+	if err := r.Client.Patch(ctx, updated, client.MergeFrom(&application)); err != nil {
+		return "", fmt.Errorf("error setting the rollback operation: %w", err)
+	}
+
+	revision := deployment.Revision
+	if len(deployment.Revisions) > 0 {
+		revision = strings.Join(deployment.Revisions, ", ")
+	}
+	return revision, nil
+}
+
+// setAppSetRolloutStatus updates the rollout status of the ApplicationSet
+func (r *ApplicationSetReconciler) setAppSetRolloutStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, rollout *argov1alpha1.ApplicationSetRolloutStatus) error {
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.Rollout = rollout
+
+		// Update the newly fetched object with the new rollout status
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set rollout status: %v", err)
+		return fmt.Errorf("unable to set application set rollout status: %w", err)
+	}
+	return nil
+}
+
+// removeRolloutAnnotation removes the rollout annotation once the requested promotion or abort is handled
+func (r *ApplicationSetReconciler) removeRolloutAnnotation(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet) error {
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		delete(updatedAppset.Annotations, common.AnnotationApplicationSetRollout)
+
+		err := r.Client.Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove the rollout annotation of the application set: %w", err)
+	}
+	return nil
+}
+
 func findApplicationStatusIndex(appStatuses []argov1alpha1.ApplicationSetApplicationStatus, application string) int {
 	for i := range appStatuses {
 		if appStatuses[i].Application == application {
@@ -1524,7 +1957,8 @@ func shouldRequeueApplicationSet(appOld *argov1alpha1.Application, appNew *argov
 	}
 
 	// progressive syncs use the application status for updates. if they differ, requeue to trigger the next progression
-	if enableProgressiveSyncs {
+	// the status of applications generated for pull requests is posted back to them, requeue to post the new status
+	if enableProgressiveSyncs || hasPullRequestFeedback(appNew) {
 		if appOld.Status.Health.Status != appNew.Status.Health.Status || appOld.Status.Sync.Status != appNew.Status.Sync.Status {
 			return true
 		}
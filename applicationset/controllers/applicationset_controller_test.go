@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -36,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 
 	appsetmetrics "github.com/argoproj/argo-cd/v2/applicationset/metrics"
+	argocommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
@@ -4353,6 +4355,124 @@ func TestBuildAppSyncMap(t *testing.T) {
 				"app9": false,
 			},
 		},
+		{
+			name: "does not sync applications of an aborted rollout",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type:        "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					Rollout: &v1alpha1.ApplicationSetRolloutStatus{
+						Aborted: true,
+					},
+				},
+			},
+			appDependencyList: [][]string{
+				{"app1"},
+				{"app2"},
+			},
+			expectedMap: map[string]bool{
+				"app1": false,
+				"app2": false,
+			},
+		},
+		{
+			name: "does not sync applications from a step waiting for an approval onwards",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{
+								{},
+								{
+									RequireApproval: true,
+								},
+								{},
+							},
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+						{
+							Application: "app1",
+							Status:      "Healthy",
+						},
+						{
+							Application: "app2",
+							Status:      "Waiting",
+						},
+						{
+							Application: "app3",
+							Status:      "Healthy",
+						},
+					},
+				},
+			},
+			appMap: map[string]v1alpha1.Application{
+				"app1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app1",
+					},
+					Status: v1alpha1.ApplicationStatus{
+						Health: v1alpha1.HealthStatus{
+							Status: health.HealthStatusHealthy,
+						},
+						Sync: v1alpha1.SyncStatus{
+							Status: v1alpha1.SyncStatusCodeSynced,
+						},
+					},
+				},
+				"app2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app2",
+					},
+					Status: v1alpha1.ApplicationStatus{
+						Health: v1alpha1.HealthStatus{
+							Status: health.HealthStatusHealthy,
+						},
+						Sync: v1alpha1.SyncStatus{
+							Status: v1alpha1.SyncStatusCodeOutOfSync,
+						},
+					},
+				},
+				"app3": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app3",
+					},
+					Status: v1alpha1.ApplicationStatus{
+						Health: v1alpha1.HealthStatus{
+							Status: health.HealthStatusHealthy,
+						},
+						Sync: v1alpha1.SyncStatus{
+							Status: v1alpha1.SyncStatusCodeSynced,
+						},
+					},
+				},
+			},
+			appDependencyList: [][]string{
+				{"app1"},
+				{"app2"},
+				{"app3"},
+			},
+			expectedMap: map[string]bool{
+				"app1": true,
+				"app2": false,
+				"app3": false,
+			},
+		},
 	} {
 		t.Run(cc.name, func(t *testing.T) {
 			kubeclientset := kubefake.NewSimpleClientset([]runtime.Object{}...)
@@ -4587,6 +4707,10 @@ func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 							Status:   v1alpha1.SyncStatusCodeOutOfSync,
 							Revision: "Next",
 						},
+						History: v1alpha1.RevisionHistories{
+							{ID: 1, Revision: "Older"},
+							{ID: 2, Revision: "Previous"},
+						},
 					},
 				},
 				{
@@ -4603,11 +4727,12 @@ func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 			},
 			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
 				{
-					Application:     "app1",
-					Message:         "Application has pending changes, setting status to Waiting.",
-					Status:          "Waiting",
-					Step:            "1",
-					TargetRevisions: []string{"Next"},
+					Application:          "app1",
+					Message:              "Application has pending changes, setting status to Waiting.",
+					Status:               "Waiting",
+					Step:                 "1",
+					TargetRevisions:      []string{"Next"},
+					PreviousDeploymentID: 2,
 				},
 				{
 					Application:     "app2-multisource",
@@ -5927,6 +6052,325 @@ func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 	}
 }
 
+func TestRolloutGate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	minutesAgo := func(minutes int) *metav1.Time {
+		t := metav1.NewTime(now.Add(-time.Duration(minutes) * time.Minute))
+		return &t
+	}
+	healthyApp := v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+		Health: v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+		Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+	}}
+	outOfSyncApp := v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+		Health: v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+		Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+	}}
+	appMap := map[string]v1alpha1.Application{"app1": healthyApp, "app2": outOfSyncApp}
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+	newAppSet := func(steps []v1alpha1.ApplicationSetRolloutStep, rollout *v1alpha1.ApplicationSetRolloutStatus, app1Status string, app1TransitionTime *metav1.Time) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:        "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{Steps: steps},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: app1Status, LastTransitionTime: app1TransitionTime, Step: "1"},
+					{Application: "app2", Status: "Waiting", LastTransitionTime: minutesAgo(30), Step: "2"},
+				},
+				Rollout: rollout,
+			},
+		}
+	}
+	soak := &metav1.Duration{Duration: 10 * time.Minute}
+
+	for _, cc := range []struct {
+		name                 string
+		appSet               v1alpha1.ApplicationSet
+		expectedStep         int
+		expectedReason       string
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:           "waits for the approval of the first step",
+			appSet:         newAppSet([]v1alpha1.ApplicationSetRolloutStep{{RequireApproval: true}, {}}, nil, "Waiting", minutesAgo(30)),
+			expectedStep:   0,
+			expectedReason: "waiting for the approval of step 1",
+		},
+		{
+			name:         "does not gate a promoted step",
+			appSet:       newAppSet([]v1alpha1.ApplicationSetRolloutStep{{RequireApproval: true}, {}}, &v1alpha1.ApplicationSetRolloutStatus{PromotedStep: 1}, "Waiting", minutesAgo(30)),
+			expectedStep: -1,
+		},
+		{
+			name:         "does not gate a healthy step",
+			appSet:       newAppSet([]v1alpha1.ApplicationSetRolloutStep{{RequireApproval: true}, {}}, nil, "Healthy", minutesAgo(30)),
+			expectedStep: -1,
+		},
+		{
+			name:                 "waits for the previous step to soak",
+			appSet:               newAppSet([]v1alpha1.ApplicationSetRolloutStep{{SoakDuration: soak}, {}}, nil, "Healthy", minutesAgo(4)),
+			expectedStep:         1,
+			expectedReason:       "waiting until 2024-01-01T12:06:00Z for the Applications of step 1 to soak",
+			expectedRequeueAfter: 6 * time.Minute,
+		},
+		{
+			name:         "does not gate a step once the previous step soaked",
+			appSet:       newAppSet([]v1alpha1.ApplicationSetRolloutStep{{SoakDuration: soak}, {}}, nil, "Healthy", minutesAgo(11)),
+			expectedStep: -1,
+		},
+		{
+			name:           "waits for the approval of a step once the previous step soaked",
+			appSet:         newAppSet([]v1alpha1.ApplicationSetRolloutStep{{SoakDuration: soak}, {RequireApproval: true}}, nil, "Healthy", minutesAgo(11)),
+			expectedStep:   1,
+			expectedReason: "waiting for the approval of step 2",
+		},
+		{
+			name:         "does not gate a step before the previous step is healthy",
+			appSet:       newAppSet([]v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}}, nil, "Progressing", minutesAgo(30)),
+			expectedStep: -1,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			step, reason, requeueAfter := rolloutGate(&cc.appSet, appDependencyList, appMap, now)
+
+			assert.Equal(t, cc.expectedStep, step)
+			assert.Equal(t, cc.expectedReason, reason)
+			assert.Equal(t, cc.expectedRequeueAfter, requeueAfter)
+		})
+	}
+}
+
+func TestUpdateApplicationSetRolloutStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	failureThreshold := intstr.FromInt(0)
+	newApp := func(name string, healthStatus health.HealthStatusCode, syncStatus v1alpha1.SyncStatusCode) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: name, TargetRevision: "HEAD"},
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.HealthStatus{Status: healthStatus},
+				Sync:   v1alpha1.SyncStatus{Status: syncStatus, Revision: "new"},
+				History: v1alpha1.RevisionHistories{
+					{ID: 1, Revision: "old", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: name, TargetRevision: "HEAD"}},
+					{ID: 2, Revision: "new", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: name, TargetRevision: "HEAD"}},
+					{ID: 3, Revision: "unrelated", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: name, TargetRevision: "HEAD"}},
+				},
+			},
+		}
+	}
+	newAppSet := func(annotation string, steps []v1alpha1.ApplicationSetRolloutStep, rollout *v1alpha1.ApplicationSetRolloutStatus, appStatuses ...v1alpha1.ApplicationSetApplicationStatus) v1alpha1.ApplicationSet {
+		appSet := v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:        "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{Steps: steps},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: appStatuses, Rollout: rollout},
+		}
+		if annotation != "" {
+			appSet.Annotations = map[string]string{argocommon.AnnotationApplicationSetRollout: annotation}
+		}
+		return appSet
+	}
+	appStatus := func(name string, status string, message string, step string) v1alpha1.ApplicationSetApplicationStatus {
+		return v1alpha1.ApplicationSetApplicationStatus{Application: name, Status: status, Message: message, Step: step, TargetRevisions: []string{"new"}, PreviousDeploymentID: 1}
+	}
+	appStepMap := map[string]int{"app1": 0, "app2": 1}
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+
+	for _, cc := range []struct {
+		name                 string
+		appSet               v1alpha1.ApplicationSet
+		apps                 []v1alpha1.Application
+		expectedRollout      *v1alpha1.ApplicationSetRolloutStatus
+		expectedAppStatus    []v1alpha1.ApplicationSetApplicationStatus
+		expectedRollbackApps []string
+	}{
+		{
+			name: "aborts the rollout once a step exceeds its failure threshold, and rolls back its updated applications",
+			appSet: newAppSet("", []v1alpha1.ApplicationSetRolloutStep{{FailureThreshold: &failureThreshold, RollbackOnFailure: true}, {}}, nil,
+				appStatus("app1", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{
+				Aborted: true,
+				Message: "Rollout was aborted as 1/1 Applications of step 1 failed, exceeding the failure threshold of 0.",
+			},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Waiting", "Application was rolled back to revision old as the rollout was aborted.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the aborted rollout to be promoted.", "2"),
+			},
+			expectedRollbackApps: []string{"app1"},
+		},
+		{
+			name: "does not abort the rollout within the failure threshold",
+			appSet: newAppSet("", []v1alpha1.ApplicationSetRolloutStep{{FailureThreshold: &failureThreshold}, {}}, nil,
+				appStatus("app1", "Pending", "Application moved to Pending status, watching for the Application resource to start Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync), newApp("app2", health.HealthStatusDegraded, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Pending", "Application moved to Pending status, watching for the Application resource to start Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			},
+		},
+		{
+			name: "aborts the rollout as requested",
+			appSet: newAppSet(argocommon.ApplicationSetRolloutActionAbort, []v1alpha1.ApplicationSetRolloutStep{{}, {}}, nil,
+				appStatus("app1", "Pending", "Application moved to Pending status, watching for the Application resource to start Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{
+				Aborted: true,
+				Message: "Rollout was aborted manually.",
+			},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Waiting", "Application was not updated as the rollout was aborted.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the aborted rollout to be promoted.", "2"),
+			},
+		},
+		{
+			name: "resumes an aborted rollout once promoted",
+			appSet: newAppSet(argocommon.ApplicationSetRolloutActionPromote, []v1alpha1.ApplicationSetRolloutStep{{}, {}}, &v1alpha1.ApplicationSetRolloutStatus{Aborted: true, Message: "Rollout was aborted manually."},
+				appStatus("app1", "Waiting", "Application was not updated as the rollout was aborted.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the aborted rollout to be promoted.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Waiting", "Application was not updated as the rollout was aborted.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			},
+		},
+		{
+			name: "does not abort the rollout again for the failures accepted by promoting it",
+			appSet: newAppSet(argocommon.ApplicationSetRolloutActionPromote, []v1alpha1.ApplicationSetRolloutStep{{FailureThreshold: &failureThreshold}, {}},
+				&v1alpha1.ApplicationSetRolloutStatus{Aborted: true, Message: "Rollout was aborted as 1/1 Applications of step 1 failed, exceeding the failure threshold of 0."},
+				appStatus("app1", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the aborted rollout to be promoted.", "2"),
+			),
+			apps:            []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{AcceptedFailedStep: 1},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			},
+		},
+		{
+			name: "aborts the rollout once a step after the accepted failed step exceeds its failure threshold",
+			appSet: newAppSet("", []v1alpha1.ApplicationSetRolloutStep{{FailureThreshold: &failureThreshold}, {FailureThreshold: &failureThreshold}}, &v1alpha1.ApplicationSetRolloutStatus{AcceptedFailedStep: 1},
+				appStatus("app1", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "1"),
+				appStatus("app2", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{
+				Aborted:            true,
+				Message:            "Rollout was aborted as 1/1 Applications of step 2 failed, exceeding the failure threshold of 0.",
+				AcceptedFailedStep: 1,
+			},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "1"),
+				appStatus("app2", "Progressing", "Application resource became Progressing, updating status from Pending to Progressing.", "2"),
+			},
+		},
+		{
+			name: "reflects the step waiting for an approval",
+			appSet: newAppSet("", []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}}, nil,
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			),
+			apps:            []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{Message: "Rollout is waiting for the approval of step 2."},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the approval of step 2.", "2"),
+			},
+		},
+		{
+			name: "approves the step waiting for an approval once promoted",
+			appSet: newAppSet(argocommon.ApplicationSetRolloutActionPromote, []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}}, &v1alpha1.ApplicationSetRolloutStatus{Message: "Rollout is waiting for the approval of step 2."},
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Waiting", "Application is waiting for the approval of step 2.", "2"),
+			),
+			apps:            []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync)},
+			expectedRollout: &v1alpha1.ApplicationSetRolloutStatus{PromotedStep: 2},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Waiting", "Application has pending changes, setting status to Waiting.", "2"),
+			},
+		},
+		{
+			name: "resets the promotions once the rollout completes",
+			appSet: newAppSet("", []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}}, &v1alpha1.ApplicationSetRolloutStatus{PromotedStep: 2},
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "2"),
+			),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced), newApp("app2", health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced)},
+			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				appStatus("app1", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "1"),
+				appStatus("app2", "Healthy", "Application resource became Healthy, updating status from Progressing to Healthy.", "2"),
+			},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			objects := []crtclient.Object{&cc.appSet}
+			appMap := map[string]v1alpha1.Application{}
+			for i := range cc.apps {
+				objects = append(objects, &cc.apps[i])
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&cc.appSet).Build()
+			for _, app := range cc.apps {
+				appMap[app.Name] = app
+			}
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(1),
+				Metrics:  appsetmetrics.NewFakeAppsetMetrics(client),
+			}
+
+			_, err := r.updateApplicationSetRolloutStatus(context.TODO(), log.NewEntry(log.StandardLogger()), &cc.appSet, appDependencyList, appStepMap, appMap)
+			require.NoError(t, err)
+
+			// opt out of testing the LastTransitionTime is accurate
+			for i := range cc.appSet.Status.ApplicationStatus {
+				cc.appSet.Status.ApplicationStatus[i].LastTransitionTime = nil
+			}
+			assert.Equal(t, cc.expectedRollout, cc.appSet.Status.Rollout)
+			assert.Equal(t, cc.expectedAppStatus, cc.appSet.Status.ApplicationStatus)
+
+			updatedAppSet := &v1alpha1.ApplicationSet{}
+			require.NoError(t, client.Get(context.TODO(), crtclient.ObjectKeyFromObject(&cc.appSet), updatedAppSet))
+			assert.Empty(t, updatedAppSet.RolloutAction(), "the rollout annotation is removed once handled")
+			assert.Equal(t, cc.expectedRollout, updatedAppSet.Status.Rollout)
+
+			for _, app := range cc.apps {
+				updatedApp := &v1alpha1.Application{}
+				require.NoError(t, client.Get(context.TODO(), crtclient.ObjectKeyFromObject(&app), updatedApp))
+				if slices.Contains(cc.expectedRollbackApps, app.Name) {
+					require.NotNil(t, updatedApp.Operation, app.Name)
+					assert.Equal(t, "old", updatedApp.Operation.Sync.Revision)
+				} else {
+					assert.Nil(t, updatedApp.Operation, app.Name)
+				}
+			}
+		})
+	}
+}
+
 func TestUpdateResourceStatus(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -6329,6 +6773,36 @@ func TestOwnsHandler(t *testing.T) {
 			},
 			enableProgressiveSyncs: true,
 		}, want: true},
+		{name: "ApplicationHealthStatusDiffWithoutProgressiveSyncs", args: args{e: event.UpdateEvent{
+			ObjectOld: &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.HealthStatus{
+					Status: "Unknown",
+				},
+			}},
+			ObjectNew: &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.HealthStatus{
+					Status: "Healthy",
+				},
+			}},
+		}}, want: false},
+		{name: "PullRequestApplicationHealthStatusDiff", args: args{e: event.UpdateEvent{
+			ObjectOld: &v1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{argocommon.AnnotationApplicationSetPullRequest: `{"number":1}`}},
+				Status: v1alpha1.ApplicationStatus{
+					Health: v1alpha1.HealthStatus{
+						Status: "Unknown",
+					},
+				},
+			},
+			ObjectNew: &v1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{argocommon.AnnotationApplicationSetPullRequest: `{"number":1}`}},
+				Status: v1alpha1.ApplicationStatus{
+					Health: v1alpha1.HealthStatus{
+						Status: "Healthy",
+					},
+				},
+			},
+		}}, want: true},
 		{name: "SameApplicationGeneration", args: args{e: event.UpdateEvent{
 			ObjectOld: &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
 				Generation: 1,
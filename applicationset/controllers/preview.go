@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
+)
+
+// previewScheme resolves the kind of the application set owning the previewed applications
+var previewScheme = func() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = argov1alpha1.AddToScheme(scheme)
+	return scheme
+}()
+
+// ApplicationChangeAction is the action the reconciliation of an application set takes on an application
+type ApplicationChangeAction string
+
+const (
+	ApplicationChangeActionCreate ApplicationChangeAction = "create"
+	ApplicationChangeActionUpdate ApplicationChangeAction = "update"
+	ApplicationChangeActionDelete ApplicationChangeAction = "delete"
+	ApplicationChangeActionNone   ApplicationChangeAction = "none"
+)
+
+// ApplicationChange is the change the reconciliation of an application set makes to an application
+type ApplicationChange struct {
+	Name   string
+	Action ApplicationChangeAction
+	// SkipReason is the reason the reconciliation does not take the action, if it does not
+	SkipReason string
+	// Desired is the application after the reconciliation, nil if it is deleted
+	Desired *argov1alpha1.Application
+	// Live is the application before the reconciliation, nil if it is created
+	Live *argov1alpha1.Application
+	// Diffs are the fields which differ between the live and the desired application
+	Diffs []FieldDiff
+}
+
+// FieldDiff is a field which differs between the live and the desired application
+type FieldDiff struct {
+	// Path is the path of the field, e.g. spec.source.targetRevision
+	Path string
+	// Live is the live value of the field, nil if the field is added
+	Live interface{}
+	// Desired is the desired value of the field, nil if the field is removed
+	Desired interface{}
+}
+
+// PreviewApplicationChanges returns the changes the reconciliation of the application set would make to the existing
+// applications of its namespace, without making them: the desired applications are created, updated and deleted as
+// createOrUpdateInCluster, createInCluster and deleteInCluster do under the given policy. The desired applications
+// which failed validation are neither created nor updated.
+func PreviewApplicationChanges(applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, validationErrors map[int]error, existingApplications []argov1alpha1.Application, policy argov1alpha1.ApplicationsSyncPolicy, globalPreservedAnnotations []string, globalPreservedLabels []string) ([]ApplicationChange, error) {
+	existing := map[string]*argov1alpha1.Application{}
+	var current []argov1alpha1.Application
+	for i := range existingApplications {
+		app := &existingApplications[i]
+		existing[app.Name] = app
+		if isControlledBy(app, applicationSet.Name) {
+			current = append(current, *app)
+		}
+	}
+	currentNames := map[string]bool{}
+	for _, app := range current {
+		currentNames[app.Name] = true
+	}
+	preservedAnnotations, preservedLabels := preservedFields(applicationSet, globalPreservedAnnotations, globalPreservedLabels)
+
+	var changes []ApplicationChange
+	desiredNames := map[string]bool{}
+	for i, generatedApp := range desiredApplications {
+		desiredNames[generatedApp.Name] = true
+		if err, invalid := validationErrors[i]; invalid {
+			changes = append(changes, ApplicationChange{
+				Name:       generatedApp.Name,
+				Action:     ApplicationChangeActionNone,
+				SkipReason: err.Error(),
+				Live:       existing[generatedApp.Name],
+			})
+			continue
+		}
+
+		change, err := previewCreateOrUpdate(applicationSet, generatedApp, existing[generatedApp.Name], preservedAnnotations, preservedLabels)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing application %s: %w", generatedApp.Name, err)
+		}
+		// createInCluster leaves the current applications alone
+		if !policy.AllowUpdate() && currentNames[generatedApp.Name] && change.Action == ApplicationChangeActionUpdate {
+			change.SkipReason = fmt.Sprintf("the applications sync policy %s does not allow updates", policy)
+		}
+		changes = append(changes, change)
+	}
+
+	for i := range current {
+		app := current[i]
+		if desiredNames[app.Name] {
+			continue
+		}
+		change := ApplicationChange{
+			Name:   app.Name,
+			Action: ApplicationChangeActionDelete,
+			Live:   &app,
+		}
+		if !policy.AllowDelete() {
+			change.SkipReason = fmt.Sprintf("the applications sync policy %s does not allow deletions", policy)
+		}
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes, nil
+}
+
+// previewCreateOrUpdate returns the change utils.CreateOrUpdate would make to the live application, if any
+func previewCreateOrUpdate(applicationSet argov1alpha1.ApplicationSet, generatedApp argov1alpha1.Application, live *argov1alpha1.Application, preservedAnnotations []string, preservedLabels []string) (ApplicationChange, error) {
+	// Normalize to avoid fighting with the application controller.
+	generatedApp.Spec = *argoutil.NormalizeApplicationSpec(&generatedApp.Spec)
+
+	found := &argov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatedApp.Name,
+			Namespace: generatedApp.Namespace,
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       application.ApplicationKind,
+			APIVersion: "argoproj.io/v1alpha1",
+		},
+	}
+	if live == nil {
+		applyGeneratedApplication(found, generatedApp, preservedAnnotations, preservedLabels)
+		if err := controllerutil.SetControllerReference(&applicationSet, found, previewScheme); err != nil {
+			return ApplicationChange{}, err
+		}
+		return ApplicationChange{Name: generatedApp.Name, Action: ApplicationChangeActionCreate, Desired: found}, nil
+	}
+
+	typeMeta := found.TypeMeta
+	live.DeepCopyInto(found)
+	// the ignoreApplicationDifferences rules match the kind of the applications, which listed applications lack
+	found.TypeMeta = typeMeta
+	// Compare copies, as the ignored fields are removed from the compared applications.
+	normalizedLive := found.DeepCopy()
+	applyGeneratedApplication(found, generatedApp, preservedAnnotations, preservedLabels)
+	if err := controllerutil.SetControllerReference(&applicationSet, found, previewScheme); err != nil {
+		// the application is controlled by another object, the update fails
+		return ApplicationChange{Name: generatedApp.Name, Action: ApplicationChangeActionUpdate, SkipReason: err.Error(), Live: live}, nil
+	}
+	normalizedDesired := found.DeepCopy()
+	equal, err := utils.NormalizeAndCompare(applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, normalizedLive, normalizedDesired)
+	if err != nil {
+		return ApplicationChange{}, err
+	}
+	change := ApplicationChange{Name: generatedApp.Name, Action: ApplicationChangeActionNone, Desired: live.DeepCopy(), Live: live}
+	if equal {
+		return change, nil
+	}
+
+	// Patch the live application as utils.CreateOrUpdate does, so that it keeps the live values of the ignored fields.
+	patch, err := client.MergeFrom(normalizedLive).Data(normalizedDesired)
+	if err != nil {
+		return ApplicationChange{}, fmt.Errorf("error computing the patch: %w", err)
+	}
+	liveJSON, err := json.Marshal(live)
+	if err != nil {
+		return ApplicationChange{}, fmt.Errorf("error marshaling live application: %w", err)
+	}
+	patchedJSON, err := jsonpatch.MergePatch(liveJSON, patch)
+	if err != nil {
+		return ApplicationChange{}, fmt.Errorf("error applying the patch: %w", err)
+	}
+	desired := &argov1alpha1.Application{}
+	if err := json.Unmarshal(patchedJSON, desired); err != nil {
+		return ApplicationChange{}, fmt.Errorf("error unmarshaling patched application: %w", err)
+	}
+	change.Action = ApplicationChangeActionUpdate
+	change.Desired = desired
+	change.Diffs, err = diffApplications(normalizedLive, normalizedDesired)
+	if err != nil {
+		return ApplicationChange{}, err
+	}
+	return change, nil
+}
+
+// isControlledBy returns true if the application is controlled by the application set of the given name, as the
+// .metadata.controller index of getCurrentApplications does.
+func isControlledBy(app *argov1alpha1.Application, applicationSetName string) bool {
+	owners := appControllerIndexer(app)
+	return len(owners) == 1 && owners[0] == applicationSetName
+}
+
+// diffApplications returns the leaf fields which differ between the live and the desired application
+func diffApplications(live *argov1alpha1.Application, desired *argov1alpha1.Application) ([]FieldDiff, error) {
+	liveObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert live application to unstructured: %w", err)
+	}
+	desiredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert desired application to unstructured: %w", err)
+	}
+	var diffs []FieldDiff
+	diffValues("", liveObj, desiredObj, &diffs)
+	return diffs, nil
+}
+
+func diffValues(path string, live, desired interface{}, diffs *[]FieldDiff) {
+	liveMap, liveIsMap := live.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if liveIsMap && desiredIsMap {
+		keys := map[string]bool{}
+		for k := range liveMap {
+			keys[k] = true
+		}
+		for k := range desiredMap {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		for _, k := range sortedKeys {
+			diffValues(joinPath(path, k), liveMap[k], desiredMap[k], diffs)
+		}
+		return
+	}
+
+	liveList, liveIsList := live.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+	if liveIsList && desiredIsList && len(liveList) == len(desiredList) {
+		for i := range liveList {
+			diffValues(path+"["+strconv.Itoa(i)+"]", liveList[i], desiredList[i], diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(live, desired) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Live: live, Desired: desired})
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	// keys such as annotation names may contain dots
+	for _, c := range key {
+		if c == '.' || c == '/' || c == '[' {
+			quoted, _ := json.Marshal(key)
+			return path + "[" + string(quoted) + "]"
+		}
+	}
+	return path + "." + key
+}
@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestPreviewApplicationChanges(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace", UID: "appset-uid"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			PreservedFields: &v1alpha1.ApplicationPreservedFields{Labels: []string{"preserved"}},
+		},
+	}
+	isController := true
+	ownerReferences := []metav1.OwnerReference{{
+		APIVersion:         "argoproj.io/v1alpha1",
+		Kind:               "ApplicationSet",
+		Name:               "name",
+		UID:                "appset-uid",
+		Controller:         &isController,
+		BlockOwnerDeletion: &isController,
+	}}
+	newApp := func(name string, revision string, labels map[string]string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "namespace", Labels: labels},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "default",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: name, TargetRevision: revision},
+				Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: name},
+			},
+		}
+	}
+	owned := func(app v1alpha1.Application) v1alpha1.Application {
+		app.OwnerReferences = ownerReferences
+		return app
+	}
+
+	existing := []v1alpha1.Application{
+		owned(newApp("unchanged", "HEAD", map[string]string{"preserved": "live"})),
+		owned(newApp("updated", "HEAD", nil)),
+		owned(newApp("removed", "HEAD", nil)),
+		owned(newApp("invalid", "HEAD", nil)),
+		newApp("adopted", "HEAD", nil),
+		newApp("unrelated", "HEAD", nil),
+	}
+	desired := []v1alpha1.Application{
+		newApp("unchanged", "HEAD", nil),
+		newApp("updated", "v1.0.0", map[string]string{"team": "a"}),
+		newApp("invalid", "v1.0.0", nil),
+		newApp("adopted", "HEAD", nil),
+		newApp("created", "HEAD", nil),
+	}
+	validationErrors := map[int]error{2: fmt.Errorf("application destination spec is invalid")}
+
+	t.Run("sync policy", func(t *testing.T) {
+		changes, err := PreviewApplicationChanges(appSet, desired, validationErrors, existing, v1alpha1.ApplicationsSyncPolicySync, nil, nil)
+		require.NoError(t, err)
+
+		actions := map[string]ApplicationChangeAction{}
+		for _, change := range changes {
+			actions[change.Name] = change.Action
+		}
+		assert.Equal(t, map[string]ApplicationChangeAction{
+			"adopted":   ApplicationChangeActionUpdate,
+			"created":   ApplicationChangeActionCreate,
+			"invalid":   ApplicationChangeActionNone,
+			"removed":   ApplicationChangeActionDelete,
+			"unchanged": ApplicationChangeActionNone,
+			"updated":   ApplicationChangeActionUpdate,
+		}, actions)
+
+		byName := map[string]ApplicationChange{}
+		for _, change := range changes {
+			byName[change.Name] = change
+		}
+		assert.Equal(t, "application destination spec is invalid", byName["invalid"].SkipReason)
+		assert.Equal(t, map[string]string{"preserved": "live"}, byName["unchanged"].Desired.Labels)
+		assert.Equal(t, ownerReferences, byName["created"].Desired.OwnerReferences)
+		assert.Equal(t, ownerReferences, byName["adopted"].Desired.OwnerReferences)
+		assert.Equal(t, "v1.0.0", byName["updated"].Desired.Spec.Source.TargetRevision)
+		assert.Equal(t, []FieldDiff{
+			{Path: "metadata.labels", Desired: map[string]interface{}{"team": "a"}},
+			{Path: "spec.source.targetRevision", Live: "HEAD", Desired: "v1.0.0"},
+		}, byName["updated"].Diffs)
+		for _, change := range changes {
+			if change.Name != "invalid" {
+				assert.Empty(t, change.SkipReason, change.Name)
+			}
+		}
+	})
+
+	t.Run("create-only policy", func(t *testing.T) {
+		changes, err := PreviewApplicationChanges(appSet, desired, validationErrors, existing, v1alpha1.ApplicationsSyncPolicyCreateOnly, nil, nil)
+		require.NoError(t, err)
+
+		skipped := map[string]string{}
+		for _, change := range changes {
+			if change.SkipReason != "" {
+				skipped[change.Name] = change.SkipReason
+			}
+		}
+		assert.Equal(t, map[string]string{
+			"invalid": "application destination spec is invalid",
+			"updated": "the applications sync policy create-only does not allow updates",
+			"removed": "the applications sync policy create-only does not allow deletions",
+		}, skipped)
+	})
+
+	t.Run("ignored differences", func(t *testing.T) {
+		ignoringAppSet := appSet.DeepCopy()
+		ignoringAppSet.Spec.IgnoreApplicationDifferences = v1alpha1.ApplicationSetIgnoreDifferences{
+			{JSONPointers: []string{"/spec/source/targetRevision"}},
+		}
+		changes, err := PreviewApplicationChanges(*ignoringAppSet, desired[1:2], nil, existing[1:2], v1alpha1.ApplicationsSyncPolicySync, nil, nil)
+		require.NoError(t, err)
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, ApplicationChangeActionUpdate, changes[0].Action)
+		assert.Equal(t, []FieldDiff{{Path: "metadata.labels", Desired: map[string]interface{}{"team": "a"}}}, changes[0].Diffs)
+		assert.Equal(t, map[string]string{"team": "a"}, changes[0].Desired.Labels)
+		assert.Equal(t, "HEAD", changes[0].Desired.Spec.Source.TargetRevision, "the application keeps the live values of the ignored fields")
+	})
+
+	t.Run("application controlled by another application set", func(t *testing.T) {
+		other := owned(newApp("updated", "HEAD", nil))
+		other.OwnerReferences = []metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "ApplicationSet", Name: "other", UID: "other-uid", Controller: &isController}}
+		changes, err := PreviewApplicationChanges(appSet, desired[1:2], nil, []v1alpha1.Application{other}, v1alpha1.ApplicationsSyncPolicySync, nil, nil)
+		require.NoError(t, err)
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, ApplicationChangeActionUpdate, changes[0].Action)
+		assert.Contains(t, changes[0].SkipReason, "is already owned by another ApplicationSet controller other")
+	})
+}
+
+func TestDiffValues(t *testing.T) {
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{"example.com/team": "a"}},
+		"spec":     map[string]interface{}{"list": []interface{}{"a", "b"}, "removed": "x", "short": []interface{}{"a"}},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{"example.com/team": "b"}},
+		"spec":     map[string]interface{}{"list": []interface{}{"a", "c"}, "added": "y", "short": []interface{}{"a", "b"}},
+	}
+
+	var diffs []FieldDiff
+	diffValues("", live, desired, &diffs)
+
+	assert.Equal(t, []FieldDiff{
+		{Path: `metadata.annotations["example.com/team"]`, Live: "a", Desired: "b"},
+		{Path: "spec.added", Desired: "y"},
+		{Path: "spec.list[1]", Live: "b", Desired: "c"},
+		{Path: "spec.removed", Live: "x"},
+		{Path: "spec.short", Live: []interface{}{"a"}, Desired: []interface{}{"a", "b"}},
+	}, diffs)
+}
@@ -0,0 +1,353 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	argodiff "github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/generators"
+	"github.com/argoproj/argo-cd/v2/applicationset/services"
+	pullrequest "github.com/argoproj/argo-cd/v2/applicationset/services/pull_request"
+	argocommon "github.com/argoproj/argo-cd/v2/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// PullRequestFeedback posts the sync and health status of the applications generated by pull request generators,
+// together with a summary of their manifest changes against the target branch, back to their pull requests.
+type PullRequestFeedback struct {
+	manifests   services.Manifests
+	settingsMgr *settings.SettingsManager
+
+	lock sync.Mutex
+	// posted holds the feedback last posted for the applications of every application set, keyed by application set
+	// and application
+	posted map[string]map[string]*postedFeedback
+}
+
+// postedFeedback is the feedback last posted for an application
+type postedFeedback struct {
+	// hash is the hash of the posted feedback, used to avoid posting the same feedback repeatedly
+	hash string
+	// diffKey identifies the revisions the manifest changes were computed for
+	diffKey string
+	// diff is the summary of the manifest changes of the application
+	diff string
+}
+
+func NewPullRequestFeedback(manifests services.Manifests, settingsMgr *settings.SettingsManager) *PullRequestFeedback {
+	return &PullRequestFeedback{
+		manifests:   manifests,
+		settingsMgr: settingsMgr,
+		posted:      map[string]map[string]*postedFeedback{},
+	}
+}
+
+// Post posts the feedback of the given applications of the application set to their pull requests, unless the same
+// feedback was already posted. Failures are logged, as they must not prevent the reconciliation of the application set.
+func (f *PullRequestFeedback) Post(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, gens map[string]generators.Generator) {
+	appsetKey := appset.Namespace + "/" + appset.Name
+	f.lock.Lock()
+	previous := f.posted[appsetKey]
+	f.lock.Unlock()
+
+	provider, _ := gens["PullRequest"].(generators.PullRequestFeedbackProvider)
+	argoURL := ""
+	if f.settingsMgr != nil {
+		argoSettings, err := f.settingsMgr.GetSettings()
+		if err != nil {
+			logCtx.Warnf("error getting settings, feedback will not link to the applications: %v", err)
+		} else {
+			argoURL = argoSettings.URL
+		}
+	}
+
+	posted := map[string]*postedFeedback{}
+	feedbackServices := map[int]pullrequest.PullRequestFeedbackService{}
+	for i := range applications {
+		app := &applications[i]
+		refJSON, ok := app.Annotations[argocommon.AnnotationApplicationSetPullRequest]
+		if !ok {
+			continue
+		}
+		appLog := logCtx.WithField("app", app.QualifiedName())
+		var ref pullrequest.Reference
+		if err := json.Unmarshal([]byte(refJSON), &ref); err != nil {
+			appLog.Warnf("error parsing pull request reference: %v", err)
+			continue
+		}
+		if ref.Generator < 0 || ref.Generator >= len(appset.Spec.Generators) {
+			continue
+		}
+		generatorConfig := appset.Spec.Generators[ref.Generator].PullRequest
+		if generatorConfig == nil || generatorConfig.Feedback == nil || (!generatorConfig.Feedback.CommitStatus && !generatorConfig.Feedback.Comment) {
+			continue
+		}
+		if provider == nil {
+			appLog.Warn("pull request generator does not support feedback")
+			continue
+		}
+
+		last := previous[app.Name]
+		current := &postedFeedback{}
+		if last != nil {
+			current.hash = last.hash
+		}
+		posted[app.Name] = current
+
+		if generatorConfig.Feedback.Comment {
+			current.diffKey, current.diff = f.getDiff(ctx, appLog, app, &ref, last)
+		}
+		feedback := buildFeedback(app, &ref, argoURL, current.diff)
+		hash := feedbackHash(&ref, generatorConfig.Feedback, feedback)
+		if hash == current.hash {
+			continue
+		}
+
+		svc, ok := feedbackServices[ref.Generator]
+		if !ok {
+			var err error
+			svc, err = provider.GetFeedbackService(ctx, generatorConfig, appset)
+			if err != nil {
+				appLog.Warnf("error getting pull request feedback service: %v", err)
+			}
+			feedbackServices[ref.Generator] = svc
+		}
+		if svc == nil {
+			continue
+		}
+
+		pullRequest := ref.PullRequest()
+		failed := false
+		if generatorConfig.Feedback.CommitStatus {
+			if err := svc.SetCommitStatus(ctx, pullRequest, feedback); err != nil {
+				appLog.Warnf("error setting commit status of pull request %d: %v", ref.Number, err)
+				failed = true
+			}
+		}
+		if generatorConfig.Feedback.Comment {
+			if err := svc.Comment(ctx, pullRequest, feedback); err != nil {
+				appLog.Warnf("error commenting pull request %d: %v", ref.Number, err)
+				failed = true
+			}
+		}
+		if !failed {
+			current.hash = hash
+			appLog.Infof("posted feedback to pull request %d", ref.Number)
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if len(posted) == 0 {
+		delete(f.posted, appsetKey)
+	} else {
+		f.posted[appsetKey] = posted
+	}
+}
+
+// getDiff returns the summary of the manifest changes of the application against the target branch of its pull
+// request, reusing the previous summary if the revisions did not change.
+func (f *PullRequestFeedback) getDiff(ctx context.Context, logCtx *log.Entry, app *argov1alpha1.Application, ref *pullrequest.Reference, last *postedFeedback) (string, string) {
+	if f.manifests == nil || app.Spec.HasMultipleSources() || app.Spec.Source == nil || ref.TargetBranch == "" {
+		return "", ""
+	}
+	revision := app.Spec.Source.TargetRevision
+	diffKey := ref.HeadSHA + "/" + revision + "/" + ref.TargetBranch
+	if last != nil && last.diffKey == diffKey {
+		return last.diffKey, last.diff
+	}
+	sourceManifests, err := f.manifests.GetManifests(ctx, app, revision)
+	if err != nil {
+		logCtx.Warnf("error generating manifests of revision %s: %v", revision, err)
+		return "", ""
+	}
+	targetManifests, err := f.manifests.GetManifests(ctx, app, ref.TargetBranch)
+	if err != nil {
+		logCtx.Warnf("error generating manifests of target branch %s: %v", ref.TargetBranch, err)
+		return "", ""
+	}
+	return diffKey, summarizeManifestChanges(ref.TargetBranch, sourceManifests, targetManifests)
+}
+
+// maxManifestDiffLen is the maximum length of the diffs of the changed resources rendered in a comment, which keeps
+// the comment below the size limits of the providers
+const maxManifestDiffLen = 32 * 1024
+
+// summarizeManifestChanges renders the resources added, modified and removed by the source manifests compared to the
+// target manifests as markdown, followed by the diffs of their values.
+func summarizeManifestChanges(targetBranch string, sourceManifests, targetManifests []*unstructured.Unstructured) string {
+	sources := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, obj := range sourceManifests {
+		sources[kube.GetResourceKey(obj)] = obj
+	}
+	targets := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, obj := range targetManifests {
+		targets[kube.GetResourceKey(obj)] = obj
+	}
+	var added, modified, removed []kube.ResourceKey
+	for key, obj := range sources {
+		target, ok := targets[key]
+		if !ok {
+			added = append(added, key)
+		} else if !reflect.DeepEqual(obj.Object, target.Object) {
+			modified = append(modified, key)
+		}
+	}
+	for key := range targets {
+		if _, ok := sources[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	for _, keys := range [][]kube.ResourceKey{added, modified, removed} {
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Manifest changes against `%s`: %d added, %d modified, %d removed\n", targetBranch, len(added), len(modified), len(removed))
+	if len(added)+len(modified)+len(removed) == 0 {
+		return b.String()
+	}
+	changes := []struct {
+		name string
+		keys []kube.ResourceKey
+	}{{"added", added}, {"modified", modified}, {"removed", removed}}
+	b.WriteString("\n| Change | Resource |\n|---|---|\n")
+	for _, change := range changes {
+		for _, key := range change.keys {
+			fmt.Fprintf(&b, "| %s | `%s` |\n", change.name, key.String())
+		}
+	}
+
+	diffLen := 0
+	for _, change := range changes {
+		for _, key := range change.keys {
+			diff, err := manifestDiff(key, targets[key], sources[key])
+			if err != nil {
+				fmt.Fprintf(&b, "\nFailed to render the diff of `%s`: %v\n", key.String(), err)
+				continue
+			}
+			if diffLen+len(diff) > maxManifestDiffLen {
+				b.WriteString("\nThe diffs of the remaining resources are omitted, as they are too large.\n")
+				return b.String()
+			}
+			diffLen += len(diff)
+			fmt.Fprintf(&b, "\n<details>\n<summary>%s <code>%s</code></summary>\n\n```diff\n%s```\n\n</details>\n", change.name, key.String(), diff)
+		}
+	}
+	return b.String()
+}
+
+// manifestDiff returns the unified diff of the YAML of the target and source manifests of the resource, either of which
+// may be nil. The data of secrets is masked, as the diff is posted to the pull request.
+func manifestDiff(key kube.ResourceKey, target, source *unstructured.Unstructured) (string, error) {
+	if key.Group == "" && key.Kind == kube.SecretKind {
+		var err error
+		source, target, err = argodiff.HideSecretData(source, target)
+		if err != nil {
+			return "", fmt.Errorf("error hiding secret data: %w", err)
+		}
+	}
+	toYAML := func(obj *unstructured.Unstructured) ([]string, error) {
+		if obj == nil {
+			return nil, nil
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		return difflib.SplitLines(strings.TrimSuffix(string(data), "\n")), nil
+	}
+	targetLines, err := toYAML(target)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling the target manifest: %w", err)
+	}
+	sourceLines, err := toYAML(source)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling the source manifest: %w", err)
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:       targetLines,
+		B:       sourceLines,
+		Context: 3,
+	})
+}
+
+// buildFeedback builds the feedback reflecting the sync and health status of the application
+func buildFeedback(app *argov1alpha1.Application, ref *pullrequest.Reference, argoURL string, diff string) *pullrequest.Feedback {
+	healthStatus, syncStatus, operationPhase := statusStrings(*app)
+	if healthStatus == "" {
+		healthStatus = string(health.HealthStatusUnknown)
+	}
+	if syncStatus == "" {
+		syncStatus = string(argov1alpha1.SyncStatusCodeUnknown)
+	}
+
+	feedback := &pullrequest.Feedback{
+		Context:     "argocd/" + app.Name,
+		State:       pullrequest.FeedbackStatePending,
+		Description: fmt.Sprintf("%s, %s", syncStatus, healthStatus),
+	}
+	switch {
+	case operationPhase == string(common.OperationFailed) || operationPhase == string(common.OperationError):
+		feedback.State = pullrequest.FeedbackStateFailure
+		feedback.Description = fmt.Sprintf("Sync %s: %s", strings.ToLower(operationPhase), app.Status.OperationState.Message)
+	case healthStatus == string(health.HealthStatusDegraded):
+		feedback.State = pullrequest.FeedbackStateFailure
+	case syncStatus == string(argov1alpha1.SyncStatusCodeSynced) && healthStatus == string(health.HealthStatusHealthy):
+		feedback.State = pullrequest.FeedbackStateSuccess
+	}
+	if argoURL != "" {
+		feedback.URL = fmt.Sprintf("%s/applications/%s/%s", strings.TrimSuffix(argoURL, "/"), app.Namespace, app.Name)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "### Argo CD application `%s`\n\n", app.Name)
+	b.WriteString("| Sync Status | Health Status | Revision |\n|---|---|---|\n")
+	fmt.Fprintf(&b, "| %s | %s | %s |\n", syncStatus, healthStatus, ref.HeadSHA)
+	if operationPhase == string(common.OperationFailed) || operationPhase == string(common.OperationError) {
+		fmt.Fprintf(&b, "\nSync %s: %s\n", strings.ToLower(operationPhase), app.Status.OperationState.Message)
+	}
+	if feedback.URL != "" {
+		fmt.Fprintf(&b, "\n[View in Argo CD](%s)\n", feedback.URL)
+	}
+	if diff != "" {
+		b.WriteString("\n" + diff)
+	}
+	feedback.Comment = b.String()
+	return feedback
+}
+
+// feedbackHash returns the hash identifying the feedback posted to the given pull request
+func feedbackHash(ref *pullrequest.Reference, config *argov1alpha1.PullRequestGeneratorFeedback, feedback *pullrequest.Feedback) string {
+	data, _ := json.Marshal(struct {
+		Ref      *pullrequest.Reference
+		Config   *argov1alpha1.PullRequestGeneratorFeedback
+		Feedback *pullrequest.Feedback
+	}{ref, config, feedback})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hasPullRequestFeedback returns true if feedback about the application is posted to its pull request
+func hasPullRequestFeedback(app *argov1alpha1.Application) bool {
+	_, ok := app.Annotations[argocommon.AnnotationApplicationSetPullRequest]
+	return ok
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/generators"
+	pullrequest "github.com/argoproj/argo-cd/v2/applicationset/services/pull_request"
+	argocommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+type fakeFeedbackGenerator struct {
+	generators.Generator
+	svc *pullrequest.FakeService
+}
+
+func (g *fakeFeedbackGenerator) GetFeedbackService(_ context.Context, _ *v1alpha1.PullRequestGenerator, _ *v1alpha1.ApplicationSet) (pullrequest.PullRequestFeedbackService, error) {
+	return g.svc, nil
+}
+
+type fakeManifests struct {
+	manifests map[string][]*unstructured.Unstructured
+	calls     int
+}
+
+func (m *fakeManifests) GetManifests(_ context.Context, _ *v1alpha1.Application, revision string) ([]*unstructured.Unstructured, error) {
+	m.calls++
+	manifests, ok := m.manifests[revision]
+	if !ok {
+		return nil, fmt.Errorf("unknown revision %s", revision)
+	}
+	return manifests, nil
+}
+
+func newFakeManifest(kind, name string, data map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"data": data}}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetNamespace("guestbook")
+	obj.SetName(name)
+	return obj
+}
+
+func newFakeSettingsManager(url string) *settings.SettingsManager {
+	clientset := kubefake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      argocommon.ArgoCDConfigMapName,
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"url": url},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      argocommon.ArgoCDSecretName,
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"server.secretkey": nil},
+	})
+	return settings.NewSettingsManager(context.Background(), clientset, "argocd")
+}
+
+func TestPullRequestFeedbackPost(t *testing.T) {
+	appset := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				PullRequest: &v1alpha1.PullRequestGenerator{
+					Feedback: &v1alpha1.PullRequestGeneratorFeedback{CommitStatus: true, Comment: true},
+				},
+			}},
+		},
+	}
+	app := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-1",
+			Namespace: "argocd",
+			Annotations: map[string]string{
+				argocommon.AnnotationApplicationSetPullRequest: `{"generator":0,"number":1,"branch":"feature","targetBranch":"main","headSHA":"abc123"}`,
+			},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "abc123"},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+			Health: v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+		},
+	}
+	unrelated := v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "argocd"}}
+
+	manifests := &fakeManifests{manifests: map[string][]*unstructured.Unstructured{
+		"abc123": {
+			newFakeManifest("ConfigMap", "config", map[string]interface{}{"foo": "baz"}),
+			newFakeManifest("Secret", "secret", map[string]interface{}{"password": "c2VjcmV0"}),
+		},
+		"main": {
+			newFakeManifest("ConfigMap", "config", map[string]interface{}{"foo": "bar"}),
+			newFakeManifest("Service", "guestbook-ui", nil),
+		},
+	}}
+	svc := &pullrequest.FakeService{}
+	gens := map[string]generators.Generator{"PullRequest": &fakeFeedbackGenerator{svc: svc}}
+	feedback := NewPullRequestFeedback(manifests, newFakeSettingsManager("https://argocd.example.com/"))
+	logCtx := log.WithField("applicationset", "appset")
+
+	feedback.Post(context.Background(), logCtx, appset, []v1alpha1.Application{app, unrelated}, gens)
+
+	require.Len(t, svc.CommitStatuses[1], 1)
+	status := svc.CommitStatuses[1][0]
+	assert.Equal(t, "argocd/guestbook-1", status.Context)
+	assert.Equal(t, pullrequest.FeedbackStatePending, status.State)
+	assert.Equal(t, "OutOfSync, Progressing", status.Description)
+	assert.Equal(t, "https://argocd.example.com/applications/argocd/guestbook-1", status.URL)
+	require.Len(t, svc.Comments[1], 1)
+	assert.Equal(t, "### Argo CD application `guestbook-1`\n\n"+
+		"| Sync Status | Health Status | Revision |\n|---|---|---|\n"+
+		"| OutOfSync | Progressing | abc123 |\n\n"+
+		"[View in Argo CD](https://argocd.example.com/applications/argocd/guestbook-1)\n\n"+
+		"Manifest changes against `main`: 1 added, 1 modified, 1 removed\n\n"+
+		"| Change | Resource |\n|---|---|\n"+
+		"| added | `/Secret/guestbook/secret` |\n"+
+		"| modified | `/ConfigMap/guestbook/config` |\n"+
+		"| removed | `/Service/guestbook/guestbook-ui` |\n\n"+
+		"<details>\n<summary>added <code>/Secret/guestbook/secret</code></summary>\n\n"+
+		"```diff\n@@ -0,0 +1,7 @@\n+apiVersion: v1\n+data:\n+  password: ++++++++\n+kind: Secret\n+metadata:\n+  name: secret\n+  namespace: guestbook\n```\n\n</details>\n\n"+
+		"<details>\n<summary>modified <code>/ConfigMap/guestbook/config</code></summary>\n\n"+
+		"```diff\n@@ -1,6 +1,6 @@\n apiVersion: v1\n data:\n-  foo: bar\n+  foo: baz\n kind: ConfigMap\n metadata:\n   name: config\n```\n\n</details>\n\n"+
+		"<details>\n<summary>removed <code>/Service/guestbook/guestbook-ui</code></summary>\n\n"+
+		"```diff\n@@ -1,6 +0,0 @@\n-apiVersion: v1\n-data: null\n-kind: Service\n-metadata:\n-  name: guestbook-ui\n-  namespace: guestbook\n```\n\n</details>\n", svc.Comments[1][0].Comment)
+	assert.Equal(t, 2, manifests.calls)
+
+	// the same feedback is not posted again
+	feedback.Post(context.Background(), logCtx, appset, []v1alpha1.Application{app}, gens)
+	assert.Len(t, svc.CommitStatuses[1], 1)
+	assert.Len(t, svc.Comments[1], 1)
+
+	// the new status is posted, without generating the manifests again
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	app.Status.Health.Status = health.HealthStatusHealthy
+	feedback.Post(context.Background(), logCtx, appset, []v1alpha1.Application{app}, gens)
+	require.Len(t, svc.CommitStatuses[1], 2)
+	assert.Equal(t, pullrequest.FeedbackStateSuccess, svc.CommitStatuses[1][1].State)
+	assert.Equal(t, "Synced, Healthy", svc.CommitStatuses[1][1].Description)
+	assert.Len(t, svc.Comments[1], 2)
+	assert.Equal(t, 2, manifests.calls)
+
+	// only the commit status is posted once comments are disabled
+	appset.Spec.Generators[0].PullRequest.Feedback.Comment = false
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationFailed, Message: "one or more objects failed to apply"}
+	feedback.Post(context.Background(), logCtx, appset, []v1alpha1.Application{app}, gens)
+	require.Len(t, svc.CommitStatuses[1], 3)
+	assert.Equal(t, pullrequest.FeedbackStateFailure, svc.CommitStatuses[1][2].State)
+	assert.Equal(t, "Sync failed: one or more objects failed to apply", svc.CommitStatuses[1][2].Description)
+	assert.Len(t, svc.Comments[1], 2)
+}
+
+func TestPullRequestFeedbackPostDisabled(t *testing.T) {
+	app := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-1",
+			Namespace: "argocd",
+			Annotations: map[string]string{
+				argocommon.AnnotationApplicationSetPullRequest: `{"generator":1,"number":1,"headSHA":"abc123"}`,
+			},
+		},
+	}
+	svc := &pullrequest.FakeService{}
+	gens := map[string]generators.Generator{"PullRequest": &fakeFeedbackGenerator{svc: svc}}
+	feedback := NewPullRequestFeedback(nil, nil)
+	logCtx := log.WithField("applicationset", "appset")
+
+	for _, generators := range [][]v1alpha1.ApplicationSetGenerator{
+		// the generator does not exist anymore
+		{{PullRequest: &v1alpha1.PullRequestGenerator{Feedback: &v1alpha1.PullRequestGeneratorFeedback{CommitStatus: true}}}},
+		// the generator is not a pull request generator anymore
+		{{}, {List: &v1alpha1.ListGenerator{}}},
+		// the feedback is disabled
+		{{}, {PullRequest: &v1alpha1.PullRequestGenerator{}}},
+		{{}, {PullRequest: &v1alpha1.PullRequestGenerator{Feedback: &v1alpha1.PullRequestGeneratorFeedback{}}}},
+	} {
+		appset := &v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSetSpec{Generators: generators},
+		}
+		feedback.Post(context.Background(), logCtx, appset, []v1alpha1.Application{app}, gens)
+	}
+	assert.Empty(t, svc.CommitStatuses)
+	assert.Empty(t, svc.Comments)
+	assert.Empty(t, feedback.posted)
+}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
@@ -19,7 +21,7 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 	var firstError error
 	var applicationSetReason argov1alpha1.ApplicationSetReasonType
 
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
+	for i, requestedGenerator := range applicationSetInfo.Spec.Generators {
 		t, err := generators.Transform(requestedGenerator, g, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]interface{}{}, client)
 		if err != nil {
 			logCtx.WithError(err).WithField("generator", requestedGenerator).
@@ -63,6 +65,19 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 					app = patchedApplication
 				}
 
+				if requestedGenerator.PullRequest != nil && requestedGenerator.PullRequest.Feedback != nil {
+					if err := setPullRequestReference(app, i, p); err != nil {
+						logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error generating application from params")
+
+						if firstError == nil {
+							firstError = err
+							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+						}
+						continue
+					}
+				}
+
 				// The app's namespace must be the same as the AppSet's namespace to preserve the appsets-in-any-namespace
 				// security boundary.
 				app.Namespace = applicationSetInfo.Namespace
@@ -77,6 +92,24 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 	return res, applicationSetReason, firstError
 }
 
+// setPullRequestReference annotates the application with the reference to the pull request it was generated for, so
+// that feedback about the application can be posted back to the pull request.
+func setPullRequestReference(app *argov1alpha1.Application, generatorIndex int, params map[string]interface{}) error {
+	ref, err := generators.PullRequestReference(generatorIndex, params)
+	if err != nil {
+		return err
+	}
+	refJSON, err := json.Marshal(ref)
+	if err != nil {
+		return fmt.Errorf("error marshalling pull request reference: %w", err)
+	}
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[common.AnnotationApplicationSetPullRequest] = string(refJSON)
+	return nil
+}
+
 func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]interface{}) (*argov1alpha1.Application, error) {
 	replacedTemplate, err := r.Replace(*applicationSetInfo.Spec.TemplatePatch, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	genmock "github.com/argoproj/argo-cd/v2/applicationset/generators/mocks"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	rendmock "github.com/argoproj/argo-cd/v2/applicationset/utils/mocks"
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/collections"
@@ -348,3 +349,46 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateAppsUsingPullRequestGeneratorWithFeedback(t *testing.T) {
+	generatorMock := genmock.Generator{}
+	generator := v1alpha1.ApplicationSetGenerator{
+		PullRequest: &v1alpha1.PullRequestGenerator{
+			Feedback: &v1alpha1.PullRequestGeneratorFeedback{CommitStatus: true},
+		},
+	}
+	template := v1alpha1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+			Name:        "app-{{.number}}",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+	}
+
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]interface{}{
+			{"number": "1", "branch": "feature-1", "target_branch": "main", "head_sha": "089d92cbf9ff857a39e6feccd32798ca700fb958"},
+			{"number": "2", "branch": "feature-2", "target_branch": "main", "head_sha": "1a8dd249c04a"},
+		}, nil)
+	generatorMock.On("GetTemplate", &generator).
+		Return(&template, nil)
+
+	gotApps, _, err := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{List: &v1alpha1.ListGenerator{}}, generator},
+			Template:   template,
+		},
+	},
+		map[string]generators.Generator{"PullRequest": &generatorMock, "List": generators.NewListGenerator()},
+		&utils.Render{},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, gotApps, 2)
+	assert.Equal(t, map[string]string{
+		"foo": "bar",
+		common.AnnotationApplicationSetPullRequest: `{"generator":1,"number":1,"branch":"feature-1","targetBranch":"main","headSHA":"089d92cbf9ff857a39e6feccd32798ca700fb958"}`,
+	}, gotApps[0].Annotations)
+	assert.Equal(t, `{"generator":1,"number":2,"branch":"feature-2","targetBranch":"main","headSHA":"1a8dd249c04a"}`, gotApps[1].Annotations[common.AnnotationApplicationSetPullRequest])
+	assert.Equal(t, map[string]string{"foo": "bar"}, template.Annotations)
+}
@@ -0,0 +1,187 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	httpjson "github.com/argoproj/argo-cd/v2/applicationset/services/http_json"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*HTTPGenerator)(nil)
+
+const (
+	DefaultHTTPRequeueAfterSeconds = 30 * time.Minute
+)
+
+type HTTPGenerator struct {
+	client client.Client
+	// cache holds the responses of the endpoints, shared by all application sets
+	cache *httpjson.Cache
+	HTTPConfig
+}
+
+// HTTPConfig configures which endpoints the HTTP generator may request
+type HTTPConfig struct {
+	enableHTTPGenerator bool
+	urlPolicy           httpjson.URLPolicy
+}
+
+func NewHTTPConfig(enableHTTPGenerator bool, allowedURLs []string) HTTPConfig {
+	return HTTPConfig{
+		enableHTTPGenerator: enableHTTPGenerator,
+		urlPolicy:           httpjson.URLPolicy{AllowedURLs: allowedURLs},
+	}
+}
+
+func NewHTTPGenerator(client client.Client, httpConfig HTTPConfig) Generator {
+	return &HTTPGenerator{
+		client:     client,
+		cache:      httpjson.NewCache(),
+		HTTPConfig: httpConfig,
+	}
+}
+
+func (g *HTTPGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.HTTP.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.HTTP.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultHTTPRequeueAfterSeconds
+}
+
+func (g *HTTPGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.HTTP.Template
+}
+
+var ErrHTTPGeneratorDisabled = errors.New("the HTTP generator is disabled")
+
+func (g *HTTPGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]interface{}, error) {
+	if appSetGenerator == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if appSetGenerator.HTTP == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if !g.enableHTTPGenerator {
+		return nil, ErrHTTPGeneratorDisabled
+	}
+
+	ctx := context.Background()
+	generatorConfig := appSetGenerator.HTTP
+
+	req, err := g.getRequest(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, err
+	}
+	svc := httpjson.NewService(utils.GetTlsConfig("", req.Insecure, req.CACerts), g.cache, g.urlPolicy)
+
+	items, err := svc.ListItems(ctx, req, g.GetRequeueAfter(appSetGenerator))
+	if err != nil {
+		return nil, fmt.Errorf("error listing items of %s: %w", generatorConfig.URL, err)
+	}
+
+	res := make([]map[string]interface{}, 0, len(items))
+	for i, item := range items {
+		fields, err := itemFields(item, generatorConfig.Params)
+		if err != nil {
+			return nil, fmt.Errorf("error mapping item %d: %w", i, err)
+		}
+
+		params := map[string]interface{}{}
+		if applicationSetInfo.Spec.GoTemplate {
+			for k, v := range fields {
+				params[k] = v
+			}
+		} else {
+			flat, err := flatten.Flatten(fields, "", flatten.DotStyle)
+			if err != nil {
+				return nil, fmt.Errorf("error flattening item %d: %w", i, err)
+			}
+			for k, v := range flat {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		err = appendTemplatedValues(generatorConfig.Values, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+	return res, nil
+}
+
+func (g *HTTPGenerator) getRequest(ctx context.Context, generatorConfig *argoprojiov1alpha1.HTTPGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (*httpjson.Request, error) {
+	req := &httpjson.Request{
+		URL:       generatorConfig.URL,
+		Method:    generatorConfig.Method,
+		Body:      generatorConfig.Body,
+		ItemsPath: generatorConfig.ItemsPath,
+		Headers:   map[string]string{},
+		Insecure:  generatorConfig.Insecure,
+	}
+	if generatorConfig.CARef != nil {
+		caCerts, err := utils.GetConfigMapData(ctx, g.client, generatorConfig.CARef, applicationSetInfo.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", err)
+		}
+		req.CACerts = caCerts
+	}
+	for _, header := range generatorConfig.Headers {
+		value := header.Value
+		if header.ValueRef != nil {
+			var err error
+			value, err = utils.GetSecretRef(ctx, g.client, header.ValueRef, applicationSetInfo.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Secret value of header %s: %w", header.Name, err)
+			}
+		}
+		req.Headers[header.Name] = value
+	}
+	if pagination := generatorConfig.Pagination; pagination != nil {
+		req.LinkHeader = pagination.LinkHeader
+		req.NextTokenPath = pagination.NextTokenPath
+		req.NextTokenParam = pagination.NextTokenParam
+		if pagination.MaxPages != nil {
+			req.MaxPages = int(*pagination.MaxPages)
+		}
+	}
+	return req, nil
+}
+
+// itemFields returns the fields of the item, or the values of the given JSONPath expressions evaluated against the
+// item if any.
+func itemFields(item interface{}, paramPaths map[string]string) (map[string]interface{}, error) {
+	if len(paramPaths) == 0 {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object, got %T; use params to map the item to parameters", item)
+		}
+		return fields, nil
+	}
+
+	fields := make(map[string]interface{}, len(paramPaths))
+	for name, path := range paramPaths {
+		value, err := httpjson.EvaluateJSONPath(path, item)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating param %s: %w", name, err)
+		}
+		if value == nil {
+			value = ""
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
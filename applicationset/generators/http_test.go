@@ -0,0 +1,186 @@
+package generators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	httpjson "github.com/argoproj/argo-cd/v2/applicationset/services/http_json"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestHTTPGenerateParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"services":[
+			{"name":"billing","owner":{"team":"payments"},"replicas":3,"tags":["a","b"]},
+			{"name":"checkout","owner":{"team":"shop"},"replicas":1234567}
+		]}`))
+	}))
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog", Namespace: "argocd"},
+		Data:       map[string][]byte{"token": []byte("Bearer token")},
+	}
+	headers := []argoprojiov1alpha1.HTTPGeneratorHeader{{
+		Name:     "Authorization",
+		ValueRef: &argoprojiov1alpha1.SecretRef{SecretName: "catalog", Key: "token"},
+	}}
+
+	cases := []struct {
+		name          string
+		generator     *argoprojiov1alpha1.HTTPGenerator
+		goTemplate    bool
+		expected      []map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "Flattened items",
+			generator: &argoprojiov1alpha1.HTTPGenerator{
+				URL:       server.URL,
+				Headers:   headers,
+				ItemsPath: ".services",
+				Values:    map[string]string{"app": "{{name}}-{{owner.team}}"},
+			},
+			expected: []map[string]interface{}{
+				{"name": "billing", "owner.team": "payments", "replicas": "3", "tags.0": "a", "tags.1": "b", "values.app": "billing-payments"},
+				{"name": "checkout", "owner.team": "shop", "replicas": "1234567", "values.app": "checkout-shop"},
+			},
+		},
+		{
+			name: "Mapped params",
+			generator: &argoprojiov1alpha1.HTTPGenerator{
+				URL:       server.URL,
+				Headers:   headers,
+				ItemsPath: "{.services[*]}",
+				Params:    map[string]string{"service": ".name", "team": "{.owner.team}", "tags": ".tags[*]"},
+				Values:    map[string]string{"app": "{{.service}}-{{.team}}"},
+			},
+			goTemplate: true,
+			expected: []map[string]interface{}{
+				{"service": "billing", "team": "payments", "tags": []interface{}{"a", "b"}, "values": map[string]string{"app": "billing-payments"}},
+				{"service": "checkout", "team": "shop", "tags": "", "values": map[string]string{"app": "checkout-shop"}},
+			},
+		},
+		{
+			name: "Missing secret",
+			generator: &argoprojiov1alpha1.HTTPGenerator{
+				URL: server.URL,
+				Headers: []argoprojiov1alpha1.HTTPGeneratorHeader{{
+					Name:     "Authorization",
+					ValueRef: &argoprojiov1alpha1.SecretRef{SecretName: "missing", Key: "token"},
+				}},
+			},
+			expectedError: "error fetching Secret value of header Authorization",
+		},
+		{
+			name:          "Unauthorized",
+			generator:     &argoprojiov1alpha1.HTTPGenerator{URL: server.URL},
+			expectedError: "returned 401 Unauthorized",
+		},
+		{
+			name: "Items which are not objects",
+			generator: &argoprojiov1alpha1.HTTPGenerator{
+				URL:       server.URL,
+				Headers:   headers,
+				ItemsPath: ".services[*].name",
+			},
+			expectedError: "error mapping item 0: expected an object, got string",
+		},
+	}
+
+	for _, testCase := range cases {
+		testCaseCopy := testCase
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			generator := NewHTTPGenerator(fake.NewClientBuilder().WithObjects(secret).Build(), HTTPConfig{enableHTTPGenerator: true, urlPolicy: httpjson.URLPolicy{AllowLocalAddresses: true}})
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCaseCopy.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{HTTP: testCaseCopy.generator}},
+				},
+			}
+
+			got, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+
+			if testCaseCopy.expectedError != "" {
+				require.ErrorContains(t, err, testCaseCopy.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.ElementsMatch(t, testCaseCopy.expected, got)
+			}
+		})
+	}
+}
+
+func TestHTTPGenerateParamsPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name          string
+		httpConfig    HTTPConfig
+		url           string
+		expectedError string
+	}{
+		{
+			name:          "Disabled",
+			httpConfig:    NewHTTPConfig(false, nil),
+			url:           "https://catalog.example.com",
+			expectedError: ErrHTTPGeneratorDisabled.Error(),
+		},
+		{
+			name:          "URL not allowed",
+			httpConfig:    NewHTTPConfig(true, []string{"https://catalog.example.com/api"}),
+			url:           "https://catalog.example.com/internal",
+			expectedError: "URL https://catalog.example.com/internal not allowed",
+		},
+		{
+			name:          "Loopback address",
+			httpConfig:    NewHTTPConfig(true, nil),
+			url:           server.URL,
+			expectedError: "connections to loopback and link-local addresses are not allowed",
+		},
+	}
+
+	for _, testCase := range cases {
+		testCaseCopy := testCase
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			generator := NewHTTPGenerator(fake.NewClientBuilder().Build(), testCaseCopy.httpConfig)
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{HTTP: &argoprojiov1alpha1.HTTPGenerator{URL: testCaseCopy.url}}},
+				},
+			}
+
+			_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+
+			require.ErrorContains(t, err, testCaseCopy.expectedError)
+		})
+	}
+}
+
+func TestHTTPGetRequeueAfter(t *testing.T) {
+	generator := NewHTTPGenerator(nil, NewHTTPConfig(true, nil))
+	requeueAfterSeconds := int64(60)
+
+	assert.Equal(t, DefaultHTTPRequeueAfterSeconds, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{HTTP: &argoprojiov1alpha1.HTTPGenerator{}}))
+	assert.Equal(t, time.Minute, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{HTTP: &argoprojiov1alpha1.HTTPGenerator{RequeueAfterSeconds: &requeueAfterSeconds}}))
+}
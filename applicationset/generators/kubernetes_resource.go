@@ -0,0 +1,347 @@
+package generators
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	httpjson "github.com/argoproj/argo-cd/v2/applicationset/services/http_json"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*KubernetesResourceGenerator)(nil)
+
+// KubernetesResourceGenerator generates Applications for the objects of a kind in the local cluster or in a cluster
+// registered with Argo CD.
+type KubernetesResourceGenerator struct {
+	ctx       context.Context
+	dynClient dynamic.Interface
+	clientset kubernetes.Interface
+	namespace string // namespace is the Argo CD namespace
+	KubernetesResourceConfig
+	// getClusterClients returns the clients of a remote cluster, overridden by tests
+	getClusterClients func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error)
+	// clusterClients caches the clients of remote clusters by server URL
+	clusterClients     map[string]*clusterClients
+	clusterClientsLock sync.Mutex
+}
+
+// clusterClients are the clients of a remote cluster, created from the cluster configuration with the given hash
+type clusterClients struct {
+	configHash      [sha256.Size]byte
+	dynClient       dynamic.Interface
+	discoveryClient discovery.DiscoveryInterface
+}
+
+// KubernetesResourceConfig configures which kinds the Kubernetes resource generator may list
+type KubernetesResourceConfig struct {
+	enableKubernetesResourceGenerator bool
+	allowedKinds                      []string
+}
+
+// NewKubernetesResourceConfig returns the configuration of the Kubernetes resource generator. The allowed kinds are
+// given as <group>/<kind>, or as <kind> for kinds of the core group, and <group>/* allows all kinds of a group.
+func NewKubernetesResourceConfig(enableKubernetesResourceGenerator bool, allowedKinds []string) KubernetesResourceConfig {
+	return KubernetesResourceConfig{
+		enableKubernetesResourceGenerator: enableKubernetesResourceGenerator,
+		allowedKinds:                      allowedKinds,
+	}
+}
+
+// isKindAllowed returns whether the given kind is one of the allowed kinds
+func (c KubernetesResourceConfig) isKindAllowed(group string, kind string) bool {
+	for _, allowedKind := range c.allowedKinds {
+		allowedGroup, allowedName, found := strings.Cut(allowedKind, "/")
+		if !found {
+			allowedGroup, allowedName = "", allowedKind
+		}
+		if allowedGroup == group && (allowedName == "*" || allowedName == kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func NewKubernetesResourceGenerator(ctx context.Context, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string, kubernetesResourceConfig KubernetesResourceConfig) Generator {
+	return &KubernetesResourceGenerator{
+		ctx:                      ctx,
+		dynClient:                dynClient,
+		clientset:                clientset,
+		namespace:                namespace,
+		KubernetesResourceConfig: kubernetesResourceConfig,
+		getClusterClients:        getClusterClients,
+		clusterClients:           map[string]*clusterClients{},
+	}
+}
+
+func (g *KubernetesResourceGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 3 minutes, if no override is specified.
+
+	if appSetGenerator.KubernetesResource.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.KubernetesResource.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultRequeueAfterSeconds
+}
+
+func (g *KubernetesResourceGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.KubernetesResource.Template
+}
+
+var ErrKubernetesResourceGeneratorDisabled = errors.New("the KubernetesResource generator is disabled")
+
+func (g *KubernetesResourceGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]interface{}, error) {
+	if appSetGenerator == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if appSetGenerator.KubernetesResource == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if !g.enableKubernetesResourceGenerator {
+		return nil, ErrKubernetesResourceGeneratorDisabled
+	}
+
+	generatorConfig := appSetGenerator.KubernetesResource
+
+	gv, err := schema.ParseGroupVersion(generatorConfig.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing apiVersion %q: %w", generatorConfig.APIVersion, err)
+	}
+	if generatorConfig.Kind == "" {
+		return nil, fmt.Errorf("kind is required")
+	}
+	// Secrets are refused so that their data cannot be copied into Applications.
+	if gv.Group == "" && generatorConfig.Kind == "Secret" {
+		return nil, fmt.Errorf("generating parameters from Secrets is not supported")
+	}
+	if !g.isKindAllowed(gv.Group, generatorConfig.Kind) {
+		return nil, fmt.Errorf("kind %s of %s is not allowed, must be one of the following: %s", generatorConfig.Kind, generatorConfig.APIVersion, strings.Join(g.allowedKinds, ", "))
+	}
+
+	cluster, err := g.getCluster(generatorConfig.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkDestinationPermitted(appSet, client, cluster, generatorConfig.Namespace); err != nil {
+		return nil, err
+	}
+
+	var dynClient dynamic.Interface
+	var discoveryClient discovery.DiscoveryInterface
+	if cluster.Server == argoprojiov1alpha1.KubernetesInternalAPIServerAddr {
+		dynClient = g.dynClient
+		discoveryClient = g.clientset.Discovery()
+	} else {
+		dynClient, discoveryClient, err = g.getCachedClusterClients(cluster)
+		if err != nil {
+			return nil, fmt.Errorf("error creating clients of cluster %s: %w", cluster.Server, err)
+		}
+	}
+
+	resource, err := findAPIResource(discoveryClient, generatorConfig.APIVersion, generatorConfig.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !resource.Namespaced && generatorConfig.Namespace != "" {
+		return nil, fmt.Errorf("kind %s is not namespaced", generatorConfig.Kind)
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(&generatorConfig.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing labelSelector: %w", err)
+	}
+
+	gvr := gv.WithResource(resource.Name)
+	listOptions := metav1.ListOptions{LabelSelector: labelSelector.String(), FieldSelector: generatorConfig.FieldSelector}
+	var resourceInterface dynamic.ResourceInterface = dynClient.Resource(gvr)
+	if generatorConfig.Namespace != "" {
+		resourceInterface = dynClient.Resource(gvr).Namespace(generatorConfig.Namespace)
+	}
+	list, err := resourceInterface.List(g.ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s in cluster %s: %w", gvr.String(), cluster.Server, err)
+	}
+
+	res := make([]map[string]interface{}, 0, len(list.Items))
+	for _, obj := range list.Items {
+		fields := map[string]interface{}{
+			"name":        obj.GetName(),
+			"namespace":   obj.GetNamespace(),
+			"apiVersion":  obj.GetAPIVersion(),
+			"kind":        obj.GetKind(),
+			"uid":         string(obj.GetUID()),
+			"labels":      stringMapToInterfaceMap(obj.GetLabels()),
+			"annotations": stringMapToInterfaceMap(obj.GetAnnotations()),
+			"cluster": map[string]interface{}{
+				"name":   cluster.Name,
+				"server": cluster.Server,
+			},
+		}
+		for name, path := range generatorConfig.Params {
+			value, err := httpjson.EvaluateJSONPath(path, obj.Object)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating param %s of %s/%s: %w", name, obj.GetNamespace(), obj.GetName(), err)
+			}
+			if value == nil {
+				value = ""
+			}
+			fields[name] = value
+		}
+
+		params := map[string]interface{}{}
+		if appSet.Spec.GoTemplate {
+			for k, v := range fields {
+				params[k] = v
+			}
+		} else {
+			flat, err := flatten.Flatten(fields, "", flatten.DotStyle)
+			if err != nil {
+				return nil, fmt.Errorf("error flattening %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			for k, v := range flat {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		err = appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+	return res, nil
+}
+
+// getCluster returns the cluster registered with Argo CD of the given name or server URL, or the local cluster if
+// none is given.
+func (g *KubernetesResourceGenerator) getCluster(nameOrServer string) (*argoprojiov1alpha1.Cluster, error) {
+	// ListCluster from Argo CD's util/db package will include the local cluster in the list of clusters
+	clusters, err := utils.ListClusters(g.ctx, g.clientset, g.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+	if nameOrServer == "" {
+		nameOrServer = argoprojiov1alpha1.KubernetesInternalAPIServerAddr
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if cluster.Name == nameOrServer || cluster.Server == nameOrServer {
+			return cluster, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster %s is not registered", nameOrServer)
+}
+
+// checkDestinationPermitted returns an error unless the project of the applications of the application set permits
+// the cluster and the namespace the objects are listed from. Listing the objects of all namespaces, or of a
+// cluster-scoped kind, requires the project to permit all namespaces of the cluster.
+func (g *KubernetesResourceGenerator) checkDestinationPermitted(appSet *argoprojiov1alpha1.ApplicationSet, client client.Client, cluster *argoprojiov1alpha1.Cluster, namespace string) error {
+	project := appSet.Spec.Template.Spec.GetProject()
+	if strings.Contains(project, "{{") {
+		return fmt.Errorf("the project of the applications must not be templated when using the KubernetesResource generator")
+	}
+	appProject := &argoprojiov1alpha1.AppProject{}
+	if err := client.Get(g.ctx, types.NamespacedName{Name: project, Namespace: g.namespace}, appProject); err != nil {
+		return fmt.Errorf("error getting project %s: %w", project, err)
+	}
+	if namespace == "" {
+		namespace = "*"
+	}
+	permitted, err := appProject.IsDestinationPermitted(argoprojiov1alpha1.ApplicationDestination{Server: cluster.Server, Name: cluster.Name, Namespace: namespace}, func(project string) ([]*argoprojiov1alpha1.Cluster, error) {
+		clusters, err := utils.ListClusters(g.ctx, g.clientset, g.namespace)
+		if err != nil {
+			return nil, err
+		}
+		var projectClusters []*argoprojiov1alpha1.Cluster
+		for i := range clusters.Items {
+			if clusters.Items[i].Project == project {
+				projectClusters = append(projectClusters, &clusters.Items[i])
+			}
+		}
+		return projectClusters, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error checking the destinations of project %s: %w", project, err)
+	}
+	if !permitted {
+		return fmt.Errorf("cluster %s and namespace %s are not permitted destinations of project %s", cluster.Server, namespace, project)
+	}
+	return nil
+}
+
+// getCachedClusterClients returns the clients of the given remote cluster, which are reused until the configuration
+// of the cluster changes.
+func (g *KubernetesResourceGenerator) getCachedClusterClients(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+	data, err := json.Marshal(cluster.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+	configHash := sha256.Sum256(data)
+
+	g.clusterClientsLock.Lock()
+	defer g.clusterClientsLock.Unlock()
+	if cached, ok := g.clusterClients[cluster.Server]; ok && cached.configHash == configHash {
+		return cached.dynClient, cached.discoveryClient, nil
+	}
+	dynClient, discoveryClient, err := g.getClusterClients(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	g.clusterClients[cluster.Server] = &clusterClients{configHash: configHash, dynClient: dynClient, discoveryClient: discoveryClient}
+	return dynClient, discoveryClient, nil
+}
+
+func getClusterClients(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+	config := cluster.RESTConfig()
+	dynClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dynClient, discoveryClient, nil
+}
+
+// findAPIResource returns the resource of the given kind, which must be served by the cluster.
+func findAPIResource(discoveryClient discovery.DiscoveryInterface, apiVersion string, kind string) (*metav1.APIResource, error) {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering the resources of %s: %w", apiVersion, err)
+	}
+	for i := range resources.APIResources {
+		resource := &resources.APIResources[i]
+		// subresources share the kind of their resource
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("kind %s is not served by %s", kind, apiVersion)
+}
+
+func stringMapToInterfaceMap(m map[string]string) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
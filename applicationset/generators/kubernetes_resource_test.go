@@ -0,0 +1,345 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newUnstructured(apiVersion, kind, namespace, name string, labels map[string]interface{}, spec map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{"name": name, "uid": name + "-uid"}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+	obj := map[string]interface{}{"apiVersion": apiVersion, "kind": kind, "metadata": metadata}
+	if spec != nil {
+		obj["spec"] = spec
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestKubernetesResourceGenerateParams(t *testing.T) {
+	apiResources := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "namespaces", Kind: "Namespace", Namespaced: false},
+				{Name: "namespaces/status", Kind: "Namespace", Namespaced: false},
+				{Name: "secrets", Kind: "Secret", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "example.com/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "tenants", Kind: "Tenant", Namespaced: true},
+			},
+		},
+	}
+	gvrToListKind := map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "namespaces"}:                          "NamespaceList",
+		{Group: "example.com", Version: "v1alpha1", Resource: "tenants"}: "TenantList",
+	}
+
+	localObjects := []runtime.Object{
+		newUnstructured("v1", "Namespace", "", "team-a", map[string]interface{}{"tenant": "true", "team": "a"}, nil),
+		newUnstructured("v1", "Namespace", "", "kube-system", nil, nil),
+		newUnstructured("example.com/v1alpha1", "Tenant", "tenants", "a", nil, map[string]interface{}{"owner": "alice", "regions": []interface{}{"eu", "us"}}),
+		newUnstructured("example.com/v1alpha1", "Tenant", "other", "b", nil, map[string]interface{}{"owner": "bob"}),
+	}
+	remoteObjects := []runtime.Object{
+		newUnstructured("v1", "Namespace", "", "team-r", map[string]interface{}{"tenant": "true"}, nil),
+	}
+
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "remote",
+			Namespace: "argocd",
+			Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("remote"),
+			"server": []byte("https://remote.example.com"),
+			"config": []byte("{}"),
+		},
+	}
+
+	tenantSelector := metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, argoprojiov1alpha1.AddToScheme(scheme))
+	projects := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&argoprojiov1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+			Spec:       argoprojiov1alpha1.AppProjectSpec{Destinations: []argoprojiov1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}}},
+		},
+		&argoprojiov1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "argocd"},
+			Spec:       argoprojiov1alpha1.AppProjectSpec{Destinations: []argoprojiov1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "tenants"}}},
+		},
+	).Build()
+
+	cases := []struct {
+		name          string
+		generator     *argoprojiov1alpha1.KubernetesResourceGenerator
+		project       string
+		goTemplate    bool
+		expected      []map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "Namespaces with a label",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion:    "v1",
+				Kind:          "Namespace",
+				LabelSelector: tenantSelector,
+				Values:        map[string]string{"team": "team-{{labels.team}}"},
+			},
+			expected: []map[string]interface{}{{
+				"name":           "team-a",
+				"namespace":      "",
+				"apiVersion":     "v1",
+				"kind":           "Namespace",
+				"uid":            "team-a-uid",
+				"labels.tenant":  "true",
+				"labels.team":    "a",
+				"cluster.name":   "in-cluster",
+				"cluster.server": "https://kubernetes.default.svc",
+				"values.team":    "team-a",
+			}},
+		},
+		{
+			name: "Custom resources of a namespace with mapped params",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "example.com/v1alpha1",
+				Kind:       "Tenant",
+				Namespace:  "tenants",
+				Params:     map[string]string{"owner": ".spec.owner", "regions": "{.spec.regions[*]}", "missing": ".spec.missing"},
+			},
+			project:    "tenants",
+			goTemplate: true,
+			expected: []map[string]interface{}{{
+				"name":        "a",
+				"namespace":   "tenants",
+				"apiVersion":  "example.com/v1alpha1",
+				"kind":        "Tenant",
+				"uid":         "a-uid",
+				"labels":      map[string]interface{}{},
+				"annotations": map[string]interface{}{},
+				"cluster":     map[string]interface{}{"name": "in-cluster", "server": "https://kubernetes.default.svc"},
+				"owner":       "alice",
+				"regions":     []interface{}{"eu", "us"},
+				"missing":     "",
+			}},
+		},
+		{
+			name: "Custom resources of all namespaces",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "example.com/v1alpha1",
+				Kind:       "Tenant",
+				Values:     map[string]string{"namespace": "{{namespace}}"},
+			},
+			expected: []map[string]interface{}{
+				{"name": "a", "namespace": "tenants", "apiVersion": "example.com/v1alpha1", "kind": "Tenant", "uid": "a-uid", "cluster.name": "in-cluster", "cluster.server": "https://kubernetes.default.svc", "values.namespace": "tenants"},
+				{"name": "b", "namespace": "other", "apiVersion": "example.com/v1alpha1", "kind": "Tenant", "uid": "b-uid", "cluster.name": "in-cluster", "cluster.server": "https://kubernetes.default.svc", "values.namespace": "other"},
+			},
+		},
+		{
+			name: "Remote cluster by name",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion:    "v1",
+				Kind:          "Namespace",
+				LabelSelector: tenantSelector,
+				Cluster:       "remote",
+			},
+			expected: []map[string]interface{}{{
+				"name":           "team-r",
+				"namespace":      "",
+				"apiVersion":     "v1",
+				"kind":           "Namespace",
+				"uid":            "team-r-uid",
+				"labels.tenant":  "true",
+				"cluster.name":   "remote",
+				"cluster.server": "https://remote.example.com",
+			}},
+		},
+		{
+			name: "Remote cluster by server",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+				Cluster:    "https://remote.example.com",
+			},
+			goTemplate: true,
+			expected: []map[string]interface{}{{
+				"name":        "team-r",
+				"namespace":   "",
+				"apiVersion":  "v1",
+				"kind":        "Namespace",
+				"uid":         "team-r-uid",
+				"labels":      map[string]interface{}{"tenant": "true"},
+				"annotations": map[string]interface{}{},
+				"cluster":     map[string]interface{}{"name": "remote", "server": "https://remote.example.com"},
+			}},
+		},
+		{
+			name:          "Unregistered cluster",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace", Cluster: "missing"},
+			expectedError: "cluster missing is not registered",
+		},
+		{
+			name:          "Secrets",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Secret"},
+			expectedError: "generating parameters from Secrets is not supported",
+		},
+		{
+			name:          "Unknown kind",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Duck"},
+			expectedError: "kind Duck is not served by v1",
+		},
+		{
+			name:          "Kind which is not allowed",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "apps/v1", Kind: "Deployment"},
+			expectedError: "kind Deployment of apps/v1 is not allowed",
+		},
+		{
+			name:          "All namespaces of a restricted project",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "example.com/v1alpha1", Kind: "Tenant"},
+			project:       "tenants",
+			expectedError: "cluster https://kubernetes.default.svc and namespace * are not permitted destinations of project tenants",
+		},
+		{
+			name:          "Cluster of a restricted project",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "example.com/v1alpha1", Kind: "Tenant", Namespace: "tenants", Cluster: "remote"},
+			project:       "tenants",
+			expectedError: "cluster https://remote.example.com and namespace tenants are not permitted destinations of project tenants",
+		},
+		{
+			name:          "Templated project",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace"},
+			project:       "{{name}}",
+			expectedError: "the project of the applications must not be templated",
+		},
+		{
+			name:          "Namespace of a cluster-scoped kind",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace", Namespace: "default"},
+			expectedError: "kind Namespace is not namespaced",
+		},
+	}
+
+	for _, testCase := range cases {
+		testCaseCopy := testCase
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientset := kubefake.NewSimpleClientset(clusterSecret)
+			clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = apiResources
+			kubernetesResourceConfig := NewKubernetesResourceConfig(true, []string{"Namespace", "Secret", "Duck", "example.com/*"})
+			generator := NewKubernetesResourceGenerator(context.Background(), dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, localObjects...), clientset, "argocd", kubernetesResourceConfig).(*KubernetesResourceGenerator)
+			generator.getClusterClients = func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+				if cluster.Server != "https://remote.example.com" {
+					return nil, nil, fmt.Errorf("unexpected cluster %s", cluster.Server)
+				}
+				return dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, remoteObjects...),
+					&fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: apiResources}}, nil
+			}
+
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCaseCopy.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{KubernetesResource: testCaseCopy.generator}},
+					Template: argoprojiov1alpha1.ApplicationSetTemplate{
+						Spec: argoprojiov1alpha1.ApplicationSpec{Project: testCaseCopy.project},
+					},
+				},
+			}
+
+			got, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, projects)
+
+			if testCaseCopy.expectedError != "" {
+				require.ErrorContains(t, err, testCaseCopy.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.ElementsMatch(t, testCaseCopy.expected, got)
+			}
+		})
+	}
+}
+
+func TestKubernetesResourceGenerateParamsDisabled(t *testing.T) {
+	generator := NewKubernetesResourceGenerator(context.Background(), nil, nil, "argocd", NewKubernetesResourceConfig(false, []string{"Namespace"}))
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace"}}},
+		},
+	}
+
+	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+
+	require.ErrorIs(t, err, ErrKubernetesResourceGeneratorDisabled)
+}
+
+func TestKubernetesResourceIsKindAllowed(t *testing.T) {
+	config := NewKubernetesResourceConfig(true, []string{"Namespace", "apps/Deployment", "example.com/*"})
+
+	assert.True(t, config.isKindAllowed("", "Namespace"))
+	assert.True(t, config.isKindAllowed("apps", "Deployment"))
+	assert.True(t, config.isKindAllowed("example.com", "Tenant"))
+	assert.False(t, config.isKindAllowed("", "ConfigMap"))
+	assert.False(t, config.isKindAllowed("apps", "StatefulSet"))
+	assert.False(t, config.isKindAllowed("other.example.com", "Namespace"))
+}
+
+func TestKubernetesResourceCachedClusterClients(t *testing.T) {
+	generator := NewKubernetesResourceGenerator(context.Background(), nil, nil, "argocd", NewKubernetesResourceConfig(true, nil)).(*KubernetesResourceGenerator)
+	var created int
+	generator.getClusterClients = func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+		created++
+		return dynfake.NewSimpleDynamicClient(runtime.NewScheme()), &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{}}, nil
+	}
+	cluster := &argoprojiov1alpha1.Cluster{Server: "https://remote.example.com", Config: argoprojiov1alpha1.ClusterConfig{BearerToken: "a"}}
+
+	first, _, err := generator.getCachedClusterClients(cluster)
+	require.NoError(t, err)
+	second, _, err := generator.getCachedClusterClients(cluster)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, created)
+
+	// the clients are created again if the configuration of the cluster changes
+	cluster.Config.BearerToken = "b"
+	third, _, err := generator.getCachedClusterClients(cluster)
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+	assert.Equal(t, 2, created)
+}
+
+func TestKubernetesResourceGetRequeueAfter(t *testing.T) {
+	generator := NewKubernetesResourceGenerator(context.Background(), nil, nil, "argocd", NewKubernetesResourceConfig(true, nil))
+	requeueAfterSeconds := int64(60)
+
+	assert.Equal(t, DefaultRequeueAfterSeconds, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{}}))
+	assert.Equal(t, time.Minute, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{RequeueAfterSeconds: &requeueAfterSeconds}}))
+}
@@ -111,6 +111,9 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			Registry:                appSetBaseGenerator.Registry,
+			HTTP:                    appSetBaseGenerator.HTTP,
+			KubernetesResource:      appSetBaseGenerator.KubernetesResource,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -150,6 +153,9 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			Registry:                r.Registry,
+			HTTP:                    r.HTTP,
+			KubernetesResource:      r.KubernetesResource,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
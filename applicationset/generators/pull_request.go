@@ -15,7 +15,17 @@ import (
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
-var _ Generator = (*PullRequestGenerator)(nil)
+var (
+	_ Generator                   = (*PullRequestGenerator)(nil)
+	_ PullRequestFeedbackProvider = (*PullRequestGenerator)(nil)
+)
+
+// PullRequestFeedbackProvider is implemented by the generators able to post feedback about the generated applications
+// back to their pull requests.
+type PullRequestFeedbackProvider interface {
+	// GetFeedbackService returns the service posting feedback to the pull requests of the given generator
+	GetFeedbackService(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestFeedbackService, error)
+}
 
 const (
 	DefaultPullRequestRequeueAfterSeconds = 30 * time.Minute
@@ -117,6 +127,34 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return params, nil
 }
 
+func (g *PullRequestGenerator) GetFeedbackService(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestFeedbackService, error) {
+	svc, err := g.selectServiceProviderFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select pull request service provider: %w", err)
+	}
+	feedbackSvc, ok := svc.(pullrequest.PullRequestFeedbackService)
+	if !ok {
+		return nil, fmt.Errorf("pull request service provider does not support feedback")
+	}
+	return feedbackSvc, nil
+}
+
+// PullRequestReference returns the reference to the pull request the given parameters were generated for by the pull
+// request generator at the given index of the generators of the application set.
+func PullRequestReference(generatorIndex int, params map[string]interface{}) (*pullrequest.Reference, error) {
+	number, err := strconv.Atoi(fmt.Sprintf("%v", params["number"]))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request number: %w", err)
+	}
+	return &pullrequest.Reference{
+		Generator:    generatorIndex,
+		Number:       number,
+		Branch:       fmt.Sprintf("%v", params["branch"]),
+		TargetBranch: fmt.Sprintf("%v", params["target_branch"]),
+		HeadSHA:      fmt.Sprintf("%v", params["head_sha"]),
+	}, nil
+}
+
 // selectServiceProvider selects the provider to get pull requests from the configuration
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
@@ -0,0 +1,128 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/registry"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*RegistryGenerator)(nil)
+
+const (
+	DefaultRegistryRequeueAfterSeconds = 30 * time.Minute
+)
+
+type RegistryGenerator struct {
+	client client.Client
+	// Testing hooks.
+	overrideService registry.RegistryService
+}
+
+func NewRegistryGenerator(client client.Client) Generator {
+	return &RegistryGenerator{
+		client: client,
+	}
+}
+
+func (g *RegistryGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.Registry.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.Registry.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultRegistryRequeueAfterSeconds
+}
+
+func (g *RegistryGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.Registry.Template
+}
+
+func (g *RegistryGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]interface{}, error) {
+	if appSetGenerator == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if appSetGenerator.Registry == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	ctx := context.Background()
+	generatorConfig := appSetGenerator.Registry
+	var limit int64
+	if generatorConfig.Limit != nil {
+		limit = *generatorConfig.Limit
+	}
+
+	params := []map[string]interface{}{}
+	for i := range generatorConfig.Repositories {
+		repo := &generatorConfig.Repositories[i]
+		svc, err := g.getService(ctx, repo, applicationSetInfo)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing registry service for %s: %w", repo.Repository, err)
+		}
+		tags, err := registry.ListTags(ctx, svc, generatorConfig.Filters, generatorConfig.SortBy, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags of %s: %w", repo.Repository, err)
+		}
+
+		for _, tag := range tags {
+			created := ""
+			if tag.Created != nil {
+				created = tag.Created.UTC().Format(time.RFC3339)
+			}
+			paramMap := map[string]interface{}{
+				"repository":    repo.Repository,
+				"tag":           tag.Name,
+				"tagNormalized": utils.SanitizeName(tag.Name),
+				"image":         repo.Repository + ":" + tag.Name,
+				"digest":        tag.Digest,
+				"created":       created,
+			}
+
+			err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			params = append(params, paramMap)
+		}
+	}
+	return params, nil
+}
+
+func (g *RegistryGenerator) getService(ctx context.Context, repo *argoprojiov1alpha1.RegistryGeneratorRepository, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (registry.RegistryService, error) {
+	if g.overrideService != nil {
+		return g.overrideService, nil
+	}
+
+	var creds registry.Credentials
+	var err error
+	if repo.BasicAuth != nil {
+		creds.Username = repo.BasicAuth.Username
+		creds.Password, err = utils.GetSecretRef(ctx, g.client, repo.BasicAuth.PasswordRef, applicationSetInfo.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret password: %w", err)
+		}
+	}
+	if repo.BearerToken != nil {
+		creds.Token, err = utils.GetSecretRef(ctx, g.client, repo.BearerToken.TokenRef, applicationSetInfo.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		}
+	}
+	var caCerts []byte
+	if repo.CARef != nil {
+		caCerts, err = utils.GetConfigMapData(ctx, g.client, repo.CARef, applicationSetInfo.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", err)
+		}
+	}
+	return registry.NewRegistryService(repo.Repository, creds, repo.Insecure, caCerts)
+}
@@ -0,0 +1,150 @@
+package generators
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/registry"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestRegistryGenerateParams(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	limit := int64(2)
+	tagMatch := "-rc"
+
+	cases := []struct {
+		name          string
+		tags          []*registry.Tag
+		listError     error
+		generator     *argoprojiov1alpha1.RegistryGenerator
+		goTemplate    bool
+		expected      []map[string]interface{}
+		expectedError error
+	}{
+		{
+			name: "Filtered and limited tags",
+			tags: []*registry.Tag{
+				{Name: "v1.0.0-rc1", Digest: "sha256:1", Created: &created},
+				{Name: "v1.1.0-rc1", Digest: "sha256:2"},
+				{Name: "v1.1.0-RC2", Digest: "sha256:3"},
+				{Name: "v1.1.0", Digest: "sha256:4"},
+			},
+			generator: &argoprojiov1alpha1.RegistryGenerator{
+				Repositories: []argoprojiov1alpha1.RegistryGeneratorRepository{{Repository: "ghcr.io/argoproj/guestbook"}},
+				Filters:      []argoprojiov1alpha1.RegistryGeneratorFilter{{TagMatch: &tagMatch}},
+				Limit:        &limit,
+			},
+			expected: []map[string]interface{}{
+				{
+					"repository":    "ghcr.io/argoproj/guestbook",
+					"tag":           "v1.1.0-rc1",
+					"tagNormalized": "v1.1.0-rc1",
+					"image":         "ghcr.io/argoproj/guestbook:v1.1.0-rc1",
+					"digest":        "sha256:2",
+					"created":       "",
+				},
+				{
+					"repository":    "ghcr.io/argoproj/guestbook",
+					"tag":           "v1.0.0-rc1",
+					"tagNormalized": "v1.0.0-rc1",
+					"image":         "ghcr.io/argoproj/guestbook:v1.0.0-rc1",
+					"digest":        "sha256:1",
+					"created":       "2024-05-01T12:00:00Z",
+				},
+			},
+		},
+		{
+			name: "Value interpolation",
+			tags: []*registry.Tag{{Name: "Feature_1", Digest: "sha256:1"}},
+			generator: &argoprojiov1alpha1.RegistryGenerator{
+				Repositories: []argoprojiov1alpha1.RegistryGeneratorRepository{{Repository: "ghcr.io/argoproj/guestbook"}},
+				Values:       map[string]string{"name": "guestbook-{{ .tagNormalized }}"},
+			},
+			goTemplate: true,
+			expected: []map[string]interface{}{
+				{
+					"repository":    "ghcr.io/argoproj/guestbook",
+					"tag":           "Feature_1",
+					"tagNormalized": "feature-1",
+					"image":         "ghcr.io/argoproj/guestbook:Feature_1",
+					"digest":        "sha256:1",
+					"created":       "",
+					"values":        map[string]string{"name": "guestbook-feature-1"},
+				},
+			},
+		},
+		{
+			name:      "Listing error",
+			listError: fmt.Errorf("unauthorized"),
+			generator: &argoprojiov1alpha1.RegistryGenerator{
+				Repositories: []argoprojiov1alpha1.RegistryGeneratorRepository{{Repository: "ghcr.io/argoproj/guestbook"}},
+			},
+			expectedError: fmt.Errorf("error listing tags of ghcr.io/argoproj/guestbook: unauthorized"),
+		},
+	}
+
+	for _, testCase := range cases {
+		testCaseCopy := testCase
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := &RegistryGenerator{overrideService: registry.NewFakeService(testCaseCopy.tags, testCaseCopy.listError)}
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCaseCopy.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+						Registry: testCaseCopy.generator,
+					}},
+				},
+			}
+
+			got, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+
+			if testCaseCopy.expectedError != nil {
+				assert.EqualError(t, err, testCaseCopy.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCaseCopy.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegistryGenerateParamsMissingSecret(t *testing.T) {
+	generator := NewRegistryGenerator(fake.NewClientBuilder().Build())
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+				Registry: &argoprojiov1alpha1.RegistryGenerator{
+					Repositories: []argoprojiov1alpha1.RegistryGeneratorRepository{{
+						Repository:  "ghcr.io/argoproj/guestbook",
+						BearerToken: &argoprojiov1alpha1.BearerTokenRegistry{TokenRef: &argoprojiov1alpha1.SecretRef{SecretName: "registry", Key: "token"}},
+					}},
+				},
+			}},
+		},
+	}
+
+	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+	require.ErrorContains(t, err, "error fetching Secret token")
+}
+
+func TestRegistryGetRequeueAfter(t *testing.T) {
+	generator := NewRegistryGenerator(nil)
+	requeueAfterSeconds := int64(60)
+
+	assert.Equal(t, DefaultRegistryRequeueAfterSeconds, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{Registry: &argoprojiov1alpha1.RegistryGenerator{}}))
+	assert.Equal(t, time.Minute, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{Registry: &argoprojiov1alpha1.RegistryGenerator{RequeueAfterSeconds: &requeueAfterSeconds}}))
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, httpConfig HTTPConfig, kubernetesResourceConfig KubernetesResourceConfig) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
 		"Clusters":                NewClusterGenerator(c, ctx, k8sClient, namespace),
@@ -19,6 +19,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, namespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
 		"Plugin":                  NewPluginGenerator(c, ctx, k8sClient, namespace),
+		"Registry":                NewRegistryGenerator(c),
+		"HTTP":                    NewHTTPGenerator(c, httpConfig),
+		"KubernetesResource":      NewKubernetesResourceGenerator(ctx, dynamicClient, k8sClient, namespace, kubernetesResourceConfig),
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,6 +32,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"Registry":                terminalGenerators["Registry"],
+		"HTTP":                    terminalGenerators["HTTP"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
 		"Matrix":                  NewMatrixGenerator(terminalGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
@@ -41,6 +47,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"Registry":                terminalGenerators["Registry"],
+		"HTTP":                    terminalGenerators["HTTP"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
 		"Matrix":                  NewMatrixGenerator(nestedGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
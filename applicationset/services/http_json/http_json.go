@@ -0,0 +1,523 @@
+package http_json
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	userAgent = "argocd-applicationset"
+
+	// DefaultMaxPages is the maximum number of pages requested, unless configured otherwise
+	DefaultMaxPages = 100
+	// DefaultNextTokenParam is the query parameter the token of the next page is passed in, unless configured otherwise
+	DefaultNextTokenParam = "pageToken"
+
+	// maxResponseSize is the maximum size of a response which is accepted from an endpoint
+	maxResponseSize = 10 * 1024 * 1024
+	// requestTimeout is the timeout of every request
+	requestTimeout = 30 * time.Second
+)
+
+// Request describes the requests listing the items of an endpoint.
+type Request struct {
+	URL     string
+	Method  string
+	Headers map[string]string
+	Body    string
+	// ItemsPath is the JSONPath expression of the list of items in every response
+	ItemsPath string
+	// LinkHeader follows the link with rel="next" of the Link header of the responses
+	LinkHeader bool
+	// NextTokenPath is the JSONPath expression of the token of the next page in every response
+	NextTokenPath string
+	// NextTokenParam is the query parameter the token of the next page is passed in
+	NextTokenParam string
+	// MaxPages is the maximum number of pages requested
+	MaxPages int
+	// Insecure and CACerts are the TLS settings the endpoint is requested with. They are part of the key of the
+	// request, so that a response is not shared with requests which would not trust the endpoint.
+	Insecure bool
+	CACerts  []byte
+}
+
+// key returns the key identifying the request in the cache. The key is a hash, so that the values of the headers,
+// which may be secrets, are not kept in memory as plain text.
+func (r *Request) key() string {
+	data, _ := json.Marshal(r)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// URLPolicy restricts the endpoints a service may request.
+type URLPolicy struct {
+	// AllowedURLs are the URL prefixes which may be requested. All URLs may be requested if empty.
+	AllowedURLs []string
+	// AllowLocalAddresses allows connections to loopback and link-local addresses, which are refused otherwise, so
+	// that neither the endpoints of the host nor the metadata services of cloud providers can be requested.
+	AllowLocalAddresses bool
+}
+
+// CheckURL returns an error if the given URL may not be requested.
+func (p URLPolicy) CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("error parsing URL %s: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL %s is not an http or https URL", rawURL)
+	}
+	if len(p.AllowedURLs) == 0 {
+		return nil
+	}
+	for _, allowedURL := range p.AllowedURLs {
+		if hasURLPrefix(u, allowedURL) {
+			return nil
+		}
+	}
+	return fmt.Errorf("URL %s not allowed, must start with one of the following: %s", rawURL, strings.Join(p.AllowedURLs, ", "))
+}
+
+// hasURLPrefix returns whether the given URL has the same scheme and host as the given prefix, and a path which
+// equals the path of the prefix or is below it.
+func hasURLPrefix(u *url.URL, prefix string) bool {
+	p, err := url.Parse(prefix)
+	if err != nil || !strings.EqualFold(u.Scheme, p.Scheme) || !strings.EqualFold(u.Host, p.Host) {
+		return false
+	}
+	prefixPath := strings.TrimSuffix(p.Path, "/")
+	return prefixPath == "" || u.Path == prefixPath || strings.HasPrefix(u.Path, prefixPath+"/")
+}
+
+// checkAddress returns an error if the given address of a connection is a loopback or link-local address.
+func (p URLPolicy) checkAddress(_ string, address string, _ syscall.RawConn) error {
+	if p.AllowLocalAddresses {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address %s", address)
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("connections to loopback and link-local addresses are not allowed: %s", host)
+	}
+	return nil
+}
+
+// Service lists the items of a list in the JSON responses of HTTP endpoints.
+type Service struct {
+	client *http.Client
+	cache  *Cache
+	policy URLPolicy
+}
+
+// NewService returns a service requesting endpoints with the given TLS configuration, which are allowed by the given
+// policy. Responses are cached in the given cache, if not nil.
+func NewService(tlsConfig *tls.Config, cache *Cache, policy URLPolicy) *Service {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		// the address is checked after it was resolved, so that a host name cannot resolve to a refused address
+		Control: policy.checkAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.DialContext = dialer.DialContext
+	return &Service{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 10 {
+					return errors.New("stopped after 10 redirects")
+				}
+				return policy.CheckURL(req.URL.String())
+			},
+		},
+		cache:  cache,
+		policy: policy,
+	}
+}
+
+// ListItems requests all pages of the endpoint and returns the items of the list of every page. If the items were
+// listed less than maxAge ago, the cached items are returned without requesting the endpoint.
+func (s *Service) ListItems(ctx context.Context, req *Request, maxAge time.Duration) ([]interface{}, error) {
+	itemsPath, err := parseJSONPath("itemsPath", req.ItemsPath)
+	if err != nil {
+		return nil, err
+	}
+	var nextTokenPath *jsonpath.JSONPath
+	if req.NextTokenPath != "" {
+		if nextTokenPath, err = parseJSONPath("nextTokenPath", req.NextTokenPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.policy.CheckURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	key := req.key()
+	if items, ok := s.cache.getItems(key, maxAge); ok {
+		return items, nil
+	}
+
+	maxPages := req.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	nextTokenParam := req.NextTokenParam
+	if nextTokenParam == "" {
+		nextTokenParam = DefaultNextTokenParam
+	}
+
+	items := []interface{}{}
+	pageURL := req.URL
+	for page := 1; pageURL != ""; page++ {
+		if page > maxPages {
+			return nil, fmt.Errorf("list exceeds the maximum of %d pages", maxPages)
+		}
+		if err := s.policy.CheckURL(pageURL); err != nil {
+			return nil, err
+		}
+		data, header, err := s.get(ctx, req, pageURL, maxAge)
+		if err != nil {
+			return nil, err
+		}
+		pageItems, err := findItems(itemsPath, data)
+		if err != nil {
+			return nil, fmt.Errorf("error finding the items of page %d: %w", page, err)
+		}
+		items = append(items, pageItems...)
+
+		currentURL := pageURL
+		pageURL = ""
+		if req.LinkHeader {
+			if pageURL, err = nextLink(currentURL, header); err != nil {
+				return nil, err
+			}
+		} else if nextTokenPath != nil {
+			token, err := findString(nextTokenPath, data)
+			if err != nil {
+				return nil, fmt.Errorf("error finding the next page token of page %d: %w", page, err)
+			}
+			if token != "" {
+				if pageURL, err = withQueryParam(req.URL, nextTokenParam, token); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	s.cache.setItems(key, items, maxAge)
+	return items, nil
+}
+
+// get requests the page with the given URL, sending the ETag of the cached response of the page, if any, and returns
+// the decoded JSON response.
+func (s *Service) get(ctx context.Context, req *Request, pageURL string, maxAge time.Duration) (interface{}, http.Header, error) {
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if req.Body != "" {
+		body = strings.NewReader(req.Body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, pageURL, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	if req.Body != "" {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range req.Headers {
+		httpReq.Header.Set(name, value)
+	}
+
+	pageKey := req.key() + "/" + pageURL
+	cached := s.cache.getPage(pageKey)
+	if cached != nil {
+		httpReq.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error requesting %s: %w", pageURL, err)
+	}
+	defer resp.Body.Close()
+
+	var data []byte
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		data = cached.body
+		s.cache.setPage(pageKey, cached.etag, cached.body, maxAge)
+	} else {
+		data, err = io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading response of %s: %w", pageURL, err)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			// the body is not part of the error, since it would be exposed in the status of the application set
+			return nil, nil, fmt.Errorf("%s %s returned %s", method, pageURL, resp.Status)
+		}
+		if len(data) > maxResponseSize {
+			return nil, nil, fmt.Errorf("response of %s exceeds the maximum size of %d bytes", pageURL, maxResponseSize)
+		}
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			s.cache.setPage(pageKey, etag, data, maxAge)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// numbers are kept as they are, so that e.g. identifiers are not rendered in exponent notation
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, nil, fmt.Errorf("error decoding response of %s: %w", pageURL, err)
+	}
+	return decoded, resp.Header, nil
+}
+
+// parseJSONPath parses the given JSONPath expression, which may omit the surrounding braces, e.g. .items
+func parseJSONPath(name string, expression string) (*jsonpath.JSONPath, error) {
+	if expression == "" {
+		expression = "{@}"
+	}
+	if !strings.Contains(expression, "{") {
+		expression = "{" + expression + "}"
+	}
+	path := jsonpath.New(name)
+	if err := path.Parse(expression); err != nil {
+		return nil, fmt.Errorf("error parsing %s %q: %w", name, expression, err)
+	}
+	return path, nil
+}
+
+// EvaluateJSONPath evaluates the given JSONPath expression against the given data. It returns nil if nothing matches,
+// the single value if one value matches and a list of the values otherwise.
+func EvaluateJSONPath(expression string, data interface{}) (interface{}, error) {
+	path, err := parseJSONPath("param", expression)
+	if err != nil {
+		return nil, err
+	}
+	path.AllowMissingKeys(true)
+	values, err := findValues(path, data)
+	if err != nil {
+		return nil, err
+	}
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	}
+	return values, nil
+}
+
+func findValues(path *jsonpath.JSONPath, data interface{}) ([]interface{}, error) {
+	results, err := path.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+	values := []interface{}{}
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+	return values, nil
+}
+
+// findItems returns the items of the list the given path points to. If the path points to a single list, the
+// elements of the list are the items.
+func findItems(path *jsonpath.JSONPath, data interface{}) ([]interface{}, error) {
+	values, err := findValues(path, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 1 {
+		if list, ok := values[0].([]interface{}); ok {
+			return list, nil
+		}
+		if values[0] == nil || reflect.ValueOf(values[0]).Kind() != reflect.Map {
+			return nil, fmt.Errorf("expected a list, got %T", values[0])
+		}
+	}
+	return values, nil
+}
+
+func findString(path *jsonpath.JSONPath, data interface{}) (string, error) {
+	path.AllowMissingKeys(true)
+	values, err := findValues(path, data)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 || values[0] == nil {
+		return "", nil
+	}
+	return fmt.Sprintf("%v", values[0]), nil
+}
+
+// nextLink returns the URL of the link with rel="next" of the Link header, resolved against the URL of the current
+// page, or an empty string if there is none.
+func nextLink(currentURL string, header http.Header) (string, error) {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.TrimSpace(name) != "rel" || !hasRel(strings.Trim(strings.TrimSpace(value), `"`), "next") {
+					continue
+				}
+				base, err := url.Parse(currentURL)
+				if err != nil {
+					return "", fmt.Errorf("error parsing URL %s: %w", currentURL, err)
+				}
+				next, err := base.Parse(strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">"))
+				if err != nil {
+					return "", fmt.Errorf("error parsing next link %s: %w", target, err)
+				}
+				return next.String(), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func hasRel(rels string, rel string) bool {
+	for _, r := range strings.Fields(rels) {
+		if strings.EqualFold(r, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+func withQueryParam(rawURL string, name string, value string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing URL %s: %w", rawURL, err)
+	}
+	query := u.Query()
+	query.Set(name, value)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Cache caches the items listed from endpoints, and the responses of the endpoints with an ETag. Entries are kept
+// for twice their maximum age, so that the responses can be revalidated after the items expired.
+type Cache struct {
+	lock  sync.Mutex
+	items map[string]*cachedItems
+	pages map[string]*cachedPage
+	now   func() time.Time
+}
+
+type cachedItems struct {
+	items     []interface{}
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+type cachedPage struct {
+	etag      string
+	body      []byte
+	expiresAt time.Time
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		items: map[string]*cachedItems{},
+		pages: map[string]*cachedPage{},
+		now:   time.Now,
+	}
+}
+
+func (c *Cache) getItems(key string, maxAge time.Duration) ([]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.items[key]
+	if !ok || c.now().Sub(entry.fetchedAt) >= maxAge {
+		return nil, false
+	}
+	return entry.items, true
+}
+
+func (c *Cache) setItems(key string, items []interface{}, maxAge time.Duration) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.now()
+	c.items[key] = &cachedItems{items: items, fetchedAt: now, expiresAt: now.Add(2 * maxAge)}
+	c.prune(now)
+}
+
+func (c *Cache) getPage(key string) *cachedPage {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.pages[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		return nil
+	}
+	return entry
+}
+
+func (c *Cache) setPage(key string, etag string, body []byte, maxAge time.Duration) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pages[key] = &cachedPage{etag: etag, body: body, expiresAt: c.now().Add(2 * maxAge)}
+}
+
+// prune removes the expired entries. It must be called with the lock held.
+func (c *Cache) prune(now time.Time) {
+	for key, entry := range c.items {
+		if now.After(entry.expiresAt) {
+			delete(c.items, key)
+		}
+	}
+	for key, entry := range c.pages {
+		if now.After(entry.expiresAt) {
+			delete(c.pages, key)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package http_json
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListItems(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		switch r.URL.Path {
+		case "/services":
+			_, _ = w.Write([]byte(`[{"name":"a","id":1234567}]`))
+		case "/wrapped":
+			_, _ = w.Write([]byte(`{"data":{"services":[{"name":"a"},{"name":"b"}]}}`))
+		case "/link":
+			switch r.URL.Query().Get("page") {
+			case "":
+				w.Header().Set("Link", `<https://example.com/other>; rel="prev", </link?page=2>; rel="next"`)
+				_, _ = w.Write([]byte(`[{"name":"a"}]`))
+			case "2":
+				w.Header().Set("Link", `</link?page=1>; rel="first"`)
+				_, _ = w.Write([]byte(`[{"name":"b"}]`))
+			}
+		case "/token":
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				_, _ = w.Write([]byte(`{"items":[{"name":"a"}],"next":"abc"}`))
+			case "abc":
+				_, _ = w.Write([]byte(`{"items":[{"name":"b"}],"next":""}`))
+			}
+		case "/endless":
+			w.Header().Set("Link", `</endless>; rel="next"`)
+			_, _ = w.Write([]byte(`[]`))
+		case "/post":
+			if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+				return
+			}
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = fmt.Fprintf(w, `[{"name":%q}]`, body["name"])
+		case "/object":
+			_, _ = w.Write([]byte(`{"name":"a"}`))
+		case "/scalar":
+			_, _ = w.Write([]byte(`"a"`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	svc := NewService(server.Client().Transport.(*http.Transport).TLSClientConfig, nil, URLPolicy{AllowLocalAddresses: true})
+
+	cases := []struct {
+		name             string
+		request          Request
+		expected         []interface{}
+		expectedRequests int
+		expectedError    string
+	}{
+		{
+			name:             "list",
+			request:          Request{URL: server.URL + "/services"},
+			expected:         []interface{}{map[string]interface{}{"name": "a", "id": json.Number("1234567")}},
+			expectedRequests: 1,
+		},
+		{
+			name:             "items path",
+			request:          Request{URL: server.URL + "/wrapped", ItemsPath: ".data.services"},
+			expected:         []interface{}{map[string]interface{}{"name": "a"}, map[string]interface{}{"name": "b"}},
+			expectedRequests: 1,
+		},
+		{
+			name:             "items path with wildcard",
+			request:          Request{URL: server.URL + "/wrapped", ItemsPath: "{.data.services[?(@.name==\"b\")]}"},
+			expected:         []interface{}{map[string]interface{}{"name": "b"}},
+			expectedRequests: 1,
+		},
+		{
+			name:             "link header pagination",
+			request:          Request{URL: server.URL + "/link", LinkHeader: true},
+			expected:         []interface{}{map[string]interface{}{"name": "a"}, map[string]interface{}{"name": "b"}},
+			expectedRequests: 2,
+		},
+		{
+			name:             "next token pagination",
+			request:          Request{URL: server.URL + "/token", ItemsPath: ".items", NextTokenPath: ".next", NextTokenParam: "cursor"},
+			expected:         []interface{}{map[string]interface{}{"name": "a"}, map[string]interface{}{"name": "b"}},
+			expectedRequests: 2,
+		},
+		{
+			name:             "post",
+			request:          Request{URL: server.URL + "/post", Method: http.MethodPost, Body: `{"name":"a"}`, Headers: map[string]string{"Authorization": "Bearer token"}},
+			expected:         []interface{}{map[string]interface{}{"name": "a"}},
+			expectedRequests: 1,
+		},
+		{
+			name:             "object",
+			request:          Request{URL: server.URL + "/object"},
+			expected:         []interface{}{map[string]interface{}{"name": "a"}},
+			expectedRequests: 1,
+		},
+		{
+			name:             "too many pages",
+			request:          Request{URL: server.URL + "/endless", LinkHeader: true, MaxPages: 3},
+			expectedRequests: 3,
+			expectedError:    "list exceeds the maximum of 3 pages",
+		},
+		{
+			name:             "error response",
+			request:          Request{URL: server.URL + "/post"},
+			expectedRequests: 1,
+			expectedError:    "returned 403 Forbidden",
+		},
+		{
+			name:             "missing items",
+			request:          Request{URL: server.URL + "/wrapped", ItemsPath: ".items"},
+			expectedRequests: 1,
+			expectedError:    "error finding the items of page 1",
+		},
+		{
+			name:             "scalar",
+			request:          Request{URL: server.URL + "/scalar"},
+			expectedRequests: 1,
+			expectedError:    "expected a list, got string",
+		},
+		{
+			name:          "invalid items path",
+			request:       Request{URL: server.URL + "/services", ItemsPath: "{.items"},
+			expectedError: "error parsing itemsPath",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests = nil
+			items, err := svc.ListItems(context.Background(), &c.request, 0)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				assert.NotContains(t, err.Error(), "forbidden\"")
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, c.expected, items)
+			}
+			assert.Len(t, requests, c.expectedRequests)
+		})
+	}
+}
+
+func TestListItemsCache(t *testing.T) {
+	body := `[{"name":"a"}]`
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		etag := fmt.Sprintf(`"%x"`, len(body))
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	cache := NewCache()
+	cache.now = func() time.Time { return now }
+	svc := NewService(nil, cache, URLPolicy{AllowLocalAddresses: true})
+	req := &Request{URL: server.URL}
+
+	items, err := svc.ListItems(context.Background(), req, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "a"}}, items)
+	assert.Equal(t, 1, requests)
+
+	// the items are not requested again before the maximum age
+	now = now.Add(30 * time.Second)
+	_, err = svc.ListItems(context.Background(), req, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// the response is revalidated after the maximum age
+	now = now.Add(time.Minute)
+	items, err = svc.ListItems(context.Background(), req, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "a"}}, items)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+
+	// a changed response is returned
+	body = `[{"name":"a"},{"name":"b"}]`
+	now = now.Add(time.Minute)
+	items, err = svc.ListItems(context.Background(), req, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, notModified)
+
+	// other requests are cached separately
+	_, err = svc.ListItems(context.Background(), &Request{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}}, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 4, requests)
+
+	// as are requests with other TLS settings
+	_, err = svc.ListItems(context.Background(), &Request{URL: server.URL, Insecure: true}, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 5, requests)
+
+	// expired entries are pruned
+	now = now.Add(time.Hour)
+	_, err = svc.ListItems(context.Background(), req, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, cache.items, 1)
+	assert.Len(t, cache.pages, 1)
+	assert.Equal(t, 1, notModified)
+}
+
+func TestURLPolicy(t *testing.T) {
+	policy := URLPolicy{AllowedURLs: []string{"https://catalog.example.com/api/", "http://services.example.com"}}
+
+	for _, allowed := range []string{
+		"https://catalog.example.com/api",
+		"https://catalog.example.com/api/services?page=2",
+		"https://CATALOG.example.com/api/services",
+		"http://services.example.com/",
+		"http://services.example.com/any",
+	} {
+		require.NoError(t, policy.CheckURL(allowed), allowed)
+	}
+	for _, refused := range []string{
+		"http://catalog.example.com/api/services",
+		"https://catalog.example.com/apis",
+		"https://catalog.example.com.evil.com/api/services",
+		"https://catalog.example.com:8443/api/services",
+		"https://services.example.com/",
+		"file:///etc/passwd",
+	} {
+		require.Error(t, policy.CheckURL(refused), refused)
+	}
+
+	require.NoError(t, URLPolicy{}.CheckURL("https://any.example.com"))
+	require.ErrorContains(t, URLPolicy{}.CheckURL("ftp://any.example.com"), "not an http or https URL")
+}
+
+func TestListItemsLocalAddresses(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	_, err := NewService(nil, nil, URLPolicy{}).ListItems(context.Background(), &Request{URL: server.URL}, 0)
+	require.ErrorContains(t, err, "connections to loopback and link-local addresses are not allowed")
+	assert.Equal(t, 0, requests)
+
+	for _, address := range []string{"127.0.0.1:80", "[::1]:443", "169.254.169.254:80", "[fe80::1]:80", "0.0.0.0:80"} {
+		require.Error(t, URLPolicy{}.checkAddress("tcp", address, nil), address)
+	}
+	require.NoError(t, URLPolicy{}.checkAddress("tcp", "10.0.0.1:443", nil))
+}
+
+func TestListItemsRedirects(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, other.URL, http.StatusFound)
+	}))
+	defer server.Close()
+
+	svc := NewService(nil, nil, URLPolicy{AllowedURLs: []string{server.URL}, AllowLocalAddresses: true})
+	_, err := svc.ListItems(context.Background(), &Request{URL: server.URL}, 0)
+	require.ErrorContains(t, err, "not allowed")
+}
+
+func TestEvaluateJSONPath(t *testing.T) {
+	item := map[string]interface{}{
+		"name":   "a",
+		"labels": map[string]interface{}{"team": "x"},
+		"ports":  []interface{}{json.Number("80"), json.Number("443")},
+	}
+
+	value, err := EvaluateJSONPath(".name", item)
+	require.NoError(t, err)
+	assert.Equal(t, "a", value)
+
+	value, err = EvaluateJSONPath("{.labels.team}", item)
+	require.NoError(t, err)
+	assert.Equal(t, "x", value)
+
+	value, err = EvaluateJSONPath(".ports[*]", item)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{json.Number("80"), json.Number("443")}, value)
+
+	value, err = EvaluateJSONPath(".missing", item)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = EvaluateJSONPath("{.name", item)
+	require.Error(t, err)
+}
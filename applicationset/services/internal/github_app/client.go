@@ -33,3 +33,25 @@ func Client(g github_app_auth.Authentication, url string) (*github.Client, error
 	}
 	return client, nil
 }
+
+// AppClient builds a github client authenticated as the app itself rather than as one of its installations, e.g. to
+// look up the app.
+func AppClient(g github_app_auth.Authentication, url string) (*github.Client, error) {
+	rt, err := ghinstallation.NewAppsTransport(http.DefaultTransport, g.Id, []byte(g.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create github app transport: %w", err)
+	}
+	if url == "" {
+		url = g.EnterpriseBaseURL
+	}
+	httpClient := http.Client{Transport: rt}
+	if url == "" {
+		return github.NewClient(&httpClient), nil
+	}
+	rt.BaseURL = url
+	client, err := github.NewClient(&httpClient).WithEnterpriseURLs(url, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create github enterprise client: %w", err)
+	}
+	return client, nil
+}
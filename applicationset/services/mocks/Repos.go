@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 // Repos is an autogenerated mock type for the Repos type
@@ -13,9 +15,9 @@ type Repos struct {
 	mock.Mock
 }
 
-// GetDirectories provides a mock function with given fields: ctx, repoURL, revision, noRevisionCache, verifyCommit
-func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache bool, verifyCommit bool) ([]string, error) {
-	ret := _m.Called(ctx, repoURL, revision, noRevisionCache, verifyCommit)
+// GetDirectories provides a mock function with given fields: ctx, repoURL, revision, noRevisionCache, verifyCommitProject
+func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) ([]string, error) {
+	ret := _m.Called(ctx, repoURL, revision, noRevisionCache, verifyCommitProject)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDirectories")
@@ -23,19 +25,19 @@ func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision st
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, bool) ([]string, error)); ok {
-		return rf(ctx, repoURL, revision, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, *v1alpha1.AppProject) ([]string, error)); ok {
+		return rf(ctx, repoURL, revision, noRevisionCache, verifyCommitProject)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, bool) []string); ok {
-		r0 = rf(ctx, repoURL, revision, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, *v1alpha1.AppProject) []string); ok {
+		r0 = rf(ctx, repoURL, revision, noRevisionCache, verifyCommitProject)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, bool) error); ok {
-		r1 = rf(ctx, repoURL, revision, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, *v1alpha1.AppProject) error); ok {
+		r1 = rf(ctx, repoURL, revision, noRevisionCache, verifyCommitProject)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,9 +45,9 @@ func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision st
 	return r0, r1
 }
 
-// GetFiles provides a mock function with given fields: ctx, repoURL, revision, pattern, noRevisionCache, verifyCommit
-func (_m *Repos) GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache bool, verifyCommit bool) (map[string][]byte, error) {
-	ret := _m.Called(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommit)
+// GetFiles provides a mock function with given fields: ctx, repoURL, revision, pattern, noRevisionCache, verifyCommitProject
+func (_m *Repos) GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) (map[string][]byte, error) {
+	ret := _m.Called(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommitProject)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFiles")
@@ -53,19 +55,19 @@ func (_m *Repos) GetFiles(ctx context.Context, repoURL string, revision string,
 
 	var r0 map[string][]byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) (map[string][]byte, error)); ok {
-		return rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, *v1alpha1.AppProject) (map[string][]byte, error)); ok {
+		return rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommitProject)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) map[string][]byte); ok {
-		r0 = rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, *v1alpha1.AppProject) map[string][]byte); ok {
+		r0 = rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommitProject)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string][]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool, bool) error); ok {
-		r1 = rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool, *v1alpha1.AppProject) error); ok {
+		r1 = rf(ctx, repoURL, revision, pattern, noRevisionCache, verifyCommitProject)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops"
 	core "github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	git "github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/location"
 )
 
 const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
@@ -15,6 +16,8 @@ const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
 type AzureDevOpsClientFactory interface {
 	// Returns an Azure Devops Client interface.
 	GetClient(ctx context.Context) (git.Client, error)
+	// Returns the id of the user the client is authenticated as.
+	GetAuthenticatedUserID(ctx context.Context) (string, error)
 }
 
 type devopsFactoryImpl struct {
@@ -29,6 +32,17 @@ func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (git.Client, er
 	return gitClient, nil
 }
 
+func (factory *devopsFactoryImpl) GetAuthenticatedUserID(ctx context.Context) (string, error) {
+	connectionData, err := location.NewClient(ctx, factory.connection).GetConnectionData(ctx, location.GetConnectionDataArgs{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get Azure DevOps connection data: %w", err)
+	}
+	if connectionData.AuthenticatedUser == nil || connectionData.AuthenticatedUser.Id == nil {
+		return "", fmt.Errorf("no authenticated Azure DevOps user")
+	}
+	return connectionData.AuthenticatedUser.Id.String(), nil
+}
+
 type AzureDevOpsService struct {
 	clientFactory AzureDevOpsClientFactory
 	project       string
@@ -37,10 +51,20 @@ type AzureDevOpsService struct {
 }
 
 var (
-	_ PullRequestService       = (*AzureDevOpsService)(nil)
-	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
+	_ PullRequestService         = (*AzureDevOpsService)(nil)
+	_ PullRequestFeedbackService = (*AzureDevOpsService)(nil)
+	_ AzureDevOpsClientFactory   = &devopsFactoryImpl{}
 )
 
+// azureDevOpsStatusGenre is the genre of the commit statuses set by Argo CD
+const azureDevOpsStatusGenre = "argocd"
+
+var azureDevOpsFeedbackStates = map[FeedbackState]git.GitStatusState{
+	FeedbackStatePending: git.GitStatusStateValues.Pending,
+	FeedbackStateSuccess: git.GitStatusStateValues.Succeeded,
+	FeedbackStateFailure: git.GitStatusStateValues.Failed,
+}
+
 func NewAzureDevOpsService(ctx context.Context, token, url, organization, project, repo string, labels []string) (PullRequestService, error) {
 	organizationUrl := buildURL(url, organization)
 
@@ -109,6 +133,101 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (a *AzureDevOpsService) SetCommitStatus(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	state := azureDevOpsFeedbackStates[feedback.State]
+	genre := azureDevOpsStatusGenre
+	description := feedbackStatusDescription(feedback, 255)
+	status := &git.GitStatus{
+		Context: &git.GitStatusContext{
+			Genre: &genre,
+			Name:  &feedback.Context,
+		},
+		Description: &description,
+		State:       &state,
+	}
+	if feedback.URL != "" {
+		status.TargetUrl = &feedback.URL
+	}
+	args := git.CreateCommitStatusArgs{
+		GitCommitStatusToCreate: status,
+		CommitId:                &pullRequest.HeadSHA,
+		RepositoryId:            &a.repo,
+		Project:                 &a.project,
+	}
+	if _, err := client.CreateCommitStatus(ctx, args); err != nil {
+		return fmt.Errorf("failed to set status of commit %s: %w", pullRequest.HeadSHA, err)
+	}
+	return nil
+}
+
+func (a *AzureDevOpsService) Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	threads, err := client.GetThreads(ctx, git.GetThreadsArgs{
+		RepositoryId:  &a.repo,
+		PullRequestId: &pullRequest.Number,
+		Project:       &a.project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get threads of pull request %d: %w", pullRequest.Number, err)
+	}
+
+	currentUserID, err := a.clientFactory.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := feedbackCommentBody(feedback)
+	if threads != nil {
+		for _, thread := range *threads {
+			if thread.Id == nil || thread.Comments == nil {
+				continue
+			}
+			for _, comment := range *thread.Comments {
+				if comment.Id == nil || comment.Content == nil || comment.Author == nil || comment.Author.Id == nil {
+					continue
+				}
+				if !isFeedbackComment(*comment.Content, strings.ToLower(*comment.Author.Id), strings.ToLower(currentUserID), feedback) {
+					continue
+				}
+				_, err := client.UpdateComment(ctx, git.UpdateCommentArgs{
+					Comment:       &git.Comment{Content: &body},
+					RepositoryId:  &a.repo,
+					PullRequestId: &pullRequest.Number,
+					ThreadId:      thread.Id,
+					CommentId:     comment.Id,
+					Project:       &a.project,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update comment of pull request %d: %w", pullRequest.Number, err)
+				}
+				return nil
+			}
+		}
+	}
+
+	_, err = client.CreateThread(ctx, git.CreateThreadArgs{
+		CommentThread: &git.GitPullRequestCommentThread{
+			Comments: &[]git.Comment{{Content: &body}},
+		},
+		RepositoryId:  &a.repo,
+		PullRequestId: &pullRequest.Number,
+		Project:       &a.project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to comment pull request %d: %w", pullRequest.Number, err)
+	}
+	return nil
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
@@ -58,6 +58,11 @@ func (m *AzureClientFactoryMock) GetClient(ctx context.Context) (git.Client, err
 	return client, err
 }
 
+func (m *AzureClientFactoryMock) GetAuthenticatedUserID(ctx context.Context) (string, error) {
+	args := m.mock.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
 func TestListPullRequest(t *testing.T) {
 	teamProject := "myorg_project"
 	repoName := "myorg_project_repo"
@@ -114,6 +119,111 @@ func TestListPullRequest(t *testing.T) {
 	assert.Equal(t, uniqueName, list[0].Author)
 }
 
+func TestSetCommitStatusAzureDevOps(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	headSHA := "cd4973d9d14a08ffe6b641a89a68891d6aac8056"
+	ctx := context.Background()
+
+	state := git.GitStatusStateValues.Succeeded
+	args := git.CreateCommitStatusArgs{
+		GitCommitStatusToCreate: &git.GitStatus{
+			Context: &git.GitStatusContext{
+				Genre: createStringPtr("argocd"),
+				Name:  createStringPtr("argocd/app"),
+			},
+			Description: createStringPtr("Synced, Healthy"),
+			State:       &state,
+			TargetUrl:   createStringPtr("https://argocd.example.com/applications/argocd/app"),
+		},
+		CommitId:     &headSHA,
+		RepositoryId: &repoName,
+		Project:      &teamProject,
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("CreateCommitStatus", ctx, args).Return(&git.GitStatus{}, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repo:          repoName,
+	}
+
+	err := provider.SetCommitStatus(ctx, &PullRequest{Number: 123, HeadSHA: headSHA}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStateSuccess,
+		Description: "Synced, Healthy",
+		URL:         "https://argocd.example.com/applications/argocd/app",
+	})
+	require.NoError(t, err)
+	gitClientMock.AssertExpectations(t)
+}
+
+func TestCommentAzureDevOps(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	prID := 123
+	ctx := context.Background()
+	feedback := &Feedback{Context: "argocd/app", Comment: "Synced"}
+	body := "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced"
+	argocdID := "C5F0A3C4-0B8D-4F6E-9C43-0F1E1D3C8B4A"
+	otherID := "9d1c2e7a-5b3f-4c8e-a6d2-3e4f5a6b7c8d"
+	threadsArgs := git.GetThreadsArgs{
+		RepositoryId:  &repoName,
+		PullRequestId: &prID,
+		Project:       &teamProject,
+	}
+
+	t.Run("creates a thread", func(t *testing.T) {
+		gitClientMock := azureMock.Client{}
+		clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+		clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+		clientFactoryMock.mock.On("GetAuthenticatedUserID", mock.Anything).Return("c5f0a3c4-0b8d-4f6e-9c43-0f1e1d3c8b4a", nil)
+		gitClientMock.On("GetThreads", ctx, threadsArgs).Return(&[]git.GitPullRequestCommentThread{
+			{Id: createIntPtr(1), Comments: &[]git.Comment{{Id: createIntPtr(1), Content: createStringPtr("LGTM"), Author: &webapi.IdentityRef{Id: &argocdID}}}},
+			{Id: createIntPtr(2), Comments: &[]git.Comment{{Id: createIntPtr(1), Content: createStringPtr("<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing"), Author: &webapi.IdentityRef{Id: &otherID}}}},
+		}, nil)
+		gitClientMock.On("CreateThread", ctx, git.CreateThreadArgs{
+			CommentThread: &git.GitPullRequestCommentThread{
+				Comments: &[]git.Comment{{Content: &body}},
+			},
+			RepositoryId:  &repoName,
+			PullRequestId: &prID,
+			Project:       &teamProject,
+		}).Return(&git.GitPullRequestCommentThread{}, nil)
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repo: repoName}
+		require.NoError(t, provider.Comment(ctx, &PullRequest{Number: prID}, feedback))
+		gitClientMock.AssertExpectations(t)
+	})
+
+	t.Run("updates the previous comment", func(t *testing.T) {
+		gitClientMock := azureMock.Client{}
+		clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+		clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+		clientFactoryMock.mock.On("GetAuthenticatedUserID", mock.Anything).Return("c5f0a3c4-0b8d-4f6e-9c43-0f1e1d3c8b4a", nil)
+		gitClientMock.On("GetThreads", ctx, threadsArgs).Return(&[]git.GitPullRequestCommentThread{
+			{Id: createIntPtr(1), Comments: &[]git.Comment{{Id: createIntPtr(1), Content: createStringPtr("LGTM"), Author: &webapi.IdentityRef{Id: &argocdID}}}},
+			{Id: createIntPtr(2), Comments: &[]git.Comment{{Id: createIntPtr(1), Content: createStringPtr("<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing"), Author: &webapi.IdentityRef{Id: &argocdID}}}},
+		}, nil)
+		gitClientMock.On("UpdateComment", ctx, git.UpdateCommentArgs{
+			Comment:       &git.Comment{Content: &body},
+			RepositoryId:  &repoName,
+			PullRequestId: &prID,
+			ThreadId:      createIntPtr(2),
+			CommentId:     createIntPtr(1),
+			Project:       &teamProject,
+		}).Return(&git.Comment{}, nil)
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repo: repoName}
+		require.NoError(t, provider.Comment(ctx, &PullRequest{Number: prID}, feedback))
+		gitClientMock.AssertExpectations(t)
+	})
+}
+
 func TestConvertLabes(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 
 	"github.com/ktrysmt/go-bitbucket"
 )
@@ -44,7 +45,24 @@ type PullRequestResponse struct {
 	Items    []PullRequest `json:"values"`
 }
 
-var _ PullRequestService = (*BitbucketCloudService)(nil)
+type BitbucketCloudComment struct {
+	ID      int                          `json:"id"`
+	Content BitbucketCloudCommentContent `json:"content"`
+	User    BitbucketCloudCommentUser    `json:"user"`
+}
+
+type BitbucketCloudCommentUser struct {
+	UUID string `json:"uuid"`
+}
+
+type BitbucketCloudCommentContent struct {
+	Raw string `json:"raw"`
+}
+
+var (
+	_ PullRequestService         = (*BitbucketCloudService)(nil)
+	_ PullRequestFeedbackService = (*BitbucketCloudService)(nil)
+)
 
 func parseUrl(uri string) (*url.URL, error) {
 	if uri == "" {
@@ -140,3 +158,73 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 
 	return pullRequests, nil
 }
+
+func (b *BitbucketCloudService) SetCommitStatus(_ context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	commitOpts := &bitbucket.CommitsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		Revision: pullRequest.HeadSHA,
+	}
+	statusOpts := &bitbucket.CommitStatusOptions{
+		Key:         feedback.Context,
+		Url:         feedback.URL,
+		State:       bitbucketFeedbackStates[feedback.State],
+		Name:        feedback.Context,
+		Description: feedbackStatusDescription(feedback, 255),
+	}
+	if _, err := b.client.Repositories.Commits.CreateCommitStatus(commitOpts, statusOpts); err != nil {
+		return fmt.Errorf("error setting status of commit %s for %s/%s: %w", pullRequest.HeadSHA, b.owner, b.repositorySlug, err)
+	}
+	return nil
+}
+
+func (b *BitbucketCloudService) Comment(_ context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	currentUser, err := b.client.User.Profile()
+	if err != nil {
+		return fmt.Errorf("error getting the authenticated user: %w", err)
+	}
+	id := strconv.Itoa(pullRequest.Number)
+	response, err := b.client.Repositories.PullRequests.GetComments(&bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		ID:       id,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing comments of pull request %d for %s/%s: %w", pullRequest.Number, b.owner, b.repositorySlug, err)
+	}
+
+	resp, ok := response.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unknown type returned from bitbucket pull request comments")
+	}
+
+	jsonStr, err := json.Marshal(resp["values"])
+	if err != nil {
+		return fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+
+	var comments []BitbucketCloudComment
+	if err := json.Unmarshal(jsonStr, &comments); err != nil {
+		return fmt.Errorf("error unmarshalling json to type '[]BitbucketCloudComment': %w", err)
+	}
+
+	opts := &bitbucket.PullRequestCommentOptions{
+		Owner:         b.owner,
+		RepoSlug:      b.repositorySlug,
+		PullRequestID: id,
+		Content:       feedbackCommentBody(feedback),
+	}
+	for _, comment := range comments {
+		if isFeedbackComment(comment.Content.Raw, comment.User.UUID, currentUser.Uuid, feedback) {
+			opts.CommentId = strconv.Itoa(comment.ID)
+			if _, err := b.client.Repositories.PullRequests.UpdateComment(opts); err != nil {
+				return fmt.Errorf("error updating comment of pull request %d for %s/%s: %w", pullRequest.Number, b.owner, b.repositorySlug, err)
+			}
+			return nil
+		}
+	}
+	if _, err := b.client.Repositories.PullRequests.AddComment(opts); err != nil {
+		return fmt.Errorf("error commenting pull request %d for %s/%s: %w", pullRequest.Number, b.owner, b.repositorySlug, err)
+	}
+	return nil
+}
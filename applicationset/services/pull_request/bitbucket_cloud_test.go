@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -441,3 +442,71 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestSetCommitStatusCloud(t *testing.T) {
+	var status map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repositories/OWNER/REPO/commit/1a8dd249c04a/statuses/build", r.RequestURI)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).SetCommitStatus(context.Background(), &PullRequest{Number: 101, HeadSHA: "1a8dd249c04a"}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStateSuccess,
+		Description: "Synced, Healthy",
+		URL:         "https://argocd.example.com/applications/argocd/app",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"key":         "argocd/app",
+		"name":        "argocd/app",
+		"state":       "SUCCESSFUL",
+		"description": "Synced, Healthy",
+		"url":         "https://argocd.example.com/applications/argocd/app",
+	}, status)
+}
+
+func TestCommentCloud(t *testing.T) {
+	var method, uri, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/user" {
+			_, _ = io.WriteString(w, `{"uuid": "{argocd}", "username": "argocd"}`)
+			return
+		}
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "/repositories/OWNER/REPO/pullrequests/101/comments/", r.URL.Path)
+			_, _ = io.WriteString(w, `{
+					"size": 3,
+					"pagelen": 10,
+					"page": 1,
+					"values": [
+						{"id": 10, "content": {"raw": "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced"}, "user": {"uuid": "{mallory}"}},
+						{"id": 11, "content": {"raw": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing"}, "user": {"uuid": "{argocd}"}}
+					]
+				}`)
+			return
+		}
+		var comment struct {
+			Content struct {
+				Raw string `json:"raw"`
+			} `json:"content"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+		method, uri, body = r.Method, r.RequestURI, comment.Content.Raw
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 101}, &Feedback{Context: "argocd/app", Comment: "Synced"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/repositories/OWNER/REPO/pullrequests/101/comments/11", uri)
+	assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", body)
+}
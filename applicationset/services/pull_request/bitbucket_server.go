@@ -2,8 +2,11 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -15,11 +18,22 @@ type BitbucketService struct {
 	client         *bitbucketv1.APIClient
 	projectKey     string
 	repositorySlug string
+	// currentUser returns the name of the user the client is authenticated as
+	currentUser func(ctx context.Context) (string, error)
 	// Not supported for PRs by Bitbucket Server
 	// labels         []string
 }
 
-var _ PullRequestService = (*BitbucketService)(nil)
+var (
+	_ PullRequestService         = (*BitbucketService)(nil)
+	_ PullRequestFeedbackService = (*BitbucketService)(nil)
+)
+
+var bitbucketFeedbackStates = map[FeedbackState]string{
+	FeedbackStatePending: "INPROGRESS",
+	FeedbackStateSuccess: "SUCCESSFUL",
+	FeedbackStateFailure: "FAILED",
+}
 
 func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	bitbucketConfig := bitbucketv1.NewConfiguration(url)
@@ -31,7 +45,10 @@ func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url,
 		UserName: username,
 		Password: password,
 	})
-	return newBitbucketService(ctx, bitbucketConfig, projectKey, repositorySlug, scmRootCAPath, insecure, caCerts)
+	currentUser := func(context.Context) (string, error) {
+		return username, nil
+	}
+	return newBitbucketService(ctx, bitbucketConfig, projectKey, repositorySlug, scmRootCAPath, insecure, caCerts, currentUser)
 }
 
 func NewBitbucketServiceBearerToken(ctx context.Context, bearerToken, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
@@ -41,14 +58,21 @@ func NewBitbucketServiceBearerToken(ctx context.Context, bearerToken, url, proje
 	bitbucketConfig.AddDefaultHeader("x-requested-with", "XMLHttpRequest")
 
 	ctx = context.WithValue(ctx, bitbucketv1.ContextAccessToken, bearerToken)
-	return newBitbucketService(ctx, bitbucketConfig, projectKey, repositorySlug, scmRootCAPath, insecure, caCerts)
+	currentUser := func(ctx context.Context) (string, error) {
+		return bitbucketWhoAmI(ctx, bitbucketConfig, bearerToken)
+	}
+	return newBitbucketService(ctx, bitbucketConfig, projectKey, repositorySlug, scmRootCAPath, insecure, caCerts, currentUser)
 }
 
 func NewBitbucketServiceNoAuth(ctx context.Context, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
-	return newBitbucketService(ctx, bitbucketv1.NewConfiguration(url), projectKey, repositorySlug, scmRootCAPath, insecure, caCerts)
+	// anonymous users cannot comment, so there are no comments to look for
+	currentUser := func(context.Context) (string, error) {
+		return "", nil
+	}
+	return newBitbucketService(ctx, bitbucketv1.NewConfiguration(url), projectKey, repositorySlug, scmRootCAPath, insecure, caCerts, currentUser)
 }
 
-func newBitbucketService(ctx context.Context, bitbucketConfig *bitbucketv1.Configuration, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
+func newBitbucketService(ctx context.Context, bitbucketConfig *bitbucketv1.Configuration, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte, currentUser func(ctx context.Context) (string, error)) (PullRequestService, error) {
 	bitbucketConfig.BasePath = utils.NormalizeBitbucketBasePath(bitbucketConfig.BasePath)
 	tlsConfig := utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
 	bitbucketConfig.HTTPClient = &http.Client{Transport: &http.Transport{
@@ -60,9 +84,33 @@ func newBitbucketService(ctx context.Context, bitbucketConfig *bitbucketv1.Confi
 		client:         bitbucketClient,
 		projectKey:     projectKey,
 		repositorySlug: repositorySlug,
+		currentUser:    currentUser,
 	}, nil
 }
 
+// bitbucketWhoAmI returns the name of the user the bearer token belongs to, as the REST API does not provide it
+func bitbucketWhoAmI(ctx context.Context, bitbucketConfig *bitbucketv1.Configuration, bearerToken string) (string, error) {
+	url := strings.TrimSuffix(bitbucketConfig.BasePath, "/rest") + "/plugins/servlet/applinks/whoami"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+bearerToken)
+	resp, err := bitbucketConfig.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error getting the authenticated user: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error getting the authenticated user: unexpected status %s", resp.Status)
+	}
+	name, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return "", fmt.Errorf("error reading the authenticated user: %w", err)
+	}
+	return strings.TrimSpace(string(name)), nil
+}
+
 func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	paged := map[string]interface{}{
 		"limit": 100,
@@ -100,3 +148,62 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (b *BitbucketService) SetCommitStatus(_ context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	status := bitbucketv1.BuildStatus{
+		State:       bitbucketFeedbackStates[feedback.State],
+		Key:         feedback.Context,
+		Name:        feedback.Context,
+		Url:         feedback.URL,
+		Description: feedbackStatusDescription(feedback, 255),
+	}
+	if _, err := b.client.DefaultApi.SetCommitStatus(pullRequest.HeadSHA, status); err != nil {
+		return fmt.Errorf("error setting status of commit %s for %s/%s: %w", pullRequest.HeadSHA, b.projectKey, b.repositorySlug, err)
+	}
+	return nil
+}
+
+// Comment replaces the comment previously posted for the feedback, as the client does not support editing comments
+// of pull requests.
+func (b *BitbucketService) Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	currentUser, err := b.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	paged := map[string]interface{}{
+		"limit": 100,
+	}
+	for {
+		response, err := b.client.DefaultApi.GetActivities(b.projectKey, b.repositorySlug, int64(pullRequest.Number), paged)
+		if err != nil {
+			return fmt.Errorf("error listing activities of pull request %d for %s/%s: %w", pullRequest.Number, b.projectKey, b.repositorySlug, err)
+		}
+		activities, err := bitbucketv1.GetActivitiesResponse(response)
+		if err != nil {
+			return fmt.Errorf("error parsing activities of pull request %d for %s/%s: %w", pullRequest.Number, b.projectKey, b.repositorySlug, err)
+		}
+		for _, activity := range activities.Values {
+			if activity.Action != bitbucketv1.ActionCommented || !isFeedbackComment(activity.Comment.Text, activity.Comment.Author.Name, currentUser, feedback) {
+				continue
+			}
+			version := map[string]interface{}{
+				"version": int32(activity.Comment.Version),
+			}
+			// The client fails to decode the empty response of successful deletions
+			if _, err := b.client.DefaultApi.DeleteComment_2(b.projectKey, b.repositorySlug, int64(pullRequest.Number), int64(activity.Comment.ID), version); err != nil && !errors.Is(err, io.EOF) {
+				return fmt.Errorf("error deleting comment of pull request %d for %s/%s: %w", pullRequest.Number, b.projectKey, b.repositorySlug, err)
+			}
+		}
+		if activities.IsLastPage {
+			break
+		}
+		paged["start"] = activities.NextPageStart
+	}
+	comment := bitbucketv1.Comment{
+		Text: feedbackCommentBody(feedback),
+	}
+	if _, err := b.client.DefaultApi.CreatePullRequestComment(b.projectKey, b.repositorySlug, pullRequest.Number, comment, []string{"application/json"}); err != nil {
+		return fmt.Errorf("error commenting pull request %d for %s/%s: %w", pullRequest.Number, b.projectKey, b.repositorySlug, err)
+	}
+	return nil
+}
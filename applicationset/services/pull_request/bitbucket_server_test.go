@@ -3,6 +3,7 @@ package pull_request
 import (
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"io"
 	"net/http"
@@ -510,3 +511,68 @@ func TestListPullRequestBranchMatch(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestSetCommitStatus(t *testing.T) {
+	var status map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/build-status/1.0/commits/cb3cf2e4d1517c83e720d2585b9402dbef71f992", r.RequestURI)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceNoAuth(context.Background(), ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).SetCommitStatus(context.Background(), &PullRequest{Number: 101, HeadSHA: "cb3cf2e4d1517c83e720d2585b9402dbef71f992"}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStatePending,
+		Description: "OutOfSync, Progressing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "INPROGRESS", status["state"])
+	assert.Equal(t, "argocd/app", status["key"])
+	assert.Equal(t, "OutOfSync, Progressing", status["description"])
+}
+
+func TestComment(t *testing.T) {
+	var deleted, created string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.RequestURI == "/plugins/servlet/applinks/whoami":
+			assert.Equal(t, "Bearer tolkien", r.Header.Get("Authorization"))
+			_, _ = io.WriteString(w, "argocd")
+		case r.Method == http.MethodGet && r.RequestURI == "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101/activities?limit=100":
+			_, _ = io.WriteString(w, `{
+					"size": 2,
+					"limit": 100,
+					"isLastPage": true,
+					"values": [
+						{"id": 1, "action": "OPENED"},
+						{"id": 2, "action": "COMMENTED", "commentAction": "ADDED", "comment": {"id": 11, "version": 3, "text": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "author": {"name": "argocd"}}},
+						{"id": 3, "action": "COMMENTED", "commentAction": "ADDED", "comment": {"id": 12, "version": 0, "text": "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", "author": {"name": "mallory"}}}
+					],
+					"start": 0
+				}`)
+		case r.Method == http.MethodDelete:
+			assert.Empty(t, deleted, "only the comment of the authenticated user must be deleted")
+			deleted = r.RequestURI
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.RequestURI == "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101/comments":
+			var comment map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			created = comment["text"]
+			_, _ = io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.RequestURI)
+		}
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceBearerToken(context.Background(), "tolkien", ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 101}, &Feedback{Context: "argocd/app", Comment: "Synced"})
+	require.NoError(t, err)
+	assert.Equal(t, "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101/comments/11?version=3", deleted)
+	assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", created)
+}
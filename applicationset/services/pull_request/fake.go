@@ -7,9 +7,15 @@ import (
 type FakeService struct {
 	listPullReuests []*PullRequest
 	listError       error
+	// CommitStatuses and Comments record the feedback posted to the pull requests, keyed by pull request number
+	CommitStatuses map[int][]Feedback
+	Comments       map[int][]Feedback
 }
 
-var _ PullRequestService = (*FakeService)(nil)
+var (
+	_ PullRequestService         = (*FakeService)(nil)
+	_ PullRequestFeedbackService = (*FakeService)(nil)
+)
 
 func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError error) (PullRequestService, error) {
 	return &FakeService{
@@ -21,3 +27,19 @@ func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError
 func (g *FakeService) List(ctx context.Context) ([]*PullRequest, error) {
 	return g.listPullReuests, g.listError
 }
+
+func (g *FakeService) SetCommitStatus(_ context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	if g.CommitStatuses == nil {
+		g.CommitStatuses = map[int][]Feedback{}
+	}
+	g.CommitStatuses[pullRequest.Number] = append(g.CommitStatuses[pullRequest.Number], *feedback)
+	return nil
+}
+
+func (g *FakeService) Comment(_ context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	if g.Comments == nil {
+		g.Comments = map[int][]Feedback{}
+	}
+	g.Comments[pullRequest.Number] = append(g.Comments[pullRequest.Number], *feedback)
+	return nil
+}
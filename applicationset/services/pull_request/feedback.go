@@ -0,0 +1,91 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FeedbackState is the state of an application reported to the pull request it was generated for.
+type FeedbackState string
+
+const (
+	// FeedbackStatePending means that the application is not synced or healthy yet
+	FeedbackStatePending FeedbackState = "pending"
+	// FeedbackStateSuccess means that the application is synced and healthy
+	FeedbackStateSuccess FeedbackState = "success"
+	// FeedbackStateFailure means that the application failed to sync or is degraded
+	FeedbackStateFailure FeedbackState = "failure"
+)
+
+// Feedback is the state of an application generated for a pull request, posted back to the pull request.
+type Feedback struct {
+	// Context identifies the feedback of the application among the commit statuses and comments of the pull request.
+	Context string
+	// State is the overall state of the application.
+	State FeedbackState
+	// Description is a short description of the sync and health status of the application.
+	Description string
+	// URL links to the application in the Argo CD UI. It is empty if the URL of Argo CD is not configured.
+	URL string
+	// Comment is the markdown body of the comment posted to the pull request.
+	Comment string
+}
+
+// PullRequestFeedbackService posts feedback about the applications generated for pull requests back to the pull
+// requests.
+type PullRequestFeedbackService interface {
+	// SetCommitStatus sets a status reflecting the state of the feedback on the head commit of the pull request.
+	SetCommitStatus(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error
+	// Comment posts the comment of the feedback to the pull request, replacing the comment previously posted with the
+	// same context.
+	Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error
+}
+
+// Reference identifies the pull request an application was generated for. It is stored in the
+// common.AnnotationApplicationSetPullRequest annotation of the application.
+type Reference struct {
+	// Generator is the index of the pull request generator in the generators of the application set.
+	Generator    int    `json:"generator"`
+	Number       int    `json:"number"`
+	Branch       string `json:"branch"`
+	TargetBranch string `json:"targetBranch"`
+	HeadSHA      string `json:"headSHA"`
+}
+
+// PullRequest returns the pull request the reference points to
+func (r *Reference) PullRequest() *PullRequest {
+	return &PullRequest{
+		Number:       r.Number,
+		Branch:       r.Branch,
+		TargetBranch: r.TargetBranch,
+		HeadSHA:      r.HeadSHA,
+	}
+}
+
+// feedbackCommentMarker returns the hidden marker identifying the comments posted for the given feedback context
+func feedbackCommentMarker(context string) string {
+	return fmt.Sprintf("<!-- argocd-applicationset-feedback: %s -->", context)
+}
+
+// feedbackCommentBody returns the body of the comment posted for the given feedback
+func feedbackCommentBody(feedback *Feedback) string {
+	return feedbackCommentMarker(feedback.Context) + "\n" + feedback.Comment
+}
+
+// isFeedbackComment returns true if the given comment was posted for the given feedback. The comment must be authored
+// by the user the service is authenticated as, so that comments of other users carrying the marker are never touched.
+func isFeedbackComment(body, author, currentUser string, feedback *Feedback) bool {
+	if currentUser == "" || author != currentUser {
+		return false
+	}
+	return strings.HasPrefix(body, feedbackCommentMarker(feedback.Context))
+}
+
+// feedbackStatusDescription truncates the description of the feedback to the given maximum length of the provider
+func feedbackStatusDescription(feedback *Feedback, maxLen int) string {
+	if len(feedback.Description) <= maxLen {
+		return feedback.Description
+	}
+	return feedback.Description[:maxLen-3] + "..."
+}
@@ -0,0 +1,22 @@
+package pull_request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedbackStatusDescription(t *testing.T) {
+	assert.Equal(t, "Synced, Healthy", feedbackStatusDescription(&Feedback{Description: "Synced, Healthy"}, 15))
+	assert.Equal(t, "Synced, ...", feedbackStatusDescription(&Feedback{Description: "Synced, Healthy"}, 11))
+}
+
+func TestIsFeedbackComment(t *testing.T) {
+	feedback := &Feedback{Context: "argocd/app", Comment: "Synced"}
+	assert.True(t, isFeedbackComment(feedbackCommentBody(feedback), "argocd", "argocd", feedback))
+	assert.False(t, isFeedbackComment(feedbackCommentBody(&Feedback{Context: "argocd/other-app"}), "argocd", "argocd", feedback))
+	assert.False(t, isFeedbackComment("LGTM", "argocd", "argocd", feedback))
+	// comments of other users carrying the marker are never considered
+	assert.False(t, isFeedbackComment(feedbackCommentBody(feedback), "mallory", "argocd", feedback))
+	assert.False(t, isFeedbackComment(feedbackCommentBody(feedback), "", "", feedback))
+}
@@ -16,7 +16,16 @@ type GiteaService struct {
 	repo   string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService         = (*GiteaService)(nil)
+	_ PullRequestFeedbackService = (*GiteaService)(nil)
+)
+
+var giteaFeedbackStates = map[FeedbackState]gitea.StatusState{
+	FeedbackStatePending: gitea.StatusPending,
+	FeedbackStateSuccess: gitea.StatusSuccess,
+	FeedbackStateFailure: gitea.StatusFailure,
+}
 
 func NewGiteaService(ctx context.Context, token, url, owner, repo string, insecure bool) (PullRequestService, error) {
 	if token == "" {
@@ -68,6 +77,49 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	return list, nil
 }
 
+func (g *GiteaService) SetCommitStatus(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	opts := gitea.CreateStatusOption{
+		State:       giteaFeedbackStates[feedback.State],
+		TargetURL:   feedback.URL,
+		Description: feedbackStatusDescription(feedback, 255),
+		Context:     feedback.Context,
+	}
+	_, _, err := g.client.CreateStatus(g.owner, g.repo, pullRequest.HeadSHA, opts)
+	return err
+}
+
+func (g *GiteaService) Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	currentUser, _, err := g.client.GetMyUserInfo()
+	if err != nil {
+		return err
+	}
+	body := feedbackCommentBody(feedback)
+	opts := gitea.ListIssueCommentOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 50,
+		},
+	}
+	for {
+		comments, resp, err := g.client.ListIssueComments(g.owner, g.repo, int64(pullRequest.Number), opts)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if comment.Poster != nil && isFeedbackComment(comment.Body, comment.Poster.UserName, currentUser.UserName, feedback) {
+				_, _, err := g.client.EditIssueComment(g.owner, g.repo, comment.ID, gitea.EditIssueCommentOption{Body: body})
+				return err
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	_, _, err = g.client.CreateIssueComment(g.owner, g.repo, int64(pullRequest.Number), gitea.CreateIssueCommentOption{Body: body})
+	return err
+}
+
 // Get the Gitea pull request label names.
 func getGiteaPRLabelNames(giteaLabels []*gitea.Label) []string {
 	var labelNames []string
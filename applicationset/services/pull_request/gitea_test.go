@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -291,3 +292,63 @@ func TestGetGiteaPRLabelNames(t *testing.T) {
 		})
 	}
 }
+
+func TestGiteaSetCommitStatus(t *testing.T) {
+	var status gitea.CreateStatusOption
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.RequestURI {
+		case "/api/v1/version":
+			_, _ = io.WriteString(w, `{"version":"1.17.0+dev-452-g1f0541780"}`)
+		case "/api/v1/repos/test-argocd/pr-test/statuses/abc123":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+			_, _ = io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s", r.RequestURI)
+		}
+	}))
+	defer ts.Close()
+	host, err := NewGiteaService(context.Background(), "", ts.URL, "test-argocd", "pr-test", false)
+	require.NoError(t, err)
+	err = host.(PullRequestFeedbackService).SetCommitStatus(context.Background(), &PullRequest{Number: 1, HeadSHA: "abc123"}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStatePending,
+		Description: "OutOfSync, Progressing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, gitea.CreateStatusOption{
+		State:       gitea.StatusPending,
+		Description: "OutOfSync, Progressing",
+		Context:     "argocd/app",
+	}, status)
+}
+
+func TestGiteaComment(t *testing.T) {
+	var method, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.RequestURI == "/api/v1/version":
+			_, _ = io.WriteString(w, `{"version":"1.17.0+dev-452-g1f0541780"}`)
+		case r.URL.Path == "/api/v1/user":
+			_, _ = io.WriteString(w, `{"id": 1, "login": "argocd"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/test-argocd/pr-test/issues/1/comments":
+			_, _ = io.WriteString(w, `[{"id": 10, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", "user": {"login": "mallory"}}, {"id": 11, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "user": {"login": "argocd"}}]`)
+		case r.URL.Path == "/api/v1/repos/test-argocd/pr-test/issues/comments/11":
+			var comment gitea.EditIssueCommentOption
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			method, body = r.Method, comment.Body
+			_, _ = io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.RequestURI)
+		}
+	}))
+	defer ts.Close()
+	host, err := NewGiteaService(context.Background(), "", ts.URL, "test-argocd", "pr-test", false)
+	require.NoError(t, err)
+	err = host.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 1}, &Feedback{Context: "argocd/app", Comment: "Synced"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPatch, method)
+	assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", body)
+}
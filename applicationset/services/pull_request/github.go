@@ -14,9 +14,14 @@ type GithubService struct {
 	owner  string
 	repo   string
 	labels []string
+	// currentUser returns the login of the user the client is authenticated as
+	currentUser func(ctx context.Context) (string, error)
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService         = (*GithubService)(nil)
+	_ PullRequestFeedbackService = (*GithubService)(nil)
+)
 
 func NewGithubService(ctx context.Context, token, url, owner, repo string, labels []string) (PullRequestService, error) {
 	var ts oauth2.TokenSource
@@ -45,6 +50,13 @@ func NewGithubService(ctx context.Context, token, url, owner, repo string, label
 		owner:  owner,
 		repo:   repo,
 		labels: labels,
+		currentUser: func(ctx context.Context) (string, error) {
+			user, _, err := client.Users.Get(ctx, "")
+			if err != nil {
+				return "", fmt.Errorf("error getting the authenticated user: %w", err)
+			}
+			return user.GetLogin(), nil
+		},
 	}, nil
 }
 
@@ -82,6 +94,59 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) SetCommitStatus(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	state := string(feedback.State)
+	description := feedbackStatusDescription(feedback, 140)
+	status := &github.RepoStatus{
+		State:       &state,
+		Description: &description,
+		Context:     &feedback.Context,
+	}
+	if feedback.URL != "" {
+		status.TargetURL = &feedback.URL
+	}
+	if _, _, err := g.client.Repositories.CreateStatus(ctx, g.owner, g.repo, pullRequest.HeadSHA, status); err != nil {
+		return fmt.Errorf("error setting status of commit %s of %s/%s: %w", pullRequest.HeadSHA, g.owner, g.repo, err)
+	}
+	return nil
+}
+
+func (g *GithubService) Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	currentUser, err := g.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	body := feedbackCommentBody(feedback)
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+	for {
+		comments, resp, err := g.client.Issues.ListComments(ctx, g.owner, g.repo, pullRequest.Number, opts)
+		if err != nil {
+			return fmt.Errorf("error listing comments of pull request %d of %s/%s: %w", pullRequest.Number, g.owner, g.repo, err)
+		}
+		for _, comment := range comments {
+			if comment.ID == nil || !isFeedbackComment(comment.GetBody(), comment.GetUser().GetLogin(), currentUser, feedback) {
+				continue
+			}
+			if _, _, err := g.client.Issues.EditComment(ctx, g.owner, g.repo, *comment.ID, &github.IssueComment{Body: &body}); err != nil {
+				return fmt.Errorf("error updating comment of pull request %d of %s/%s: %w", pullRequest.Number, g.owner, g.repo, err)
+			}
+			return nil
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if _, _, err := g.client.Issues.CreateComment(ctx, g.owner, g.repo, pullRequest.Number, &github.IssueComment{Body: &body}); err != nil {
+		return fmt.Errorf("error commenting pull request %d of %s/%s: %w", pullRequest.Number, g.owner, g.repo, err)
+	}
+	return nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
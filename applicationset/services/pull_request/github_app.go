@@ -1,6 +1,9 @@
 package pull_request
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/argoproj/argo-cd/v2/applicationset/services/github_app_auth"
 	"github.com/argoproj/argo-cd/v2/applicationset/services/internal/github_app"
 )
@@ -15,5 +18,18 @@ func NewGithubAppService(g github_app_auth.Authentication, url, owner, repo stri
 		owner:  owner,
 		repo:   repo,
 		labels: labels,
+		currentUser: func(ctx context.Context) (string, error) {
+			// installations cannot look up the authenticated user, the app has to be looked up with its own credentials
+			appClient, err := github_app.AppClient(g, url)
+			if err != nil {
+				return "", err
+			}
+			app, _, err := appClient.Apps.Get(ctx, "")
+			if err != nil {
+				return "", fmt.Errorf("error getting the github app: %w", err)
+			}
+			// the comments of apps are authored by their bot user
+			return app.GetSlug() + "[bot]", nil
+		},
 	}, nil
 }
@@ -1,10 +1,16 @@
 package pull_request
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-github/v63/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func toPtr(s string) *string {
@@ -87,3 +93,81 @@ func TestGetGitHubPRLabelNames(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubSetCommitStatus(t *testing.T) {
+	var status github.RepoStatus
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/OWNER/REPO/statuses/abc123", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	svc, err := NewGithubService(context.Background(), "", server.URL, "OWNER", "REPO", nil)
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).SetCommitStatus(context.Background(), &PullRequest{Number: 1, HeadSHA: "abc123"}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStateSuccess,
+		Description: "Synced, Healthy",
+		URL:         "https://argocd.example.com/applications/argocd/app",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", status.GetState())
+	assert.Equal(t, "argocd/app", status.GetContext())
+	assert.Equal(t, "Synced, Healthy", status.GetDescription())
+	assert.Equal(t, "https://argocd.example.com/applications/argocd/app", status.GetTargetURL())
+}
+
+func TestGithubComment(t *testing.T) {
+	feedback := &Feedback{Context: "argocd/app", Comment: "Synced"}
+
+	t.Run("creates the comment", func(t *testing.T) {
+		var created github.IssueComment
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"login": "argocd"}`)
+		})
+		mux.HandleFunc("/api/v3/repos/OWNER/REPO/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				_, _ = fmt.Fprint(w, `[{"id": 10, "body": "LGTM", "user": {"login": "argocd"}}, {"id": 11, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "user": {"login": "mallory"}}]`)
+				return
+			}
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		svc, err := NewGithubService(context.Background(), "", server.URL, "OWNER", "REPO", nil)
+		require.NoError(t, err)
+		require.NoError(t, svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 1}, feedback))
+		assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", created.GetBody())
+	})
+
+	t.Run("updates the previous comment", func(t *testing.T) {
+		var updated github.IssueComment
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"login": "argocd"}`)
+		})
+		mux.HandleFunc("/api/v3/repos/OWNER/REPO/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = fmt.Fprint(w, `[{"id": 10, "body": "LGTM", "user": {"login": "argocd"}}, {"id": 11, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "user": {"login": "argocd"}}]`)
+		})
+		mux.HandleFunc("/api/v3/repos/OWNER/REPO/issues/comments/11", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		svc, err := NewGithubService(context.Background(), "", server.URL, "OWNER", "REPO", nil)
+		require.NoError(t, err)
+		require.NoError(t, svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 1}, feedback))
+		assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", updated.GetBody())
+	})
+}
@@ -19,7 +19,16 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService         = (*GitLabService)(nil)
+	_ PullRequestFeedbackService = (*GitLabService)(nil)
+)
+
+var gitlabFeedbackStates = map[FeedbackState]gitlab.BuildStateValue{
+	FeedbackStatePending: gitlab.Pending,
+	FeedbackStateSuccess: gitlab.Success,
+	FeedbackStateFailure: gitlab.Failed,
+}
 
 func NewGitLabService(ctx context.Context, token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -96,3 +105,56 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) SetCommitStatus(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	description := feedbackStatusDescription(feedback, 255)
+	opts := &gitlab.SetCommitStatusOptions{
+		State:       gitlabFeedbackStates[feedback.State],
+		Ref:         &pullRequest.Branch,
+		Name:        &feedback.Context,
+		Description: &description,
+	}
+	if feedback.URL != "" {
+		opts.TargetURL = &feedback.URL
+	}
+	if _, _, err := g.client.Commits.SetCommitStatus(g.project, pullRequest.HeadSHA, opts, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("error setting status of commit %s for project '%s': %w", pullRequest.HeadSHA, g.project, err)
+	}
+	return nil
+}
+
+func (g *GitLabService) Comment(ctx context.Context, pullRequest *PullRequest, feedback *Feedback) error {
+	currentUser, _, err := g.client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error getting the authenticated user: %w", err)
+	}
+	body := feedbackCommentBody(feedback)
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+	for {
+		notes, resp, err := g.client.Notes.ListMergeRequestNotes(g.project, pullRequest.Number, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("error listing notes of merge request %d for project '%s': %w", pullRequest.Number, g.project, err)
+		}
+		for _, note := range notes {
+			if !isFeedbackComment(note.Body, note.Author.Username, currentUser.Username, feedback) {
+				continue
+			}
+			if _, _, err := g.client.Notes.UpdateMergeRequestNote(g.project, pullRequest.Number, note.ID, &gitlab.UpdateMergeRequestNoteOptions{Body: &body}, gitlab.WithContext(ctx)); err != nil {
+				return fmt.Errorf("error updating note of merge request %d for project '%s': %w", pullRequest.Number, g.project, err)
+			}
+			return nil
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if _, _, err := g.client.Notes.CreateMergeRequestNote(g.project, pullRequest.Number, &gitlab.CreateMergeRequestNoteOptions{Body: &body}, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("error creating note of merge request %d for project '%s': %w", pullRequest.Number, g.project, err)
+	}
+	return nil
+}
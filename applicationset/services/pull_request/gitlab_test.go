@@ -3,7 +3,9 @@ package pull_request
 import (
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -194,3 +196,82 @@ func TestListWithStateTLS(t *testing.T) {
 		})
 	}
 }
+
+func TestGitLabSetCommitStatus(t *testing.T) {
+	var status map[string]string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/278964/statuses/abc123", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	svc, err := NewGitLabService(context.Background(), "", server.URL, "278964", nil, "", "", false, nil)
+	require.NoError(t, err)
+	err = svc.(PullRequestFeedbackService).SetCommitStatus(context.Background(), &PullRequest{Number: 1, Branch: "feature", HeadSHA: "abc123"}, &Feedback{
+		Context:     "argocd/app",
+		State:       FeedbackStateFailure,
+		Description: "OutOfSync, Degraded",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"state":       "failed",
+		"ref":         "feature",
+		"name":        "argocd/app",
+		"description": "OutOfSync, Degraded",
+	}, status)
+}
+
+func TestGitLabComment(t *testing.T) {
+	feedback := &Feedback{Context: "argocd/app", Comment: "Synced"}
+
+	t.Run("creates the note", func(t *testing.T) {
+		var created map[string]string
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"id": 1, "username": "argocd"}`)
+		})
+		mux.HandleFunc("/api/v4/projects/278964/merge_requests/1/notes", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				_, _ = fmt.Fprint(w, `[{"id": 10, "body": "LGTM", "author": {"username": "argocd"}}, {"id": 11, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "author": {"username": "mallory"}}]`)
+				return
+			}
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		svc, err := NewGitLabService(context.Background(), "", server.URL, "278964", nil, "", "", false, nil)
+		require.NoError(t, err)
+		require.NoError(t, svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 1}, feedback))
+		assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", created["body"])
+	})
+
+	t.Run("updates the previous note", func(t *testing.T) {
+		var updated map[string]string
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"id": 1, "username": "argocd"}`)
+		})
+		mux.HandleFunc("/api/v4/projects/278964/merge_requests/1/notes", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = fmt.Fprint(w, `[{"id": 10, "body": "LGTM", "author": {"username": "argocd"}}, {"id": 11, "body": "<!-- argocd-applicationset-feedback: argocd/app -->\nProgressing", "author": {"username": "argocd"}}]`)
+		})
+		mux.HandleFunc("/api/v4/projects/278964/merge_requests/1/notes/11", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		svc, err := NewGitLabService(context.Background(), "", server.URL, "278964", nil, "", "", false, nil)
+		require.NoError(t, err)
+		require.NoError(t, svc.(PullRequestFeedbackService).Comment(context.Background(), &PullRequest{Number: 1}, feedback))
+		assert.Equal(t, "<!-- argocd-applicationset-feedback: argocd/app -->\nSynced", updated["body"])
+	})
+}
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+)
+
+type FakeService struct {
+	tags      []*Tag
+	listError error
+	// GetTagCalls records the names of the tags whose details were requested
+	GetTagCalls []string
+}
+
+var _ RegistryService = (*FakeService)(nil)
+
+func NewFakeService(tags []*Tag, listError error) *FakeService {
+	return &FakeService{
+		tags:      tags,
+		listError: listError,
+	}
+}
+
+func (s *FakeService) ListTags(_ context.Context) ([]string, error) {
+	if s.listError != nil {
+		return nil, s.listError
+	}
+	names := make([]string, 0, len(s.tags))
+	for _, tag := range s.tags {
+		names = append(names, tag.Name)
+	}
+	return names, nil
+}
+
+func (s *FakeService) GetTag(_ context.Context, name string) (*Tag, error) {
+	s.GetTagCalls = append(s.GetTagCalls, name)
+	for _, tag := range s.tags {
+		if tag.Name == name {
+			return tag, nil
+		}
+	}
+	return nil, fmt.Errorf("tag %s not found", name)
+}
@@ -0,0 +1,169 @@
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	// maxManifestSize is the maximum size of an image manifest or config which is accepted from a registry
+	maxManifestSize = 4 * 1024 * 1024
+
+	// mediaTypeDockerManifestList is the media type of the Docker equivalent of an OCI image index
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// Tag is a tag of a container image repository.
+type Tag struct {
+	// Name of the tag
+	Name string
+	// Digest of the manifest the tag points to
+	Digest string
+	// Created is the creation time of the image, if the image records it
+	Created *time.Time
+}
+
+// RegistryService lists the tags of a container image repository.
+type RegistryService interface {
+	// ListTags returns the names of all tags of the repository.
+	ListTags(ctx context.Context) ([]string, error)
+	// GetTag returns the digest and creation time of the image with the given tag.
+	GetTag(ctx context.Context, name string) (*Tag, error)
+}
+
+// Credentials are the credentials used to authenticate against a registry. Username and Password are used for Basic
+// auth, Token is used for Bearer auth.
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+type registryService struct {
+	repo *remote.Repository
+}
+
+var _ RegistryService = &registryService{}
+
+// NewRegistryService returns a service listing the tags of the given repository, e.g. ghcr.io/argoproj/argocd.
+func NewRegistryService(repository string, creds Credentials, insecure bool, caCerts []byte) (RegistryService, error) {
+	repo, err := remote.NewRepository(repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize repository %s: %w", repository, err)
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	if len(caCerts) > 0 {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		certPool.AppendCertsFromPEM(caCerts)
+		tlsConfig.RootCAs = certPool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	repo.Client = &auth.Client{
+		Client: &http.Client{Transport: transport},
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username:    creds.Username,
+			Password:    creds.Password,
+			AccessToken: creds.Token,
+		}),
+	}
+	return &registryService{repo: repo}, nil
+}
+
+func (s *registryService) ListTags(ctx context.Context) ([]string, error) {
+	var tags []string
+	err := s.repo.Tags(ctx, "", func(result []string) error {
+		tags = append(tags, result...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of %s: %w", s.repo.Reference, err)
+	}
+	return tags, nil
+}
+
+func (s *registryService) GetTag(ctx context.Context, name string) (*Tag, error) {
+	desc, manifest, err := s.fetchManifest(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	tag := &Tag{Name: name, Digest: desc.Digest.String()}
+
+	// The creation time of a multi-platform image is taken from the image of its first platform.
+	if desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == mediaTypeDockerManifestList {
+		if len(manifest.Manifests) == 0 {
+			return tag, nil
+		}
+		if _, manifest, err = s.fetchManifest(ctx, manifest.Manifests[0].Digest.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	if created, ok := manifest.Annotations[ocispec.AnnotationCreated]; ok {
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			tag.Created = &t
+			return tag, nil
+		}
+	}
+	if manifest.Config == nil || manifest.Config.Size > maxManifestSize {
+		return tag, nil
+	}
+	rc, err := s.repo.Fetch(ctx, *manifest.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch config of %s:%s: %w", s.repo.Reference, name, err)
+	}
+	defer rc.Close()
+	data, err := content.ReadAll(rc, *manifest.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config of %s:%s: %w", s.repo.Reference, name, err)
+	}
+	var config ocispec.Image
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config of %s:%s: %w", s.repo.Reference, name, err)
+	}
+	tag.Created = config.Created
+	return tag, nil
+}
+
+// imageManifest holds the fields shared by image manifests and image indexes, in both their OCI and Docker flavors.
+type imageManifest struct {
+	Config      *ocispec.Descriptor  `json:"config,omitempty"`
+	Manifests   []ocispec.Descriptor `json:"manifests,omitempty"`
+	Annotations map[string]string    `json:"annotations,omitempty"`
+}
+
+func (s *registryService) fetchManifest(ctx context.Context, reference string) (ocispec.Descriptor, *imageManifest, error) {
+	desc, rc, err := s.repo.FetchReference(ctx, reference)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("failed to fetch manifest %s@%s: %w", s.repo.Reference, reference, err)
+	}
+	defer rc.Close()
+	if desc.Size > maxManifestSize {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("manifest %s@%s exceeds the maximum size of %d bytes", s.repo.Reference, reference, maxManifestSize)
+	}
+	data, err := content.ReadAll(rc, desc)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("failed to read manifest %s@%s: %w", s.repo.Reference, reference, err)
+	}
+	var manifest imageManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("failed to parse manifest %s@%s: %w", s.repo.Reference, reference, err)
+	}
+	return desc, &manifest, nil
+}
@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRegistry struct {
+	tags      []string
+	manifests map[string]ocispec.Descriptor
+	blobs     map[digest.Digest][]byte
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{manifests: map[string]ocispec.Descriptor{}, blobs: map[digest.Digest][]byte{}}
+}
+
+func (r *fakeRegistry) push(t *testing.T, mediaType string, v interface{}) ocispec.Descriptor {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	desc := ocispec.Descriptor{MediaType: mediaType, Digest: digest.FromBytes(data), Size: int64(len(data))}
+	r.blobs[desc.Digest] = data
+	r.manifests[desc.Digest.String()] = desc
+	return desc
+}
+
+func (r *fakeRegistry) tag(name string, desc ocispec.Descriptor) {
+	r.tags = append(r.tags, name)
+	r.manifests[name] = desc
+}
+
+func (r *fakeRegistry) handler(t *testing.T, authorized func(*http.Request) bool, challenge string) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !authorized(req) {
+			w.Header().Set("WWW-Authenticate", challenge)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case req.URL.Path == "/v2/argoproj/guestbook/tags/list":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "argoproj/guestbook", "tags": r.tags})
+		case strings.HasPrefix(req.URL.Path, "/v2/argoproj/guestbook/manifests/"):
+			desc, ok := r.manifests[strings.TrimPrefix(req.URL.Path, "/v2/argoproj/guestbook/manifests/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", desc.MediaType)
+			w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+			_, _ = w.Write(r.blobs[desc.Digest])
+		case strings.HasPrefix(req.URL.Path, "/v2/argoproj/guestbook/blobs/"):
+			data, ok := r.blobs[digest.Digest(strings.TrimPrefix(req.URL.Path, "/v2/argoproj/guestbook/blobs/"))]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestRegistryService(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	annotated := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	registry := newFakeRegistry()
+	config := registry.push(t, ocispec.MediaTypeImageConfig, ocispec.Image{Created: &created})
+	image := registry.push(t, ocispec.MediaTypeImageManifest, ocispec.Manifest{MediaType: ocispec.MediaTypeImageManifest, Config: config})
+	registry.tag("v1.0.0", image)
+	annotatedImage := registry.push(t, ocispec.MediaTypeImageManifest, ocispec.Manifest{
+		MediaType:   ocispec.MediaTypeImageManifest,
+		Config:      config,
+		Annotations: map[string]string{ocispec.AnnotationCreated: annotated.Format(time.RFC3339)},
+	})
+	index := registry.push(t, ocispec.MediaTypeImageIndex, ocispec.Index{MediaType: ocispec.MediaTypeImageIndex, Manifests: []ocispec.Descriptor{annotatedImage, image}})
+	registry.tag("v1.1.0", index)
+	registry.tag("empty", registry.push(t, ocispec.MediaTypeImageIndex, ocispec.Index{MediaType: ocispec.MediaTypeImageIndex}))
+
+	for _, c := range []struct {
+		name       string
+		creds      Credentials
+		authorized func(*http.Request) bool
+		challenge  string
+	}{
+		{
+			name:  "basic auth",
+			creds: Credentials{Username: "user", Password: "password"},
+			authorized: func(req *http.Request) bool {
+				username, password, ok := req.BasicAuth()
+				return ok && username == "user" && password == "password"
+			},
+			challenge: `Basic realm="registry"`,
+		},
+		{
+			name:  "bearer auth",
+			creds: Credentials{Token: "token"},
+			authorized: func(req *http.Request) bool {
+				return req.Header.Get("Authorization") == "Bearer token"
+			},
+			challenge: `Bearer realm="https://auth.example.com/token",service="registry"`,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(registry.handler(t, c.authorized, c.challenge))
+			defer server.Close()
+
+			svc, err := NewRegistryService(strings.TrimPrefix(server.URL, "https://")+"/argoproj/guestbook", c.creds, true, nil)
+			require.NoError(t, err)
+
+			tags, err := svc.ListTags(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []string{"v1.0.0", "v1.1.0", "empty"}, tags)
+
+			tag, err := svc.GetTag(context.Background(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, &Tag{Name: "v1.0.0", Digest: image.Digest.String(), Created: &created}, tag)
+
+			tag, err = svc.GetTag(context.Background(), "v1.1.0")
+			require.NoError(t, err)
+			assert.Equal(t, &Tag{Name: "v1.1.0", Digest: index.Digest.String(), Created: &annotated}, tag)
+
+			tag, err = svc.GetTag(context.Background(), "empty")
+			require.NoError(t, err)
+			assert.Equal(t, "empty", tag.Name)
+			assert.Nil(t, tag.Created)
+
+			_, err = svc.GetTag(context.Background(), "unknown")
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		server := httptest.NewTLSServer(registry.handler(t, func(*http.Request) bool { return false }, `Basic realm="registry"`))
+		defer server.Close()
+
+		svc, err := NewRegistryService(strings.TrimPrefix(server.URL, "https://")+"/argoproj/guestbook", Credentials{}, true, nil)
+		require.NoError(t, err)
+		_, err = svc.ListTags(context.Background())
+		require.ErrorContains(t, err, "failed to list tags")
+	})
+}
@@ -0,0 +1,162 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	SortBySemver  = "semver"
+	SortByName    = "name"
+	SortByCreated = "created"
+)
+
+// Filter is a compiled RegistryGeneratorFilter.
+type Filter struct {
+	TagMatch         *regexp.Regexp
+	SemverConstraint *semver.Constraints
+}
+
+func compileFilters(filters []argoprojiov1alpha1.RegistryGeneratorFilter) ([]*Filter, error) {
+	outFilters := make([]*Filter, 0, len(filters))
+	for _, filter := range filters {
+		outFilter := &Filter{}
+		var err error
+		if filter.TagMatch != nil {
+			outFilter.TagMatch, err = regexp.Compile(*filter.TagMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling TagMatch regexp %q: %w", *filter.TagMatch, err)
+			}
+		}
+		if filter.SemverConstraint != nil {
+			outFilter.SemverConstraint, err = semver.NewConstraint(*filter.SemverConstraint)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing SemverConstraint %q: %w", *filter.SemverConstraint, err)
+			}
+		}
+		outFilters = append(outFilters, outFilter)
+	}
+	return outFilters, nil
+}
+
+func matchFilter(tag string, filter *Filter) bool {
+	if filter.TagMatch != nil && !filter.TagMatch.MatchString(tag) {
+		return false
+	}
+	if filter.SemverConstraint != nil {
+		version, err := semver.NewVersion(tag)
+		if err != nil || !filter.SemverConstraint.Check(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortTagNames sorts the given tags by semantic version or by name, newest first. When sorting by semantic version,
+// the tags which are not a semantic version are sorted by name after the others.
+func sortTagNames(tags []string, sortBy string) {
+	versions := make(map[string]*semver.Version, len(tags))
+	if sortBy == SortBySemver {
+		for _, tag := range tags {
+			if version, err := semver.NewVersion(tag); err == nil {
+				versions[tag] = version
+			}
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		vi, vj := versions[tags[i]], versions[tags[j]]
+		switch {
+		case vi != nil && vj != nil && !vi.Equal(vj):
+			return vi.GreaterThan(vj)
+		case vi != nil && vj == nil:
+			return true
+		case vi == nil && vj != nil:
+			return false
+		}
+		return tags[i] > tags[j]
+	})
+}
+
+// sortTagsByCreated sorts the given tags by creation time, newest first. The tags without a creation time are sorted
+// by name after the others.
+func sortTagsByCreated(tags []*Tag) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		ci, cj := tags[i].Created, tags[j].Created
+		switch {
+		case ci != nil && cj != nil && !ci.Equal(*cj):
+			return ci.After(*cj)
+		case ci != nil && cj == nil:
+			return true
+		case ci == nil && cj != nil:
+			return false
+		}
+		return tags[i].Name > tags[j].Name
+	})
+}
+
+// ListTags returns the tags of the repository matching any of the given filters, sorted newest first and limited to
+// the given number of tags if limit is positive.
+func ListTags(ctx context.Context, provider RegistryService, filters []argoprojiov1alpha1.RegistryGeneratorFilter, sortBy string, limit int64) ([]*Tag, error) {
+	if sortBy == "" {
+		sortBy = SortBySemver
+	}
+	if sortBy != SortBySemver && sortBy != SortByName && sortBy != SortByCreated {
+		return nil, fmt.Errorf("unknown sortBy %q, must be one of %s, %s or %s", sortBy, SortBySemver, SortByName, SortByCreated)
+	}
+	compiledFilters, err := compileFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := provider.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredNames := make([]string, 0, len(names))
+	for _, name := range names {
+		if len(compiledFilters) == 0 {
+			filteredNames = append(filteredNames, name)
+			continue
+		}
+		for _, filter := range compiledFilters {
+			if matchFilter(name, filter) {
+				filteredNames = append(filteredNames, name)
+				break
+			}
+		}
+	}
+
+	// The details of the tags require additional requests, so only the tags which are generated are fetched, unless
+	// the tags are sorted by creation time.
+	if sortBy != SortByCreated {
+		sortTagNames(filteredNames, sortBy)
+		if limit > 0 && int64(len(filteredNames)) > limit {
+			filteredNames = filteredNames[:limit]
+		}
+	}
+
+	tags := make([]*Tag, 0, len(filteredNames))
+	for _, name := range filteredNames {
+		tag, err := provider.GetTag(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if sortBy == SortByCreated {
+		sortTagsByCreated(tags)
+		if limit > 0 && int64(len(tags)) > limit {
+			tags = tags[:limit]
+		}
+	}
+	return tags, nil
+}
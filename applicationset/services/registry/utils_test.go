@@ -0,0 +1,118 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func strp(s string) *string {
+	return &s
+}
+
+func tagNames(tags []*Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestListTags(t *testing.T) {
+	created := func(day int) *time.Time {
+		t := time.Date(2024, 5, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	tags := []*Tag{
+		{Name: "latest", Digest: "sha256:1", Created: created(5)},
+		{Name: "v1.2.0-rc1", Digest: "sha256:2", Created: created(4)},
+		{Name: "v1.10.0", Digest: "sha256:3", Created: created(2)},
+		{Name: "v1.9.0", Digest: "sha256:4", Created: created(3)},
+		{Name: "main-abc123", Digest: "sha256:5"},
+		{Name: "1.2.0", Digest: "sha256:6", Created: created(1)},
+	}
+
+	cases := []struct {
+		name          string
+		filters       []argoprojiov1alpha1.RegistryGeneratorFilter
+		sortBy        string
+		limit         int64
+		expected      []string
+		expectedCalls []string
+		expectedError string
+	}{
+		{
+			name:          "semver by default",
+			expected:      []string{"v1.10.0", "v1.9.0", "1.2.0", "v1.2.0-rc1", "main-abc123", "latest"},
+			expectedCalls: []string{"v1.10.0", "v1.9.0", "1.2.0", "v1.2.0-rc1", "main-abc123", "latest"},
+		},
+		{
+			name:          "name",
+			sortBy:        SortByName,
+			limit:         2,
+			expected:      []string{"v1.9.0", "v1.2.0-rc1"},
+			expectedCalls: []string{"v1.9.0", "v1.2.0-rc1"},
+		},
+		{
+			name:          "created",
+			sortBy:        SortByCreated,
+			limit:         3,
+			expected:      []string{"latest", "v1.2.0-rc1", "v1.9.0"},
+			expectedCalls: []string{"latest", "v1.2.0-rc1", "v1.10.0", "v1.9.0", "main-abc123", "1.2.0"},
+		},
+		{
+			name: "filters",
+			filters: []argoprojiov1alpha1.RegistryGeneratorFilter{
+				{TagMatch: strp("^v"), SemverConstraint: strp(">=1.9.0")},
+				{TagMatch: strp("^main-")},
+			},
+			expected:      []string{"v1.10.0", "v1.9.0", "main-abc123"},
+			expectedCalls: []string{"v1.10.0", "v1.9.0", "main-abc123"},
+		},
+		{
+			name:          "prereleases",
+			filters:       []argoprojiov1alpha1.RegistryGeneratorFilter{{SemverConstraint: strp(">=1.2.0-0 <1.9.0")}},
+			limit:         1,
+			expected:      []string{"1.2.0"},
+			expectedCalls: []string{"1.2.0"},
+		},
+		{
+			name:          "invalid regexp",
+			filters:       []argoprojiov1alpha1.RegistryGeneratorFilter{{TagMatch: strp("(")}},
+			expectedError: "error compiling TagMatch regexp",
+		},
+		{
+			name:          "invalid constraint",
+			filters:       []argoprojiov1alpha1.RegistryGeneratorFilter{{SemverConstraint: strp("foo")}},
+			expectedError: "error parsing SemverConstraint",
+		},
+		{
+			name:          "invalid sort",
+			sortBy:        "size",
+			expectedError: "unknown sortBy",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svc := NewFakeService(tags, nil)
+			result, err := ListTags(context.Background(), svc, c.filters, c.sortBy, c.limit)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, tagNames(result))
+			assert.Equal(t, c.expectedCalls, svc.GetTagCalls)
+		})
+	}
+
+	_, err := ListTags(context.Background(), NewFakeService(nil, errors.New("fake error")), nil, "", 0)
+	require.EqualError(t, err, "fake error")
+}
@@ -4,10 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type argoCDService struct {
@@ -20,10 +29,15 @@ type argoCDService struct {
 
 type Repos interface {
 	// GetFiles returns content of files (not directories) within the target repo
-	GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error)
+	GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) (map[string][]byte, error)
 
 	// GetDirectories returns a list of directories (not files) within the target repo
-	GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache, verifyCommit bool) ([]string, error)
+	GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) ([]string, error)
+}
+
+type Manifests interface {
+	// GetManifests returns the manifests generated for the source of the application at the given revision
+	GetManifests(ctx context.Context, app *v1alpha1.Application, revision string) ([]*unstructured.Unstructured, error)
 }
 
 func NewArgoCDService(getRepository func(ctx context.Context, url, project string) (*v1alpha1.Repository, error), submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) (Repos, error) {
@@ -35,7 +49,29 @@ func NewArgoCDService(getRepository func(ctx context.Context, url, project strin
 	}, nil
 }
 
-func (a *argoCDService) GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error) {
+// manifestService generates the manifests of applications the way the application controller does, with the
+// settings of Argo CD and the repositories and credentials permitted by the project of the application
+type manifestService struct {
+	db                  db.ArgoDB
+	settingsMgr         *settings.SettingsManager
+	appClientset        appclientset.Interface
+	kubectl             kube.Kubectl
+	repoServerClientSet apiclient.Clientset
+	namespace           string
+}
+
+func NewManifestService(argoDB db.ArgoDB, settingsMgr *settings.SettingsManager, appClientset appclientset.Interface, kubectl kube.Kubectl, repoClientset apiclient.Clientset, namespace string) Manifests {
+	return &manifestService{
+		db:                  argoDB,
+		settingsMgr:         settingsMgr,
+		appClientset:        appClientset,
+		kubectl:             kubectl,
+		repoServerClientSet: repoClientset,
+		namespace:           namespace,
+	}
+}
+
+func (a *argoCDService) GetFiles(ctx context.Context, repoURL string, revision string, pattern string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) (map[string][]byte, error) {
 	repo, err := a.getRepository(ctx, repoURL, "")
 	if err != nil {
 		return nil, fmt.Errorf("error in GetRepository: %w", err)
@@ -48,7 +84,10 @@ func (a *argoCDService) GetFiles(ctx context.Context, repoURL string, revision s
 		Path:                      pattern,
 		NewGitFileGlobbingEnabled: a.newFileGlobbingEnabled,
 		NoRevisionCache:           noRevisionCache,
-		VerifyCommit:              verifyCommit,
+	}
+	if verifyCommitProject != nil {
+		fileRequest.VerifyCommit = true
+		fileRequest.SshSignatureKeys, fileRequest.KeylessSignatureIdentities = signingIdentities(verifyCommitProject)
 	}
 	closer, client, err := a.repoServerClientSet.NewRepoServerClient()
 	if err != nil {
@@ -63,7 +102,7 @@ func (a *argoCDService) GetFiles(ctx context.Context, repoURL string, revision s
 	return fileResponse.GetMap(), nil
 }
 
-func (a *argoCDService) GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache, verifyCommit bool) ([]string, error) {
+func (a *argoCDService) GetDirectories(ctx context.Context, repoURL string, revision string, noRevisionCache bool, verifyCommitProject *v1alpha1.AppProject) ([]string, error) {
 	repo, err := a.getRepository(ctx, repoURL, "")
 	if err != nil {
 		return nil, fmt.Errorf("error in GetRepository: %w", err)
@@ -74,7 +113,10 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL string, revi
 		SubmoduleEnabled: a.submoduleEnabled,
 		Revision:         revision,
 		NoRevisionCache:  noRevisionCache,
-		VerifyCommit:     verifyCommit,
+	}
+	if verifyCommitProject != nil {
+		dirRequest.VerifyCommit = true
+		dirRequest.SshSignatureKeys, dirRequest.KeylessSignatureIdentities = signingIdentities(verifyCommitProject)
 	}
 
 	closer, client, err := a.repoServerClientSet.NewRepoServerClient()
@@ -89,3 +131,129 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL string, revi
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+// signingIdentities returns the SSH keys and keyless signature identities the project permits to sign commits
+func signingIdentities(project *v1alpha1.AppProject) ([]*v1alpha1.SSHSignatureKey, []*v1alpha1.KeylessSignatureIdentity) {
+	sshSignatureKeys := make([]*v1alpha1.SSHSignatureKey, 0, len(project.Spec.SSHSignatureKeys))
+	for i := range project.Spec.SSHSignatureKeys {
+		sshSignatureKeys = append(sshSignatureKeys, &project.Spec.SSHSignatureKeys[i])
+	}
+	keylessSignatureIdentities := make([]*v1alpha1.KeylessSignatureIdentity, 0, len(project.Spec.KeylessSignatureIdentities))
+	for i := range project.Spec.KeylessSignatureIdentities {
+		keylessSignatureIdentities = append(keylessSignatureIdentities, &project.Spec.KeylessSignatureIdentities[i])
+	}
+	return sshSignatureKeys, keylessSignatureIdentities
+}
+
+func (m *manifestService) GetManifests(ctx context.Context, app *v1alpha1.Application, revision string) ([]*unstructured.Unstructured, error) {
+	source := app.Spec.GetSource()
+	source.TargetRevision = revision
+
+	proj, err := m.appClientset.ArgoprojV1alpha1().AppProjects(m.namespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting project %s: %w", app.Spec.GetProject(), err)
+	}
+	if !proj.IsSourcePermitted(source) {
+		return nil, fmt.Errorf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name)
+	}
+
+	repo, err := m.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+	helmRepos, err := m.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing helm repositories: %w", err)
+	}
+	permittedHelmRepos, err := argo.GetPermittedRepos(proj, helmRepos)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving permitted repos: %w", err)
+	}
+	helmRepositoryCredentials, err := m.db.GetAllHelmRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting helm repository credentials: %w", err)
+	}
+	permittedHelmCredentials, err := argo.GetPermittedReposCredentials(proj, helmRepositoryCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("error getting permitted repos credentials: %w", err)
+	}
+	helmOptions, err := m.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting helm settings: %w", err)
+	}
+	kustomizeSettings, err := m.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+	kustomizeOptions, err := kustomizeSettings.GetOptions(source)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings options: %w", err)
+	}
+	enabledSourceTypes, err := m.settingsMgr.GetEnabledSourceTypes()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings enabled source types: %w", err)
+	}
+	appInstanceLabelKey, err := m.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+
+	// the manifests are generated for the destination cluster, as charts may depend on its version and APIs
+	destination := app.Spec.Destination
+	if err := argo.ValidateDestination(ctx, &destination, m.db); err != nil {
+		return nil, fmt.Errorf("error validating destination: %w", err)
+	}
+	cluster, err := m.db.GetCluster(ctx, destination.Server)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster: %w", err)
+	}
+	config := cluster.RESTConfig()
+	serverVersion, err := m.kubectl.GetServerVersion(config)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server version: %w", err)
+	}
+	apiResources, err := m.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+
+	manifestRequest := &apiclient.ManifestRequest{
+		Repo:                  repo,
+		Revision:              revision,
+		AppLabelKey:           appInstanceLabelKey,
+		AppName:               app.InstanceName(m.namespace),
+		Namespace:             destination.Namespace,
+		ApplicationSource:     &source,
+		Repos:                 permittedHelmRepos,
+		KustomizeOptions:      kustomizeOptions,
+		KubeVersion:           serverVersion,
+		ApiVersions:           argo.APIResourcesToStrings(apiResources, true),
+		HelmRepoCreds:         permittedHelmCredentials,
+		HelmOptions:           helmOptions,
+		TrackingMethod:        string(argo.GetTrackingMethod(m.settingsMgr)),
+		EnabledSourceTypes:    enabledSourceTypes,
+		ProjectName:           proj.Name,
+		ProjectSourceRepos:    proj.Spec.SourceRepos,
+		HelmChartVerification: apiclient.NewHelmChartVerification(proj),
+	}
+
+	closer, client, err := m.repoServerClientSet.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error initialising new repo server client: %w", err)
+	}
+	defer io.Close(closer)
+
+	manifestResponse, err := client.GenerateManifest(ctx, manifestRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests: %w", err)
+	}
+	manifests := make([]*unstructured.Unstructured, 0, len(manifestResponse.Manifests))
+	for _, manifest := range manifestResponse.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling manifest: %w", err)
+		}
+		manifests = append(manifests, obj)
+	}
+	return manifests, nil
+}
@@ -5,13 +5,22 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
 
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	repo_mocks "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
 	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
@@ -24,11 +33,11 @@ func TestGetDirectories(t *testing.T) {
 		repoServerClientFuncs []func(*repo_mocks.RepoServerServiceClient)
 	}
 	type args struct {
-		ctx             context.Context
-		repoURL         string
-		revision        string
-		noRevisionCache bool
-		verifyCommit    bool
+		ctx                 context.Context
+		repoURL             string
+		revision            string
+		noRevisionCache     bool
+		verifyCommitProject *v1alpha1.AppProject
 	}
 	tests := []struct {
 		name    string
@@ -74,6 +83,25 @@ func TestGetDirectories(t *testing.T) {
 				},
 			},
 		}, args: args{}, want: nil, wantErr: assert.Error},
+		{name: "SendsSigningIdentities", fields: fields{
+			getRepository: func(ctx context.Context, url, project string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{}, nil
+			},
+			repoServerClientFuncs: []func(*repo_mocks.RepoServerServiceClient){
+				func(client *repo_mocks.RepoServerServiceClient) {
+					client.On("GetGitDirectories", mock.Anything, mock.MatchedBy(func(request *apiclient.GitDirectoriesRequest) bool {
+						return request.VerifyCommit &&
+							len(request.SshSignatureKeys) == 1 && request.SshSignatureKeys[0].PublicKey == "ssh-ed25519 AAAA" &&
+							len(request.KeylessSignatureIdentities) == 1 && request.KeylessSignatureIdentities[0].Issuer == "https://token.actions.githubusercontent.com"
+					})).Return(&apiclient.GitDirectoriesResponse{
+						Paths: []string{"foo"},
+					}, nil)
+				},
+			},
+		}, args: args{verifyCommitProject: &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{
+			SSHSignatureKeys:           []v1alpha1.SSHSignatureKey{{PublicKey: "ssh-ed25519 AAAA"}},
+			KeylessSignatureIdentities: []v1alpha1.KeylessSignatureIdentity{{Issuer: "https://token.actions.githubusercontent.com", SubjectRegex: ".*"}},
+		}}}, want: []string{"foo"}, wantErr: assert.NoError},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,7 +117,7 @@ func TestGetDirectories(t *testing.T) {
 				submoduleEnabled:    tt.fields.submoduleEnabled,
 				repoServerClientSet: &repo_mocks.Clientset{RepoServerServiceClient: mockRepoClient},
 			}
-			got, err := a.GetDirectories(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.noRevisionCache, tt.args.verifyCommit)
+			got, err := a.GetDirectories(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.noRevisionCache, tt.args.verifyCommitProject)
 			if !tt.wantErr(t, err, fmt.Sprintf("GetDirectories(%v, %v, %v, %v)", tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.noRevisionCache)) {
 				return
 			}
@@ -106,12 +134,12 @@ func TestGetFiles(t *testing.T) {
 		getRepository         func(ctx context.Context, url, project string) (*v1alpha1.Repository, error)
 	}
 	type args struct {
-		ctx             context.Context
-		repoURL         string
-		revision        string
-		pattern         string
-		noRevisionCache bool
-		verifyCommit    bool
+		ctx                 context.Context
+		repoURL             string
+		revision            string
+		pattern             string
+		noRevisionCache     bool
+		verifyCommitProject *v1alpha1.AppProject
 	}
 	tests := []struct {
 		name    string
@@ -178,7 +206,7 @@ func TestGetFiles(t *testing.T) {
 				submoduleEnabled:    tt.fields.submoduleEnabled,
 				repoServerClientSet: &repo_mocks.Clientset{RepoServerServiceClient: mockRepoClient},
 			}
-			got, err := a.GetFiles(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, tt.args.noRevisionCache, tt.args.verifyCommit)
+			got, err := a.GetFiles(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, tt.args.noRevisionCache, tt.args.verifyCommitProject)
 			if !tt.wantErr(t, err, fmt.Sprintf("GetFiles(%v, %v, %v, %v, %v)", tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, tt.args.noRevisionCache)) {
 				return
 			}
@@ -194,3 +222,99 @@ func TestNewArgoCDService(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, service)
 }
+
+func TestGetManifests(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+				Path:           "guestbook",
+				TargetRevision: "feature",
+			},
+			Destination: v1alpha1.ApplicationDestination{Name: "staging", Namespace: "guestbook"},
+		},
+	}
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}
+	restrictedProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"https://github.com/argoproj/other"}},
+	}
+	newSettingsManager := func() *settings.SettingsManager {
+		kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data: map[string]string{
+				"application.instanceLabelKey": "mycompany.com/appname",
+				"kustomize.buildOptions":       "--enable-helm",
+			},
+		}, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		})
+		return settings.NewSettingsManager(context.Background(), kubeClient, "argocd")
+	}
+
+	t.Run("SourceNotPermitted", func(t *testing.T) {
+		m := &manifestService{
+			appClientset: appclientset.NewSimpleClientset(restrictedProject),
+			namespace:    "argocd",
+		}
+		_, err := m.GetManifests(context.Background(), app, "main")
+		require.ErrorContains(t, err, "is not permitted in project 'default'")
+	})
+
+	t.Run("ErrorGettingRepos", func(t *testing.T) {
+		argoDB := &dbmocks.ArgoDB{}
+		argoDB.On("GetRepository", mock.Anything, "https://github.com/argoproj/argocd-example-apps", "default").Return(nil, fmt.Errorf("unable to get repos"))
+		m := &manifestService{
+			db:           argoDB,
+			appClientset: appclientset.NewSimpleClientset(defaultProject),
+			namespace:    "argocd",
+		}
+		_, err := m.GetManifests(context.Background(), app, "main")
+		require.ErrorContains(t, err, "unable to get repos")
+	})
+
+	t.Run("HappyCase", func(t *testing.T) {
+		helmRepo := &v1alpha1.Repository{Repo: "https://charts.example.com", Type: "helm"}
+		helmCreds := &v1alpha1.RepoCreds{URL: "https://charts.example.com", Username: "admin"}
+		argoDB := &dbmocks.ArgoDB{}
+		argoDB.On("GetRepository", mock.Anything, "https://github.com/argoproj/argocd-example-apps", "default").Return(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, nil)
+		argoDB.On("ListHelmRepositories", mock.Anything).Return([]*v1alpha1.Repository{helmRepo}, nil)
+		argoDB.On("GetAllHelmRepositoryCredentials", mock.Anything).Return([]*v1alpha1.RepoCreds{helmCreds}, nil)
+		argoDB.On("GetClusterServersByName", mock.Anything, "staging").Return([]string{"https://staging.example.com"}, nil)
+		argoDB.On("GetCluster", mock.Anything, "https://staging.example.com").Return(&v1alpha1.Cluster{Name: "staging", Server: "https://staging.example.com"}, nil)
+
+		mockRepoClient := &repo_mocks.RepoServerServiceClient{}
+		mockRepoClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(q *apiclient.ManifestRequest) bool {
+			return q.Revision == "main" && q.ApplicationSource.TargetRevision == "main" && q.ApplicationSource.Path == "guestbook" &&
+				q.AppName == "app" && q.Namespace == "guestbook" && q.ProjectName == "default" &&
+				q.AppLabelKey == "mycompany.com/appname" && q.KustomizeOptions.BuildOptions == "--enable-helm" &&
+				q.KubeVersion == "1.30" && len(q.ApiVersions) > 0 &&
+				len(q.Repos) == 1 && len(q.HelmRepoCreds) == 1 && q.HelmOptions != nil
+		})).Return(&apiclient.ManifestResponse{
+			Manifests: []string{`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`},
+		}, nil)
+		m := &manifestService{
+			db:           argoDB,
+			settingsMgr:  newSettingsManager(),
+			appClientset: appclientset.NewSimpleClientset(defaultProject),
+			kubectl: &kubetest.MockKubectlCmd{
+				Version:      "1.30",
+				APIResources: []kube.APIResourceInfo{{GroupKind: schema.GroupKind{Kind: "Deployment", Group: "apps"}, GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}}},
+			},
+			repoServerClientSet: &repo_mocks.Clientset{RepoServerServiceClient: mockRepoClient},
+			namespace:           "argocd",
+		}
+		manifests, err := m.GetManifests(context.Background(), app, "main")
+		require.NoError(t, err)
+		require.Len(t, manifests, 1)
+		assert.Equal(t, "Service", manifests[0].GetKind())
+		assert.Equal(t, "guestbook-ui", manifests[0].GetName())
+		assert.Equal(t, "feature", app.Spec.Source.TargetRevision)
+		assert.Empty(t, app.Spec.Destination.Server)
+	})
+}
@@ -58,16 +58,38 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 		return controllerutil.OperationResultNone, err
 	}
 
+	equal, err := NormalizeAndCompare(ignoreAppDifferences, ignoreNormalizerOpts, normalizedLive, obj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if equal {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	patch := client.MergeFrom(normalizedLive)
+	if log.IsLevelEnabled(log.DebugLevel) {
+		LogPatch(logCtx, patch, obj)
+	}
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	return controllerutil.OperationResultUpdated, nil
+}
+
+// NormalizeAndCompare removes the fields ignored by the ignoreApplicationDifferences rules from both the live and the
+// desired application, normalizes their specs, and returns whether they are equal. It modifies the applications in
+// place.
+func NormalizeAndCompare(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, live *argov1alpha1.Application, desired *argov1alpha1.Application) (bool, error) {
 	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
 	// prevents those differences from appearing in the diff and therefore in the patch.
-	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, obj, ignoreNormalizerOpts)
+	err := applyIgnoreDifferences(ignoreAppDifferences, live, desired, ignoreNormalizerOpts)
 	if err != nil {
-		return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %w", err)
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
 	}
 
 	// Normalize to avoid diffing on unimportant differences.
-	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
-	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
+	live.Spec = *argo.NormalizeApplicationSpec(&live.Spec)
+	desired.Spec = *argo.NormalizeApplicationSpec(&desired.Spec)
 
 	equality := conversion.EqualitiesOrDie(
 		func(a, b resource.Quantity) bool {
@@ -94,18 +116,7 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 		},
 	)
 
-	if equality.DeepEqual(normalizedLive, obj) {
-		return controllerutil.OperationResultNone, nil
-	}
-
-	patch := client.MergeFrom(normalizedLive)
-	if log.IsLevelEnabled(log.DebugLevel) {
-		LogPatch(logCtx, patch, obj)
-	}
-	if err := c.Patch(ctx, obj, patch); err != nil {
-		return controllerutil.OperationResultNone, err
-	}
-	return controllerutil.OperationResultUpdated, nil
+	return equality.DeepEqual(live, desired), nil
 }
 
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
@@ -511,6 +511,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		Registry:                g0.Registry,
+		HTTP:                    g0.HTTP,
+		KubernetesResource:      g0.KubernetesResource,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -566,6 +569,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		Registry:                g1.Registry,
+		HTTP:                    g1.HTTP,
+		KubernetesResource:      g1.KubernetesResource,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
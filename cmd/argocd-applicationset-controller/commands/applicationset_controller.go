@@ -42,6 +42,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	argosettings "github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -72,6 +73,10 @@ func NewCommand() *cobra.Command {
 		globalPreservedLabels        []string
 		metricsAplicationsetLabels   []string
 		enableScmProviders           bool
+		enableHTTPGenerator          bool
+		allowedHTTPGeneratorURLs     []string
+		enableKubernetesResourceGen  bool
+		allowedKubernetesKinds       []string
 		webhookParallelism           int
 	)
 	scheme := runtime.NewScheme()
@@ -120,6 +125,9 @@ func NewCommand() *cobra.Command {
 			} else if enableScmProviders && len(allowedScmProviders) == 0 {
 				log.Error("When enabling applicationset in any namespace using applicationset-namespaces, you must either set --enable-scm-providers=false or specify --allowed-scm-providers")
 				os.Exit(1)
+			} else if enableHTTPGenerator && len(allowedHTTPGeneratorURLs) == 0 {
+				log.Error("When enabling applicationset in any namespace using applicationset-namespaces, you must either set --enable-http-generator=false or specify --allowed-http-generator-urls")
+				os.Exit(1)
 			}
 
 			var cacheOpt ctrlcache.Options
@@ -166,6 +174,8 @@ func NewCommand() *cobra.Command {
 			argoCDDB := db.NewDB(namespace, argoSettingsMgr, k8sClient)
 
 			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)))
+			httpConfig := generators.NewHTTPConfig(enableHTTPGenerator, allowedHTTPGeneratorURLs)
+			kubernetesResourceConfig := generators.NewKubernetesResourceConfig(enableKubernetesResourceGen, allowedKubernetesKinds)
 
 			tlsConfig := apiclient.TLSConfiguration{
 				DisableTLS:       repoServerPlaintext,
@@ -185,7 +195,7 @@ func NewCommand() *cobra.Command {
 			argoCDService, err := services.NewArgoCDService(argoCDDB.GetRepository, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
 			errors.CheckError(err)
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, httpConfig, kubernetesResourceConfig)
 
 			// start a webhook server that listens to incoming webhook payloads
 			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
@@ -221,6 +231,7 @@ func NewCommand() *cobra.Command {
 				GlobalPreservedAnnotations: globalPreservedAnnotations,
 				GlobalPreservedLabels:      globalPreservedLabels,
 				Metrics:                    &metrics,
+				PullRequestFeedback:        controllers.NewPullRequestFeedback(services.NewManifestService(argoCDDB, argoSettingsMgr, appSetConfig, kubeutil.NewKubectl(), repoClientset, namespace), argoSettingsMgr),
 			}).SetupWithManager(mgr, enableProgressiveSyncs, maxConcurrentReconciliations); err != nil {
 				log.Error(err, "unable to create controller", "controller", "ApplicationSet")
 				os.Exit(1)
@@ -251,6 +262,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().StringSliceVar(&allowedScmProviders, "allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
 	command.Flags().BoolVar(&enableScmProviders, "enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
+	command.Flags().BoolVar(&enableHTTPGenerator, "enable-http-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_HTTP_GENERATOR", false), "Enable the HTTP generator, which requests the endpoints configured in ApplicationSets (Default: false)")
+	command.Flags().StringSliceVar(&allowedHTTPGeneratorURLs, "allowed-http-generator-urls", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_HTTP_GENERATOR_URLS", []string{}, ","), "The list of URL prefixes the HTTP generator may request. Loopback and link-local addresses are never requested. (Default: Empty = all)")
+	command.Flags().BoolVar(&enableKubernetesResourceGen, "enable-kubernetes-resource-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_KUBERNETES_RESOURCE_GENERATOR", false), "Enable the KubernetesResource generator, which lists the objects of the allowed kinds in the clusters of the projects of ApplicationSets (Default: false)")
+	command.Flags().StringSliceVar(&allowedKubernetesKinds, "allowed-kubernetes-resource-generator-kinds", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_KUBERNETES_RESOURCE_GENERATOR_KINDS", []string{}, ","), "The list of kinds the KubernetesResource generator may list, as <group>/<kind>, <kind> for the core group or <group>/* for all kinds of a group (Default: Empty = none)")
 	command.Flags().BoolVar(&dryRun, "dry-run", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DRY_RUN", false), "Enable dry run mode")
 	command.Flags().BoolVar(&enableProgressiveSyncs, "enable-progressive-syncs", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_PROGRESSIVE_SYNCS", false), "Enable use of the experimental progressive syncs feature.")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
@@ -74,7 +74,15 @@ func NewCommand() *cobra.Command {
 		helmManifestMaxExtractedSize      string
 		helmRegistryMaxIndexSize          string
 		disableManifestMaxExtractedSize   bool
+		ociManifestMaxExtractedSize       string
+		disableOCIMaxExtractedSize        bool
 		includeHiddenDirectories          bool
+		gitMirrorPath                     string
+		gitMirrorMaxSize                  string
+		sparseCheckout                    bool
+		partialCloneFilter                string
+		sigstoreTrustRoot                 string
+		timestampAuthorityTrustRoot       string
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -119,6 +127,12 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			ociManifestMaxExtractedSizeQuantity, err := resource.ParseQuantity(ociManifestMaxExtractedSize)
+			errors.CheckError(err)
+
+			gitMirrorMaxSizeQuantity, err := resource.ParseQuantity(gitMirrorMaxSize)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer)
@@ -136,6 +150,14 @@ func NewCommand() *cobra.Command {
 				HelmManifestMaxExtractedSize:                 helmManifestMaxExtractedSizeQuantity.ToDec().Value(),
 				HelmRegistryMaxIndexSize:                     helmRegistryMaxIndexSizeQuantity.ToDec().Value(),
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
+				OCIManifestMaxExtractedSize:                  ociManifestMaxExtractedSizeQuantity.ToDec().Value(),
+				DisableOCIManifestMaxExtractedSize:           disableOCIMaxExtractedSize,
+				GitMirrorPath:                                gitMirrorPath,
+				GitMirrorMaxSize:                             gitMirrorMaxSizeQuantity.ToDec().Value(),
+				SparseCheckout:                               sparseCheckout,
+				PartialCloneFilter:                           partialCloneFilter,
+				SigstoreTrustRoot:                            sigstoreTrustRoot,
+				TimestampAuthorityTrustRoot:                  timestampAuthorityTrustRoot,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -240,7 +262,15 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&helmManifestMaxExtractedSize, "helm-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of helm manifest archives when extracted")
 	command.Flags().StringVar(&helmRegistryMaxIndexSize, "helm-registry-max-index-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_INDEX_SIZE", "1G"), "Maximum size of registry index file")
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
+	command.Flags().StringVar(&ociManifestMaxExtractedSize, "oci-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of OCI artifacts when extracted")
+	command.Flags().BoolVar(&disableOCIMaxExtractedSize, "disable-oci-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_OCI_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of OCI artifacts when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
+	command.Flags().StringVar(&gitMirrorPath, "git-mirror-path", env.StringFromEnv("ARGOCD_REPO_SERVER_GIT_MIRROR_PATH", ""), "Directory of git mirrors shared by all repo server replicas, e.g. on a persistent volume. Git mirrors are disabled if empty")
+	command.Flags().StringVar(&gitMirrorMaxSize, "git-mirror-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_GIT_MIRROR_MAX_SIZE", "10G"), "Maximum size of all git mirrors before the least recently used mirrors are removed. Zero disables the removal of mirrors")
+	command.Flags().BoolVar(&sparseCheckout, "sparse-checkout", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_SPARSE_CHECKOUT", false), "Limit the working trees of git repositories to the files needed to generate manifests")
+	command.Flags().StringVar(&partialCloneFilter, "partial-clone-filter", env.StringFromEnv("ARGOCD_REPO_SERVER_PARTIAL_CLONE_FILTER", ""), "Filter used to fetch git repositories if sparse checkout is enabled, e.g. blob:none. Not used for repositories using git mirrors")
+	command.Flags().StringVar(&sigstoreTrustRoot, "sigstore-trust-root", env.StringFromEnv("ARGOCD_REPO_SERVER_SIGSTORE_TRUST_ROOT", ""), "Path of a PEM file with the certificate authorities trusted to issue certificates for X.509 (e.g. keyless Sigstore/gitsign) commit signatures")
+	command.Flags().StringVar(&timestampAuthorityTrustRoot, "timestamp-authority-trust-root", env.StringFromEnv("ARGOCD_REPO_SERVER_TIMESTAMP_AUTHORITY_TRUST_ROOT", ""), "Path of a PEM file with the certificate authorities trusted to issue certificates of RFC 3161 timestamp authorities, which are required to verify keyless X.509 commit signatures")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
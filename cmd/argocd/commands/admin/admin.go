@@ -59,7 +59,7 @@ $ argocd admin initial-password reset
 
 	command.AddCommand(NewClusterCommand(clientOpts, pathOpts))
 	command.AddCommand(NewProjectsCommand())
-	command.AddCommand(NewSettingsCommand())
+	command.AddCommand(NewSettingsCommand(clientOpts))
 	command.AddCommand(NewAppCommand(clientOpts))
 	command.AddCommand(NewRepoCommand())
 	command.AddCommand(NewImportCommand())
@@ -2,10 +2,15 @@ package admin
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -20,6 +25,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	secutil "github.com/argoproj/argo-cd/v2/util/security"
 )
 
@@ -30,6 +36,8 @@ func NewExportCommand() *cobra.Command {
 		out                      string
 		applicationNamespaces    []string
 		applicationsetNamespaces []string
+		passphraseFile           string
+		keyFile                  string
 	)
 	command := cobra.Command{
 		Use:   "export",
@@ -41,6 +49,8 @@ func NewExportCommand() *cobra.Command {
 			errors.CheckError(err)
 			namespace, _, err := clientConfig.Namespace()
 			errors.CheckError(err)
+			keySource, err := newBackupKeySource(passphraseFile, keyFile)
+			errors.CheckError(err)
 
 			var writer io.Writer
 			if out == "-" {
@@ -57,6 +67,16 @@ func NewExportCommand() *cobra.Command {
 					errors.CheckError(err)
 				}()
 			}
+			if keySource != nil {
+				// resources are collected and encrypted as a whole before being written
+				out := writer
+				var resources bytes.Buffer
+				writer = &resources
+				defer func() {
+					err := writeBackupArchive(out, resources.Bytes(), namespace, keySource)
+					errors.CheckError(err)
+				}()
+			}
 
 			acdClients := newArgoCDClientsets(config, namespace)
 			acdConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDConfigMapName, v1.GetOptions{})
@@ -125,6 +145,8 @@ func NewExportCommand() *cobra.Command {
 	command.Flags().StringVarP(&out, "out", "o", "-", "Output to the specified file instead of stdout")
 	command.Flags().StringSliceVarP(&applicationNamespaces, "application-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs to export applications from. If not provided value from '%s' in %s will be used,if it's not defined only applications from Argo CD namespace will be exported", applicationNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
 	command.Flags().StringSliceVarP(&applicationsetNamespaces, "applicationset-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs to export applicationsets from. If not provided value from '%s' in %s will be used,if it's not defined only applicationsets from Argo CD namespace will be exported", applicationsetNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+	command.Flags().StringVar(&passphraseFile, "passphrase-file", "", fmt.Sprintf("Encrypt the export with the passphrase stored in the specified file. The passphrase can also be set using the %s environment variable", backupPassphraseEnv))
+	command.Flags().StringVar(&keyFile, "key-file", "", "Encrypt the export with the 256 bit key stored in the specified file, either raw or base64 encoded")
 	return &command
 }
 
@@ -137,8 +159,12 @@ func NewImportCommand() *cobra.Command {
 		verbose                  bool
 		stopOperation            bool
 		ignoreTracking           bool
+		showDiff                 bool
 		applicationNamespaces    []string
 		applicationsetNamespaces []string
+		passphraseFile           string
+		keyFile                  string
+		filter                   backupFilter
 	)
 	command := cobra.Command{
 		Use:   "import SOURCE",
@@ -150,6 +176,10 @@ func NewImportCommand() *cobra.Command {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			err := filter.validate()
+			errors.CheckError(err)
+			keySource, err := newBackupKeySource(passphraseFile, keyFile)
+			errors.CheckError(err)
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 			config.QPS = 100
@@ -167,6 +197,16 @@ func NewImportCommand() *cobra.Command {
 				input, err = os.ReadFile(in)
 			}
 			errors.CheckError(err)
+			var backupVersion string
+			if isBackupArchive(input) {
+				header, data, err := readBackupArchive(input, keySource)
+				errors.CheckError(err)
+				input = data
+				backupVersion = header.ArgoCDVersion
+				if verbose {
+					fmt.Printf("Importing backup of namespace %s made by Argo CD %s at %s\n", header.Namespace, header.ArgoCDVersion, header.CreatedAt)
+				}
+			}
 			var dryRunMsg string
 			if dryRun {
 				dryRunMsg = " (dry run)"
@@ -191,7 +231,7 @@ func NewImportCommand() *cobra.Command {
 			// secrets need to be imported too
 			var referencedSecrets map[string]bool
 			for _, cm := range configMaps.Items {
-				if isArgoCDConfigMap(cm.GetName()) {
+				if isArgoCDConfigMap(cm.GetName()) && filter.matches(cm) {
 					pruneObjects[kube.ResourceKey{Group: "", Kind: "ConfigMap", Name: cm.GetName(), Namespace: cm.GetNamespace()}] = cm
 				}
 				if cm.GetName() == common.ArgoCDConfigMapName {
@@ -202,20 +242,23 @@ func NewImportCommand() *cobra.Command {
 			secrets, err := acdClients.secrets.List(ctx, v1.ListOptions{})
 			errors.CheckError(err)
 			for _, secret := range secrets.Items {
-				if isArgoCDSecret(referencedSecrets, secret) {
+				if isArgoCDSecret(referencedSecrets, secret) && filter.matches(secret) {
 					pruneObjects[kube.ResourceKey{Group: "", Kind: "Secret", Name: secret.GetName(), Namespace: secret.GetNamespace()}] = secret
 				}
 			}
 			applications, err := acdClients.applications.List(ctx, v1.ListOptions{})
 			errors.CheckError(err)
 			for _, app := range applications.Items {
-				if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) {
+				if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) && filter.matches(app) {
 					pruneObjects[kube.ResourceKey{Group: application.Group, Kind: application.ApplicationKind, Name: app.GetName(), Namespace: app.GetNamespace()}] = app
 				}
 			}
 			projects, err := acdClients.projects.List(ctx, v1.ListOptions{})
 			errors.CheckError(err)
 			for _, proj := range projects.Items {
+				if !filter.matches(proj) {
+					continue
+				}
 				pruneObjects[kube.ResourceKey{Group: application.Group, Kind: application.AppProjectKind, Name: proj.GetName(), Namespace: proj.GetNamespace()}] = proj
 			}
 			applicationSets, err := acdClients.applicationSets.List(ctx, v1.ListOptions{})
@@ -226,7 +269,7 @@ func NewImportCommand() *cobra.Command {
 			}
 			if applicationSets != nil {
 				for _, appSet := range applicationSets.Items {
-					if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) {
+					if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) && filter.matches(appSet) {
 						pruneObjects[kube.ResourceKey{Group: application.Group, Kind: application.ApplicationSetKind, Name: appSet.GetName(), Namespace: appSet.GetNamespace()}] = appSet
 					}
 				}
@@ -235,12 +278,17 @@ func NewImportCommand() *cobra.Command {
 			// Create or replace existing object
 			backupObjects, err := kube.SplitYAML(input)
 			errors.CheckError(err)
+			err = migrateBackup(backupObjects, backupVersion)
+			errors.CheckError(err)
 			for _, bakObj := range backupObjects {
 				gvk := bakObj.GroupVersionKind()
 				// For objects without namespace, assume they belong in ArgoCD namespace
 				if bakObj.GetNamespace() == "" {
 					bakObj.SetNamespace(namespace)
 				}
+				if !filter.matches(*bakObj) {
+					continue
+				}
 				key := kube.ResourceKey{Group: gvk.Group, Kind: gvk.Kind, Name: bakObj.GetName(), Namespace: bakObj.GetNamespace()}
 				liveObj, exists := pruneObjects[key]
 				delete(pruneObjects, key)
@@ -285,6 +333,9 @@ func NewImportCommand() *cobra.Command {
 					}
 					if !isForbidden {
 						fmt.Printf("%s/%s %s in namespace %s created%s\n", gvk.Group, gvk.Kind, bakObj.GetName(), bakObj.GetNamespace(), dryRunMsg)
+						if showDiff {
+							printBackupDiff(nil, bakObj)
+						}
 					}
 				} else if specsEqual(*bakObj, liveObj) && checkAppHasNoNeedToStopOperation(liveObj, stopOperation) {
 					if verbose {
@@ -292,8 +343,8 @@ func NewImportCommand() *cobra.Command {
 					}
 				} else {
 					isForbidden := false
+					newLive := updateLive(bakObj, &liveObj, stopOperation)
 					if !dryRun {
-						newLive := updateLive(bakObj, &liveObj, stopOperation)
 						_, err = dynClient.Update(ctx, newLive, v1.UpdateOptions{})
 						if apierr.IsForbidden(err) || apierr.IsNotFound(err) {
 							isForbidden = true
@@ -304,6 +355,9 @@ func NewImportCommand() *cobra.Command {
 					}
 					if !isForbidden {
 						fmt.Printf("%s/%s %s in namespace %s updated%s\n", gvk.Group, gvk.Kind, bakObj.GetName(), bakObj.GetNamespace(), dryRunMsg)
+						if showDiff {
+							printBackupDiff(&liveObj, newLive)
+						}
 					}
 				}
 			}
@@ -346,6 +400,9 @@ func NewImportCommand() *cobra.Command {
 					}
 					if !isForbidden {
 						fmt.Printf("%s/%s %s pruned%s\n", key.Group, key.Kind, key.Name, dryRunMsg)
+						if showDiff {
+							printBackupDiff(&liveObj, nil)
+						}
 					}
 				} else {
 					fmt.Printf("%s/%s %s needs pruning\n", key.Group, key.Kind, key.Name)
@@ -360,6 +417,12 @@ func NewImportCommand() *cobra.Command {
 	command.Flags().BoolVar(&ignoreTracking, "ignore-tracking", false, "Do not update the tracking annotation if the resource is already tracked")
 	command.Flags().BoolVar(&verbose, "verbose", false, "Verbose output (versus only changed output)")
 	command.Flags().BoolVar(&stopOperation, "stop-operation", false, "Stop any existing operations")
+	command.Flags().BoolVar(&showDiff, "diff", false, "Show the difference between the live and the imported resources. Combine with --dry-run to review an import without applying it")
+	command.Flags().StringVar(&passphraseFile, "passphrase-file", "", fmt.Sprintf("Decrypt the backup with the passphrase stored in the specified file. The passphrase can also be set using the %s environment variable", backupPassphraseEnv))
+	command.Flags().StringVar(&keyFile, "key-file", "", "Decrypt the backup with the 256 bit key stored in the specified file, either raw or base64 encoded")
+	command.Flags().StringSliceVar(&filter.kinds, "kinds", []string{}, "Comma separated list of kinds to import, out of ConfigMap, Secret, AppProject, Application and ApplicationSet. All kinds are imported by default")
+	command.Flags().StringSliceVar(&filter.projects, "projects", []string{}, "Comma separated list of project globs. Only these projects and their applications, applicationsets and repository secrets are imported")
+	command.Flags().StringSliceVar(&filter.applications, "applications", []string{}, "Comma separated list of application globs, optionally prefixed with a namespace (namespace/name). Only these applications, plus the resources selected by --projects, are imported")
 	command.Flags().StringSliceVarP(&applicationNamespaces, "application-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs to which import of applications is allowed. If not provided value from '%s' in %s will be used,if it's not defined only applications without an explicit namespace will be imported to the Argo CD namespace", applicationNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
 	command.Flags().StringSliceVarP(&applicationsetNamespaces, "applicationset-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs which import of applicationsets is allowed. If not provided value from '%s' in %s will be used,if it's not defined only applicationsets without an explicit namespace will be imported to the Argo CD namespace", applicationsetNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
 
@@ -378,6 +441,91 @@ func checkAppHasNoNeedToStopOperation(liveObj unstructured.Unstructured, stopOpe
 	return true
 }
 
+// backupFilter selects the resources of a backup which are imported
+type backupFilter struct {
+	kinds        []string
+	projects     []string
+	applications []string
+}
+
+var backupKinds = []string{"ConfigMap", "Secret", application.AppProjectKind, application.ApplicationKind, application.ApplicationSetKind}
+
+func (f *backupFilter) validate() error {
+	for _, kind := range f.kinds {
+		if !slices.ContainsFunc(backupKinds, func(k string) bool { return strings.EqualFold(k, kind) }) {
+			return fmt.Errorf("unsupported kind %q, must be one of %s", kind, strings.Join(backupKinds, ", "))
+		}
+	}
+	return nil
+}
+
+// matches returns whether the given resource is selected by the filter. If projects or applications are set, only
+// resources belonging to one of the projects or matching one of the applications are selected.
+func (f *backupFilter) matches(un unstructured.Unstructured) bool {
+	if len(f.kinds) > 0 && !slices.ContainsFunc(f.kinds, func(k string) bool { return strings.EqualFold(k, un.GetKind()) }) {
+		return false
+	}
+	if len(f.projects) == 0 && len(f.applications) == 0 {
+		return true
+	}
+	if project := resourceProject(un); project != "" && glob.MatchStringInList(f.projects, project, glob.GLOB) {
+		return true
+	}
+	if un.GetKind() == application.ApplicationKind {
+		for _, pattern := range f.applications {
+			name := un.GetName()
+			if strings.Contains(pattern, "/") {
+				name = un.GetNamespace() + "/" + name
+			}
+			if glob.Match(pattern, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resourceProject returns the name of the project the given resource belongs to, or an empty string if the
+// resource does not belong to a project
+func resourceProject(un unstructured.Unstructured) string {
+	switch un.GetKind() {
+	case application.AppProjectKind:
+		return un.GetName()
+	case application.ApplicationKind:
+		project, _, _ := unstructured.NestedString(un.Object, "spec", "project")
+		return project
+	case application.ApplicationSetKind:
+		project, _, _ := unstructured.NestedString(un.Object, "spec", "template", "spec", "project")
+		return project
+	case "Secret":
+		// repository secrets may be scoped to a project
+		if project, ok, _ := unstructured.NestedString(un.Object, "stringData", "project"); ok {
+			return project
+		}
+		if encoded, ok, _ := unstructured.NestedString(un.Object, "data", "project"); ok {
+			if project, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				return string(project)
+			}
+		}
+	}
+	return ""
+}
+
+// printBackupDiff prints the difference between the live and the imported version of a resource, hiding the data
+// of secrets
+func printBackupDiff(live *unstructured.Unstructured, target *unstructured.Unstructured) {
+	obj := target
+	if obj == nil {
+		obj = live
+	}
+	if obj.GetKind() == "Secret" {
+		var err error
+		target, live, err = diff.HideSecretData(target, live)
+		errors.CheckError(err)
+	}
+	_ = cli.PrintDiff(fmt.Sprintf("%s-%s", strings.ToLower(obj.GetKind()), obj.GetName()), live, target)
+}
+
 // export writes the unstructured object and removes extraneous cruft from output before writing
 func export(w io.Writer, un unstructured.Unstructured, argocdNamespace string) {
 	name := un.GetName()
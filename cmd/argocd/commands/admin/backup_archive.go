@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/crypto"
+)
+
+const (
+	// backupArchiveMagic is the first line of an encrypted backup archive
+	backupArchiveMagic = "argocd-backup-archive"
+	// backupArchiveFormatVersion is the version of the archive format written by this version of Argo CD
+	backupArchiveFormatVersion = 1
+	// backupEncryptionAlgorithm is the algorithm used to encrypt the content of backup archives
+	backupEncryptionAlgorithm = "aes-256-gcm"
+	// backupKeyDerivationScrypt means the encryption key is derived from a passphrase using scrypt
+	backupKeyDerivationScrypt = "scrypt"
+	// backupKeyDerivationNone means the encryption key is read from a key file
+	backupKeyDerivationNone = "none"
+	// backupPassphraseEnv is the environment variable holding the passphrase of backup archives
+	backupPassphraseEnv = "ARGOCD_BACKUP_PASSPHRASE"
+	// backupKeySize is the size of the keys used to encrypt backup archives
+	backupKeySize = 32
+	// backupArchiveLineLength is the length of the lines holding the base64 encoded encrypted content
+	backupArchiveLineLength = 76
+)
+
+// backupArchiveHeader is the unencrypted header of a backup archive. It describes the content of the archive and
+// how it is encrypted.
+type backupArchiveHeader struct {
+	FormatVersion int    `json:"formatVersion"`
+	ArgoCDVersion string `json:"argocdVersion"`
+	CreatedAt     string `json:"createdAt"`
+	Namespace     string `json:"namespace"`
+	// Resources holds the number of backed up resources by kind
+	Resources     map[string]int `json:"resources"`
+	Encryption    string         `json:"encryption"`
+	KeyDerivation string         `json:"keyDerivation"`
+	Salt          []byte         `json:"salt,omitempty"`
+}
+
+// backupKeySource holds the secret used to encrypt or decrypt a backup archive
+type backupKeySource struct {
+	passphrase string
+	key        []byte
+}
+
+// newBackupKeySource reads the passphrase or key from the given files. The passphrase may also be set using the
+// ARGOCD_BACKUP_PASSPHRASE environment variable. Nil is returned if no secret is configured.
+func newBackupKeySource(passphraseFile string, keyFile string) (*backupKeySource, error) {
+	if passphraseFile != "" && keyFile != "" {
+		return nil, errors.New("only one of --passphrase-file and --key-file may be specified")
+	}
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading key file: %w", err)
+		}
+		key, err := parseBackupKey(data)
+		if err != nil {
+			return nil, err
+		}
+		return &backupKeySource{key: key}, nil
+	}
+	passphrase := os.Getenv(backupPassphraseEnv)
+	if passphraseFile != "" {
+		data, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading passphrase file: %w", err)
+		}
+		passphrase = strings.TrimRight(string(data), "\r\n")
+	}
+	if passphrase == "" {
+		if passphraseFile != "" {
+			return nil, fmt.Errorf("passphrase file %s is empty", passphraseFile)
+		}
+		return nil, nil
+	}
+	return &backupKeySource{passphrase: passphrase}, nil
+}
+
+// parseBackupKey parses a 256 bit key, which is either stored as is or base64 encoded like the output of
+// `openssl rand -base64 32`
+func parseBackupKey(data []byte) ([]byte, error) {
+	if len(data) == backupKeySize {
+		return data, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != backupKeySize {
+		return nil, fmt.Errorf("key file must hold a %d byte key, either raw or base64 encoded", backupKeySize)
+	}
+	return key, nil
+}
+
+// encryptionKey returns the key to encrypt or decrypt an archive with the given header
+func (s *backupKeySource) encryptionKey(header *backupArchiveHeader) ([]byte, error) {
+	switch header.KeyDerivation {
+	case backupKeyDerivationScrypt:
+		if s.passphrase == "" {
+			return nil, errors.New("backup is encrypted with a passphrase, use --passphrase-file or the " + backupPassphraseEnv + " environment variable")
+		}
+		return crypto.KeyFromPassphraseAndSalt(s.passphrase, header.Salt)
+	case backupKeyDerivationNone:
+		if s.key == nil {
+			return nil, errors.New("backup is encrypted with a key file, use --key-file")
+		}
+		return s.key, nil
+	}
+	return nil, fmt.Errorf("unsupported key derivation %q", header.KeyDerivation)
+}
+
+// writeBackupArchive encrypts the given YAML stream of resources and writes it as archive
+func writeBackupArchive(w io.Writer, data []byte, namespace string, keySource *backupKeySource) error {
+	header := backupArchiveHeader{
+		FormatVersion: backupArchiveFormatVersion,
+		ArgoCDVersion: common.GetVersion().Version,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		Namespace:     namespace,
+		Resources:     map[string]int{},
+		Encryption:    backupEncryptionAlgorithm,
+		KeyDerivation: backupKeyDerivationNone,
+	}
+	objs, err := kube.SplitYAML(data)
+	if err != nil {
+		return fmt.Errorf("error parsing exported resources: %w", err)
+	}
+	for _, obj := range objs {
+		header.Resources[obj.GetKind()]++
+	}
+	if keySource.key == nil {
+		header.KeyDerivation = backupKeyDerivationScrypt
+		header.Salt = make([]byte, 32)
+		if _, err := rand.Read(header.Salt); err != nil {
+			return err
+		}
+	}
+	key, err := keySource.encryptionKey(&header)
+	if err != nil {
+		return err
+	}
+	headerData, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	// the serialized header is authenticated along with the content, so that it cannot be tampered with
+	encrypted, err := crypto.EncryptWithAdditionalData(data, key, headerData)
+	if err != nil {
+		return fmt.Errorf("error encrypting backup: %w", err)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(backupArchiveMagic + "\n")
+	out.Write(headerData)
+	out.WriteString("\n")
+	encoded := base64.StdEncoding.EncodeToString(encrypted)
+	for len(encoded) > backupArchiveLineLength {
+		out.WriteString(encoded[:backupArchiveLineLength] + "\n")
+		encoded = encoded[backupArchiveLineLength:]
+	}
+	out.WriteString(encoded + "\n")
+	_, err = w.Write(out.Bytes())
+	return err
+}
+
+// isBackupArchive returns whether the given backup is an archive rather than a plain YAML stream
+func isBackupArchive(input []byte) bool {
+	return bytes.HasPrefix(input, []byte(backupArchiveMagic+"\n"))
+}
+
+// readBackupArchive decrypts the given archive and returns its header and the YAML stream of resources
+func readBackupArchive(input []byte, keySource *backupKeySource) (*backupArchiveHeader, []byte, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(input))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	if !scanner.Scan() || scanner.Text() != backupArchiveMagic {
+		return nil, nil, errors.New("input is not a backup archive")
+	}
+	if !scanner.Scan() {
+		return nil, nil, errors.New("backup archive has no header")
+	}
+	headerData := append([]byte{}, scanner.Bytes()...)
+	var header backupArchiveHeader
+	if err := json.Unmarshal(headerData, &header); err != nil {
+		return nil, nil, fmt.Errorf("error parsing backup archive header: %w", err)
+	}
+	if header.FormatVersion > backupArchiveFormatVersion {
+		return nil, nil, fmt.Errorf("backup archive format version %d is not supported, upgrade the Argo CD CLI to import backups made by Argo CD %s", header.FormatVersion, header.ArgoCDVersion)
+	}
+	if header.Encryption != backupEncryptionAlgorithm {
+		return nil, nil, fmt.Errorf("unsupported backup encryption %q", header.Encryption)
+	}
+	var encoded strings.Builder
+	for scanner.Scan() {
+		encoded.WriteString(strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error reading backup archive: %w", err)
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(encoded.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding backup archive: %w", err)
+	}
+
+	if keySource == nil {
+		keySource = &backupKeySource{}
+	}
+	key, err := keySource.encryptionKey(&header)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := crypto.DecryptWithAdditionalData(encrypted, key, headerData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decrypting backup archive, the passphrase or key may be wrong or the archive was tampered with: %w", err)
+	}
+	return &header, data, nil
+}
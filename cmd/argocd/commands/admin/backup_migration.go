@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+)
+
+// backupMigration converts resources of backups made by Argo CD versions older than version to the schema of the
+// current version. Migrations must be idempotent, since they are applied to all backups of unknown version.
+type backupMigration struct {
+	version     string
+	description string
+	// migrate converts the given resource in place and returns whether it was changed
+	migrate func(un *unstructured.Unstructured) (bool, error)
+}
+
+var backupMigrations = []backupMigration{
+	{
+		version:     "v2.4.0",
+		description: "remove Ksonnet sources of applications, Ksonnet is not supported anymore",
+		migrate:     removeKsonnetSources,
+	},
+	{
+		version:     "v2.4.0",
+		description: "render Helm sources of applications using Helm 3, Helm 2 is not supported anymore",
+		migrate:     upgradeHelmVersion,
+	},
+}
+
+// migrateBackup applies the migrations needed for a backup made by the given Argo CD version. All migrations are
+// applied if the version is unknown, e.g. for backups which are not archives.
+func migrateBackup(objs []*unstructured.Unstructured, backupVersion string) error {
+	var version *semver.Version
+	if backupVersion != "" {
+		v, err := semver.NewVersion(backupVersion)
+		if err != nil {
+			log.Warnf("Cannot parse Argo CD version %q of backup, applying all migrations: %v", backupVersion, err)
+		} else {
+			version = v
+		}
+	}
+	for _, migration := range backupMigrations {
+		if version != nil && !version.LessThan(semver.MustParse(migration.version)) {
+			continue
+		}
+		for _, obj := range objs {
+			changed, err := migration.migrate(obj)
+			if err != nil {
+				return fmt.Errorf("error migrating %s %s to %s: %w", obj.GetKind(), obj.GetName(), migration.version, err)
+			}
+			if changed {
+				fmt.Printf("%s %s migrated to %s: %s\n", obj.GetKind(), obj.GetName(), migration.version, migration.description)
+			}
+		}
+	}
+	return nil
+}
+
+// migrateApplicationSources calls the given function for the source and all sources of the given resource if it is an
+// Application. The function converts the source in place and returns whether it was changed.
+func migrateApplicationSources(un *unstructured.Unstructured, migrate func(source map[string]interface{}) bool) (bool, error) {
+	if un.GetKind() != application.ApplicationKind {
+		return false, nil
+	}
+	changed := false
+	if source, ok, _ := unstructured.NestedMap(un.Object, "spec", "source"); ok && migrate(source) {
+		if err := unstructured.SetNestedMap(un.Object, source, "spec", "source"); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	sources, ok, err := unstructured.NestedSlice(un.Object, "spec", "sources")
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		sourcesChanged := false
+		for i := range sources {
+			if source, ok := sources[i].(map[string]interface{}); ok && migrate(source) {
+				sourcesChanged = true
+			}
+		}
+		if sourcesChanged {
+			if err := unstructured.SetNestedSlice(un.Object, sources, "spec", "sources"); err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// removeKsonnetSources removes the Ksonnet settings of application sources, which were removed in Argo CD v2.4
+func removeKsonnetSources(un *unstructured.Unstructured) (bool, error) {
+	return migrateApplicationSources(un, func(source map[string]interface{}) bool {
+		if _, ok := source["ksonnet"]; !ok {
+			return false
+		}
+		delete(source, "ksonnet")
+		return true
+	})
+}
+
+// upgradeHelmVersion changes the Helm version of application sources which are rendered using Helm 2 to Helm 3, since
+// Argo CD v2.4 rejects any other version than Helm 3
+func upgradeHelmVersion(un *unstructured.Unstructured) (bool, error) {
+	return migrateApplicationSources(un, func(source map[string]interface{}) bool {
+		helm, ok := source["helm"].(map[string]interface{})
+		if !ok || helm["version"] != "v2" {
+			return false
+		}
+		helm["version"] = "v3"
+		return true
+	})
+}
@@ -1,10 +1,16 @@
 package admin
 
 import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -85,3 +91,144 @@ func Test_updateTracking(t *testing.T) {
 		})
 	}
 }
+
+func Test_backupArchive(t *testing.T) {
+	data := []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: argocd-cm\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: argocd-secret\n---\n")
+
+	t.Run("Passphrase", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, writeBackupArchive(&archive, data, "argocd", &backupKeySource{passphrase: "secret"}))
+		assert.True(t, isBackupArchive(archive.Bytes()))
+		assert.NotContains(t, archive.String(), "argocd-secret")
+
+		header, decrypted, err := readBackupArchive(archive.Bytes(), &backupKeySource{passphrase: "secret"})
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted)
+		assert.Equal(t, backupKeyDerivationScrypt, header.KeyDerivation)
+		assert.Equal(t, map[string]int{"ConfigMap": 1, "Secret": 1}, header.Resources)
+		assert.Equal(t, "argocd", header.Namespace)
+
+		_, _, err = readBackupArchive(archive.Bytes(), &backupKeySource{passphrase: "wrong"})
+		require.ErrorContains(t, err, "passphrase or key may be wrong")
+		tampered := strings.Replace(archive.String(), `"namespace":"argocd"`, `"namespace":"default"`, 1)
+		require.NotEqual(t, archive.String(), tampered)
+		_, _, err = readBackupArchive([]byte(tampered), &backupKeySource{passphrase: "secret"})
+		require.ErrorContains(t, err, "tampered with")
+		_, _, err = readBackupArchive(archive.Bytes(), nil)
+		require.ErrorContains(t, err, "--passphrase-file")
+	})
+	t.Run("KeyFile", func(t *testing.T) {
+		keyFile := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("k"), 32))+"\n"), 0o600))
+		keySource, err := newBackupKeySource("", keyFile)
+		require.NoError(t, err)
+
+		var archive bytes.Buffer
+		require.NoError(t, writeBackupArchive(&archive, data, "argocd", keySource))
+		header, decrypted, err := readBackupArchive(archive.Bytes(), keySource)
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted)
+		assert.Equal(t, backupKeyDerivationNone, header.KeyDerivation)
+		assert.Empty(t, header.Salt)
+
+		_, _, err = readBackupArchive(archive.Bytes(), &backupKeySource{passphrase: "secret"})
+		require.ErrorContains(t, err, "--key-file")
+	})
+	t.Run("NewerFormat", func(t *testing.T) {
+		archive := backupArchiveMagic + "\n" + `{"formatVersion":2,"argocdVersion":"v9.0.0"}` + "\n"
+		_, _, err := readBackupArchive([]byte(archive), nil)
+		require.ErrorContains(t, err, "format version 2 is not supported")
+	})
+	t.Run("NoSecret", func(t *testing.T) {
+		t.Setenv(backupPassphraseEnv, "")
+		keySource, err := newBackupKeySource("", "")
+		require.NoError(t, err)
+		assert.Nil(t, keySource)
+		assert.False(t, isBackupArchive(data))
+	})
+}
+
+func Test_backupFilter(t *testing.T) {
+	newResource := func(kind string, namespace string, name string, fields map[string]interface{}) unstructured.Unstructured {
+		un := unstructured.Unstructured{Object: fields}
+		if un.Object == nil {
+			un.Object = map[string]interface{}{}
+		}
+		un.SetKind(kind)
+		un.SetNamespace(namespace)
+		un.SetName(name)
+		return un
+	}
+	cm := newResource("ConfigMap", "argocd", "argocd-cm", nil)
+	proj := newResource("AppProject", "argocd", "team-a", nil)
+	app := newResource("Application", "argocd", "guestbook", map[string]interface{}{"spec": map[string]interface{}{"project": "team-a"}})
+	otherApp := newResource("Application", "apps", "other", map[string]interface{}{"spec": map[string]interface{}{"project": "default"}})
+	appSet := newResource("ApplicationSet", "argocd", "guestbooks", map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"project": "team-a"}}}})
+	repo := newResource("Secret", "argocd", "repo", map[string]interface{}{"data": map[string]interface{}{"project": base64.StdEncoding.EncodeToString([]byte("team-a"))}})
+
+	tests := []struct {
+		name     string
+		filter   backupFilter
+		expected []unstructured.Unstructured
+	}{
+		{name: "no filter", filter: backupFilter{}, expected: []unstructured.Unstructured{cm, proj, app, otherApp, appSet, repo}},
+		{name: "kinds", filter: backupFilter{kinds: []string{"application", "AppProject"}}, expected: []unstructured.Unstructured{proj, app, otherApp}},
+		{name: "projects", filter: backupFilter{projects: []string{"team-*"}}, expected: []unstructured.Unstructured{proj, app, appSet, repo}},
+		{name: "applications", filter: backupFilter{applications: []string{"apps/*"}}, expected: []unstructured.Unstructured{otherApp}},
+		{name: "kinds and projects", filter: backupFilter{kinds: []string{"Application"}, projects: []string{"team-a"}, applications: []string{"other"}}, expected: []unstructured.Unstructured{app, otherApp}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matched []unstructured.Unstructured
+			for _, un := range []unstructured.Unstructured{cm, proj, app, otherApp, appSet, repo} {
+				if tt.filter.matches(un) {
+					matched = append(matched, un)
+				}
+			}
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	require.NoError(t, (&backupFilter{kinds: []string{"secret"}}).validate())
+	require.ErrorContains(t, (&backupFilter{kinds: []string{"Deployment"}}).validate(), "unsupported kind")
+}
+
+func Test_migrateBackup(t *testing.T) {
+	newApp := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Application",
+			"metadata":   map[string]interface{}{"name": "guestbook"},
+			"spec": map[string]interface{}{
+				"source": map[string]interface{}{"repoURL": "https://example.com/repo.git", "ksonnet": map[string]interface{}{"environment": "dev"}},
+				"sources": []interface{}{
+					map[string]interface{}{"repoURL": "https://example.com/charts", "chart": "guestbook", "helm": map[string]interface{}{"version": "v2"}},
+				},
+			},
+		}}
+	}
+	helmVersion := func(app *unstructured.Unstructured) string {
+		sources, _, _ := unstructured.NestedSlice(app.Object, "spec", "sources")
+		version, _, _ := unstructured.NestedString(sources[0].(map[string]interface{}), "helm", "version")
+		return version
+	}
+
+	app := newApp()
+	require.NoError(t, migrateBackup([]*unstructured.Unstructured{app}, "v2.3.4"))
+	_, found, _ := unstructured.NestedFieldNoCopy(app.Object, "spec", "source", "ksonnet")
+	assert.False(t, found)
+	repoURL, _, _ := unstructured.NestedString(app.Object, "spec", "source", "repoURL")
+	assert.Equal(t, "https://example.com/repo.git", repoURL)
+	assert.Equal(t, "v3", helmVersion(app))
+
+	// all migrations are applied to backups of unknown version
+	app = newApp()
+	require.NoError(t, migrateBackup([]*unstructured.Unstructured{app}, ""))
+	_, found, _ = unstructured.NestedFieldNoCopy(app.Object, "spec", "source", "ksonnet")
+	assert.False(t, found)
+	assert.Equal(t, "v3", helmVersion(app))
+
+	app = newApp()
+	require.NoError(t, migrateBackup([]*unstructured.Unstructured{app}, "v2.4.0"))
+	assert.Equal(t, newApp(), app)
+}
@@ -78,6 +78,33 @@ type ClusterWithInfo struct {
 	Namespaces []string
 }
 
+// getAppStateCache returns the cache populated by the application controller, optionally port-forwarding the redis
+// of the given namespace.
+func getAppStateCache(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), redisName string, redisHaProxyName string, redisCompressionStr string) (*appstatecache.Cache, error) {
+	if !portForwardRedis {
+		return cacheSrc()
+	}
+	overrides := clientcmd.ConfigOverrides{}
+	redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
+	redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
+	port, err := kubeutil.PortForward(6379, namespace, &overrides,
+		redisHaProxyPodLabelSelector, redisPodLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
+	if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
+		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+	}
+	client := redis.NewClient(redisOptions)
+	compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+	return appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour), nil
+}
+
 func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
@@ -94,32 +121,9 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 	clusterShardingCache.Init(clustersList, appItems)
 	clusterShards := clusterShardingCache.GetDistribution()
 
-	var cache *appstatecache.Cache
-	if portForwardRedis {
-		overrides := clientcmd.ConfigOverrides{}
-		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
-		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
-		port, err := kubeutil.PortForward(6379, namespace, &overrides,
-			redisHaProxyPodLabelSelector, redisPodLabelSelector)
-		if err != nil {
-			return nil, err
-		}
-
-		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
-		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
-			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
-		}
-		client := redis.NewClient(redisOptions)
-		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
-		if err != nil {
-			return nil, err
-		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
-	} else {
-		cache, err = cacheSrc()
-		if err != nil {
-			return nil, err
-		}
+	cache, err := getAppStateCache(ctx, kubeClient, namespace, portForwardRedis, cacheSrc, redisName, redisHaProxyName, redisCompressionStr)
+	if err != nil {
+		return nil, err
 	}
 
 	apps := appItems.Items
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -145,7 +146,7 @@ func (opts *settingsOpts) getK8sClient() (*kubernetes.Clientset, string, error)
 	return realClientset, namespace, nil
 }
 
-func NewSettingsCommand() *cobra.Command {
+func NewSettingsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts settingsOpts
 
 	command := &cobra.Command{
@@ -160,6 +161,7 @@ func NewSettingsCommand() *cobra.Command {
 	command.AddCommand(NewValidateSettingsCommand(&opts))
 	command.AddCommand(NewResourceOverridesCommand(&opts))
 	command.AddCommand(NewRBACCommand(&opts))
+	command.AddCommand(NewSuggestIgnoreDifferencesCommand(&opts, clientOpts))
 
 	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
 	command.PersistentFlags().StringVar(&opts.argocdCMPath, "argocd-cm-path", "", "Path to local argocd-cm.yaml file")
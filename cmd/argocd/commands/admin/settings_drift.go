@@ -0,0 +1,214 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+)
+
+const (
+	ignoreDifferencesLevelApp    = "app"
+	ignoreDifferencesLevelSystem = "system"
+)
+
+// appWithDrift associates an application with the fields of its resources which drift from their desired state
+type appWithDrift struct {
+	app   *v1alpha1.Application
+	drift *drift.AppDrift
+}
+
+func NewSuggestIgnoreDifferencesCommand(opts *settingsOpts, clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		minReconciliations  int64
+		level               string
+		useManagers         bool
+		preview             bool
+		portForwardRedis    bool
+		cacheSrc            func() (*appstatecache.Cache, error)
+		redisCompressionStr string
+	)
+	command := &cobra.Command{
+		Use:   "suggest-ignore-differences [APPNAME...]",
+		Short: "Suggests ignoreDifferences rules for the fields which persistently drift from their desired state",
+		Long: `Suggests ignoreDifferences rules for the fields of the managed resources which have continuously differed from their desired state during the last reconciliations of the applications, as tracked by the application controller.
+Application level rules are rendered as Application patches and system level rules as an 'argocd-cm' ConfigMap patch. Both are merged into the existing rules and can be applied using 'kubectl patch --type merge --patch-file'.`,
+		Example: `
+# Suggest application level rules for the fields which drifted during at least 10 reconciliations
+argocd admin settings suggest-ignore-differences
+
+# Suggest application level rules for the given applications ignoring the fields owned by other field managers
+argocd admin settings suggest-ignore-differences guestbook --use-managers
+
+# Suggest system level rules merged into the settings of the cluster
+argocd admin settings suggest-ignore-differences --level system --load-cluster-settings
+
+# Preview which applications would become Synced
+argocd admin settings suggest-ignore-differences --preview`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if level != ignoreDifferencesLevelApp && level != ignoreDifferencesLevelSystem {
+				errors.CheckError(fmt.Errorf("unknown level %q, supported levels are: %s, %s", level, ignoreDifferencesLevelApp, ignoreDifferencesLevelSystem))
+			}
+			restConfig, err := opts.clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := opts.clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(restConfig)
+			appClient := versioned.NewForConfigOrDie(restConfig)
+
+			appList, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, v1.ListOptions{})
+			errors.CheckError(err)
+			cache, err := getAppStateCache(ctx, kubeClient, namespace, portForwardRedis, cacheSrc, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
+			errors.CheckError(err)
+
+			var apps []appWithDrift
+			for i := range appList.Items {
+				app := &appList.Items[i]
+				if len(args) > 0 && !slices.Contains(args, app.Name) && !slices.Contains(args, app.QualifiedName()) {
+					continue
+				}
+				appDrift := &drift.AppDrift{}
+				if err := cache.GetAppDrift(app.InstanceName(namespace), appDrift); err != nil {
+					if err == appstatecache.ErrCacheMiss {
+						continue
+					}
+					errors.CheckError(err)
+				}
+				apps = append(apps, appWithDrift{app: app, drift: appDrift})
+			}
+			sort.Slice(apps, func(i, j int) bool {
+				return apps[i].app.QualifiedName() < apps[j].app.QualifiedName()
+			})
+
+			suggestOpts := drift.SuggestOptions{MinReconciliations: minReconciliations, UseManagers: useManagers}
+			if preview {
+				printDriftPreview(os.Stdout, apps, minReconciliations)
+				return
+			}
+			switch level {
+			case ignoreDifferencesLevelApp:
+				errors.CheckError(printAppIgnoreDifferences(os.Stdout, apps, suggestOpts))
+			case ignoreDifferencesLevelSystem:
+				existing := map[string]v1alpha1.ResourceOverride{}
+				if opts.argocdCMPath != "" || opts.loadClusterSettings {
+					settingsManager, err := opts.createSettingsManager(ctx)
+					errors.CheckError(err)
+					existing, err = settingsManager.GetResourceOverrides()
+					errors.CheckError(err)
+				}
+				errors.CheckError(printSystemIgnoreDifferences(os.Stdout, apps, existing, suggestOpts))
+			}
+		},
+	}
+	command.Flags().Int64Var(&minReconciliations, "min-reconciliations", 10, "Minimum number of consecutive reconciliations a field must have drifted to be ignored")
+	command.Flags().StringVar(&level, "level", ignoreDifferencesLevelApp, fmt.Sprintf("Level of the suggested rules, one of: %s, %s", ignoreDifferencesLevelApp, ignoreDifferencesLevelSystem))
+	command.Flags().BoolVar(&useManagers, "use-managers", false, "Ignore the fields owned by other field managers using managedFieldsManagers instead of their path")
+	command.Flags().BoolVar(&preview, "preview", false, "Print the applications which would become Synced instead of the rules")
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(command)
+
+	// parse all added flags so far to get the redis-compression flag that was added by AddCacheFlagsToCmd() above
+	// we can ignore unchecked error here as the command will be parsed again and checked when command.Execute() is run later
+	// nolint:errcheck
+	command.ParseFlags(os.Args[1:])
+	redisCompressionStr, _ = command.Flags().GetString(cacheutil.CLIFlagRedisCompress)
+	return command
+}
+
+// printAppIgnoreDifferences prints an Application patch adding the suggested rules for every application with
+// persistently drifted fields
+func printAppIgnoreDifferences(out io.Writer, apps []appWithDrift, opts drift.SuggestOptions) error {
+	for _, a := range apps {
+		if len(a.drift.PersistentFields(opts.MinReconciliations)) == 0 {
+			continue
+		}
+		data, err := yaml.Marshal(map[string]interface{}{
+			"apiVersion": application.Group + "/v1alpha1",
+			"kind":       application.ApplicationKind,
+			"metadata":   map[string]string{"name": a.app.Name, "namespace": a.app.Namespace},
+			"spec":       map[string]interface{}{"ignoreDifferences": drift.SuggestIgnoreDifferences(a.app.Spec.IgnoreDifferences, a.drift, opts)},
+		})
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(out, "---\n%s", data)
+	}
+	return nil
+}
+
+// printSystemIgnoreDifferences prints an 'argocd-cm' ConfigMap patch setting the suggested rules of every group kind
+// with persistently drifted fields
+func printSystemIgnoreDifferences(out io.Writer, apps []appWithDrift, existing map[string]v1alpha1.ResourceOverride, opts drift.SuggestOptions) error {
+	var drifts []*drift.AppDrift
+	for _, a := range apps {
+		drifts = append(drifts, a.drift)
+	}
+	rules := drift.SuggestResourceOverrides(existing, drifts, opts)
+	if len(rules) == 0 {
+		return nil
+	}
+	cmData := map[string]string{}
+	for key, rule := range rules {
+		data, err := yaml.Marshal(rule)
+		if err != nil {
+			return err
+		}
+		// the config map keys use the <group>_<kind> format
+		cmData["resource.customizations.ignoreDifferences."+strings.Replace(key, "/", "_", 1)] = string(data)
+	}
+	data, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]string{"name": common.ArgoCDConfigMapName},
+		"data":       cmData,
+	})
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(out, string(data))
+	return nil
+}
+
+// printDriftPreview prints the drifted fields of the applications and whether they would become Synced
+func printDriftPreview(out io.Writer, apps []appWithDrift, minReconciliations int64) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "APP\tSYNC STATUS\tDRIFTED FIELDS\tPERSISTENT FIELDS\tMANAGERS\tWOULD BE SYNCED\n")
+	for _, a := range apps {
+		var managers []string
+		for _, f := range a.drift.PersistentFields(minReconciliations) {
+			if f.Manager != "" && !slices.Contains(managers, f.Manager) {
+				managers = append(managers, f.Manager)
+			}
+		}
+		sort.Strings(managers)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%t\n",
+			a.app.QualifiedName(),
+			a.app.Status.Sync.Status,
+			len(a.drift.Fields),
+			len(a.drift.PersistentFields(minReconciliations)),
+			strings.Join(managers, ","),
+			drift.WouldBeSynced(a.app, a.drift, minReconciliations))
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
+)
+
+func newAppsWithDrift() []appWithDrift {
+	app := &v1alpha1.Application{
+		ObjectMeta: v1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{IgnoreDifferences: []v1alpha1.ResourceIgnoreDifferences{
+			{Kind: "ConfigMap", JSONPointers: []string{"/data"}},
+		}},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:      v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+			Resources: []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync}},
+		},
+	}
+	synced := &v1alpha1.Application{
+		ObjectMeta: v1.ObjectMeta{Name: "synced", Namespace: "argocd"},
+		Status:     v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced}},
+	}
+	return []appWithDrift{{
+		app: app,
+		drift: &drift.AppDrift{Fields: []drift.FieldDrift{
+			{Group: "apps", Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/replicas", Manager: "kube-controller-manager", Reconciliations: 10},
+		}},
+	}, {
+		app:   synced,
+		drift: &drift.AppDrift{},
+	}}
+}
+
+func TestPrintAppIgnoreDifferences(t *testing.T) {
+	out := bytes.Buffer{}
+	require.NoError(t, printAppIgnoreDifferences(&out, newAppsWithDrift(), drift.SuggestOptions{MinReconciliations: 10}))
+	assert.Equal(t, `---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  ignoreDifferences:
+  - jsonPointers:
+    - /data
+    kind: ConfigMap
+  - group: apps
+    jsonPointers:
+    - /spec/replicas
+    kind: Deployment
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, printAppIgnoreDifferences(&out, newAppsWithDrift(), drift.SuggestOptions{MinReconciliations: 11}))
+	assert.Empty(t, out.String())
+}
+
+func TestPrintSystemIgnoreDifferences(t *testing.T) {
+	out := bytes.Buffer{}
+	existing := map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/spec/paused"}}},
+	}
+	require.NoError(t, printSystemIgnoreDifferences(&out, newAppsWithDrift(), existing, drift.SuggestOptions{MinReconciliations: 10, UseManagers: true}))
+	assert.Equal(t, `apiVersion: v1
+data:
+  resource.customizations.ignoreDifferences.apps_Deployment: |
+    jqPathExpressions: null
+    jsonPointers:
+    - /spec/paused
+    managedFieldsManagers:
+    - kube-controller-manager
+kind: ConfigMap
+metadata:
+  name: argocd-cm
+`, out.String())
+}
+
+func TestPrintDriftPreview(t *testing.T) {
+	out := bytes.Buffer{}
+	printDriftPreview(&out, newAppsWithDrift(), 10)
+	assert.Equal(t, `APP               SYNC STATUS  DRIFTED FIELDS  PERSISTENT FIELDS  MANAGERS                 WOULD BE SYNCED
+argocd/guestbook  OutOfSync    1               1                  kube-controller-manager  true
+argocd/synced     Synced       0               0                                           false
+`, out.String())
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/repository"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
@@ -98,6 +99,8 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
+	command.AddCommand(NewApplicationFreezeCommand(clientOpts))
+	command.AddCommand(NewApplicationUnfreezeCommand(clientOpts))
 	return command
 }
 
@@ -567,6 +570,43 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// formatSyncFreeze returns a description of a maintenance freeze, e.g. "database migration (set by admin, expires 2024-03-01T12:00:00Z)"
+func formatSyncFreeze(freeze *argoappv1.SyncFreeze) string {
+	var details []string
+	if freeze.SetBy != "" {
+		details = append(details, "set by "+freeze.SetBy)
+	}
+	if freeze.ExpiresAt != nil {
+		details = append(details, "expires "+freeze.ExpiresAt.Format(time.RFC3339))
+	}
+	description := freeze.Reason
+	if description == "" {
+		description = "<no reason>"
+	}
+	if len(details) > 0 {
+		description += " (" + strings.Join(details, ", ") + ")"
+	}
+	return description
+}
+
+// formatSyncSchedule returns a description of a sync schedule, e.g. "0 2 * * * Europe/Berlin (next: 2024-03-01T01:00:00Z)"
+func formatSyncSchedule(schedule *argoappv1.SyncSchedule) string {
+	timeZone := schedule.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	description := schedule.Cron + " " + timeZone
+	if schedule.Prune {
+		description += " (Prune)"
+	}
+	if next, err := schedule.Next(time.Now()); err == nil {
+		description += " (next: " + next.UTC().Format(time.RFC3339) + ")"
+	} else {
+		description += " (invalid)"
+	}
+	return description
+}
+
 func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *argoappv1.SyncWindows) {
 	fmt.Printf(printOpFmtStr, "Name:", app.QualifiedName())
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
@@ -616,10 +656,20 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	} else {
 		status = "Sync Allowed"
 	}
+	freeze := app.Spec.SyncPolicy.GetFreeze()
+	if freeze.IsActive() {
+		status = "Sync Denied"
+	}
 	fmt.Printf(printOpFmtStr, "SyncWindow:", status)
 	if len(wds) > 0 {
 		fmt.Printf(printOpFmtStr, "Assigned Windows:", strings.Join(wds, ","))
 	}
+	if freeze.IsActive() {
+		fmt.Printf(printOpFmtStr, "Sync Freeze:", formatSyncFreeze(freeze))
+	}
+	if schedule := app.Spec.SyncPolicy.GetSchedule(); schedule != nil {
+		fmt.Printf(printOpFmtStr, "Sync Schedule:", formatSyncSchedule(schedule))
+	}
 
 	var syncPolicy string
 	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
@@ -1148,6 +1198,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		revisions            []string
 		sourcePositions      []int64
 		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+		explain              bool
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
@@ -1183,7 +1234,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			defer argoio.Close(conn)
 			argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
 			errors.CheckError(err)
-			diffOption := &DifferenceOption{}
+			diffOption := &DifferenceOption{explain: explain}
 			if app.Spec.HasMultipleSources() && len(revisions) > 0 && len(sourcePositions) > 0 {
 				numOfSources := int64(len(app.Spec.GetSources()))
 				for _, pos := range sourcePositions {
@@ -1257,6 +1308,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().BoolVar(&explain, "explain", false, "Attribute the fields which differ to the field managers which changed them")
 	return command
 }
 
@@ -1270,6 +1322,7 @@ type DifferenceOption struct {
 	serversideRes   *repoapiclient.ManifestResponse
 	revisions       []string
 	sourcePositions []int64
+	explain         bool
 }
 
 // findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
@@ -1354,11 +1407,51 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 				foundDiffs = true
 			}
 			_ = cli.PrintDiff(item.key.Name, live, target)
+			if diffOptions.explain && item.live != nil && item.target != nil {
+				printFieldChanges(os.Stdout, getFieldChanges(resources, item.key, diffRes.NormalizedLive, diffRes.PredictedLive, diffOptions))
+			}
 		}
 	}
 	return foundDiffs
 }
 
+// getFieldChanges returns the fields of the given resource which differ, attributed to the field managers which
+// changed them. The attribution computed by the controller is used when diffing against the target state of the
+// application, otherwise it is computed using the managed fields of the live state.
+func getFieldChanges(resources *application.ManagedResourcesResponse, key kube.ResourceKey, normalizedLive, predictedLive []byte, diffOptions *DifferenceOption) []argoappv1.ResourceFieldChange {
+	for _, res := range resources.Items {
+		if kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name) != key {
+			continue
+		}
+		if diffOptions.local == "" && diffOptions.res == nil && diffOptions.serversideRes == nil {
+			return res.FieldChanges
+		}
+		live := &unstructured.Unstructured{}
+		errors.CheckError(json.Unmarshal([]byte(res.LiveState), &live))
+		fieldChanges, err := drift.GetFieldChanges(live, normalizedLive, predictedLive)
+		errors.CheckError(err)
+		return fieldChanges
+	}
+	return nil
+}
+
+// printFieldChanges prints the fields which differ and the field managers which changed them
+func printFieldChanges(out io.Writer, fieldChanges []argoappv1.ResourceFieldChange) {
+	if len(fieldChanges) == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "\nFIELD\tMANAGER\tOPERATION\tCHANGED AT\n")
+	for _, change := range fieldChanges {
+		changedAt := ""
+		if change.ChangedAt != nil {
+			changedAt = change.ChangedAt.UTC().Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", change.Path, change.Manager, change.Operation, changedAt)
+	}
+	_ = w.Flush()
+}
+
 func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName, namespace string) []objKeyLiveTarget {
 	resourceTracking := argo.NewResourceTracking()
 	for _, res := range resources.Items {
@@ -2694,11 +2787,131 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			}
 		},
 	}
+	command.AddCommand(NewApplicationHistoryDiffCommand(clientOpts))
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show application deployment history in namespace")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
 	return command
 }
 
+// NewApplicationHistoryDiffCommand returns a new instance of an `argocd app history diff` command
+func NewApplicationHistoryDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		exitCode             bool
+		appNamespace         string
+		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	)
+	command := &cobra.Command{
+		Use:   "diff APPNAME ID1 ID2",
+		Short: "Show the differences between the manifests of two application deployment history entries",
+		Long:  "Show the differences between the manifests of two application deployment history entries. The manifests are regenerated from the sources of the history entries, or read from their snapshots if the revisions do not exist anymore.",
+		Example: `  # Show the changes between the deployments 1 and 2 of an application
+  argocd app history diff my-app 1 2`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			fromId, err := strconv.ParseInt(args[1], 10, 64)
+			errors.CheckError(err)
+			toId, err := strconv.ParseInt(args[2], 10, 64)
+			errors.CheckError(err)
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := clientset.NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+			liveObjs, err := cmdutil.LiveObjects(resources.Items)
+			errors.CheckError(err)
+
+			settingsConn, settingsIf := clientset.NewSettingsClientOrDie()
+			defer argoio.Close(settingsConn)
+			argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
+			errors.CheckError(err)
+
+			getHistoryObjs := func(id int64) map[kube.ResourceKey]*unstructured.Unstructured {
+				res, err := appIf.GetManifests(ctx, &application.ApplicationManifestQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					HistoryId:    &id,
+				})
+				errors.CheckError(err)
+				var objs []*unstructured.Unstructured
+				for _, mfst := range res.Manifests {
+					obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+					errors.CheckError(err)
+					objs = append(objs, obj)
+				}
+				return groupObjsByKey(objs, liveObjs, app.Spec.Destination.Namespace)
+			}
+			fromObjs := getHistoryObjs(fromId)
+			toObjs := getHistoryObjs(toId)
+
+			foundDiffs := printHistoryDiff(app, fromObjs, toObjs, argoSettings, ignoreNormalizerOpts)
+			if foundDiffs && exitCode {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only diff application deployment history in namespace")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff")
+	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	return command
+}
+
+// printHistoryDiff prints the differences between the manifests of two history entries and returns whether any were found
+func printHistoryDiff(app *argoappv1.Application, fromObjs, toObjs map[kube.ResourceKey]*unstructured.Unstructured, argoSettings *settings.Settings, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) bool {
+	keys := make([]kube.ResourceKey, 0, len(fromObjs)+len(toObjs))
+	for key := range fromObjs {
+		keys = append(keys, key)
+	}
+	for key := range toObjs {
+		if _, ok := fromObjs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	overrides := make(map[string]argoappv1.ResourceOverride)
+	for k := range argoSettings.ResourceOverrides {
+		overrides[k] = *argoSettings.ResourceOverrides[k]
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(app.Spec.IgnoreDifferences, overrides, false, ignoreNormalizerOpts).
+		WithTracking(argoSettings.AppLabelKey, argoSettings.TrackingMethod).
+		WithNoCache().
+		WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
+		Build()
+	errors.CheckError(err)
+
+	var foundDiffs bool
+	for _, key := range keys {
+		from, to := fromObjs[key], toObjs[key]
+		if from != nil && to != nil {
+			diffRes, err := argodiff.StateDiff(from, to, diffConfig)
+			errors.CheckError(err)
+			if !diffRes.Modified {
+				continue
+			}
+		}
+		foundDiffs = true
+		fmt.Printf("\n===== %s/%s %s/%s ======\n", key.Group, key.Kind, key.Namespace, key.Name)
+		_ = cli.PrintDiff(key.Name, from, to)
+	}
+	return foundDiffs
+}
+
 func findRevisionHistory(application *argoappv1.Application, historyId int64) (*argoappv1.RevisionHistory, error) {
 	// in case if history id not passed and need fetch previous history revision
 	if historyId == -1 {
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewApplicationFreezeCommand returns a new instance of an `argocd app freeze` command
+func NewApplicationFreezeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		reason       string
+		duration     time.Duration
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "freeze APPNAME",
+		Short: "Prevent all syncs of an application, e.g. during maintenance",
+		Long:  "Prevent all syncs of an application, including manual and scheduled syncs, until it is unfrozen or the freeze expires. The user who set the freeze is recorded by the API server.",
+		Example: `  # Freeze an application until it is unfrozen
+  argocd app freeze my-app --reason "database migration"
+
+  # Freeze an application for two hours
+  argocd app freeze my-app --reason "database migration" --duration 2h`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if duration < 0 {
+				errors.CheckError(fmt.Errorf("duration must not be negative"))
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+
+			freeze := &argoappv1.SyncFreeze{Reason: reason}
+			if duration > 0 {
+				freeze.ExpiresAt = &metav1.Time{Time: time.Now().Add(duration).Truncate(time.Second)}
+			}
+			if app.Spec.SyncPolicy == nil {
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			app.Spec.SyncPolicy.Freeze = freeze
+			spec, err := appIf.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{
+				Name:         &app.Name,
+				Spec:         &app.Spec,
+				Validate:     ptr.To(false),
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' frozen: %s\n", app.QualifiedName(), formatSyncFreeze(spec.SyncPolicy.GetFreeze()))
+		},
+	}
+	command.Flags().StringVar(&reason, "reason", "", "Reason of the freeze")
+	command.Flags().DurationVar(&duration, "duration", 0, "Duration after which the freeze expires (e.g. 2h), the freeze does not expire if not set")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the target application where the freeze will be set")
+	errors.CheckError(command.MarkFlagRequired("reason"))
+	return command
+}
+
+// NewApplicationUnfreezeCommand returns a new instance of an `argocd app unfreeze` command
+func NewApplicationUnfreezeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appNamespace string
+	command := &cobra.Command{
+		Use:   "unfreeze APPNAME",
+		Short: "Remove the maintenance freeze of an application",
+		Example: `  # Allow syncs of a frozen application again
+  argocd app unfreeze my-app`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+
+			if app.Spec.SyncPolicy.GetFreeze() == nil {
+				fmt.Printf("Application '%s' is not frozen\n", app.QualifiedName())
+				return
+			}
+			app.Spec.SyncPolicy.Freeze = nil
+			if app.Spec.SyncPolicy.IsZero() {
+				app.Spec.SyncPolicy = nil
+			}
+			_, err = appIf.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{
+				Name:         &app.Name,
+				Spec:         &app.Spec,
+				Validate:     ptr.To(false),
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' unfrozen\n", app.QualifiedName())
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the target application where the freeze will be removed")
+	return command
+}
@@ -497,6 +497,20 @@ func TestFormatSyncPolicy(t *testing.T) {
 	})
 }
 
+func TestFormatSyncFreeze(t *testing.T) {
+	assert.Equal(t, "<no reason>", formatSyncFreeze(&v1alpha1.SyncFreeze{}))
+	assert.Equal(t, "database migration (set by admin, expires 2024-03-01T12:00:00Z)", formatSyncFreeze(&v1alpha1.SyncFreeze{
+		Reason:    "database migration",
+		SetBy:     "admin",
+		ExpiresAt: &metav1.Time{Time: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+	}))
+}
+
+func TestFormatSyncSchedule(t *testing.T) {
+	assert.Regexp(t, `^0 2 \* \* \* Europe/Berlin \(Prune\) \(next: .*T0[01]:00:00Z\)$`, formatSyncSchedule(&v1alpha1.SyncSchedule{Cron: "0 2 * * *", TimeZone: "Europe/Berlin", Prune: true}))
+	assert.Equal(t, "invalid UTC (invalid)", formatSyncSchedule(&v1alpha1.SyncSchedule{Cron: "invalid"}))
+}
+
 func TestFormatConditionSummary(t *testing.T) {
 	t.Run("No conditions are defined", func(t *testing.T) {
 		app := v1alpha1.Application{
@@ -917,6 +931,32 @@ func TestPrintAppConditions(t *testing.T) {
 	}
 }
 
+func TestPrintFieldChanges(t *testing.T) {
+	changedAt := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	output, _ := captureOutput(func() error {
+		printFieldChanges(os.Stdout, []v1alpha1.ResourceFieldChange{
+			{Path: "/spec/replicas", Manager: "kube-controller-manager", Operation: "Update", ChangedAt: &changedAt},
+			{Path: "/metadata/labels/team"},
+		})
+		printFieldChanges(os.Stdout, nil)
+		return nil
+	})
+	assert.Equal(t, "\nFIELD                  MANAGER                  OPERATION  CHANGED AT\n"+
+		"/spec/replicas         kube-controller-manager  Update     2024-01-01T00:00:00Z\n"+
+		"/metadata/labels/team                                      \n", output)
+}
+
+func TestGetFieldChanges(t *testing.T) {
+	fieldChanges := []v1alpha1.ResourceFieldChange{{Path: "/spec/replicas", Manager: "kube-controller-manager"}}
+	resources := &applicationpkg.ManagedResourcesResponse{Items: []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", FieldChanges: fieldChanges},
+	}}
+	key := kube.NewResourceKey("apps", "Deployment", "default", "guestbook")
+
+	assert.Equal(t, fieldChanges, getFieldChanges(resources, key, nil, nil, &DifferenceOption{}))
+	assert.Nil(t, getFieldChanges(resources, kube.NewResourceKey("", "ConfigMap", "default", "guestbook"), nil, nil, &DifferenceOption{}))
+}
+
 func TestPrintParams(t *testing.T) {
 	testCases := []struct {
 		name           string
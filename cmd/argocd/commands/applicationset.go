@@ -10,10 +10,12 @@ import (
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/admin"
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
+	"github.com/argoproj/argo-cd/v2/common"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
 	arogappsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -55,6 +57,8 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetPreviewCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutCommand(clientOpts))
 	return command
 }
 
@@ -279,6 +283,117 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationSetPreviewCommand returns a new instance of an `argocd appset preview` command
+func NewApplicationSetPreviewCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		fileURL         string
+		renderManifests bool
+	)
+	command := &cobra.Command{
+		Use:   "preview [APPSETNAME]",
+		Short: "Preview the changes the next reconciliation of an ApplicationSet would make to its apps",
+		Example: templates.Examples(`
+	# Preview the changes of an existing ApplicationSet
+	argocd appset preview APPSETNAME
+
+	# Preview the changes of a proposed ApplicationSet stored in a file or at given URL
+	argocd appset preview --file <filename or URL>
+
+	# Preview the changes and render the manifests of the created and updated apps
+	argocd appset preview --file <filename or URL> --render-manifests
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if (len(args) == 0) == (fileURL == "") {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := argocdClient.NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			var appset *arogappsetv1.ApplicationSet
+			if fileURL != "" {
+				appsets, err := cmdutil.ConstructApplicationSet(fileURL)
+				errors.CheckError(err)
+
+				if len(appsets) != 1 {
+					fmt.Printf("Input file must contain one ApplicationSet")
+					os.Exit(1)
+				}
+				appset = appsets[0]
+				if appset.Name == "" {
+					err := fmt.Errorf("Error previewing ApplicationSet %s. ApplicationSet does not have Name field set", appset)
+					errors.CheckError(err)
+				}
+			} else {
+				appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+				var err error
+				appset, err = appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+				errors.CheckError(err)
+			}
+
+			resp, err := appIf.Preview(ctx, &applicationset.ApplicationSetPreviewRequest{
+				ApplicationSet:  appset,
+				RenderManifests: renderManifests,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(resp.Changes, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printApplicationSetChanges(resp.Changes)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL of a proposed ApplicationSet to preview instead of an existing one")
+	command.Flags().BoolVar(&renderManifests, "render-manifests", false, "Render the manifests of the created and updated apps")
+	return command
+}
+
+// printApplicationSetChanges prints a summary table of the changes, followed by the updated fields and the rendered
+// manifests of every changed app
+func printApplicationSetChanges(changes []*applicationset.ApplicationSetApplicationChange) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tACTION\tSKIPPED\n")
+	for _, change := range changes {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", change.Name, change.Action, change.SkipReason)
+	}
+	_ = w.Flush()
+
+	for _, change := range changes {
+		if len(change.Diffs) == 0 && len(change.Manifests) == 0 && change.ManifestsError == "" {
+			continue
+		}
+		fmt.Printf("\n===== %s ======\n", change.Name)
+		for _, diff := range change.Diffs {
+			fmt.Printf("%s: %s => %s\n", diff.Path, valueOrNone(diff.Live), valueOrNone(diff.Desired))
+		}
+		if change.ManifestsError != "" {
+			fmt.Printf("\nFailed to render manifests: %s\n", change.ManifestsError)
+		}
+		for _, manifest := range change.Manifests {
+			data, err := yaml.JSONToYAML([]byte(manifest))
+			errors.CheckError(err)
+			fmt.Printf("---\n%s", data)
+		}
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
 // NewApplicationSetListCommand returns a new instance of an `argocd appset list` command
 func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -392,6 +507,56 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetRolloutCommand returns a new instance of an `argocd appset rollout` command
+func NewApplicationSetRolloutCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage the RollingSync rollout of an ApplicationSet",
+		Example: templates.Examples(`
+	# Approve the step of the rollout waiting for an approval, or resume an aborted rollout
+	argocd appset rollout promote APPSETNAME
+
+	# Abort the rollout
+	argocd appset rollout abort APPSETNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(newApplicationSetRolloutActionCommand(clientOpts, common.ApplicationSetRolloutActionPromote, "Approve the step of the rollout waiting for an approval, or resume an aborted rollout"))
+	command.AddCommand(newApplicationSetRolloutActionCommand(clientOpts, common.ApplicationSetRolloutActionAbort, "Abort the rollout, halting the sync of further steps"))
+	return command
+}
+
+// newApplicationSetRolloutActionCommand returns a new instance of an `argocd appset rollout ACTION` command
+func newApplicationSetRolloutActionCommand(clientOpts *argocdclient.ClientOptions, action string, short string) *cobra.Command {
+	command := &cobra.Command{
+		Use:   action + " APPSETNAME",
+		Short: short,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			appSet, err := appIf.Rollout(ctx, &applicationset.ApplicationSetRolloutRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Action:          action,
+			})
+			errors.CheckError(err)
+			fmt.Printf("requested to %s the rollout of applicationset '%s'\n", action, appSet.QualifiedName())
+		},
+	}
+	return command
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -466,6 +631,9 @@ func printAppSetSummaryTable(appSet *arogappsetv1.ApplicationSet) {
 		syncPolicyStr = "<none>"
 	}
 	fmt.Printf(printOpFmtStr, "SyncPolicy:", syncPolicyStr)
+	if rollout := appSet.Status.Rollout; rollout != nil && rollout.Message != "" {
+		fmt.Printf(printOpFmtStr, "Rollout:", rollout.Message)
+	}
 }
 
 func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
@@ -170,6 +171,11 @@ func TestPrintAppSetSummaryTable(t *testing.T) {
 		},
 	}
 
+	appSetRollout := baseAppSet.DeepCopy()
+	appSetRollout.Status.Rollout = &v1alpha1.ApplicationSetRolloutStatus{
+		Message: "Rollout is waiting for the approval of step 2.",
+	}
+
 	for _, tt := range []struct {
 		name           string
 		appSet         *v1alpha1.ApplicationSet
@@ -212,6 +218,20 @@ Source:
 - Repo:             
   Target:           
 SyncPolicy:         Automated
+`,
+		},
+		{
+			name:   "appset with a rollout message",
+			appSet: appSetRollout,
+			expectedOutput: `Name:               app-name
+Project:            default
+Server:             
+Namespace:          
+Source:
+- Repo:             
+  Target:           
+SyncPolicy:         <none>
+Rollout:            Rollout is waiting for the approval of step 2.
 `,
 		},
 	} {
@@ -233,3 +253,35 @@ SyncPolicy:         Automated
 		})
 	}
 }
+
+func TestPrintApplicationSetChanges(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printApplicationSetChanges([]*applicationset.ApplicationSetApplicationChange{
+			{Name: "created", Action: "create", Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`}},
+			{Name: "removed", Action: "delete", SkipReason: "the applications sync policy create-only does not allow deletions"},
+			{Name: "updated", Action: "update", Diffs: []*applicationset.ApplicationSetFieldDiff{
+				{Path: "metadata.labels", Desired: `{"team":"a"}`},
+				{Path: "spec.source.targetRevision", Live: `"HEAD"`, Desired: `"v1.0.0"`},
+			}},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `NAME     ACTION  SKIPPED
+created  create  
+removed  delete  the applications sync policy create-only does not allow deletions
+updated  update  
+
+===== created ======
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+
+===== updated ======
+metadata.labels: <none> => {"team":"a"}
+spec.source.targetRevision: "HEAD" => "v1.0.0"
+`
+	assert.Equal(t, expectation, output)
+}
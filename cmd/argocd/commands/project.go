@@ -14,6 +14,7 @@ import (
 	humanize "github.com/dustin/go-humanize"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
@@ -68,6 +69,10 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectEditCommand(clientOpts))
 	command.AddCommand(NewProjectAddSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSignatureKeyCommand(clientOpts))
+	command.AddCommand(NewProjectAddSSHSignatureKeyCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveSSHSignatureKeyCommand(clientOpts))
+	command.AddCommand(NewProjectAddKeylessSignatureIdentityCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveKeylessSignatureIdentityCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
@@ -264,6 +269,196 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// parseAllowedSigner parses an entry of an SSH allowed signers file into an SSH signature key
+func parseAllowedSigner(entry string) (*v1alpha1.SSHSignatureKey, error) {
+	fields := strings.Fields(entry)
+	for i := range fields {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.Join(fields[i:], " ")))
+		if err != nil {
+			continue
+		}
+		signatureKey := &v1alpha1.SSHSignatureKey{PublicKey: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))}
+		if i > 0 {
+			signatureKey.Principals = fields[0]
+		}
+		return signatureKey, nil
+	}
+	return nil, fmt.Errorf("%q is not a valid SSH allowed signers entry", entry)
+}
+
+// NewProjectAddSSHSignatureKeyCommand returns a new instance of an `argocd proj add-ssh-signature-key` command
+func NewProjectAddSSHSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "add-ssh-signature-key PROJECT ALLOWED-SIGNER",
+		Short: "Add SSH signature key to project",
+		Example: templates.Examples(`
+			# Add an SSH allowed signers entry to project PROJECT
+			argocd proj add-ssh-signature-key PROJECT "alice@example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIH..."
+
+			# Add the SSH public key of the current user to project PROJECT
+			argocd proj add-ssh-signature-key PROJECT "$(cat ~/.ssh/id_ed25519.pub)"
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			signatureKey, err := parseAllowedSigner(args[1])
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, key := range proj.Spec.SSHSignatureKeys {
+				if key.PublicKey == signatureKey.PublicKey {
+					log.Fatal("Specified SSH signature key is already defined in project")
+				}
+			}
+			proj.Spec.SSHSignatureKeys = append(proj.Spec.SSHSignatureKeys, *signatureKey)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveSSHSignatureKeyCommand returns a new instance of an `argocd proj remove-ssh-signature-key` command
+func NewProjectRemoveSSHSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "remove-ssh-signature-key PROJECT FINGERPRINT",
+		Short: "Remove SSH signature key from project",
+		Example: templates.Examples(`
+			# Remove the SSH signature key with the given SHA256 fingerprint from project PROJECT
+			argocd proj remove-ssh-signature-key PROJECT SHA256:lfzVOftJWJaj6v0q7J0Dj1r5nHAXZu+EJ6g/d/Hw61Y
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			fingerprint := args[1]
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := -1
+			for i, key := range proj.Spec.SSHSignatureKeys {
+				if sshKeyFingerprint(key) == fingerprint {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				log.Fatal("Specified SSH signature key is not configured for project")
+			} else {
+				proj.Spec.SSHSignatureKeys = append(proj.Spec.SSHSignatureKeys[:index], proj.Spec.SSHSignatureKeys[index+1:]...)
+				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				errors.CheckError(err)
+			}
+		},
+	}
+
+	return command
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of the given SSH signature key, or an empty string if the key cannot be parsed
+func sshKeyFingerprint(key v1alpha1.SSHSignatureKey) string {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+	if err != nil {
+		return ""
+	}
+	return ssh.FingerprintSHA256(publicKey)
+}
+
+// NewProjectAddKeylessSignatureIdentityCommand returns a new instance of an `argocd proj add-keyless-signature-identity` command
+func NewProjectAddKeylessSignatureIdentityCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "add-keyless-signature-identity PROJECT ISSUER SUBJECT-REGEX",
+		Short: "Add keyless (Sigstore) signature identity to project",
+		Example: templates.Examples(`
+			# Allow commits signed with gitsign by any example.com Google account
+			argocd proj add-keyless-signature-identity PROJECT https://accounts.google.com '.*@example\.com'
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			identity := v1alpha1.KeylessSignatureIdentity{Issuer: args[1], SubjectRegex: args[2]}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, i := range proj.Spec.KeylessSignatureIdentities {
+				if i == identity {
+					log.Fatal("Specified keyless signature identity is already defined in project")
+				}
+			}
+			proj.Spec.KeylessSignatureIdentities = append(proj.Spec.KeylessSignatureIdentities, identity)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveKeylessSignatureIdentityCommand returns a new instance of an `argocd proj remove-keyless-signature-identity` command
+func NewProjectRemoveKeylessSignatureIdentityCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "remove-keyless-signature-identity PROJECT ISSUER SUBJECT-REGEX",
+		Short: "Remove keyless (Sigstore) signature identity from project",
+		Example: templates.Examples(`
+			# Remove keyless signature identity from project PROJECT
+			argocd proj remove-keyless-signature-identity PROJECT https://accounts.google.com '.*@example\.com'
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			identity := v1alpha1.KeylessSignatureIdentity{Issuer: args[1], SubjectRegex: args[2]}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := slices.Index(proj.Spec.KeylessSignatureIdentities, identity)
+			if index == -1 {
+				log.Fatal("Specified keyless signature identity is not configured for project")
+			} else {
+				proj.Spec.KeylessSignatureIdentities = append(proj.Spec.KeylessSignatureIdentities[:index], proj.Spec.KeylessSignatureIdentities[index+1:]...)
+				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				errors.CheckError(err)
+			}
+		},
+	}
+
+	return command
+}
+
 // NewProjectAddDestinationCommand returns a new instance of an `argocd proj add-destination` command
 func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var nameInsteadServer bool
@@ -897,11 +1092,11 @@ func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	default:
 		namespaceBlacklist = fmt.Sprintf("%d resources", len(p.Spec.NamespaceResourceBlacklist))
 	}
-	switch len(p.Spec.SignatureKeys) {
+	switch keys := len(p.Spec.SignatureKeys) + len(p.Spec.SSHSignatureKeys) + len(p.Spec.KeylessSignatureIdentities); keys {
 	case 0:
 		signatureKeys = "<none>"
 	default:
-		signatureKeys = fmt.Sprintf("%d key(s)", len(p.Spec.SignatureKeys))
+		signatureKeys = fmt.Sprintf("%d key(s)", keys)
 	}
 	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, destinations, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
 }
@@ -993,6 +1188,30 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	}
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
 
+	sshSignatureKeysStr := "<none>"
+	if len(p.Spec.SSHSignatureKeys) > 0 {
+		fingerprints := make([]string, 0)
+		for _, key := range p.Spec.SSHSignatureKeys {
+			fingerprint := sshKeyFingerprint(key)
+			if key.Principals != "" {
+				fingerprint = fmt.Sprintf("%s (%s)", fingerprint, key.Principals)
+			}
+			fingerprints = append(fingerprints, fingerprint)
+		}
+		sshSignatureKeysStr = strings.Join(fingerprints, ", ")
+	}
+	fmt.Printf(printProjFmtStr, "SSH signature keys:", sshSignatureKeysStr)
+
+	keylessIdentitiesStr := "<none>"
+	if len(p.Spec.KeylessSignatureIdentities) > 0 {
+		identities := make([]string, 0)
+		for _, identity := range p.Spec.KeylessSignatureIdentities {
+			identities = append(identities, fmt.Sprintf("%s issued by %s", identity.SubjectRegex, identity.Issuer))
+		}
+		keylessIdentitiesStr = strings.Join(identities, ", ")
+	}
+	fmt.Printf(printProjFmtStr, "Keyless signers:", keylessIdentitiesStr)
+
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
 }
 
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -45,6 +46,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsScheduleCommand(clientOpts))
 	return roleCommand
 }
 
@@ -343,6 +345,99 @@ argocd proj windows list test-project`,
 	return command
 }
 
+// NewProjectWindowsScheduleCommand returns a new instance of an `argocd proj windows schedule` command
+func NewProjectWindowsScheduleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		from    string
+		to      string
+		appName string
+		output  string
+	)
+	command := &cobra.Command{
+		Use:   "schedule PROJECT",
+		Short: "Show the timeline of the project sync windows",
+		Long:  "Show the time ranges during which the project sync windows are active, the applications matched by each window, the overlaps between allow and deny windows and the next time an application is allowed to sync.",
+		Example: `
+#Show the sync windows timeline of a project for the next week
+argocd proj windows schedule PROJECT
+
+#Show the sync windows timeline of a project for March 2024
+argocd proj windows schedule PROJECT --from 2024-03-01T00:00:00Z --to 2024-04-01T00:00:00Z
+
+#Show the sync windows matching an application and the next time it is allowed to sync
+argocd proj windows schedule PROJECT --app my-app`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer io.Close(conn)
+
+			if from == "" {
+				from = time.Now().UTC().Truncate(time.Minute).Format(time.RFC3339)
+			}
+			res, err := projIf.GetSyncWindowsState(ctx, &projectpkg.SyncWindowsQuery{Name: projName, From: from, To: to, Application: appName})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(res, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printSyncWindowsSchedule(res, appName)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "Start of the time range in RFC3339 format (default: now)")
+	command.Flags().StringVar(&to, "to", "", "End of the time range in RFC3339 format (default: one week after --from)")
+	command.Flags().StringVar(&appName, "app", "", "Only show the windows matching the application and compute the next time it is allowed to sync")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// Print table of the sync windows timeline
+func printSyncWindowsSchedule(res *projectpkg.SyncWindowsResponse, appName string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	headers := []interface{}{"ID", "KIND", "START", "END", "APPLICATIONS"}
+	fmtStr := strings.Repeat("%s\t", len(headers)) + "\n"
+	fmt.Fprintf(w, fmtStr, headers...)
+	for _, o := range res.Timeline {
+		fmt.Fprintf(w, fmtStr, strconv.Itoa(int(o.WindowID)), o.Kind, o.Start, o.End, formatListOutput(o.Applications))
+	}
+	_ = w.Flush()
+
+	if len(res.Overlaps) > 0 {
+		fmt.Println()
+		fmt.Println("OVERLAPPING ALLOW AND DENY WINDOWS:")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		headers = []interface{}{"ALLOW ID", "DENY ID", "START", "END", "DENIED APPLICATIONS"}
+		fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
+		fmt.Fprintf(w, fmtStr, headers...)
+		for _, o := range res.Overlaps {
+			fmt.Fprintf(w, fmtStr, strconv.Itoa(int(o.AllowWindowID)), strconv.Itoa(int(o.DenyWindowID)), o.Start, o.End, formatListOutput(o.Applications))
+		}
+		_ = w.Flush()
+	}
+
+	if appName != "" {
+		fmt.Println()
+		fmt.Printf("Next automated sync of '%s': %s\n", appName, formatNextSyncTime(res.NextSyncTime))
+		fmt.Printf("Next manual sync of '%s':    %s\n", appName, formatNextSyncTime(res.NextManualSyncTime))
+	}
+}
+
+func formatNextSyncTime(t string) string {
+	if t == "" {
+		return "not allowed in the time range"
+	}
+	return t
+}
+
 // Print table of sync window data
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -86,6 +86,9 @@ type AppOptions struct {
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
 	ref                             string
+	syncSchedule                    string
+	syncScheduleTimeZone            string
+	syncSchedulePrune               bool
 }
 
 func AddAppFlags(command *cobra.Command, opts *AppOptions) {
@@ -147,6 +150,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Sync the application at the times of a cron schedule (e.g. \"0 2 * * *\"), an empty value removes the schedule")
+	command.Flags().StringVar(&opts.syncScheduleTimeZone, "sync-schedule-timezone", "", "Time zone of the sync schedule (e.g. Europe/Berlin), defaults to UTC")
+	command.Flags().BoolVar(&opts.syncSchedulePrune, "sync-schedule-prune", false, "Set pruning for scheduled syncs")
 }
 
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions, sourcePosition int) int {
@@ -240,8 +246,37 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "sync-schedule":
+			if appOpts.syncSchedule == "" {
+				if spec.SyncPolicy != nil {
+					spec.SyncPolicy.Schedule = nil
+				}
+				if spec.SyncPolicy.IsZero() {
+					spec.SyncPolicy = nil
+				}
+			} else {
+				if spec.SyncPolicy == nil {
+					spec.SyncPolicy = &argoappv1.SyncPolicy{}
+				}
+				if spec.SyncPolicy.Schedule == nil {
+					spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{}
+				}
+				spec.SyncPolicy.Schedule.Cron = appOpts.syncSchedule
+			}
 		}
 	})
+	if flags.Changed("sync-schedule-timezone") {
+		if spec.SyncPolicy.GetSchedule() == nil {
+			log.Fatal("Cannot set --sync-schedule-timezone: application not configured with a sync schedule")
+		}
+		spec.SyncPolicy.Schedule.TimeZone = appOpts.syncScheduleTimeZone
+	}
+	if flags.Changed("sync-schedule-prune") {
+		if spec.SyncPolicy.GetSchedule() == nil {
+			log.Fatal("Cannot set --sync-schedule-prune: application not configured with a sync schedule")
+		}
+		spec.SyncPolicy.Schedule.Prune = appOpts.syncSchedulePrune
+	}
 	if flags.Changed("auto-prune") {
 		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
 			log.Fatal("Cannot set --auto-prune: application not configured with automatic sync")
@@ -253,6 +253,18 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("SyncSchedule", func(t *testing.T) {
+		f := newAppOptionsFixture()
+		require.NoError(t, f.SetFlag("sync-schedule", "0 2 * * *"))
+		require.NoError(t, f.SetFlag("sync-schedule-timezone", "Europe/Berlin"))
+		require.NoError(t, f.SetFlag("sync-schedule-prune", "true"))
+		assert.Equal(t, &v1alpha1.SyncSchedule{Cron: "0 2 * * *", TimeZone: "Europe/Berlin", Prune: true}, f.spec.SyncPolicy.Schedule)
+
+		f = newAppOptionsFixture()
+		f.spec.SyncPolicy = &v1alpha1.SyncPolicy{Schedule: &v1alpha1.SyncSchedule{Cron: "0 2 * * *"}}
+		require.NoError(t, f.SetFlag("sync-schedule", ""))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
@@ -0,0 +1,216 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+)
+
+const (
+	// DefaultAuthorName is the name used for hydrated commits when no other author is configured
+	DefaultAuthorName = "Argo CD"
+	// DefaultAuthorEmail is the email used for hydrated commits when no other author is configured
+	DefaultAuthorEmail = "argo-cd@example.com"
+)
+
+// ManifestsRequest is a request to commit hydrated manifests to a git repository.
+type ManifestsRequest struct {
+	// Repo contains the repository (and its credentials) to push to
+	Repo *v1alpha1.Repository
+	// SyncBranch is the branch the hydrated manifests are synced from. It is used as the base when TargetBranch does
+	// not exist yet.
+	SyncBranch string
+	// TargetBranch is the branch the hydrated manifests are committed to
+	TargetBranch string
+	// DrySHA is the commit SHA of the dry source the manifests were hydrated from
+	DrySHA string
+	// CommitMessage is the message of the hydrated commit
+	CommitMessage string
+	// Paths holds the hydrated manifests, grouped by the path they are committed to
+	Paths []*PathDetails
+}
+
+// PathDetails holds the hydrated manifests for a single path in the hydrated branch.
+type PathDetails struct {
+	// Path is the path relative to the repository root the manifests are written to
+	Path string
+	// Manifests is the list of hydrated manifests, each one as JSON
+	Manifests []string
+	// Commands is the list of commands which were used to hydrate the manifests
+	Commands []string
+}
+
+// ManifestsResponse is the response to a ManifestsRequest.
+type ManifestsResponse struct {
+	// HydratedSHA is the commit SHA of the hydrated commit
+	HydratedSHA string
+}
+
+// RepoClientFactory creates git clients for a repository checked out at a given path.
+type RepoClientFactory interface {
+	NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error)
+}
+
+type repoClientFactory struct {
+	gitCredsStore git.CredsStore
+}
+
+// NewRepoClientFactory returns a RepoClientFactory creating native git clients.
+func NewRepoClientFactory(gitCredsStore git.CredsStore) RepoClientFactory {
+	return &repoClientFactory{gitCredsStore: gitCredsStore}
+}
+
+// NewClient creates a new git client for the given repository.
+func (r *repoClientFactory) NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error) {
+	return git.NewClientExt(repo.Repo, rootPath, repo.GetGitCreds(r.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
+}
+
+// Service commits hydrated manifests to git repositories.
+type Service struct {
+	repoClientFactory RepoClientFactory
+	authorName        string
+	authorEmail       string
+}
+
+// NewService returns a new commit Service.
+func NewService(gitCredsStore git.CredsStore) *Service {
+	return &Service{
+		repoClientFactory: NewRepoClientFactory(gitCredsStore),
+		authorName:        DefaultAuthorName,
+		authorEmail:       DefaultAuthorEmail,
+	}
+}
+
+// CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
+// the target branch, clears the hydrated paths, writes the manifests to the repository, commits the changes, and
+// pushes the changes. It returns the hydrated revision SHA.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *ManifestsRequest) (*ManifestsResponse, error) {
+	if err := validateRequest(r); err != nil {
+		return nil, err
+	}
+
+	logCtx := log.WithFields(log.Fields{"repo": r.Repo.Repo, "branch": r.TargetBranch, "drySHA": r.DrySHA})
+
+	dirPath, err := os.MkdirTemp("", "_commit-service")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			logCtx.Errorf("failed to remove temp dir %s: %v", dirPath, err)
+		}
+	}()
+
+	gitClient, err := s.repoClientFactory.NewClient(r.Repo, dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create git client: %w", err)
+	}
+
+	logCtx.Debug("Initiating git repo")
+	if err := gitClient.Init(); err != nil {
+		return nil, fmt.Errorf("failed to init git client: %w", err)
+	}
+
+	logCtx.Debug("Fetching repo")
+	if err := gitClient.Fetch(""); err != nil {
+		return nil, fmt.Errorf("failed to fetch repo: %w", err)
+	}
+
+	logCtx.Debug("Setting author")
+	if out, err := gitClient.SetAuthor(s.authorName, s.authorEmail); err != nil {
+		return nil, fmt.Errorf("failed to set author: %s: %w", out, err)
+	}
+
+	logCtx.Debugf("Checking out sync branch %s", r.SyncBranch)
+	if out, err := gitClient.CheckoutOrOrphan(r.SyncBranch, false); err != nil {
+		return nil, fmt.Errorf("failed to checkout sync branch: %s: %w", out, err)
+	}
+
+	if r.TargetBranch != r.SyncBranch {
+		logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
+		if out, err := gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false); err != nil {
+			return nil, fmt.Errorf("failed to checkout target branch: %s: %w", out, err)
+		}
+	}
+
+	// The hydrated paths are owned by the hydrator, so we start from a blank slate for every commit. This guarantees
+	// that manifests which are no longer generated are removed from the paths. The other paths are left as they are,
+	// since they may be hydrated by another controller shard.
+	logCtx.Debug("Clearing hydrated paths")
+	if out, err := gitClient.RemoveContents(hydratedPaths(r.Paths)); err != nil {
+		return nil, fmt.Errorf("failed to clear repo: %s: %w", out, err)
+	}
+
+	logCtx.Debug("Writing manifests")
+	if err := WriteForPaths(gitClient.Root(), r.Repo.Repo, r.DrySHA, r.Paths); err != nil {
+		return nil, fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	logCtx.Debug("Committing and pushing changes")
+	if out, err := gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage); err != nil {
+		return nil, fmt.Errorf("failed to commit and push: %s: %w", out, err)
+	}
+
+	logCtx.Debug("Getting commit SHA")
+	sha, err := gitClient.CommitSHA()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	logCtx.Infof("Committed hydrated manifests as %s", sha)
+
+	return &ManifestsResponse{HydratedSHA: sha}, nil
+}
+
+// hydratedPaths returns the paths of the repository the hydration of the given paths writes to. The root path holds
+// the other hydrated paths, so only its own files are returned.
+func hydratedPaths(paths []*PathDetails) []string {
+	hydrated := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if p.Path == "" || p.Path == "." {
+			hydrated = append(hydrated, ManifestFileName, ReadmeFileName)
+			continue
+		}
+		hydrated = append(hydrated, p.Path)
+	}
+	return hydrated
+}
+
+func validateRequest(r *ManifestsRequest) error {
+	if r.Repo == nil {
+		return fmt.Errorf("repo is required")
+	}
+	if r.SyncBranch == "" {
+		return fmt.Errorf("sync branch is required")
+	}
+	if r.TargetBranch == "" {
+		return fmt.Errorf("target branch is required")
+	}
+	if r.DrySHA == "" {
+		return fmt.Errorf("dry SHA is required")
+	}
+	seen := make(map[string]bool)
+	for _, p := range r.Paths {
+		if !isHydratedPathValid(p.Path) {
+			return fmt.Errorf("path %q must be a relative path inside the repository", p.Path)
+		}
+		if seen[p.Path] {
+			return fmt.Errorf("path %q is used by more than one application", p.Path)
+		}
+		seen[p.Path] = true
+	}
+	return nil
+}
+
+// isHydratedPathValid returns true if the path points to the repository root or to a directory inside of it.
+func isHydratedPathValid(path string) bool {
+	if path == "" || path == "." {
+		return true
+	}
+	return files.Inbound(path, "/repo")
+}
@@ -0,0 +1,124 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v2/util/git/mocks"
+)
+
+type fakeRepoClientFactory struct {
+	client *gitmocks.Client
+}
+
+func (f *fakeRepoClientFactory) NewClient(_ *v1alpha1.Repository, rootPath string) (git.Client, error) {
+	f.client.On("Root").Return(rootPath).Maybe()
+	return f.client, nil
+}
+
+func newTestService(client *gitmocks.Client) *Service {
+	return &Service{
+		repoClientFactory: &fakeRepoClientFactory{client: client},
+		authorName:        DefaultAuthorName,
+		authorEmail:       DefaultAuthorEmail,
+	}
+}
+
+func validRequest() *ManifestsRequest {
+	return &ManifestsRequest{
+		Repo:          &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"},
+		SyncBranch:    "env/test",
+		TargetBranch:  "env/test",
+		DrySHA:        "abc123",
+		CommitMessage: "hydrate abc123",
+		Paths: []*PathDetails{{
+			Path:      "guestbook",
+			Manifests: []string{`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm"}}`},
+		}},
+	}
+}
+
+func Test_hydratedPaths(t *testing.T) {
+	assert.Equal(t, []string{"guestbook", ManifestFileName, ReadmeFileName}, hydratedPaths([]*PathDetails{{Path: "guestbook"}, {Path: "."}}))
+}
+
+func TestService_CommitHydratedManifests(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		service := newTestService(&gitmocks.Client{})
+
+		r := validRequest()
+		r.Repo = nil
+		_, err := service.CommitHydratedManifests(context.Background(), r)
+		require.ErrorContains(t, err, "repo is required")
+
+		r = validRequest()
+		r.Paths[0].Path = "../escape"
+		_, err = service.CommitHydratedManifests(context.Background(), r)
+		require.ErrorContains(t, err, "must be a relative path inside the repository")
+
+		r = validRequest()
+		r.Paths = append(r.Paths, &PathDetails{Path: "guestbook"})
+		_, err = service.CommitHydratedManifests(context.Background(), r)
+		require.ErrorContains(t, err, "is used by more than one application")
+	})
+
+	t.Run("sync branch is target branch", func(t *testing.T) {
+		client := &gitmocks.Client{}
+		client.On("Init").Return(nil).Once()
+		client.On("Fetch", "").Return(nil).Once()
+		client.On("SetAuthor", DefaultAuthorName, DefaultAuthorEmail).Return("", nil).Once()
+		client.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		client.On("RemoveContents", []string{"guestbook"}).Return("", nil).Once()
+		client.On("CommitAndPush", "env/test", "hydrate abc123").Return("", nil).Once()
+		client.On("CommitSHA").Return("def456", nil).Once()
+		service := newTestService(client)
+
+		resp, err := service.CommitHydratedManifests(context.Background(), validRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "def456", resp.HydratedSHA)
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "CheckoutOrNew", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("target branch differs from sync branch", func(t *testing.T) {
+		client := &gitmocks.Client{}
+		client.On("Init").Return(nil).Once()
+		client.On("Fetch", "").Return(nil).Once()
+		client.On("SetAuthor", DefaultAuthorName, DefaultAuthorEmail).Return("", nil).Once()
+		client.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		client.On("CheckoutOrNew", "env/test-next", "env/test", false).Return("", nil).Once()
+		client.On("RemoveContents", []string{"guestbook"}).Return("", nil).Once()
+		client.On("CommitAndPush", "env/test-next", "hydrate abc123").Return("", nil).Once()
+		client.On("CommitSHA").Return("def456", nil).Once()
+		service := newTestService(client)
+
+		r := validRequest()
+		r.TargetBranch = "env/test-next"
+		resp, err := service.CommitHydratedManifests(context.Background(), r)
+		require.NoError(t, err)
+		assert.Equal(t, "def456", resp.HydratedSHA)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("push fails", func(t *testing.T) {
+		client := &gitmocks.Client{}
+		client.On("Init").Return(nil).Once()
+		client.On("Fetch", "").Return(nil).Once()
+		client.On("SetAuthor", DefaultAuthorName, DefaultAuthorEmail).Return("", nil).Once()
+		client.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		client.On("RemoveContents", []string{"guestbook"}).Return("", nil).Once()
+		client.On("CommitAndPush", "env/test", "hydrate abc123").Return("", fmt.Errorf("permission denied")).Once()
+		service := newTestService(client)
+
+		_, err := service.CommitHydratedManifests(context.Background(), validRequest())
+		require.ErrorContains(t, err, "failed to commit and push")
+		require.ErrorContains(t, err, "permission denied")
+	})
+}
@@ -0,0 +1,130 @@
+package commit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ManifestFileName is the name of the file holding the hydrated manifests of a path
+	ManifestFileName = "manifest.yaml"
+	// MetadataFileName is the name of the file holding the hydration metadata
+	MetadataFileName = "hydrator.metadata"
+	// ReadmeFileName is the name of the README file generated for each hydrated path
+	ReadmeFileName = "README.md"
+)
+
+var manifestHydrationReadmeTemplate = template.Must(template.New("readme").Parse(`# Manifest Hydration
+
+To hydrate the manifests in this repository, run the following commands:
+
+` + "```shell" + `
+git clone {{ .RepoURL }}
+# cd into the cloned directory
+git checkout {{ .DrySHA }}
+{{ range $command := .Commands -}}
+{{ $command }}
+{{ end -}}` + "```" + `
+`))
+
+// hydratorMetadataFile is the contents of the metadata files written next to the hydrated manifests
+type hydratorMetadataFile struct {
+	RepoURL  string   `json:"repoURL"`
+	DrySHA   string   `json:"drySha"`
+	Commands []string `json:"commands,omitempty"`
+}
+
+// WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
+func WriteForPaths(rootPath string, repoURL string, drySha string, paths []*PathDetails) error {
+	// Write the top-level metadata file.
+	if err := writeMetadata(rootPath, "", hydratorMetadataFile{RepoURL: repoURL, DrySHA: drySha}); err != nil {
+		return fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
+	}
+
+	for _, p := range paths {
+		hydratePath := p.Path
+		if hydratePath == "." {
+			hydratePath = ""
+		}
+
+		fullHydratePath := filepath.Join(rootPath, hydratePath)
+		if err := os.MkdirAll(fullHydratePath, 0o755); err != nil {
+			return fmt.Errorf("failed to create path %q: %w", hydratePath, err)
+		}
+
+		if err := writeManifests(fullHydratePath, p.Manifests); err != nil {
+			return fmt.Errorf("failed to write manifests to path %q: %w", hydratePath, err)
+		}
+
+		metadata := hydratorMetadataFile{RepoURL: repoURL, DrySHA: drySha, Commands: p.Commands}
+		// The top-level metadata file was already written. Don't overwrite it with the path-level commands.
+		if hydratePath != "" {
+			if err := writeMetadata(fullHydratePath, hydratePath, metadata); err != nil {
+				return fmt.Errorf("failed to write hydrator metadata to path %q: %w", hydratePath, err)
+			}
+		}
+
+		if err := writeReadme(fullHydratePath, metadata); err != nil {
+			return fmt.Errorf("failed to write readme to path %q: %w", hydratePath, err)
+		}
+	}
+	return nil
+}
+
+// writeMetadata writes the metadata to the hydrator.metadata file.
+func writeMetadata(dirPath string, subPath string, metadata hydratorMetadataFile) error {
+	hydratorMetadataJson, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal hydrator metadata for %q: %w", subPath, err)
+	}
+	// No need to use SecureJoin here, as the path is already sanitized.
+	err = os.WriteFile(filepath.Join(dirPath, MetadataFileName), hydratorMetadataJson, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write hydrator metadata file: %w", err)
+	}
+	return nil
+}
+
+// writeReadme writes the readme to the README.md file.
+func writeReadme(dirPath string, metadata hydratorMetadataFile) error {
+	readmeFile, err := os.Create(filepath.Join(dirPath, ReadmeFileName))
+	if err != nil {
+		return fmt.Errorf("failed to create README file: %w", err)
+	}
+	defer func() { _ = readmeFile.Close() }()
+	if err := manifestHydrationReadmeTemplate.Execute(readmeFile, metadata); err != nil {
+		return fmt.Errorf("failed to execute readme template: %w", err)
+	}
+	return nil
+}
+
+// writeManifests writes the manifests to the manifest.yaml file as a multi-document YAML stream.
+func writeManifests(dirPath string, manifests []string) error {
+	docs := make([]string, 0, len(manifests))
+	for _, m := range manifests {
+		obj := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(m), &obj); err != nil {
+			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		doc, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		docs = append(docs, string(doc))
+	}
+	content := ""
+	if len(docs) > 0 {
+		content = "---\n" + strings.Join(docs, "---\n")
+	}
+	if err := os.WriteFile(filepath.Join(dirPath, ManifestFileName), []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package commit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteForPaths(t *testing.T) {
+	dir := t.TempDir()
+
+	repoURL := "https://github.com/example/repo"
+	drySha := "abc123"
+	paths := []*PathDetails{
+		{
+			Path:      "path1",
+			Manifests: []string{`{"kind":"Pod","apiVersion":"v1","metadata":{"name":"pod1"}}`},
+			Commands:  []string{"command1", "command2"},
+		},
+		{
+			Path: "path2/nested",
+			Manifests: []string{
+				`{"kind":"Service","apiVersion":"v1","metadata":{"name":"service1"}}`,
+				`{"kind":"Service","apiVersion":"v1","metadata":{"name":"service2"}}`,
+			},
+		},
+	}
+
+	err := WriteForPaths(dir, repoURL, drySha, paths)
+	require.NoError(t, err)
+
+	// Check if the top-level hydrator.metadata exists and contains the repo URL and dry SHA
+	topMetadataBytes, err := os.ReadFile(filepath.Join(dir, MetadataFileName))
+	require.NoError(t, err)
+	var topMetadata hydratorMetadataFile
+	require.NoError(t, json.Unmarshal(topMetadataBytes, &topMetadata))
+	assert.Equal(t, repoURL, topMetadata.RepoURL)
+	assert.Equal(t, drySha, topMetadata.DrySHA)
+	assert.Empty(t, topMetadata.Commands)
+
+	manifestBytes, err := os.ReadFile(filepath.Join(dir, "path1", ManifestFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "---\napiVersion: v1\nkind: Pod\nmetadata:\n  name: pod1\n", string(manifestBytes))
+
+	manifestBytes, err = os.ReadFile(filepath.Join(dir, "path2", "nested", ManifestFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "---\napiVersion: v1\nkind: Service\nmetadata:\n  name: service1\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: service2\n", string(manifestBytes))
+
+	metadataBytes, err := os.ReadFile(filepath.Join(dir, "path1", MetadataFileName))
+	require.NoError(t, err)
+	var metadata hydratorMetadataFile
+	require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+	assert.Equal(t, []string{"command1", "command2"}, metadata.Commands)
+
+	readmeBytes, err := os.ReadFile(filepath.Join(dir, "path1", ReadmeFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(readmeBytes), "git clone https://github.com/example/repo")
+	assert.Contains(t, string(readmeBytes), "git checkout abc123")
+	assert.Contains(t, string(readmeBytes), "command1\ncommand2\n")
+}
+
+func TestWriteForPaths_RootPath(t *testing.T) {
+	dir := t.TempDir()
+
+	err := WriteForPaths(dir, "https://github.com/example/repo", "abc123", []*PathDetails{{
+		Path:      ".",
+		Manifests: []string{`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm"}}`},
+	}})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(dir, ManifestFileName))
+	assert.FileExists(t, filepath.Join(dir, ReadmeFileName))
+	assert.FileExists(t, filepath.Join(dir, MetadataFileName))
+}
+
+func TestWriteForPaths_InvalidManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	err := WriteForPaths(dir, "https://github.com/example/repo", "abc123", []*PathDetails{{
+		Path:      "path1",
+		Manifests: []string{`not json`},
+	}})
+	require.ErrorContains(t, err, "failed to unmarshal manifest")
+}
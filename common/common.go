@@ -129,6 +129,12 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// LoadBasedShardingAlgorithm distributes the clusters across the shards based on their measured load, i.e. the
+	// number of watched resources, the reconciliation time and the rate of resource events. Each shard publishes the
+	// load of its clusters in the shard mapping ConfigMap. Clusters are only moved between shards when the load of a
+	// shard exceeds the average load by more than the configured tolerance.
+	LoadBasedShardingAlgorithm = "load-based"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -200,6 +206,24 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+
+	// AnnotationKeySyncGate defines a check which has to pass before a sync proceeds past the sync wave of the annotated resource.
+	// The value is a YAML document describing a Prometheus query, webhook or Lua predicate along with interval, timeout and failure policy.
+	AnnotationKeySyncGate = "argocd.argoproj.io/sync-gate"
+
+	// AnnotationKeyApplicationSharding set on a cluster secret distributes the Applications destined to the cluster
+	// across all the application controller shards instead of processing them by the shard of the cluster. Each shard
+	// then only watches the namespaces needed by its Applications. Supported values are "namespace" and "application".
+	AnnotationKeyApplicationSharding = "argocd.argoproj.io/application-sharding"
+	// ApplicationShardingByNamespace distributes the Applications across the shards by destination namespace
+	ApplicationShardingByNamespace = "namespace"
+	// ApplicationShardingByApplication distributes the Applications across the shards by Application name
+	ApplicationShardingByApplication = "application"
+
+	// AnnotationKeyDynamicNamespaceWatch set to "true" on a cluster secret restricts the resources watched in the
+	// cluster to the namespaces needed by the Applications destined to the cluster. Cluster level resources are only
+	// watched when an Application manages cluster level resources.
+	AnnotationKeyDynamicNamespaceWatch = "argocd.argoproj.io/dynamic-namespace-watch"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -244,6 +268,12 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingLoadTolerance is the percentage by which the load of a shard may exceed the average load
+	// before clusters are moved to other shards by the load-based sharding algorithm (default: 20)
+	EnvControllerShardingLoadTolerance = "ARGOCD_CONTROLLER_SHARDING_LOAD_TOLERANCE"
+	// EnvControllerShardingLoadPublishInterval is the interval at which each shard publishes the load of its clusters
+	// for the load-based sharding algorithm (default: 1m)
+	EnvControllerShardingLoadPublishInterval = "ARGOCD_CONTROLLER_SHARDING_LOAD_PUBLISH_INTERVAL"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -287,6 +317,9 @@ const (
 	// EnvServerSideDiff defines the env var used to enable ServerSide Diff feature.
 	// If defined, value must be "true" or "false".
 	EnvServerSideDiff = "ARGOCD_APPLICATION_CONTROLLER_SERVER_SIDE_DIFF"
+	// EnvHydratorEnabled defines the env var used to enable the source hydrator.
+	// If defined, value must be "true" or "false".
+	EnvHydratorEnabled = "ARGOCD_HYDRATOR_ENABLED"
 	// EnvGRPCMaxSizeMB is the environment variable to look for a max GRPC message size
 	EnvGRPCMaxSizeMB = "ARGOCD_GRPC_MAX_SIZE_MB"
 )
@@ -378,6 +411,16 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetPullRequest is an annotation that is added to the Applications generated for a pull request by a pull request generator with feedback enabled. It references the pull request the feedback is posted to.
+	AnnotationApplicationSetPullRequest = "argocd.argoproj.io/application-set-pull-request"
+	// AnnotationApplicationSetRollout is an annotation that is added when the RollingSync rollout of an ApplicationSet is requested to be promoted or aborted. The ApplicationSet controller will remove this annotation once it has handled the request.
+	AnnotationApplicationSetRollout = "argocd.argoproj.io/application-set-rollout"
+)
+
+// Actions which can be requested on the RollingSync rollout of an ApplicationSet with the AnnotationApplicationSetRollout annotation
+const (
+	ApplicationSetRolloutActionPromote = "promote"
+	ApplicationSetRolloutActionAbort   = "abort"
 )
 
 // gRPC settings
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// dependencyRecheckInterval is the interval at which the dependencies of a waiting application are checked again
+const dependencyRecheckInterval = 10 * time.Second
+
+// resolveAppDependencies returns the Applications the given application depends on. References to Applications which
+// do not exist (yet) are returned as descriptions in missing.
+func (ctrl *ApplicationController) resolveAppDependencies(app *appv1.Application) ([]*appv1.Application, []string, error) {
+	if app.Spec.DependsOn == nil {
+		return nil, nil, nil
+	}
+	var deps []*appv1.Application
+	var missing []string
+	seen := map[string]bool{}
+	add := func(dep *appv1.Application) {
+		key := dep.Namespace + "/" + dep.Name
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		deps = append(deps, dep)
+	}
+	for _, ref := range app.Spec.DependsOn.Applications {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = app.Namespace
+		}
+		switch {
+		case ref.Name != "" && ref.Selector != nil:
+			return nil, nil, fmt.Errorf("dependency %s/%s must not specify both name and selector", namespace, ref.Name)
+		case ref.Name != "":
+			dep, err := ctrl.appLister.Applications(namespace).Get(ref.Name)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					missing = append(missing, fmt.Sprintf("%s/%s (not found)", namespace, ref.Name))
+					continue
+				}
+				return nil, nil, fmt.Errorf("failed to get dependency %s/%s: %w", namespace, ref.Name, err)
+			}
+			add(dep)
+		case ref.Selector != nil:
+			selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid dependency selector: %w", err)
+			}
+			matches, err := ctrl.appLister.Applications(namespace).List(selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list dependencies matching %q: %w", selector.String(), err)
+			}
+			matched := 0
+			for _, dep := range matches {
+				// an application selecting itself is not a dependency
+				if dep.Namespace == app.Namespace && dep.Name == app.Name {
+					continue
+				}
+				add(dep)
+				matched++
+			}
+			if matched == 0 {
+				missing = append(missing, fmt.Sprintf("selector %q in namespace %s (no matching applications)", selector.String(), namespace))
+			}
+		default:
+			return nil, nil, fmt.Errorf("dependency in namespace %s must specify either name or selector", namespace)
+		}
+	}
+	return deps, missing, nil
+}
+
+// findDependencyCycle returns the chain of applications which leads from the given application back to itself, or nil
+// if the application is not part of a dependency cycle.
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) []string {
+	visited := map[string]bool{}
+	var visit func(current *appv1.Application, chain []string) []string
+	visit = func(current *appv1.Application, chain []string) []string {
+		// invalid dependencies of other applications are reported on those applications
+		deps, _, _ := ctrl.resolveAppDependencies(current)
+		for _, dep := range deps {
+			next := append(append([]string{}, chain...), dep.QualifiedName())
+			if dep.Namespace == app.Namespace && dep.Name == app.Name {
+				return next
+			}
+			key := dep.Namespace + "/" + dep.Name
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			if cycle := visit(dep, next); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(app, []string{app.QualifiedName()})
+}
+
+// checkAppDependencies verifies that all dependencies of the given application are Synced and Healthy. It returns nil
+// if the application may be synced, a DependencyPendingWarning condition if the sync has to wait, or a DependencyError
+// condition if the dependencies are invalid or did not become ready within the timeout since waitingSince.
+func (ctrl *ApplicationController) checkAppDependencies(app *appv1.Application, waitingSince time.Time) *appv1.ApplicationCondition {
+	if app.Spec.DependsOn == nil || len(app.Spec.DependsOn.Applications) == 0 {
+		return nil
+	}
+	timeout, err := app.Spec.DependsOn.GetTimeout()
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyError, Message: err.Error()}
+	}
+	deps, pending, err := ctrl.resolveAppDependencies(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyError, Message: err.Error()}
+	}
+	if cycle := ctrl.findDependencyCycle(app); cycle != nil {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionDependencyError,
+			Message: fmt.Sprintf("dependency cycle detected: %s", strings.Join(cycle, " -> ")),
+		}
+	}
+	for _, dep := range deps {
+		if dep.Status.Sync.Status != appv1.SyncStatusCodeSynced || dep.Status.Health.Status != health.HealthStatusHealthy {
+			pending = append(pending, fmt.Sprintf("%s (%s, %s)", dep.QualifiedName(), dep.Status.Sync.Status, dep.Status.Health.Status))
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// The wait start is carried over so that the timeout is not reset whenever the list of pending dependencies changes.
+	since := metav1.NewTime(waitingSince)
+	if time.Since(waitingSince) > timeout {
+		return &appv1.ApplicationCondition{
+			Type:               appv1.ApplicationConditionDependencyError,
+			Message:            fmt.Sprintf("timed out after %v waiting for dependencies to be Synced and Healthy: %s", timeout, strings.Join(pending, ", ")),
+			LastTransitionTime: &since,
+		}
+	}
+	return &appv1.ApplicationCondition{
+		Type:               appv1.ApplicationConditionDependencyPendingWarning,
+		Message:            fmt.Sprintf("waiting for dependencies to be Synced and Healthy: %s", strings.Join(pending, ", ")),
+		LastTransitionTime: &since,
+	}
+}
+
+// dependencyWaitStart returns the time since which the automated sync of the given application has been waiting for
+// its dependencies.
+func dependencyWaitStart(app *appv1.Application) time.Time {
+	start := time.Now()
+	for _, condition := range app.Status.Conditions {
+		if condition.Type != appv1.ApplicationConditionDependencyPendingWarning && condition.Type != appv1.ApplicationConditionDependencyError {
+			continue
+		}
+		if condition.LastTransitionTime != nil && condition.LastTransitionTime.Time.Before(start) {
+			start = condition.LastTransitionTime.Time
+		}
+	}
+	return start
+}
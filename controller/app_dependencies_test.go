@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Labels = map[string]string{"tier": name}
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	if len(dependsOn) > 0 {
+		app.Spec.DependsOn = &v1alpha1.ApplicationDependencies{}
+		for _, dep := range dependsOn {
+			app.Spec.DependsOn.Applications = append(app.Spec.DependsOn.Applications, v1alpha1.ApplicationDependency{Name: dep})
+		}
+	}
+	return app
+}
+
+func TestCheckAppDependencies(t *testing.T) {
+	t.Run("no dependencies", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.Nil(t, ctrl.checkAppDependencies(app, time.Now()))
+	})
+	t.Run("dependencies ready", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+		assert.Nil(t, ctrl.checkAppDependencies(app, time.Now()))
+	})
+	t.Run("dependency not healthy", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyPendingWarning, cond.Type)
+		assert.Equal(t, "waiting for dependencies to be Synced and Healthy: "+test.FakeArgoCDNamespace+"/crds (Synced, Progressing)", cond.Message)
+	})
+	t.Run("dependency not found", func(t *testing.T) {
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyPendingWarning, cond.Type)
+		assert.Contains(t, cond.Message, test.FakeArgoCDNamespace+"/crds (not found)")
+	})
+	t.Run("selector", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		operator := newFakeDependencyApp("operator", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		operator.Labels = crds.Labels
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		app.Labels = crds.Labels
+		app.Spec.DependsOn = &v1alpha1.ApplicationDependencies{Applications: []v1alpha1.ApplicationDependency{{
+			Selector: &metav1.LabelSelector{MatchLabels: crds.Labels},
+		}}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds, operator}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, "waiting for dependencies to be Synced and Healthy: "+test.FakeArgoCDNamespace+"/operator (OutOfSync, Healthy)", cond.Message)
+	})
+	t.Run("name and selector", func(t *testing.T) {
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		app.Spec.DependsOn = &v1alpha1.ApplicationDependencies{Applications: []v1alpha1.ApplicationDependency{{
+			Name:     "crds",
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "crds"}},
+		}}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyError, cond.Type)
+	})
+	t.Run("cycle", func(t *testing.T) {
+		a := newFakeDependencyApp("a", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "b")
+		b := newFakeDependencyApp("b", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "c")
+		c := newFakeDependencyApp("c", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "a")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{a, b, c}}, nil)
+		cond := ctrl.checkAppDependencies(a, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyError, cond.Type)
+		assert.Equal(t, "dependency cycle detected: fake-argocd-ns/a -> fake-argocd-ns/b -> fake-argocd-ns/c -> fake-argocd-ns/a", cond.Message)
+	})
+	t.Run("self", func(t *testing.T) {
+		app := newFakeDependencyApp("a", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "a")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now())
+		require.NotNil(t, cond)
+		assert.Equal(t, "dependency cycle detected: fake-argocd-ns/a -> fake-argocd-ns/a", cond.Message)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		app := newFakeDependencyApp("workload", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+		app.Spec.DependsOn.Timeout = "5m"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+		cond := ctrl.checkAppDependencies(app, time.Now().Add(-10*time.Minute))
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyError, cond.Type)
+		assert.Contains(t, cond.Message, "timed out after 5m0s")
+	})
+}
+
+func TestDependencyWaitStart(t *testing.T) {
+	app := newFakeApp()
+	assert.WithinDuration(t, time.Now(), dependencyWaitStart(app), time.Second)
+
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionDependencyPendingWarning, LastTransitionTime: &since}}
+	assert.Equal(t, since.Time, dependencyWaitStart(app))
+}
+
+func TestAutoSyncWaitsForDependencies(t *testing.T) {
+	crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	app := newFakeDependencyApp("my-app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	require.NotNil(t, cond)
+	assert.Equal(t, v1alpha1.ApplicationConditionDependencyPendingWarning, cond.Type)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestProcessRequestedAppOperation_WaitsForDependencies(t *testing.T) {
+	crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	app := newFakeDependencyApp("my-app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+
+	t.Run("waiting", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds, &defaultProj}}, nil)
+		ctrl.processRequestedAppOperation(app.DeepCopy())
+
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updated.Status.OperationState)
+		assert.Equal(t, synccommon.OperationRunning, updated.Status.OperationState.Phase)
+		assert.Contains(t, updated.Status.OperationState.Message, "waiting for dependencies")
+		require.Len(t, updated.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionDependencyPendingWarning: true}), 1)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		timedOut := app.DeepCopy()
+		timedOut.Spec.DependsOn.Timeout = "1m"
+		timedOut.Status.OperationState = &v1alpha1.OperationState{
+			Operation: *timedOut.Operation,
+			Phase:     synccommon.OperationRunning,
+			StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+		}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{timedOut, crds, &defaultProj}}, nil)
+		ctrl.processRequestedAppOperation(timedOut.DeepCopy())
+
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, synccommon.OperationFailed, updated.Status.OperationState.Phase)
+		assert.Contains(t, updated.Status.OperationState.Message, "timed out after 1m0s")
+	})
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// automatedRollbackInfoName is the name of the operation info which marks automated rollback operations
+const automatedRollbackInfoName = "Automated rollback"
+
+// isAutomatedRollback returns whether the given operation is an automated rollback
+func isAutomatedRollback(op *appv1.Operation) bool {
+	for _, info := range op.Info {
+		if info != nil && info.Name == automatedRollbackInfoName {
+			return true
+		}
+	}
+	return false
+}
+
+// isRolledBackRevision returns whether the given sync status targets the revision(s) of the most recent automated
+// rollback of the application
+func isRolledBackRevision(app *appv1.Application, syncStatus *appv1.SyncStatus) bool {
+	rollback := app.Status.AutomatedRollback
+	if rollback == nil {
+		return false
+	}
+	if app.Spec.HasMultipleSources() {
+		return len(rollback.Revisions) > 0 && reflect.DeepEqual(rollback.Revisions, syncStatus.Revisions)
+	}
+	return rollback.Revision != "" && rollback.Revision == syncStatus.Revision
+}
+
+// automatedRollbackCondition returns a warning condition if the application was automatically rolled back from the
+// revision it currently targets
+func automatedRollbackCondition(app *appv1.Application, syncStatus *appv1.SyncStatus) *appv1.ApplicationCondition {
+	if !isRolledBackRevision(app, syncStatus) {
+		return nil
+	}
+	rollback := app.Status.AutomatedRollback
+	return &appv1.ApplicationCondition{
+		Type:               appv1.ApplicationConditionAutomatedRollbackWarning,
+		Message:            fmt.Sprintf("Rolled back to history ID %d: %s. Automated sync to this revision is disabled.", rollback.HistoryID, rollback.Message),
+		LastTransitionTime: &rollback.RolledBackAt,
+	}
+}
+
+// autoRollback rolls the application back to the previous entry of its revision history if it became Degraded within
+// the rollback window after the most recent sync, or is still Progressing after the progress deadline. It returns
+// whether a rollback was initiated, and an error condition if the rollback was required but could not be initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus *appv1.HealthStatus) (*appv1.ApplicationCondition, bool) {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Rollback == nil {
+		return nil, false
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return nil, false
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Phase != synccommon.OperationSucceeded || opState.Operation.Sync == nil ||
+		opState.Operation.Sync.DryRun || opState.FinishedAt == nil {
+		return nil, false
+	}
+	// a rollback is never rolled back itself, otherwise a revision which was rolled back might be synced again
+	if isAutomatedRollback(&opState.Operation) {
+		return nil, false
+	}
+	logCtx := getAppLog(app)
+	policy := app.Spec.SyncPolicy.Rollback
+	window, err := policy.GetWindow()
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, false
+	}
+	deadline, err := policy.GetProgressDeadline()
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, false
+	}
+
+	elapsed := time.Since(opState.FinishedAt.Time)
+	if elapsed > window {
+		return nil, false
+	}
+	var reason string
+	switch healthStatus.Status {
+	case health.HealthStatusDegraded:
+		reason = fmt.Sprintf("application became Degraded %s after sync", elapsed.Round(time.Second))
+	case health.HealthStatusProgressing:
+		if elapsed < deadline {
+			// check again once the progress deadline is exceeded
+			retryAfter := deadline - elapsed
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &retryAfter)
+			return nil, false
+		}
+		reason = fmt.Sprintf("application still Progressing %s after sync", elapsed.Round(time.Second))
+	default:
+		return nil, false
+	}
+
+	history := app.Status.History
+	if len(history) < 2 {
+		message := fmt.Sprintf("Cannot roll back: %s, but there is no previous revision", reason)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, false
+	}
+	current := history[len(history)-1]
+	previous := history[len(history)-2]
+	if previous.Source.IsZero() && previous.Sources.IsZero() {
+		message := fmt.Sprintf("Cannot roll back to history ID %d: the revision history entry has no source", previous.ID)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, false
+	}
+
+	// Rollback is just a convenience around Sync
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     previous.Revision,
+			Revisions:    previous.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       &previous.Source,
+			Sources:      previous.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Info:        []*appv1.Info{{Name: automatedRollbackInfoName, Value: reason}},
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate automated rollback to history ID %d: %v", previous.ID, err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, false
+	}
+	ctrl.writeBackToInformer(updatedApp)
+	app.Operation = updatedApp.Operation
+
+	app.Status.AutomatedRollback = &appv1.AutomatedRollbackStatus{
+		Revision:     current.Revision,
+		Revisions:    current.Revisions,
+		HistoryID:    previous.ID,
+		RolledBackAt: metav1.Now(),
+		Message:      reason,
+	}
+	message := fmt.Sprintf("Initiated automated rollback to history ID %d: %s", previous.ID, reason)
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message, context.TODO())
+	logCtx.Info(message)
+	return nil, true
+}
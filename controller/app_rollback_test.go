@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeRollbackApp(syncedAgo time.Duration) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Rollback = &v1alpha1.SyncPolicyRollback{Window: "10m", ProgressDeadline: "5m"}
+	finishedAt := metav1.NewTime(time.Now().Add(-syncedAgo))
+	app.Status.OperationState.FinishedAt = &finishedAt
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: app.Spec.GetSource()},
+		{ID: 2, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Source: app.Spec.GetSource()},
+	}
+	return app
+}
+
+func getFakeApp(t *testing.T, ctrl *ApplicationController) *v1alpha1.Application {
+	t.Helper()
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	t.Run("degraded within window", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.Nil(t, cond)
+		assert.True(t, rolledBack)
+
+		updated := getFakeApp(t, ctrl)
+		require.NotNil(t, updated.Operation)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", updated.Operation.Sync.Revision)
+		assert.True(t, updated.Operation.InitiatedBy.Automated)
+		assert.True(t, isAutomatedRollback(updated.Operation))
+
+		require.NotNil(t, app.Status.AutomatedRollback)
+		assert.Equal(t, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", app.Status.AutomatedRollback.Revision)
+		assert.Equal(t, int64(1), app.Status.AutomatedRollback.HistoryID)
+		assert.Contains(t, app.Status.AutomatedRollback.Message, "application became Degraded")
+	})
+	t.Run("healthy", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.Nil(t, cond)
+		assert.False(t, rolledBack)
+		assert.Nil(t, getFakeApp(t, ctrl).Operation)
+	})
+	t.Run("degraded after window", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Hour)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+	})
+	t.Run("progressing within deadline", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing})
+		assert.False(t, rolledBack)
+	})
+	t.Run("progressing after deadline", func(t *testing.T) {
+		app := newFakeRollbackApp(6 * time.Minute)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing})
+		assert.True(t, rolledBack)
+		assert.Contains(t, app.Status.AutomatedRollback.Message, "application still Progressing")
+	})
+	t.Run("rollback is not rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		app.Status.OperationState.Operation.Info = []*v1alpha1.Info{{Name: automatedRollbackInfoName, Value: "degraded"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+	})
+	t.Run("no previous revision", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		app.Status.History = app.Status.History[1:]
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		app := newFakeRollbackApp(time.Minute)
+		app.Spec.SyncPolicy.Rollback.Window = "ten minutes"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Contains(t, cond.Message, "invalid rollback window")
+	})
+}
+
+func TestAutoSyncSkipsRolledBackRevision(t *testing.T) {
+	app := newFakeRollbackApp(time.Minute)
+	app.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{
+		Revision:     "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		HistoryID:    1,
+		RolledBackAt: metav1.Now(),
+		Message:      "application became Degraded 1m0s after sync",
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+	assert.Nil(t, cond)
+	assert.Nil(t, getFakeApp(t, ctrl).Operation)
+
+	rollbackCond := automatedRollbackCondition(app, &syncStatus)
+	require.NotNil(t, rollbackCond)
+	assert.Equal(t, v1alpha1.ApplicationConditionAutomatedRollbackWarning, rollbackCond.Type)
+
+	// a new revision is synced again
+	syncStatus.Revision = "cccccccccccccccccccccccccccccccccccccccc"
+	assert.Nil(t, automatedRollbackCondition(app, &syncStatus))
+	cond, _ = ctrl.autoSync(app, &syncStatus, resources, true)
+	assert.Nil(t, cond)
+	updated := getFakeApp(t, ctrl)
+	require.NotNil(t, updated.Operation)
+	assert.Equal(t, "cccccccccccccccccccccccccccccccccccccccc", updated.Operation.Sync.Revision)
+}
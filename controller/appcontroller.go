@@ -44,8 +44,11 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
+	"github.com/argoproj/argo-cd/v2/controller/healthplugin"
+	"github.com/argoproj/argo-cd/v2/controller/hydrator"
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	"github.com/argoproj/argo-cd/v2/controller/sharding"
+	"github.com/argoproj/argo-cd/v2/controller/syncgate"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -54,6 +57,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/stats"
@@ -145,6 +149,14 @@ type ApplicationController struct {
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
 	deploymentInformer                informerv1.DeploymentInformer
+
+	// hydrator and hydrationQueue are nil if the source hydrator is disabled
+	hydrator *hydrator.Hydrator
+	// queue contains source repo URL and hydrated branch of apps which need to be hydrated
+	hydrationQueue workqueue.TypedRateLimitingInterface[hydrator.HydrationQueueKey]
+
+	// healthPlugins assesses the health of resources which have a health plugin configured
+	healthPlugins *healthplugin.Manager
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -173,6 +185,7 @@ func NewApplicationController(
 	serverSideDiff bool,
 	dynamicClusterDistributionEnabled bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	commitService hydrator.CommitService,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -206,6 +219,10 @@ func NewApplicationController(
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 	}
+	if commitService != nil {
+		ctrl.hydrationQueue = workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[hydrator.HydrationQueueKey](), workqueue.TypedRateLimitingQueueConfig[hydrator.HydrationQueueKey]{Name: "app_hydration_queue"})
+		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitService)
+	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
 	}
@@ -290,8 +307,9 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	ctrl.healthPlugins = healthplugin.NewManager()
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking(), ctrl.healthPlugins)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, syncgate.NewEvaluator(), ctrl.healthPlugins)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -487,6 +505,30 @@ func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application,
 	return tree, nil
 }
 
+// setAppDrift tracks the fields of the managed resources which differ from their desired state across
+// reconciliations and persists them in the cache.
+func (ctrl *ApplicationController) setAppDrift(a *appv1.Application, comparisonResult *comparisonResult) error {
+	var observed []drift.FieldDrift
+	for _, res := range comparisonResult.managedResources {
+		if res.Hook || res.Live == nil || res.Target == nil || !res.Diff.Modified {
+			continue
+		}
+		fields, err := drift.DriftedFields(res.Live, res.Diff.NormalizedLive, res.Diff.PredictedLive)
+		if err != nil {
+			return fmt.Errorf("error getting drifted fields of %s/%s: %w", res.Kind, res.Name, err)
+		}
+		observed = append(observed, fields...)
+	}
+	var previous *drift.AppDrift
+	appDrift := &drift.AppDrift{}
+	if err := ctrl.cache.GetAppDrift(a.InstanceName(ctrl.namespace), appDrift); err == nil {
+		previous = appDrift
+	} else if !goerrors.Is(err, appstatecache.ErrCacheMiss) {
+		return fmt.Errorf("error getting app drift: %w", err)
+	}
+	return ctrl.cache.SetAppDrift(a.InstanceName(ctrl.namespace), previous.Track(observed, time.Now()))
+}
+
 // returns true of given resources exist in the namespace by default and not managed by the user
 func isKnownOrphanedResourceExclusion(key kube.ResourceKey, proj *appv1.AppProject) bool {
 	if key.Namespace == "default" && key.Group == "" && key.Kind == kube.ServiceKind && key.Name == "kubernetes" {
@@ -822,6 +864,14 @@ func (ctrl *ApplicationController) hideSecretData(app *appv1.Application, compar
 		item.PredictedLiveState = string(resDiff.PredictedLive)
 		item.NormalizedLiveState = string(resDiff.NormalizedLive)
 		item.Modified = resDiff.Modified
+		if resDiff.Modified && live != nil && target != nil && !res.Hook {
+			fieldChanges, err := drift.GetFieldChanges(live, resDiff.NormalizedLive, resDiff.PredictedLive)
+			if err != nil {
+				// the field changes are informative only, failing to compute them must not fail the reconciliation
+				log.Warnf("Failed to get field changes of %s/%s/%s: %v", res.Kind, res.Namespace, res.Name, err)
+			}
+			item.FieldChanges = fieldChanges
+		}
 
 		items[i] = &item
 	}
@@ -835,6 +885,9 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.appComparisonTypeRefreshQueue.ShutDown()
 	defer ctrl.appOperationQueue.ShutDown()
 	defer ctrl.projectRefreshQueue.ShutDown()
+	if ctrl.hydrationQueue != nil {
+		defer ctrl.hydrationQueue.ShutDown()
+	}
 
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache)
 	ctrl.RegisterClusterSecretUpdater(ctx)
@@ -870,6 +923,11 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
+	if ctrl.clusterSharding.UsesClusterLoads() {
+		publisher := sharding.NewClusterLoadPublisher(ctrl.kubeClientset, ctrl.settingsMgr.GetNamespace(), ctrl.clusterSharding, ctrl.stateCache, ctrl.metricsServer.GetClusterLoadStats, ctrl.stateCache.ReleaseUnmanagedClusters)
+		go publisher.Run(ctx)
+	}
+
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
 			for ctrl.processAppRefreshQueueItem() {
@@ -893,6 +951,13 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		for ctrl.processProjectQueueItem() {
 		}
 	}, time.Second, ctx.Done())
+
+	if ctrl.hydrator != nil {
+		go wait.Until(func() {
+			for ctrl.processHydrationQueueItem() {
+			}
+		}, time.Second, ctx.Done())
+	}
 	<-ctx.Done()
 }
 
@@ -1026,6 +1091,24 @@ func (ctrl *ApplicationController) processAppComparisonTypeQueueItem() (processN
 	return
 }
 
+func (ctrl *ApplicationController) processHydrationQueueItem() (processNext bool) {
+	hydrationKey, shutdown := ctrl.hydrationQueue.Get()
+	if shutdown {
+		processNext = false
+		return
+	}
+	processNext = true
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+		}
+		ctrl.hydrationQueue.Done(hydrationKey)
+	}()
+
+	ctrl.hydrator.ProcessHydrationQueueItem(hydrationKey)
+	return
+}
+
 func (ctrl *ApplicationController) processProjectQueueItem() (processNext bool) {
 	key, shutdown := ctrl.projectRefreshQueue.Get()
 	processNext = true
@@ -1243,7 +1326,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
-		done, err := ctrl.cleanupPostDeleteHooks(objsMap, config, logCtx)
+		done, err := ctrl.cleanupPostDeleteHooks(app, objsMap, config, logCtx)
 		if err != nil {
 			return err
 		}
@@ -1262,6 +1345,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
 			return err
 		}
+
+		if err := ctrl.cache.SetAppDrift(app.Name, nil); err != nil {
+			return err
+		}
 		ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
 	}
 
@@ -1370,9 +1457,29 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 	ts.AddCheckpoint("initial_operation_stage_ms")
 
+	var depCond *appv1.ApplicationCondition
+	if !terminating && state.Operation.Sync != nil {
+		depCond = ctrl.checkAppDependencies(app, state.StartedAt.Time)
+	}
+	ts.AddCheckpoint("dependencies_check_ms")
+
 	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
+	} else if depCond != nil {
+		ctrl.setAppCondition(app, *depCond)
+		if depCond.Type == appv1.ApplicationConditionDependencyError {
+			state.Phase = synccommon.OperationFailed
+			state.Message = depCond.Message
+		} else {
+			// keep the operation running and check the dependencies again later
+			logCtx.Info(depCond.Message)
+			state.Message = depCond.Message
+			ctrl.setOperationState(app, state)
+			retryAfter := dependencyRecheckInterval
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &retryAfter)
+			return
+		}
 	} else {
 		ctrl.appStateManager.SyncAppState(app, state)
 	}
@@ -1422,6 +1529,13 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 	}
 
+	if state.Phase == synccommon.OperationRunning {
+		// sync gates are not backed by any resource events, so the operation has to be resumed to check them again
+		if retryAfter, pending := syncgate.RecheckAfter(state.SyncResult); pending {
+			ctrl.requestAppRefresh(app.QualifiedName(), nil, &retryAfter)
+		}
+	}
+
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
@@ -1632,6 +1746,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 
+	if ctrl.hydrator != nil && app.Spec.SourceHydrator != nil {
+		ctrl.hydrator.ProcessAppHydrateQueueItem(origApp)
+		ts.AddCheckpoint("process_app_hydrate_ms")
+	}
+
 	var localManifests []string
 	if opState := app.Status.OperationState; opState != nil && opState.Operation.Sync != nil {
 		localManifests = opState.Operation.Sync.Manifests
@@ -1690,20 +1809,41 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
-		setOpMs = opMS
+	if err := ctrl.setAppDrift(app, compareResult); err != nil {
+		logCtx.Warnf("Failed to track app drift: %v", err)
+	}
+	ts.AddCheckpoint("set_app_drift_ms")
+
+	if !app.Spec.SyncPolicy.GetFreeze().IsActive() && project.Spec.SyncWindows.Matches(app).CanSync(false) {
+		syncErrCond, rolledBack := ctrl.autoRollback(app, compareResult.healthStatus)
+		if !rolledBack && syncErrCond == nil {
+			var opMS time.Duration
+			var scheduled bool
+			syncErrCond, opMS, scheduled = ctrl.scheduledSync(app, compareResult.syncStatus)
+			if !scheduled && syncErrCond == nil {
+				syncErrCond, opMS = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
+			}
+			setOpMs = opMS
+		}
+		evaluatedTypes := map[appv1.ApplicationConditionType]bool{
+			appv1.ApplicationConditionSyncError:                true,
+			appv1.ApplicationConditionAutomatedRollbackWarning: true,
+		}
+		if app.Operation == nil {
+			// dependency conditions of an operation in progress are maintained by processRequestedAppOperation
+			evaluatedTypes[appv1.ApplicationConditionDependencyPendingWarning] = true
+			evaluatedTypes[appv1.ApplicationConditionDependencyError] = true
+		}
+		conditions := []appv1.ApplicationCondition{}
 		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
-		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			conditions = append(conditions, *syncErrCond)
+		}
+		if rollbackCond := automatedRollbackCondition(app, compareResult.syncStatus); rollbackCond != nil {
+			conditions = append(conditions, *rollbackCond)
 		}
+		app.Status.SetConditions(conditions, evaluatedTypes)
+	} else if app.Spec.SyncPolicy.GetFreeze().IsActive() {
+		logCtx.Info("Sync prevented by maintenance freeze")
 	} else {
 		logCtx.Info("Sync prevented by sync window")
 	}
@@ -1751,7 +1891,8 @@ func currentSourceEqualsSyncedSource(app *appv1.Application) bool {
 	if app.Spec.HasMultipleSources() {
 		return app.Spec.Sources.Equals(app.Status.Sync.ComparedTo.Sources)
 	}
-	return app.Spec.Source.Equals(&app.Status.Sync.ComparedTo.Source)
+	source := app.Spec.GetSource()
+	return source.Equals(&app.Status.Sync.ComparedTo.Source)
 }
 
 // needRefreshAppStatus answers if application status needs to be refreshed.
@@ -1871,6 +2012,12 @@ func createMergePatch(orig, new interface{}) ([]byte, bool, error) {
 
 // persistAppStatus persists updates to application status. If no changes were made, it is a no-op
 func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) (patchMs time.Duration) {
+	return ctrl.persistAppStatusAndRemoveAnnotations(orig, newStatus, appv1.AnnotationKeyRefresh)
+}
+
+// persistAppStatusAndRemoveAnnotations persists updates to application status and removes the given annotations, which
+// request work the controller handled. If no changes were made, it is a no-op
+func (ctrl *ApplicationController) persistAppStatusAndRemoveAnnotations(orig *appv1.Application, newStatus *appv1.ApplicationStatus, annotationKeys ...string) (patchMs time.Duration) {
 	logCtx := getAppLog(orig)
 	if orig.Status.Sync.Status != newStatus.Sync.Status {
 		message := fmt.Sprintf("Updated sync status: %s -> %s", orig.Status.Sync.Status, newStatus.Sync.Status)
@@ -1886,7 +2033,9 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		for k, v := range orig.GetAnnotations() {
 			newAnnotations[k] = v
 		}
-		delete(newAnnotations, appv1.AnnotationKeyRefresh)
+		for _, key := range annotationKeys {
+			delete(newAnnotations, key)
+		}
 	}
 	patch, modified, err := createMergePatch(
 		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: orig.Status},
@@ -1968,6 +2117,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 
 	desiredCommitSHA := syncStatus.Revision
 	desiredCommitSHAsMS := syncStatus.Revisions
+	// The rolled back revision is reported by the AutomatedRollbackWarning condition
+	if isRolledBackRevision(app, syncStatus) {
+		logCtx.Infof("Skipping auto-sync: revision %s was automatically rolled back", desiredCommitSHA)
+		return nil, 0
+	}
 	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA, desiredCommitSHAsMS, app.Spec.HasMultipleSources(), revisionUpdated)
 	ts.AddCheckpoint("already_attempted_sync_ms")
 	op := appv1.Operation{
@@ -2028,6 +2182,14 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
+	if depCond := ctrl.checkAppDependencies(app, dependencyWaitStart(app)); depCond != nil {
+		logCtx.Infof("Skipping auto-sync: %s", depCond.Message)
+		retryAfter := dependencyRecheckInterval
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &retryAfter)
+		return depCond, 0
+	}
+	ts.AddCheckpoint("dependencies_check_ms")
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	ts.AddCheckpoint("get_applications_ms")
 	start := time.Now()
@@ -2101,7 +2263,7 @@ func alreadyAttemptedSync(app *appv1.Application, commitSHA string, commitSHAsMS
 	} else {
 		// Ignore differences in target revision, since we already just verified commitSHAs are equal,
 		// and we do not want to trigger auto-sync due to things like HEAD != master
-		specSource := app.Spec.Source.DeepCopy()
+		specSource := app.Spec.GetSource()
 		specSource.TargetRevision = ""
 		syncResSource := app.Status.OperationState.SyncResult.Source.DeepCopy()
 		syncResSource.TargetRevision = ""
@@ -2159,7 +2321,7 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(cluster)
+	return ctrl.clusterSharding.IsManagedApp(app, cluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2259,6 +2421,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				newApp, newOK := obj.(*appv1.Application)
 				if err == nil && newOK {
 					ctrl.clusterSharding.AddApp(newApp)
+					ctrl.stateCache.UpdateWatchedResources()
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
@@ -2293,6 +2456,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					ctrl.appOperationQueue.AddRateLimited(key)
 				}
 				ctrl.clusterSharding.UpdateApp(newApp)
+				if oldOK && newOK && sharding.HasWatchedResourcesUpdates(oldApp, newApp) {
+					ctrl.stateCache.UpdateWatchedResources()
+				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				if !ctrl.canProcessApp(obj) {
@@ -2308,6 +2474,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				delApp, delOK := obj.(*appv1.Application)
 				if err == nil && delOK {
 					ctrl.clusterSharding.DeleteApp(delApp)
+					ctrl.stateCache.UpdateWatchedResources()
 				}
 			},
 		},
@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/controller/sharding"
 
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +44,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	mockrepoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
@@ -164,6 +166,7 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 		false,
 		false,
 		normalizers.IgnoreNormalizerOpts{},
+		nil,
 	)
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -193,6 +196,7 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 	}
 	mockStateCache.On("GetNamespaceTopLevelResources", mock.Anything, mock.Anything).Return(response, nil)
 	mockStateCache.On("IterateResources", mock.Anything, mock.Anything).Return(nil)
+	mockStateCache.On("UpdateWatchedResources").Return()
 	mockStateCache.On("GetClusterCache", mock.Anything).Return(&clusterCacheMock, nil)
 	mockStateCache.On("IterateHierarchyV2", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		keys := args[1].([]kube.ResourceKey)
@@ -1268,6 +1272,85 @@ func TestGetResourceTree_HasOrphanedResources(t *testing.T) {
 	assert.Equal(t, []v1alpha1.ResourceNode{orphanedDeploy1, orphanedDeploy2}, tree.OrphanedNodes)
 }
 
+func TestSetAppDrift(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	live := test.NewDeployment()
+	live.SetNamespace("default")
+	replicas := func(count int64) []byte {
+		obj := live.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(obj.Object, count, "spec", "replicas"))
+		data, err := obj.MarshalJSON()
+		require.NoError(t, err)
+		return data
+	}
+	compareResult := &comparisonResult{managedResources: []managedResource{{
+		Live:   live,
+		Target: live,
+		Diff:   diff.DiffResult{Modified: true, NormalizedLive: replicas(3), PredictedLive: replicas(1)},
+	}}}
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, ctrl.setAppDrift(app, compareResult))
+	}
+	appDrift := &drift.AppDrift{}
+	require.NoError(t, ctrl.cache.GetAppDrift(app.InstanceName(ctrl.namespace), appDrift))
+	require.Len(t, appDrift.Fields, 1)
+	assert.Equal(t, "/spec/replicas", appDrift.Fields[0].JSONPointer)
+	assert.Equal(t, int64(2), appDrift.Fields[0].Reconciliations)
+
+	// the drift is reset once the resource is synced
+	compareResult.managedResources[0].Diff.Modified = false
+	require.NoError(t, ctrl.setAppDrift(app, compareResult))
+	appDrift = &drift.AppDrift{}
+	require.NoError(t, ctrl.cache.GetAppDrift(app.InstanceName(ctrl.namespace), appDrift))
+	assert.Empty(t, appDrift.Fields)
+}
+
+func TestHideSecretDataFieldChanges(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	live := test.NewDeployment()
+	live.SetNamespace("default")
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    "kube-controller-manager",
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		APIVersion: "apps/v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}})
+	replicas := func(count int64) []byte {
+		obj := live.DeepCopy()
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		require.NoError(t, unstructured.SetNestedField(obj.Object, count, "spec", "replicas"))
+		data, err := obj.MarshalJSON()
+		require.NoError(t, err)
+		return data
+	}
+	compareResult := &comparisonResult{managedResources: []managedResource{{
+		Group:  "apps",
+		Kind:   "Deployment",
+		Live:   live,
+		Target: live,
+		Diff:   diff.DiffResult{Modified: true, NormalizedLive: replicas(3), PredictedLive: replicas(1)},
+	}}}
+
+	items, err := ctrl.hideSecretData(app, compareResult)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, []v1alpha1.ResourceFieldChange{{
+		Path:      "/spec/replicas",
+		Manager:   "kube-controller-manager",
+		Operation: "Update",
+	}}, items[0].FieldChanges)
+
+	// the fields of resources which are in sync are not attributed
+	compareResult.managedResources[0].Diff.Modified = false
+	items, err = ctrl.hideSecretData(app, compareResult)
+	require.NoError(t, err)
+	assert.Empty(t, items[0].FieldChanges)
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}}, nil)
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
@@ -1949,6 +2032,7 @@ func TestGetAppHosts(t *testing.T) {
 		}})
 		return true
 	})).Return(nil)
+	mockStateCache.On("UpdateWatchedResources").Return().Maybe()
 	ctrl.stateCache = mockStateCache
 
 	hosts, err := ctrl.getAppHosts(app, []v1alpha1.ResourceNode{{
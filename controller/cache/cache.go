@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os/exec"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/v2/controller/healthplugin"
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	"github.com/argoproj/argo-cd/v2/controller/sharding"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
@@ -38,7 +40,6 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
-	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -139,6 +140,10 @@ type LiveStateCache interface {
 	GetClustersInfo() []clustercache.ClusterInfo
 	// Init must be executed before cache can be used
 	Init() error
+	// Stops watching the clusters which are not managed by the controller shard anymore
+	ReleaseUnmanagedClusters()
+	// Updates the resources watched in the clusters whose watched resources depend on their applications
+	UpdateWatchedResources()
 }
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
@@ -179,6 +184,7 @@ func NewLiveStateCache(
 	onObjectUpdated ObjectUpdatedHandler,
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
+	healthPlugins *healthplugin.Manager,
 ) LiveStateCache {
 	return &liveStateCache{
 		appInformer:      appInformer,
@@ -190,6 +196,7 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		healthPlugins:    healthPlugins,
 	}
 }
 
@@ -214,6 +221,7 @@ type liveStateCache struct {
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	healthPlugins        *healthplugin.Manager
 
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
@@ -242,7 +250,8 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 		return nil, err
 	}
 	clusterSettings := clustercache.Settings{
-		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
+		// health plugins are only called during the health assessment of applications, the cache reuses their results
+		ResourceHealthOverride: c.healthPlugins.CachedHealthOverride(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
 	}
 
@@ -480,6 +489,11 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
 	}
 
+	watchedResources, restricted := c.clusterSharding.GetWatchedResources(cluster.Server)
+	if restricted && watchedResources.IsEmpty() {
+		return nil, fmt.Errorf("no application processed by the controller shard is destined to cluster %s", cluster.Server)
+	}
+
 	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
 	if err != nil {
 		return nil, fmt.Errorf("error getting custom label: %w", err)
@@ -512,8 +526,6 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
@@ -548,7 +560,19 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetRespectRBAC(respectRBAC),
 	}
 
-	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
+	if restricted {
+		// the namespaces are watched by separate caches, so that they can be watched and released independently
+		clusterCache = newNamespacedClusterCache(watchedResources, func(namespaces []string, clusterResources bool) clustercache.ClusterCache {
+			if len(namespaces) == 0 {
+				return newClusterLevelCache(clusterCacheConfig, c.kubectl, slices.Clone(clusterCacheOpts)...)
+			}
+			opts := append(slices.Clone(clusterCacheOpts), clustercache.SetNamespaces(namespaces), clustercache.SetClusterResources(clusterResources))
+			return clustercache.NewClusterCache(clusterCacheConfig, opts...)
+		})
+	} else {
+		clusterCacheOpts = append(clusterCacheOpts, clustercache.SetNamespaces(cluster.Namespaces), clustercache.SetClusterResources(cluster.ClusterResources))
+		clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
+	}
 
 	_ = clusterCache.OnResourceUpdated(func(newRes *clustercache.Resource, oldRes *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) {
 		toNotify := make(map[string]bool)
@@ -692,11 +716,55 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster info for %q: %w", a.Spec.Destination.Server, err)
 	}
+	if err := c.watchTargetResources(a.Spec.Destination.Server, clusterInfo, targetObjs); err != nil {
+		return nil, err
+	}
 	return clusterInfo.GetManagedLiveObjs(targetObjs, func(r *clustercache.Resource) bool {
 		return resInfo(r).AppName == a.InstanceName(c.settingsMgr.GetNamespace())
 	})
 }
 
+// watchTargetResources starts watching the namespaces and the cluster level resources of the target resources which
+// are not watched yet if the watched resources of the cluster depend on its applications. The resources an
+// application is about to deploy are not known until it has been compared once. The new namespaces are listed when
+// the live objects of the application are retrieved, without synchronizing again the other namespaces.
+func (c *liveStateCache) watchTargetResources(server string, clusterCache clustercache.ClusterCache, targetObjs []*unstructured.Unstructured) error {
+	namespacedCache, ok := clusterCache.(*namespacedClusterCache)
+	if !ok {
+		return nil
+	}
+	watched := namespacedCache.getWatchedResources()
+	required := &sharding.WatchedResources{Namespaces: slices.Clone(watched.Namespaces), ClusterResources: watched.ClusterResources}
+	for _, obj := range targetObjs {
+		if ns := obj.GetNamespace(); ns == "" {
+			required.ClusterResources = true
+		} else if !slices.Contains(required.Namespaces, ns) {
+			required.Namespaces = append(required.Namespaces, ns)
+		}
+	}
+	slices.Sort(required.Namespaces)
+	if required.Equal(watched) {
+		return nil
+	}
+	cluster, err := c.db.GetCluster(context.Background(), server)
+	if err != nil {
+		return fmt.Errorf("error getting cluster: %w", err)
+	}
+	// the cluster configuration still restricts the resources which can be watched
+	if len(cluster.Namespaces) > 0 {
+		required.Namespaces = slices.DeleteFunc(required.Namespaces, func(ns string) bool {
+			return !slices.Contains(cluster.Namespaces, ns)
+		})
+		required.ClusterResources = required.ClusterResources && (watched.ClusterResources || cluster.ClusterResources)
+	}
+	if required.Equal(watched) {
+		return nil
+	}
+	log.Infof("Updating the resources watched in cluster %s: namespaces %v, cluster level resources %t", server, required.Namespaces, required.ClusterResources)
+	namespacedCache.SetWatchedResources(required)
+	return nil
+}
+
 func (c *liveStateCache) GetVersionsInfo(serverURL string) (string, []kube.APIResourceInfo, error) {
 	clusterInfo, err := c.getSyncedCluster(serverURL)
 	if err != nil {
@@ -778,7 +846,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 }
 
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	return c.clusterSharding.IsWatchedCluster(cluster)
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -811,7 +879,8 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	cluster, ok := c.clusters[newCluster.Server]
 	c.lock.Unlock()
 	if ok {
-		if !c.canHandleCluster(newCluster) {
+		// the cache is recreated with the right resources when the way they are selected changes
+		if !c.canHandleCluster(newCluster) || sharding.HasWatchedResourcesModeUpdates(oldCluster, newCluster) {
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
@@ -823,11 +892,17 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
 			updateSettings = append(updateSettings, clustercache.SetConfig(newCluster.RESTConfig()))
 		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
-		}
-		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
-			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+		if namespacedCache, ok := cluster.(*namespacedClusterCache); ok {
+			if watched, ok := c.clusterSharding.GetWatchedResources(newCluster.Server); ok && !watched.IsEmpty() && !watched.Equal(namespacedCache.getWatchedResources()) {
+				namespacedCache.SetWatchedResources(watched)
+			}
+		} else {
+			if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
+				updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+			}
+			if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
+				updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+			}
 		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
@@ -859,6 +934,54 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 	}
 }
 
+func (c *liveStateCache) ReleaseUnmanagedClusters() {
+	c.lock.RLock()
+	unmanaged := make(map[string]clustercache.ClusterCache)
+	for server, cluster := range c.clusters {
+		if !c.canHandleCluster(&appv1.Cluster{Server: server}) {
+			unmanaged[server] = cluster
+		}
+	}
+	c.lock.RUnlock()
+	for server, cluster := range unmanaged {
+		log.Infof("Releasing cluster %s which is not managed by this shard anymore", server)
+		cluster.Invalidate()
+		c.lock.Lock()
+		delete(c.clusters, server)
+		c.lock.Unlock()
+	}
+}
+
+func (c *liveStateCache) UpdateWatchedResources() {
+	c.lock.RLock()
+	clusters := make(map[string]clustercache.ClusterCache)
+	for server, cluster := range c.clusters {
+		clusters[server] = cluster
+	}
+	c.lock.RUnlock()
+	for server, cluster := range clusters {
+		watched, restricted := c.clusterSharding.GetWatchedResources(server)
+		namespacedCache, namespaced := cluster.(*namespacedClusterCache)
+		switch {
+		case restricted && watched.IsEmpty():
+			log.Infof("Releasing cluster %s as no application processed by this shard is destined to it anymore", server)
+		case restricted != namespaced:
+			// the cache is recreated with the right resources when the way they are selected changes
+			log.Infof("Releasing cluster %s as the way its watched resources are selected has changed", server)
+		case restricted && !watched.Equal(namespacedCache.getWatchedResources()):
+			log.Infof("Updating the resources watched in cluster %s: namespaces %v, cluster level resources %t", server, watched.Namespaces, watched.ClusterResources)
+			namespacedCache.SetWatchedResources(watched)
+			continue
+		default:
+			continue
+		}
+		cluster.Invalidate()
+		c.lock.Lock()
+		delete(c.clusters, server)
+		c.lock.Unlock()
+	}
+}
+
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	clusters := make(map[string]clustercache.ClusterCache)
 	c.lock.RLock()
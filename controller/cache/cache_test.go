@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -227,6 +228,169 @@ func TestHandleDeleteEvent_CacheDeadlock(t *testing.T) {
 	}
 }
 
+func TestReleaseUnmanagedClusters(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	clusterSharding := sharding.NewClusterSharding(db, 0, 2, common.LoadBasedShardingAlgorithm)
+	clusterSharding.Init(&appv1.ClusterList{Items: []appv1.Cluster{
+		{ID: "1", Server: "https://cluster1"},
+		{ID: "2", Server: "https://cluster2"},
+	}}, &appv1.ApplicationList{})
+	managedCache := &mocks.ClusterCache{}
+	unmanagedCache := &mocks.ClusterCache{}
+	unmanagedCache.On("Invalidate").Return().Once()
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://cluster1": managedCache,
+			"https://cluster2": unmanagedCache,
+		},
+		clusterSharding: clusterSharding,
+	}
+
+	clustersCache.ReleaseUnmanagedClusters()
+
+	assert.Equal(t, map[string]cache.ClusterCache{"https://cluster1": managedCache}, clustersCache.clusters)
+	unmanagedCache.AssertExpectations(t)
+	managedCache.AssertNotCalled(t, "Invalidate")
+}
+
+func TestUpdateWatchedResources(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := appv1.Cluster{
+		ID:          "1",
+		Server:      "https://cluster1",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: common.ApplicationShardingByApplication},
+	}
+	app := appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server, Namespace: "ns1"}},
+	}
+	// find the shard processing the application to watch the cluster from it
+	shard := sharding.GetApplicationShard(&app, common.ApplicationShardingByApplication, 2)
+	clusterSharding := sharding.NewClusterSharding(db, shard, 2, common.DefaultShardingAlgorithm)
+	clusterSharding.Init(&appv1.ClusterList{Items: []appv1.Cluster{cluster}}, &appv1.ApplicationList{Items: []appv1.Application{app}})
+
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1"}, ClusterResources: true}, caches.newCache)
+	clustersCache := liveStateCache{
+		clusters:        map[string]cache.ClusterCache{cluster.Server: clusterCache},
+		clusterSharding: clusterSharding,
+	}
+
+	// nothing to do if the watched resources have not changed
+	clustersCache.UpdateWatchedResources()
+	caches.get("ns1").AssertNumberOfCalls(t, "Invalidate", 0)
+
+	// only the new namespace is listed
+	app.Status.Resources = []appv1.ResourceStatus{{Kind: "ConfigMap", Namespace: "ns2", Name: "cm"}}
+	clusterSharding.UpdateApp(&app)
+	clustersCache.UpdateWatchedResources()
+	assert.Equal(t, &sharding.WatchedResources{Namespaces: []string{"ns1", "ns2"}, ClusterResources: true}, clusterCache.getWatchedResources())
+	caches.get("ns1").AssertNumberOfCalls(t, "Invalidate", 0)
+	require.NotNil(t, caches.get("ns2"))
+
+	// the cluster is released once the shard does not process any of its applications
+	clusterSharding.DeleteApp(&app)
+	clustersCache.UpdateWatchedResources()
+	assert.Empty(t, clustersCache.clusters)
+	caches.get("ns1").AssertCalled(t, "Invalidate")
+	caches.get("ns2").AssertCalled(t, "Invalidate")
+}
+
+func TestUpdateWatchedResources_DynamicNamespaceWatch(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := appv1.Cluster{
+		ID:          "1",
+		Server:      "https://cluster1",
+		Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "true"},
+	}
+	app := appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server, Namespace: "ns1"}},
+		Status:     appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{{Kind: "ClusterRole", Name: "role"}}},
+	}
+	clusterSharding := sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm)
+	clusterSharding.Init(&appv1.ClusterList{Items: []appv1.Cluster{cluster}}, &appv1.ApplicationList{Items: []appv1.Application{app}})
+
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1"}}, caches.newCache)
+	unrestrictedCache := &mocks.ClusterCache{}
+	unrestrictedCache.On("Invalidate").Return().Once()
+	clustersCache := liveStateCache{
+		clusters:        map[string]cache.ClusterCache{cluster.Server: clusterCache, "https://cluster2": unrestrictedCache},
+		clusterSharding: clusterSharding,
+	}
+
+	// cluster level resources are watched once an application manages them
+	clustersCache.UpdateWatchedResources()
+	assert.Equal(t, &sharding.WatchedResources{Namespaces: []string{"ns1"}, ClusterResources: true}, clusterCache.getWatchedResources())
+	caches.get("ns1").AssertCalled(t, "Invalidate", mock.Anything)
+	// the clusters whose watched resources do not depend on their applications are left untouched
+	unrestrictedCache.AssertNumberOfCalls(t, "Invalidate", 0)
+
+	// the cache is released if the watched resources of the cluster do not depend on its applications anymore
+	delete(cluster.Annotations, common.AnnotationKeyDynamicNamespaceWatch)
+	clusterSharding.Update(&cluster, &cluster)
+	clustersCache.UpdateWatchedResources()
+	assert.Equal(t, map[string]cache.ClusterCache{"https://cluster2": unrestrictedCache}, clustersCache.clusters)
+}
+
+func TestWatchTargetResources(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := &appv1.Cluster{Server: "https://cluster1", Namespaces: []string{"ns1", "ns2", "ns3"}}
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns2"}}, caches.newCache)
+	unrestrictedCache := &mocks.ClusterCache{}
+	clustersCache := liveStateCache{
+		db:       db,
+		clusters: map[string]cache.ClusterCache{cluster.Server: clusterCache, "https://other": unrestrictedCache},
+	}
+	newObj := func(namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(namespace)
+		return obj
+	}
+
+	// the resources of the clusters watching all their resources are not updated
+	require.NoError(t, clustersCache.watchTargetResources("https://other", unrestrictedCache, []*unstructured.Unstructured{newObj("ns1")}))
+	// the resources which are already watched or not allowed by the cluster are not updated
+	require.NoError(t, clustersCache.watchTargetResources(cluster.Server, clusterCache, []*unstructured.Unstructured{newObj("ns2"), newObj(""), newObj("ns4")}))
+	assert.Equal(t, []string{"ns2"}, caches.namespaces())
+
+	// only the caches of the new namespaces are created, they are synchronized in the background
+	require.NoError(t, clustersCache.watchTargetResources(cluster.Server, clusterCache, []*unstructured.Unstructured{newObj("ns3"), newObj("ns1"), newObj("ns4")}))
+	assert.Equal(t, &sharding.WatchedResources{Namespaces: []string{"ns1", "ns2", "ns3"}}, clusterCache.getWatchedResources())
+	assert.Equal(t, []string{"ns1", "ns2", "ns3"}, caches.namespaces())
+	caches.get("ns2").AssertNumberOfCalls(t, "Invalidate", 0)
+
+	// cluster level resources are watched if the cluster configuration allows them
+	cluster.ClusterResources = true
+	require.NoError(t, clustersCache.watchTargetResources(cluster.Server, clusterCache, []*unstructured.Unstructured{newObj("")}))
+	assert.Equal(t, &sharding.WatchedResources{Namespaces: []string{"ns1", "ns2", "ns3"}, ClusterResources: true}, clusterCache.getWatchedResources())
+	caches.get("ns1").AssertCalled(t, "Invalidate", mock.Anything)
+	caches.get("ns2").AssertNumberOfCalls(t, "Invalidate", 0)
+}
+
+func TestWatchTargetResources_ClusterLevelOnly(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := &appv1.Cluster{Server: "https://cluster1"}
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{}, ClusterResources: true}, caches.newCache)
+	clustersCache := liveStateCache{
+		db:       db,
+		clusters: map[string]cache.ClusterCache{cluster.Server: clusterCache},
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("ns1")
+
+	// the namespaces of the target resources are watched in addition to the cluster level resources
+	require.NoError(t, clustersCache.watchTargetResources(cluster.Server, clusterCache, []*unstructured.Unstructured{obj}))
+	assert.Equal(t, &sharding.WatchedResources{Namespaces: []string{"ns1"}, ClusterResources: true}, clusterCache.getWatchedResources())
+	assert.Equal(t, []string{"", "ns1"}, caches.namespaces())
+	caches.get("").AssertCalled(t, "Invalidate")
+}
+
 func TestIsRetryableError(t *testing.T) {
 	var (
 		tlsHandshakeTimeoutErr net.Error = netError("net/http: TLS handshake timeout")
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"slices"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// clusterLevelCache is the cache of the cluster level resources of a cluster, which is used when the applications
+// destined to the cluster do not manage any namespaced resource. The cluster cache watches all the namespaces if none
+// is given, so the namespaced resources are removed from the resources it discovers instead.
+type clusterLevelCache struct {
+	clustercache.ClusterCache
+}
+
+func newClusterLevelCache(config *rest.Config, kubectl kube.Kubectl, opts ...clustercache.UpdateSettingsFunc) *clusterLevelCache {
+	opts = append(opts,
+		clustercache.SetKubectl(&clusterLevelKubectl{Kubectl: kubectl}),
+		clustercache.SetNamespaces(nil),
+		clustercache.SetClusterResources(true))
+	return &clusterLevelCache{ClusterCache: clustercache.NewClusterCache(config, opts...)}
+}
+
+// IsNamespaced answers using all the resources of the cluster, since the cache does not know the scope of the
+// namespaced resources it does not watch
+func (c *clusterLevelCache) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	for _, res := range c.GetAPIResources() {
+		if res.GroupKind == gk {
+			return res.Meta.Namespaced, nil
+		}
+	}
+	return c.ClusterCache.IsNamespaced(gk)
+}
+
+// clusterLevelKubectl restricts the resources watched by a cluster cache to the cluster level resources
+type clusterLevelKubectl struct {
+	kube.Kubectl
+}
+
+// GetAPIResources removes the namespaced resources from the resources to watch, which the cluster cache discovers with
+// their preferred versions. All the resources of the cluster are still returned otherwise, so that the cache can list
+// them in its cluster info.
+func (k *clusterLevelKubectl) GetAPIResources(config *rest.Config, preferred bool, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	apiResources, err := k.Kubectl.GetAPIResources(config, preferred, resourceFilter)
+	if err != nil || !preferred {
+		return apiResources, err
+	}
+	return slices.DeleteFunc(slices.Clone(apiResources), func(res kube.APIResourceInfo) bool {
+		return res.Meta.Namespaced
+	}), nil
+}
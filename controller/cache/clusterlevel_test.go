@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	podsResource         = kube.APIResourceInfo{GroupKind: schema.GroupKind{Kind: "Pod"}, Meta: metav1.APIResource{Namespaced: true}}
+	clusterRolesResource = kube.APIResourceInfo{GroupKind: schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}}
+)
+
+func TestClusterLevelKubectl_GetAPIResources(t *testing.T) {
+	kubectl := &clusterLevelKubectl{Kubectl: &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{podsResource, clusterRolesResource}}}
+
+	// only the cluster level resources are watched
+	apiResources, err := kubectl.GetAPIResources(nil, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []kube.APIResourceInfo{clusterRolesResource}, apiResources)
+
+	// but all the resources of the cluster are known
+	apiResources, err = kubectl.GetAPIResources(nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []kube.APIResourceInfo{podsResource, clusterRolesResource}, apiResources)
+}
+
+func TestClusterLevelCache_IsNamespaced(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("GetAPIResources").Return([]kube.APIResourceInfo{podsResource, clusterRolesResource})
+	unknown := schema.GroupKind{Group: "example.com", Kind: "Unknown"}
+	clusterCache.On("IsNamespaced", unknown).Return(false, apierrors.NewNotFound(schema.GroupResource{Group: unknown.Group}, ""))
+	cache := &clusterLevelCache{ClusterCache: clusterCache}
+
+	namespaced, err := cache.IsNamespaced(podsResource.GroupKind)
+	require.NoError(t, err)
+	assert.True(t, namespaced)
+	namespaced, err = cache.IsNamespaced(clusterRolesResource.GroupKind)
+	require.NoError(t, err)
+	assert.False(t, namespaced)
+	_, err = cache.IsNamespaced(unknown)
+	assert.True(t, apierrors.IsNotFound(err))
+}
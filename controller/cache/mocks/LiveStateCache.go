@@ -272,6 +272,11 @@ func (_m *LiveStateCache) IterateResources(server string, callback func(*cache.R
 	return r0
 }
 
+// ReleaseUnmanagedClusters provides a mock function with given fields:
+func (_m *LiveStateCache) ReleaseUnmanagedClusters() {
+	_m.Called()
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *LiveStateCache) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -290,6 +295,11 @@ func (_m *LiveStateCache) Run(ctx context.Context) error {
 	return r0
 }
 
+// UpdateWatchedResources provides a mock function with given fields:
+func (_m *LiveStateCache) UpdateWatchedResources() {
+	_m.Called()
+}
+
 // NewLiveStateCache creates a new instance of LiveStateCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLiveStateCache(t interface {
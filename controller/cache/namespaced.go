@@ -0,0 +1,396 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+)
+
+// namespacedClusterCache is the cache of a cluster whose watched resources depend on the applications destined to it.
+// It is made of one cache per watched namespace, so that watching a new namespace only lists the resources of this
+// namespace and stopping to watch a namespace does not affect the other ones. The cluster level resources are watched
+// by the cache of the first namespace, or by a cache of the cluster level resources only if no namespace is watched.
+type namespacedClusterCache struct {
+	// newCache creates the cache of the given namespaces, only the cluster level resources are watched if none is given
+	newCache func(namespaces []string, clusterResources bool) clustercache.ClusterCache
+
+	lock    sync.RWMutex
+	watched *sharding.WatchedResources
+	// caches contains the caches indexed by namespace, or a single cache of the cluster level resources with an empty
+	// key if no namespace is watched
+	caches map[string]*namespaceCache
+
+	handlersLock            sync.Mutex
+	handlerKey              uint64
+	resourceUpdatedHandlers map[uint64]clustercache.OnResourceUpdatedHandler
+	eventHandlers           map[uint64]clustercache.OnEventHandler
+}
+
+type namespaceCache struct {
+	clustercache.ClusterCache
+	namespace string
+	// clusterResources indicates whether the cache watches the cluster level resources
+	clusterResources bool
+	// ready is closed once the cache has been synchronized in the background, it is nil if the cache is synchronized
+	// on demand
+	ready chan struct{}
+}
+
+var _ clustercache.ClusterCache = &namespacedClusterCache{}
+
+func newNamespacedClusterCache(watched *sharding.WatchedResources, newCache func(namespaces []string, clusterResources bool) clustercache.ClusterCache) *namespacedClusterCache {
+	c := &namespacedClusterCache{
+		newCache:                newCache,
+		watched:                 watched,
+		caches:                  make(map[string]*namespaceCache),
+		resourceUpdatedHandlers: make(map[uint64]clustercache.OnResourceUpdatedHandler),
+		eventHandlers:           make(map[uint64]clustercache.OnEventHandler),
+	}
+	for i, namespace := range watchedNamespaces(watched) {
+		c.caches[namespace] = c.newNamespaceCache(namespace, watched.ClusterResources && i == 0)
+	}
+	return c
+}
+
+// watchedNamespaces returns the keys of the caches of the watched resources
+func watchedNamespaces(watched *sharding.WatchedResources) []string {
+	if len(watched.Namespaces) == 0 {
+		return []string{""}
+	}
+	return watched.Namespaces
+}
+
+func (c *namespacedClusterCache) newNamespaceCache(namespace string, clusterResources bool) *namespaceCache {
+	var namespaces []string
+	if namespace != "" {
+		namespaces = []string{namespace}
+	}
+	cache := &namespaceCache{
+		ClusterCache:     c.newCache(namespaces, clusterResources),
+		namespace:        namespace,
+		clusterResources: clusterResources,
+	}
+	_ = cache.OnResourceUpdated(func(newRes *clustercache.Resource, oldRes *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) {
+		for _, h := range c.getResourceUpdatedHandlers() {
+			h(newRes, oldRes, namespaceResources)
+		}
+	})
+	_ = cache.OnEvent(func(event watch.EventType, un *unstructured.Unstructured) {
+		for _, h := range c.getEventHandlers() {
+			h(event, un)
+		}
+	})
+	return cache
+}
+
+// warmUp synchronizes the cache in the background. A write lock of the cluster cache should be acquired before calling
+// warmUp.
+func (c *namespaceCache) warmUp() {
+	ready := make(chan struct{})
+	c.ready = ready
+	go func() {
+		_ = c.EnsureSynced()
+		close(ready)
+	}()
+}
+
+// isWarmingUp returns true if the cache is being synchronized in the background. A read lock of the cluster cache
+// should be acquired before calling isWarmingUp.
+func (c *namespaceCache) isWarmingUp() bool {
+	if c.ready == nil {
+		return false
+	}
+	select {
+	case <-c.ready:
+		return false
+	default:
+		return true
+	}
+}
+
+// getWatchedResources returns the resources watched in the cluster
+func (c *namespacedClusterCache) getWatchedResources() *sharding.WatchedResources {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.watched
+}
+
+// SetWatchedResources updates the resources watched in the cluster. Only the caches of the namespaces which were not
+// watched yet are created, and they are synchronized in the background. The caches of the namespaces which are not
+// watched anymore are invalidated.
+func (c *namespacedClusterCache) SetWatchedResources(watched *sharding.WatchedResources) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	caches := make(map[string]*namespaceCache)
+	for i, namespace := range watchedNamespaces(watched) {
+		clusterResources := watched.ClusterResources && i == 0
+		cache, ok := c.caches[namespace]
+		if !ok {
+			cache = c.newNamespaceCache(namespace, clusterResources)
+			cache.warmUp()
+		} else if cache.clusterResources != clusterResources {
+			cache.Invalidate(clustercache.SetClusterResources(clusterResources))
+			cache.clusterResources = clusterResources
+			cache.warmUp()
+		}
+		caches[namespace] = cache
+		delete(c.caches, namespace)
+	}
+	for _, cache := range c.caches {
+		cache.Invalidate()
+	}
+	c.caches = caches
+	c.watched = watched
+}
+
+// getCaches returns the caches sorted by namespace, except the ones being synchronized in the background if ready is
+// true
+func (c *namespacedClusterCache) getCaches(ready bool) []*namespaceCache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	caches := make([]*namespaceCache, 0, len(c.caches))
+	for _, cache := range c.caches {
+		if !ready || !cache.isWarmingUp() {
+			caches = append(caches, cache)
+		}
+	}
+	slices.SortFunc(caches, func(a, b *namespaceCache) int {
+		return strings.Compare(a.namespace, b.namespace)
+	})
+	return caches
+}
+
+// getCache returns the cache holding the resources of the given namespace, or the cluster level resources if the
+// namespace is empty
+func (c *namespacedClusterCache) getCache(namespace string) *namespaceCache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if namespace != "" {
+		return c.caches[namespace]
+	}
+	for _, cache := range c.caches {
+		if cache.clusterResources {
+			return cache
+		}
+	}
+	return nil
+}
+
+// EnsureSynced synchronizes the caches of the namespaces, except the ones being synchronized in the background so that
+// the applications which do not need them are not blocked.
+func (c *namespacedClusterCache) EnsureSynced() error {
+	for _, cache := range c.getCaches(true) {
+		if err := cache.EnsureSynced(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *namespacedClusterCache) getReadyCache() *namespaceCache {
+	caches := c.getCaches(true)
+	if len(caches) == 0 {
+		return nil
+	}
+	return caches[0]
+}
+
+func (c *namespacedClusterCache) GetServerVersion() string {
+	if cache := c.getReadyCache(); cache != nil {
+		return cache.GetServerVersion()
+	}
+	return ""
+}
+
+func (c *namespacedClusterCache) GetAPIResources() []kube.APIResourceInfo {
+	if cache := c.getReadyCache(); cache != nil {
+		return cache.GetAPIResources()
+	}
+	return nil
+}
+
+func (c *namespacedClusterCache) GetOpenAPISchema() openapi.Resources {
+	if cache := c.getReadyCache(); cache != nil {
+		return cache.GetOpenAPISchema()
+	}
+	return nil
+}
+
+func (c *namespacedClusterCache) GetGVKParser() *managedfields.GvkParser {
+	if cache := c.getReadyCache(); cache != nil {
+		return cache.GetGVKParser()
+	}
+	return nil
+}
+
+// Invalidate invalidates the caches of all the namespaces. The settings must not update the watched namespaces nor the
+// cluster level resources, which are updated by SetWatchedResources.
+func (c *namespacedClusterCache) Invalidate(opts ...clustercache.UpdateSettingsFunc) {
+	for _, cache := range c.getCaches(false) {
+		cache.Invalidate(opts...)
+	}
+}
+
+func (c *namespacedClusterCache) FindResources(namespace string, predicates ...func(r *clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+	if namespace != "" {
+		if cache := c.getCache(namespace); cache != nil {
+			return cache.FindResources(namespace, predicates...)
+		}
+		return map[kube.ResourceKey]*clustercache.Resource{}
+	}
+	res := make(map[kube.ResourceKey]*clustercache.Resource)
+	for _, cache := range c.getCaches(true) {
+		for k, r := range cache.FindResources("", predicates...) {
+			res[k] = r
+		}
+	}
+	return res
+}
+
+func (c *namespacedClusterCache) IterateHierarchy(key kube.ResourceKey, action func(resource *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) bool) {
+	if cache := c.getCache(key.Namespace); cache != nil {
+		cache.IterateHierarchy(key, action)
+	}
+}
+
+func (c *namespacedClusterCache) IterateHierarchyV2(keys []kube.ResourceKey, action func(resource *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) bool) {
+	keysPerCache := make(map[*namespaceCache][]kube.ResourceKey)
+	for _, key := range keys {
+		if cache := c.getCache(key.Namespace); cache != nil {
+			keysPerCache[cache] = append(keysPerCache[cache], key)
+		}
+	}
+	for cache, cacheKeys := range keysPerCache {
+		cache.IterateHierarchyV2(cacheKeys, action)
+	}
+}
+
+func (c *namespacedClusterCache) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	for _, cache := range c.getCaches(true) {
+		if namespaced, err := cache.IsNamespaced(gk); err == nil {
+			return namespaced, nil
+		}
+	}
+	return false, kerrors.NewNotFound(schema.GroupResource{Group: gk.Group}, "")
+}
+
+// GetManagedLiveObjs returns the managed resources of all the namespaces. The caches holding the target resources are
+// synchronized first if they are being synchronized in the background.
+func (c *namespacedClusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructured, isManaged func(r *clustercache.Resource) bool) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	targetsPerCache := make(map[*namespaceCache][]*unstructured.Unstructured)
+	for _, o := range targetObjs {
+		cache := c.getCache(o.GetNamespace())
+		if cache == nil {
+			if o.GetNamespace() == "" {
+				return nil, fmt.Errorf("cluster level %s %q can not be managed when in namespaced mode", o.GetKind(), o.GetName())
+			}
+			return nil, fmt.Errorf("namespace %q for %s %q is not managed", o.GetNamespace(), o.GetKind(), o.GetName())
+		}
+		targetsPerCache[cache] = append(targetsPerCache[cache], o)
+	}
+	managedObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, cache := range c.getCaches(false) {
+		targets, ok := targetsPerCache[cache]
+		if !ok {
+			c.lock.RLock()
+			warmingUp := cache.isWarmingUp()
+			c.lock.RUnlock()
+			if warmingUp {
+				continue
+			}
+		}
+		if err := cache.EnsureSynced(); err != nil {
+			return nil, fmt.Errorf("error synchronizing cache state of namespace %q: %w", cache.namespace, err)
+		}
+		objs, err := cache.GetManagedLiveObjs(targets, isManaged)
+		if err != nil {
+			return nil, err
+		}
+		for k, o := range objs {
+			managedObjs[k] = o
+		}
+	}
+	return managedObjs, nil
+}
+
+// GetClusterInfo returns the statistics of all the caches, the last synchronization time being the oldest one
+func (c *namespacedClusterCache) GetClusterInfo() clustercache.ClusterInfo {
+	var info clustercache.ClusterInfo
+	for i, cache := range c.getCaches(false) {
+		cacheInfo := cache.GetClusterInfo()
+		if i == 0 {
+			info = cacheInfo
+			continue
+		}
+		info.APIsCount = max(info.APIsCount, cacheInfo.APIsCount)
+		info.ResourcesCount += cacheInfo.ResourcesCount
+		if cacheInfo.LastCacheSyncTime == nil || (info.LastCacheSyncTime != nil && cacheInfo.LastCacheSyncTime.Before(*info.LastCacheSyncTime)) {
+			info.LastCacheSyncTime = cacheInfo.LastCacheSyncTime
+		}
+		if info.SyncError == nil {
+			info.SyncError = cacheInfo.SyncError
+		}
+		if info.K8SVersion == "" {
+			info.K8SVersion, info.APIResources = cacheInfo.K8SVersion, cacheInfo.APIResources
+		}
+	}
+	return info
+}
+
+func (c *namespacedClusterCache) OnResourceUpdated(handler clustercache.OnResourceUpdatedHandler) clustercache.Unsubscribe {
+	c.handlersLock.Lock()
+	defer c.handlersLock.Unlock()
+	key := c.handlerKey
+	c.handlerKey++
+	c.resourceUpdatedHandlers[key] = handler
+	return func() {
+		c.handlersLock.Lock()
+		defer c.handlersLock.Unlock()
+		delete(c.resourceUpdatedHandlers, key)
+	}
+}
+
+func (c *namespacedClusterCache) getResourceUpdatedHandlers() []clustercache.OnResourceUpdatedHandler {
+	c.handlersLock.Lock()
+	defer c.handlersLock.Unlock()
+	handlers := make([]clustercache.OnResourceUpdatedHandler, 0, len(c.resourceUpdatedHandlers))
+	for _, h := range c.resourceUpdatedHandlers {
+		handlers = append(handlers, h)
+	}
+	return handlers
+}
+
+func (c *namespacedClusterCache) OnEvent(handler clustercache.OnEventHandler) clustercache.Unsubscribe {
+	c.handlersLock.Lock()
+	defer c.handlersLock.Unlock()
+	key := c.handlerKey
+	c.handlerKey++
+	c.eventHandlers[key] = handler
+	return func() {
+		c.handlersLock.Lock()
+		defer c.handlersLock.Unlock()
+		delete(c.eventHandlers, key)
+	}
+}
+
+func (c *namespacedClusterCache) getEventHandlers() []clustercache.OnEventHandler {
+	c.handlersLock.Lock()
+	defer c.handlersLock.Unlock()
+	handlers := make([]clustercache.OnEventHandler, 0, len(c.eventHandlers))
+	for _, h := range c.eventHandlers {
+		handlers = append(handlers, h)
+	}
+	return handlers
+}
@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+)
+
+// namespaceCacheMocks creates the caches of the namespaces of a namespaced cluster cache
+type namespaceCacheMocks struct {
+	lock   sync.Mutex
+	caches map[string]*mocks.ClusterCache
+	// eventHandlers contains the event handlers registered on the caches
+	eventHandlers map[string]cache.OnEventHandler
+	// ensureSynced is called when a cache is synchronized, if set
+	ensureSynced func(namespace string)
+}
+
+func newNamespaceCacheMocks() *namespaceCacheMocks {
+	return &namespaceCacheMocks{caches: make(map[string]*mocks.ClusterCache), eventHandlers: make(map[string]cache.OnEventHandler)}
+}
+
+func (m *namespaceCacheMocks) newCache(namespaces []string, _ bool) cache.ClusterCache {
+	namespace := strings.Join(namespaces, ",")
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("OnResourceUpdated", mock.Anything).Return(nil)
+	clusterCache.On("OnEvent", mock.Anything).Run(func(args mock.Arguments) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.eventHandlers[namespace] = args.Get(0).(cache.OnEventHandler)
+	}).Return(nil)
+	clusterCache.On("EnsureSynced").Run(func(_ mock.Arguments) {
+		if m.ensureSynced != nil {
+			m.ensureSynced(namespace)
+		}
+	}).Return(nil).Maybe()
+	clusterCache.On("Invalidate").Return().Maybe()
+	clusterCache.On("Invalidate", mock.Anything).Return().Maybe()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.caches[namespace] = clusterCache
+	return clusterCache
+}
+
+func (m *namespaceCacheMocks) get(namespace string) *mocks.ClusterCache {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.caches[namespace]
+}
+
+func (m *namespaceCacheMocks) getEventHandler(namespace string) cache.OnEventHandler {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.eventHandlers[namespace]
+}
+
+func (m *namespaceCacheMocks) namespaces() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var namespaces []string
+	for namespace := range m.caches {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func TestNamespacedClusterCache_SetWatchedResources(t *testing.T) {
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1", "ns2"}, ClusterResources: true}, caches.newCache)
+	require.NoError(t, clusterCache.EnsureSynced())
+	assert.True(t, clusterCache.caches["ns1"].clusterResources)
+	assert.False(t, clusterCache.caches["ns2"].clusterResources)
+
+	// the cluster level resources move to the cache of the first namespace
+	clusterCache.SetWatchedResources(&sharding.WatchedResources{Namespaces: []string{"ns2", "ns3"}, ClusterResources: true})
+	assert.Equal(t, []string{"ns1", "ns2", "ns3"}, caches.namespaces())
+	caches.get("ns1").AssertCalled(t, "Invalidate")
+	caches.get("ns2").AssertCalled(t, "Invalidate", mock.Anything)
+	assert.True(t, clusterCache.caches["ns2"].clusterResources)
+	assert.False(t, clusterCache.caches["ns3"].clusterResources)
+	assert.NotContains(t, clusterCache.caches, "ns1")
+
+	// only the cluster level resources are watched by a single cache
+	clusterCache.SetWatchedResources(&sharding.WatchedResources{Namespaces: []string{}, ClusterResources: true})
+	assert.Equal(t, []string{"", "ns1", "ns2", "ns3"}, caches.namespaces())
+	assert.Len(t, clusterCache.caches, 1)
+	assert.True(t, clusterCache.caches[""].clusterResources)
+	assert.Nil(t, clusterCache.getCache("ns4"))
+	assert.Equal(t, clusterCache.caches[""], clusterCache.getCache(""))
+}
+
+func TestNamespacedClusterCache_EnsureSynced(t *testing.T) {
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1"}}, caches.newCache)
+
+	synced := make(chan struct{})
+	caches.ensureSynced = func(namespace string) {
+		if namespace == "ns2" {
+			<-synced
+		}
+	}
+	clusterCache.SetWatchedResources(&sharding.WatchedResources{Namespaces: []string{"ns1", "ns2"}})
+
+	// the namespaces being synchronized in the background do not block the other applications
+	require.NoError(t, clusterCache.EnsureSynced())
+	caches.get("ns1").AssertNumberOfCalls(t, "EnsureSynced", 1)
+	caches.get("ns1").On("GetManagedLiveObjs", []*unstructured.Unstructured(nil), mock.Anything).Return(map[kube.ResourceKey]*unstructured.Unstructured{}, nil)
+	_, err := clusterCache.GetManagedLiveObjs(nil, func(_ *cache.Resource) bool { return true })
+	require.NoError(t, err)
+
+	close(synced)
+	assert.Eventually(t, func() bool {
+		return len(clusterCache.getCaches(true)) == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNamespacedClusterCache_GetManagedLiveObjs(t *testing.T) {
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1", "ns2"}}, caches.newCache)
+	newObj := func(namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetKind("ConfigMap")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	obj1, obj2 := newObj("ns1", "cm1"), newObj("ns2", "cm2")
+	caches.get("ns1").On("GetManagedLiveObjs", []*unstructured.Unstructured{obj1}, mock.Anything).Return(map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(obj1): obj1}, nil)
+	caches.get("ns2").On("GetManagedLiveObjs", []*unstructured.Unstructured(nil), mock.Anything).Return(map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(obj2): obj2}, nil)
+
+	objs, err := clusterCache.GetManagedLiveObjs([]*unstructured.Unstructured{obj1}, func(_ *cache.Resource) bool { return true })
+	require.NoError(t, err)
+	assert.Equal(t, map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(obj1): obj1, kube.GetResourceKey(obj2): obj2}, objs)
+
+	_, err = clusterCache.GetManagedLiveObjs([]*unstructured.Unstructured{newObj("ns3", "cm3")}, func(_ *cache.Resource) bool { return true })
+	require.EqualError(t, err, `namespace "ns3" for ConfigMap "cm3" is not managed`)
+	_, err = clusterCache.GetManagedLiveObjs([]*unstructured.Unstructured{newObj("", "cm")}, func(_ *cache.Resource) bool { return true })
+	require.EqualError(t, err, `cluster level ConfigMap "cm" can not be managed when in namespaced mode`)
+}
+
+func TestNamespacedClusterCache_Handlers(t *testing.T) {
+	caches := newNamespaceCacheMocks()
+	clusterCache := newNamespacedClusterCache(&sharding.WatchedResources{Namespaces: []string{"ns1"}}, caches.newCache)
+	events := 0
+	unsubscribe := clusterCache.OnEvent(func(_ watch.EventType, _ *unstructured.Unstructured) {
+		events++
+	})
+
+	// the handlers are notified of the events of the caches created later
+	clusterCache.SetWatchedResources(&sharding.WatchedResources{Namespaces: []string{"ns1", "ns2"}})
+	for _, namespace := range []string{"ns1", "ns2"} {
+		caches.getEventHandler(namespace)(watch.Added, &unstructured.Unstructured{})
+	}
+	assert.Equal(t, 2, events)
+
+	unsubscribe()
+	caches.getEventHandler("ns1")(watch.Added, &unstructured.Unstructured{})
+	assert.Equal(t, 2, events)
+}
@@ -0,0 +1,290 @@
+package healthplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/healthplugin/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/lua"
+)
+
+// defaultCacheExpiration is the maximum amount of time a health assessment of a plugin is reused. Results are cached per
+// resourceVersion, but plugins may base their assessment on external state, so results have to expire eventually.
+const defaultCacheExpiration = 1 * time.Minute
+
+const (
+	// defaultInitialBackoff is the time a plugin is not called after it failed for the first time. It doubles with every
+	// consecutive failure, up to defaultMaxBackoff.
+	defaultInitialBackoff = 5 * time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+)
+
+type cacheEntry struct {
+	resourceVersion string
+	status          *health.HealthStatus
+	expiresAt       time.Time
+}
+
+// failure records the consecutive failures of a plugin, so that it is not called again before retryAt.
+type failure struct {
+	count   int
+	retryAt time.Time
+	err     error
+}
+
+type connection struct {
+	closer io.Closer
+	client apiclient.HealthPluginServiceClient
+}
+
+// Manager assesses the health of resources using the health plugins configured in the resource overrides. It keeps the
+// connections to the plugins and caches their results per resource version.
+type Manager struct {
+	newClientset    func(address string) apiclient.Clientset
+	cacheExpiration time.Duration
+	initialBackoff  time.Duration
+	maxBackoff      time.Duration
+
+	lock        sync.Mutex
+	connections map[string]*connection
+	failures    map[string]failure
+	cache       map[string]cacheEntry
+}
+
+// NewManager returns a new health plugin Manager
+func NewManager() *Manager {
+	return &Manager{
+		newClientset:    apiclient.NewHealthPluginClientSet,
+		cacheExpiration: defaultCacheExpiration,
+		initialBackoff:  defaultInitialBackoff,
+		maxBackoff:      defaultMaxBackoff,
+		connections:     map[string]*connection{},
+		failures:        map[string]failure{},
+		cache:           map[string]cacheEntry{},
+	}
+}
+
+// HealthOverride returns a health.HealthOverride which assesses the health of resources using their configured health
+// plugin. Resources without a health plugin, or for which the plugin fails or has no opinion, are assessed using the
+// Lua health checks. A nil Manager only uses the Lua health checks. The application may be nil for resources which are
+// not managed by an application.
+func (m *Manager) HealthOverride(resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application) health.HealthOverride {
+	luaOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	if m == nil {
+		return luaOverrides
+	}
+	return &healthOverride{manager: m, overrides: resourceOverrides, lua: luaOverrides, app: app}
+}
+
+// CachedHealthOverride returns a health.HealthOverride which never calls the health plugins, but only uses their cached
+// results of previous health assessments. It is meant for the live state cache, whose handlers must not block on the
+// plugins. Resources without a cached result are assessed using the Lua health checks.
+func (m *Manager) CachedHealthOverride(resourceOverrides map[string]appv1.ResourceOverride) health.HealthOverride {
+	luaOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	if m == nil {
+		return luaOverrides
+	}
+	return &healthOverride{manager: m, overrides: resourceOverrides, lua: luaOverrides, cachedOnly: true}
+}
+
+type healthOverride struct {
+	manager    *Manager
+	overrides  map[string]appv1.ResourceOverride
+	lua        lua.ResourceHealthOverrides
+	app        *appv1.Application
+	cachedOnly bool
+}
+
+func (o *healthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	plugin := o.getHealthPlugin(obj)
+	if plugin != nil && o.cachedOnly {
+		if status, ok := o.manager.getCachedResourceHealth(plugin, obj, time.Now()); ok && status != nil {
+			return status, nil
+		}
+	} else if plugin != nil {
+		status, err := o.manager.getResourceHealth(plugin, obj, o.app)
+		if err != nil {
+			logCtx := log.NewEntry(log.StandardLogger())
+			if o.app != nil {
+				logCtx = logCtx.WithField("application", o.app.QualifiedName())
+			}
+			logCtx.Warnf("Health plugin %s failed to assess %s %s/%s, falling back to Lua: %v", plugin.Address, obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		} else if status != nil {
+			return status, nil
+		}
+	}
+	return o.lua.GetResourceHealth(obj)
+}
+
+func (o *healthOverride) getHealthPlugin(obj *unstructured.Unstructured) *appv1.ResourceHealthPlugin {
+	if override, ok := o.overrides[lua.GetConfigMapKey(obj.GroupVersionKind())]; ok && override.HealthPlugin != nil {
+		return override.HealthPlugin
+	}
+	if wildcardKey := lua.GetWildcardConfigMapKey(lua.VM{ResourceOverrides: o.overrides}, obj.GroupVersionKind()); wildcardKey != "" {
+		if override, ok := o.overrides[wildcardKey]; ok && override.HealthPlugin != nil {
+			return override.HealthPlugin
+		}
+	}
+	return nil
+}
+
+func cacheKey(address string, obj *unstructured.Unstructured) string {
+	if uid := obj.GetUID(); uid != "" {
+		return fmt.Sprintf("%s|%s", address, uid)
+	}
+	return fmt.Sprintf("%s|%s|%s|%s|%s", address, obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName(), obj.GetResourceVersion())
+}
+
+// getCachedResourceHealth returns the cached health of the given resource as assessed by the plugin, and whether there
+// is a cached result for the current resource version.
+func (m *Manager) getCachedResourceHealth(plugin *appv1.ResourceHealthPlugin, obj *unstructured.Unstructured, now time.Time) (*health.HealthStatus, bool) {
+	m.lock.Lock()
+	entry, ok := m.cache[cacheKey(plugin.Address, obj)]
+	m.lock.Unlock()
+	if ok && entry.resourceVersion == obj.GetResourceVersion() && now.Before(entry.expiresAt) {
+		return entry.status, true
+	}
+	return nil, false
+}
+
+// getResourceHealth returns the health of the given resource as assessed by the plugin, or nil if the plugin has no
+// opinion on the resource. Plugins which failed recently are not called until their backoff has passed.
+func (m *Manager) getResourceHealth(plugin *appv1.ResourceHealthPlugin, obj *unstructured.Unstructured, app *appv1.Application) (*health.HealthStatus, error) {
+	now := time.Now()
+	if status, ok := m.getCachedResourceHealth(plugin, obj, now); ok {
+		return status, nil
+	}
+	if err := m.checkBackoff(plugin.Address, now); err != nil {
+		return nil, err
+	}
+
+	timeout, err := plugin.GetTimeout()
+	if err != nil {
+		return nil, err
+	}
+	resource, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := m.getClient(ctx, plugin.Address)
+	if err != nil {
+		m.recordFailure(plugin.Address, err)
+		return nil, err
+	}
+	req := &apiclient.HealthRequest{Resource: string(resource)}
+	if app != nil {
+		req.AppName = app.Name
+		req.AppNamespace = app.Namespace
+	}
+	resp, err := client.GetResourceHealth(ctx, req)
+	if err != nil {
+		m.closeClient(plugin.Address)
+		err = fmt.Errorf("failed to get resource health: %w", err)
+		m.recordFailure(plugin.Address, err)
+		return nil, err
+	}
+
+	var status *health.HealthStatus
+	if resp.Status != "" {
+		status = &health.HealthStatus{Status: health.HealthStatusCode(resp.Status), Message: resp.Message}
+		if !isValidHealthStatus(status.Status) {
+			return nil, fmt.Errorf("plugin returned an invalid health status %q", resp.Status)
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.failures, plugin.Address)
+	m.evictExpired(now)
+	m.cache[cacheKey(plugin.Address, obj)] = cacheEntry{resourceVersion: obj.GetResourceVersion(), status: status, expiresAt: now.Add(m.cacheExpiration)}
+	return status, nil
+}
+
+// evictExpired removes expired results, which usually belong to deleted resources. Must be called with the lock held.
+func (m *Manager) evictExpired(now time.Time) {
+	for key, entry := range m.cache {
+		if now.After(entry.expiresAt) {
+			delete(m.cache, key)
+		}
+	}
+}
+
+// checkBackoff returns the last error of the plugin with the given address if it must not be called yet.
+func (m *Manager) checkBackoff(address string, now time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if f, ok := m.failures[address]; ok && now.Before(f.retryAt) {
+		return fmt.Errorf("plugin failed %d times, retrying after %s: %w", f.count, f.retryAt.Format(time.RFC3339), f.err)
+	}
+	return nil
+}
+
+// recordFailure doubles the backoff of the plugin with the given address.
+func (m *Manager) recordFailure(address string, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	f := m.failures[address]
+	backoff := m.initialBackoff
+	for i := 0; i < f.count && backoff < m.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > m.maxBackoff {
+		backoff = m.maxBackoff
+	}
+	m.failures[address] = failure{count: f.count + 1, retryAt: time.Now().Add(backoff), err: err}
+}
+
+// getClient returns the client of the plugin with the given address, connecting to it if needed. The connection is
+// established without holding the lock, so that a plugin which is slow to connect does not block the others.
+func (m *Manager) getClient(ctx context.Context, address string) (apiclient.HealthPluginServiceClient, error) {
+	m.lock.Lock()
+	conn, ok := m.connections[address]
+	m.lock.Unlock()
+	if ok {
+		return conn.client, nil
+	}
+	closer, client, err := m.newClientset(address).NewHealthPluginClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if conn, ok := m.connections[address]; ok {
+		// another assessment connected to the plugin concurrently
+		io.Close(closer)
+		return conn.client, nil
+	}
+	m.connections[address] = &connection{closer: closer, client: client}
+	return client, nil
+}
+
+// closeClient closes the connection to the plugin with the given address, so that it is re-established by the next
+// health assessment.
+func (m *Manager) closeClient(address string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if conn, ok := m.connections[address]; ok {
+		io.Close(conn.closer)
+		delete(m.connections, address)
+	}
+}
+
+func isValidHealthStatus(status health.HealthStatusCode) bool {
+	switch status {
+	case health.HealthStatusHealthy, health.HealthStatusProgressing, health.HealthStatusDegraded,
+		health.HealthStatusSuspended, health.HealthStatusMissing, health.HealthStatusUnknown:
+		return true
+	}
+	return false
+}
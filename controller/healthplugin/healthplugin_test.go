@@ -0,0 +1,274 @@
+package healthplugin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/healthplugin/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+type fakeHealthPlugin struct {
+	response *apiclient.HealthResponse
+	delay    time.Duration
+	calls    int32
+}
+
+func (p *fakeHealthPlugin) GetResourceHealth(ctx context.Context, req *apiclient.HealthRequest) (*apiclient.HealthResponse, error) {
+	atomic.AddInt32(&p.calls, 1)
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return p.response, nil
+}
+
+func startFakeHealthPlugin(t *testing.T, plugin *fakeHealthPlugin) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	apiclient.RegisterHealthPluginServiceServer(server, plugin)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func newCertificate(resourceVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("cert-manager.io/v1")
+	obj.SetKind("Certificate")
+	obj.SetName("my-cert")
+	obj.SetNamespace("default")
+	obj.SetUID("4c3b2a1")
+	obj.SetResourceVersion(resourceVersion)
+	return obj
+}
+
+const luaDegraded = `hs = {}
+hs.status = "Degraded"
+hs.message = "from lua"
+return hs`
+
+func newApp() *appv1.Application {
+	return &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "argocd"}}
+}
+
+func TestHealthOverride(t *testing.T) {
+	t.Run("plugin result is used", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Healthy", Message: "from plugin"}}
+		address := startFakeHealthPlugin(t, plugin)
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+		}
+		status, err := NewManager().HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "from plugin"}, status)
+	})
+
+	t.Run("resource without application", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Healthy"}}
+		address := startFakeHealthPlugin(t, plugin)
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+		}
+		status, err := NewManager().HealthOverride(overrides, nil).GetResourceHealth(newCertificate("1"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+	})
+
+	t.Run("results are cached per resource version", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Progressing"}}
+		address := startFakeHealthPlugin(t, plugin)
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+		}
+		override := NewManager().HealthOverride(overrides, newApp())
+		for _, resourceVersion := range []string{"1", "1", "2"} {
+			status, err := override.GetResourceHealth(newCertificate(resourceVersion))
+			require.NoError(t, err)
+			assert.Equal(t, health.HealthStatusProgressing, status.Status)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&plugin.calls))
+	})
+
+	t.Run("falls back to Lua on timeout", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Healthy"}, delay: time.Second}
+		address := startFakeHealthPlugin(t, plugin)
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address, Timeout: "100ms"}},
+		}
+		status, err := NewManager().HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "from lua"}, status)
+	})
+
+	t.Run("falls back to Lua on empty or invalid status", func(t *testing.T) {
+		for _, response := range []*apiclient.HealthResponse{{}, {Status: "Great"}} {
+			address := startFakeHealthPlugin(t, &fakeHealthPlugin{response: response})
+			overrides := map[string]appv1.ResourceOverride{
+				"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+			}
+			status, err := NewManager().HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+			require.NoError(t, err)
+			assert.Equal(t, health.HealthStatusDegraded, status.Status)
+		}
+	})
+
+	t.Run("no plugin configured", func(t *testing.T) {
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded},
+		}
+		status, err := NewManager().HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	})
+
+	t.Run("nil manager", func(t *testing.T) {
+		var manager *Manager
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: "127.0.0.1:1"}},
+		}
+		status, err := manager.HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	})
+}
+
+type failingClientset struct {
+	dials int32
+}
+
+func (c *failingClientset) NewHealthPluginClient(_ context.Context) (io.Closer, apiclient.HealthPluginServiceClient, error) {
+	atomic.AddInt32(&c.dials, 1)
+	return nil, nil, errors.New("connection refused")
+}
+
+func TestHealthOverride_Backoff(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{
+		"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: "127.0.0.1:1"}},
+	}
+
+	t.Run("failed plugin is not called again before its backoff passed", func(t *testing.T) {
+		clientset := &failingClientset{}
+		manager := NewManager()
+		manager.newClientset = func(_ string) apiclient.Clientset { return clientset }
+		override := manager.HealthOverride(overrides, newApp())
+		for i := 0; i < 3; i++ {
+			status, err := override.GetResourceHealth(newCertificate("1"))
+			require.NoError(t, err)
+			assert.Equal(t, health.HealthStatusDegraded, status.Status)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&clientset.dials))
+	})
+
+	t.Run("failed plugin is called again after its backoff passed", func(t *testing.T) {
+		clientset := &failingClientset{}
+		manager := NewManager()
+		manager.newClientset = func(_ string) apiclient.Clientset { return clientset }
+		manager.initialBackoff = 0
+		manager.maxBackoff = 0
+		override := manager.HealthOverride(overrides, newApp())
+		for i := 0; i < 3; i++ {
+			_, err := override.GetResourceHealth(newCertificate("1"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&clientset.dials))
+	})
+
+	t.Run("backoff doubles up to the maximum", func(t *testing.T) {
+		manager := NewManager()
+		manager.initialBackoff = time.Minute
+		manager.maxBackoff = 3 * time.Minute
+		for _, expected := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute} {
+			manager.recordFailure("plugin", errors.New("failed"))
+			assert.WithinDuration(t, time.Now().Add(expected), manager.failures["plugin"].retryAt, time.Second)
+		}
+	})
+}
+
+func TestCachedHealthOverride(t *testing.T) {
+	plugin := &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Healthy"}}
+	address := startFakeHealthPlugin(t, plugin)
+	overrides := map[string]appv1.ResourceOverride{
+		"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+	}
+	manager := NewManager()
+
+	// without a cached result, the plugin is not called and Lua is used
+	status, err := manager.CachedHealthOverride(overrides).GetResourceHealth(newCertificate("1"))
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&plugin.calls))
+
+	// the result of the application health assessment is reused
+	_, err = manager.HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+	require.NoError(t, err)
+	status, err = manager.CachedHealthOverride(overrides).GetResourceHealth(newCertificate("1"))
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusHealthy, status.Status)
+
+	// but not for other resource versions
+	status, err = manager.CachedHealthOverride(overrides).GetResourceHealth(newCertificate("2"))
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&plugin.calls))
+}
+
+type blockingClientset struct {
+	dialing chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingClientset) NewHealthPluginClient(_ context.Context) (io.Closer, apiclient.HealthPluginServiceClient, error) {
+	close(c.dialing)
+	<-c.release
+	return nil, nil, errors.New("connection refused")
+}
+
+func TestHealthOverride_SlowConnectionDoesNotBlockOtherPlugins(t *testing.T) {
+	address := startFakeHealthPlugin(t, &fakeHealthPlugin{response: &apiclient.HealthResponse{Status: "Healthy"}})
+	blocking := &blockingClientset{dialing: make(chan struct{}), release: make(chan struct{})}
+	manager := NewManager()
+	manager.newClientset = func(addr string) apiclient.Clientset {
+		if addr == "slow:1" {
+			return blocking
+		}
+		return apiclient.NewHealthPluginClientSet(addr)
+	}
+
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		overrides := map[string]appv1.ResourceOverride{
+			"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: "slow:1"}},
+		}
+		_, _ = manager.HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+	}()
+	<-blocking.dialing
+
+	overrides := map[string]appv1.ResourceOverride{
+		"cert-manager.io/Certificate": {HealthLua: luaDegraded, HealthPlugin: &appv1.ResourceHealthPlugin{Address: address}},
+	}
+	status, err := manager.HealthOverride(overrides, newApp()).GetResourceHealth(newCertificate("1"))
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusHealthy, status.Status)
+
+	close(blocking.release)
+	<-slowDone
+}
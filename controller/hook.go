@@ -12,8 +12,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
-	"github.com/argoproj/argo-cd/v2/util/lua"
-
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
@@ -90,7 +88,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	if err != nil {
 		return false, err
 	}
-	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	healthOverrides := ctrl.healthPlugins.HealthOverride(resourceOverrides, app)
 
 	progressingHooksCnt := 0
 	for _, obj := range runningHooks {
@@ -122,12 +120,12 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	return true, nil
 }
 
-func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+func (ctrl *ApplicationController) cleanupPostDeleteHooks(app *v1alpha1.Application, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return false, err
 	}
-	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	healthOverrides := ctrl.healthPlugins.HealthOverride(resourceOverrides, app)
 
 	pendingDeletionCount := 0
 	aggregatedHealth := health.HealthStatusHealthy
@@ -0,0 +1,344 @@
+package hydrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/commitserver/commit"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+// failedHydrationRetryTimeout is the time to wait before retrying a failed hydration
+const failedHydrationRetryTimeout = 2 * time.Minute
+
+// Dependencies is the interface for the dependencies of the Hydrator. It serves two purposes: 1) it prevents the
+// hydrator from having direct access to the app controller, and 2) it allows for easy mocking of dependencies in tests.
+// If you add something here, be sure that it is something the app controller needs to provide to the hydrator.
+type Dependencies interface {
+	// GetProcessableAppProj returns the AppProject for the given application. It should only return projects that
+	// are processable by the controller, meaning that the project is not deleted and the application is in a
+	// namespace permitted by the project.
+	GetProcessableAppProj(app *appv1.Application) (*appv1.AppProject, error)
+
+	// GetProcessableApps returns a list of applications that are processable by the controller.
+	GetProcessableApps() (*appv1.ApplicationList, error)
+
+	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
+	// server and gets the manifests (objects).
+	GetRepoObjs(app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+
+	// GetRepository returns the repository, including its credentials, which hydrated manifests are pushed to.
+	GetRepository(ctx context.Context, repoURL, project string) (*appv1.Repository, error)
+
+	// RequestAppRefresh requests a refresh of the application with the given name and namespace. This is used after a
+	// successful hydration so that the application syncs the newly hydrated revision.
+	RequestAppRefresh(appName string, appNamespace string) error
+
+	// PersistAppHydratorStatus persists the application's hydrator status.
+	PersistAppHydratorStatus(orig *appv1.Application, newStatus *appv1.SourceHydratorStatus)
+
+	// AddHydrationQueueItem adds the given hydration queue key to the hydration queue.
+	AddHydrationQueueItem(key HydrationQueueKey)
+}
+
+// CommitService commits hydrated manifests to the hydrated branch of a repository.
+type CommitService interface {
+	CommitHydratedManifests(ctx context.Context, r *commit.ManifestsRequest) (*commit.ManifestsResponse, error)
+}
+
+// Hydrator renders the dry source of applications and commits the result to their hydrated branch.
+type Hydrator struct {
+	dependencies         Dependencies
+	statusRefreshTimeout time.Duration
+	commitService        CommitService
+}
+
+// NewHydrator creates a new Hydrator instance with the given dependencies, status refresh timeout, and commit service.
+func NewHydrator(dependencies Dependencies, statusRefreshTimeout time.Duration, commitService CommitService) *Hydrator {
+	return &Hydrator{
+		dependencies:         dependencies,
+		statusRefreshTimeout: statusRefreshTimeout,
+		commitService:        commitService,
+	}
+}
+
+// HydrationQueueKey identifies a set of applications which are hydrated together, because they commit to the same
+// branch of the same repository.
+type HydrationQueueKey struct {
+	SourceRepoURL     string
+	DestinationBranch string
+}
+
+func getHydrationQueueKey(app *appv1.Application) HydrationQueueKey {
+	return HydrationQueueKey{
+		SourceRepoURL:     app.Spec.SourceHydrator.DrySource.RepoURL,
+		DestinationBranch: app.Spec.SourceHydrator.GetHydrateToBranch(),
+	}
+}
+
+// ProcessAppHydrateQueueItem checks whether the given application needs to be hydrated. If it does, the application's
+// hydrator status is set to Hydrating and its hydration queue key is added to the hydration queue.
+func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
+	if origApp.Spec.SourceHydrator == nil {
+		return
+	}
+	app := origApp.DeepCopy()
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+
+	needsHydration, reason := appNeedsHydration(app, h.statusRefreshTimeout)
+	if !needsHydration {
+		return
+	}
+	logCtx.WithField("reason", reason).Info("Hydrating app")
+
+	if app.Status.SourceHydrator.CurrentOperation == nil || app.Status.SourceHydrator.CurrentOperation.Phase != appv1.HydrateOperationPhaseHydrating {
+		app.Status.SourceHydrator.CurrentOperation = &appv1.HydrateOperation{
+			StartedAt:      metav1.Now(),
+			Phase:          appv1.HydrateOperationPhaseHydrating,
+			SourceHydrator: *app.Spec.SourceHydrator,
+		}
+		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+	} else if app.IsHydrateRequested() {
+		// Persisting the status removes the hydrate annotation, even if the app was already being hydrated.
+		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+	}
+
+	// Adding the same key more than once is a no-op, so concurrent refreshes of apps sharing a hydrated branch only
+	// cause a single hydration.
+	h.dependencies.AddHydrationQueueItem(getHydrationQueueKey(app))
+	logCtx.Debug("Added hydration queue item")
+}
+
+// ProcessHydrationQueueItem hydrates all applications which share the given hydration queue key, commits the result
+// and updates the hydrator status of each of the applications.
+func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey HydrationQueueKey) {
+	logCtx := log.WithFields(log.Fields{
+		"sourceRepoURL":     hydrationKey.SourceRepoURL,
+		"destinationBranch": hydrationKey.DestinationBranch,
+	})
+
+	relevantApps, drySHA, hydratedSHA, err := h.hydrateAppsLatestCommit(logCtx, hydrationKey)
+	if drySHA != "" {
+		logCtx = logCtx.WithField("drySHA", drySHA)
+	}
+	if err != nil {
+		logCtx.Errorf("Failed to hydrate apps: %v", err)
+		for _, app := range relevantApps {
+			origApp := app.DeepCopy()
+			failedOperation := &appv1.HydrateOperation{
+				StartedAt:      metav1.Now(),
+				Phase:          appv1.HydrateOperationPhaseFailed,
+				SourceHydrator: *app.Spec.SourceHydrator,
+			}
+			if app.Status.SourceHydrator.CurrentOperation != nil {
+				failedOperation = app.Status.SourceHydrator.CurrentOperation.DeepCopy()
+				failedOperation.Phase = appv1.HydrateOperationPhaseFailed
+			}
+			failedAt := metav1.Now()
+			failedOperation.FinishedAt = &failedAt
+			failedOperation.Message = fmt.Sprintf("Failed to hydrate revision %q: %v", drySHA, err.Error())
+			// We may or may not have gotten far enough in the hydration process to get a non-empty SHA, but set it
+			// just in case we did.
+			failedOperation.DrySHA = drySHA
+			app.Status.SourceHydrator.CurrentOperation = failedOperation
+			h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		}
+		return
+	}
+	logCtx.WithField("appCount", len(relevantApps)).Info("Successfully hydrated apps")
+
+	finishedAt := metav1.Now()
+	for _, app := range relevantApps {
+		origApp := app.DeepCopy()
+		// The hydrated branch only changed if the hydrated SHA did, as nothing is pushed when the manifests did not
+		// change.
+		hydratedSHAChanged := app.Status.SourceHydrator.LastSuccessfulOperation == nil || app.Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA != hydratedSHA
+		operation := &appv1.HydrateOperation{
+			StartedAt:      finishedAt,
+			SourceHydrator: *app.Spec.SourceHydrator,
+		}
+		if app.Status.SourceHydrator.CurrentOperation != nil {
+			operation = app.Status.SourceHydrator.CurrentOperation.DeepCopy()
+		}
+		operation.Phase = appv1.HydrateOperationPhaseHydrated
+		operation.FinishedAt = &finishedAt
+		operation.Message = ""
+		operation.DrySHA = drySHA
+		operation.HydratedSHA = hydratedSHA
+		app.Status.SourceHydrator.CurrentOperation = operation
+		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
+			DrySHA:         drySHA,
+			HydratedSHA:    hydratedSHA,
+			SourceHydrator: operation.SourceHydrator,
+		}
+		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+
+		// Request a refresh if we pushed a new commit.
+		if !hydratedSHAChanged {
+			continue
+		}
+		if err := h.dependencies.RequestAppRefresh(app.Name, app.Namespace); err != nil {
+			logCtx.WithField("app", app.QualifiedName()).Errorf("Failed to request app refresh after hydration: %v", err)
+		}
+	}
+}
+
+func (h *Hydrator) hydrateAppsLatestCommit(logCtx *log.Entry, hydrationKey HydrationQueueKey) ([]*appv1.Application, string, string, error) {
+	relevantApps, err := h.getRelevantAppsForHydration(logCtx, hydrationKey)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to get relevant apps for hydration: %w", err)
+	}
+
+	drySHA, hydratedSHA, err := h.hydrate(logCtx, relevantApps)
+	if err != nil {
+		return relevantApps, drySHA, "", fmt.Errorf("failed to hydrate apps: %w", err)
+	}
+
+	return relevantApps, drySHA, hydratedSHA, nil
+}
+
+// getRelevantAppsForHydration returns all applications which hydrate to the branch identified by the given key.
+// Since the hydrated branch is rewritten on every hydration, every application committing to it must be included.
+func (h *Hydrator) getRelevantAppsForHydration(logCtx *log.Entry, hydrationKey HydrationQueueKey) ([]*appv1.Application, error) {
+	apps, err := h.dependencies.GetProcessableApps()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list apps: %w", err)
+	}
+
+	var relevantApps []*appv1.Application
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if app.Spec.SourceHydrator == nil {
+			continue
+		}
+		if getHydrationQueueKey(app) != hydrationKey {
+			continue
+		}
+		if app.DeletionTimestamp != nil {
+			logCtx.WithField("app", app.QualifiedName()).Debug("Skipping app being deleted")
+			continue
+		}
+		proj, err := h.dependencies.GetProcessableAppProj(app)
+		if err != nil {
+			logCtx.WithField("app", app.QualifiedName()).Warnf("Skipping app with unavailable project: %v", err)
+			continue
+		}
+		if !proj.IsSourcePermitted(app.Spec.SourceHydrator.GetDrySource()) {
+			logCtx.WithField("app", app.QualifiedName()).Warnf("Skipping app whose dry source is not permitted in project %q", proj.Name)
+			continue
+		}
+		relevantApps = append(relevantApps, app)
+	}
+	return relevantApps, nil
+}
+
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application) (string, string, error) {
+	if len(apps) == 0 {
+		return "", "", nil
+	}
+	repoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
+	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
+	targetBranch := apps[0].Spec.SourceHydrator.GetHydrateToBranch()
+	targetRevision := apps[0].Spec.SourceHydrator.DrySource.TargetRevision
+	var project string
+	var drySHA string
+	paths := make([]*commit.PathDetails, 0, len(apps))
+	for _, app := range apps {
+		if app.Spec.SourceHydrator.SyncSource.TargetBranch != syncBranch {
+			return drySHA, "", fmt.Errorf("app %q syncs from branch %q, but apps hydrating to branch %q must all sync from branch %q", app.QualifiedName(), app.Spec.SourceHydrator.SyncSource.TargetBranch, targetBranch, syncBranch)
+		}
+		if app.Spec.SourceHydrator.DrySource.TargetRevision != targetRevision {
+			return drySHA, "", fmt.Errorf("app %q hydrates revision %q, but apps hydrating to branch %q must all hydrate revision %q", app.QualifiedName(), app.Spec.SourceHydrator.DrySource.TargetRevision, targetBranch, targetRevision)
+		}
+		proj, err := h.dependencies.GetProcessableAppProj(app)
+		if err != nil {
+			return drySHA, "", fmt.Errorf("failed to get project for app %q: %w", app.QualifiedName(), err)
+		}
+		if project == "" {
+			project = proj.Name
+		}
+
+		// If we already resolved the dry SHA, use it so that all apps are hydrated from the same commit.
+		revision := targetRevision
+		if drySHA != "" {
+			revision = drySHA
+		}
+		objs, resp, err := h.dependencies.GetRepoObjs(app, app.Spec.SourceHydrator.GetDrySource(), revision, proj)
+		if err != nil {
+			return drySHA, "", fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
+		}
+		if drySHA == "" {
+			drySHA = resp.Revision
+		}
+
+		manifests := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			manifest, err := json.Marshal(obj.Object)
+			if err != nil {
+				return drySHA, "", fmt.Errorf("failed to marshal manifest of app %q: %w", app.QualifiedName(), err)
+			}
+			manifests = append(manifests, string(manifest))
+		}
+		paths = append(paths, &commit.PathDetails{
+			Path:      app.Spec.SourceHydrator.SyncSource.Path,
+			Manifests: manifests,
+			Commands:  resp.Commands,
+		})
+	}
+
+	repo, err := h.dependencies.GetRepository(context.Background(), repoURL, project)
+	if err != nil {
+		return drySHA, "", fmt.Errorf("failed to get repository %q: %w", repoURL, err)
+	}
+
+	logCtx.WithField("appCount", len(apps)).Debug("Committing hydrated manifests")
+	resp, err := h.commitService.CommitHydratedManifests(context.Background(), &commit.ManifestsRequest{
+		Repo:          repo,
+		SyncBranch:    syncBranch,
+		TargetBranch:  targetBranch,
+		DrySHA:        drySHA,
+		CommitMessage: fmt.Sprintf("[Argo CD Bot] hydrate %s", drySHA),
+		Paths:         paths,
+	})
+	if err != nil {
+		return drySHA, "", fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	return drySHA, resp.HydratedSHA, nil
+}
+
+// appNeedsHydration answers if application needs manifests hydrated.
+func appNeedsHydration(app *appv1.Application, statusHydrateTimeout time.Duration) (bool, string) {
+	if app.Spec.SourceHydrator == nil {
+		return false, "source hydrator not configured"
+	}
+
+	currentOperation := app.Status.SourceHydrator.CurrentOperation
+	var hydratedAt *metav1.Time
+	if currentOperation != nil {
+		hydratedAt = currentOperation.FinishedAt
+	}
+
+	switch {
+	case app.IsHydrateRequested():
+		return true, "hydrate requested"
+	case currentOperation == nil:
+		return true, "no previous hydrate operation"
+	case currentOperation.Phase == appv1.HydrateOperationPhaseHydrating:
+		return true, "hydrate operation in progress"
+	case !app.Spec.SourceHydrator.DeepEquals(currentOperation.SourceHydrator):
+		return true, "spec.sourceHydrator differs"
+	case currentOperation.Phase == appv1.HydrateOperationPhaseFailed && (hydratedAt == nil || time.Since(hydratedAt.Time) > failedHydrationRetryTimeout):
+		return true, "previous hydrate operation failed"
+	case hydratedAt == nil || time.Since(hydratedAt.Time) > statusHydrateTimeout:
+		return true, "hydration expired"
+	}
+
+	return false, ""
+}
@@ -0,0 +1,248 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/commitserver/commit"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+type fakeDependencies struct {
+	apps             []v1alpha1.Application
+	repoObjsErr      error
+	persisted        map[string]*v1alpha1.SourceHydratorStatus
+	refreshRequested []string
+	queued           []HydrationQueueKey
+}
+
+func (f *fakeDependencies) GetProcessableAppProj(_ *v1alpha1.Application) (*v1alpha1.AppProject, error) {
+	return &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}, nil
+}
+
+func (f *fakeDependencies) GetProcessableApps() (*v1alpha1.ApplicationList, error) {
+	return &v1alpha1.ApplicationList{Items: f.apps}, nil
+}
+
+func (f *fakeDependencies) GetRepoObjs(_ *v1alpha1.Application, source v1alpha1.ApplicationSource, _ string, _ *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+	if f.repoObjsErr != nil {
+		return nil, nil, f.repoObjsErr
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": source.Path},
+	}}
+	return []*unstructured.Unstructured{obj}, &apiclient.ManifestResponse{Revision: "dry-sha", Commands: []string{"kustomize build ."}}, nil
+}
+
+func (f *fakeDependencies) GetRepository(_ context.Context, repoURL, _ string) (*v1alpha1.Repository, error) {
+	return &v1alpha1.Repository{Repo: repoURL}, nil
+}
+
+func (f *fakeDependencies) RequestAppRefresh(appName string, appNamespace string) error {
+	f.refreshRequested = append(f.refreshRequested, appNamespace+"/"+appName)
+	return nil
+}
+
+func (f *fakeDependencies) PersistAppHydratorStatus(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+	if f.persisted == nil {
+		f.persisted = map[string]*v1alpha1.SourceHydratorStatus{}
+	}
+	f.persisted[orig.QualifiedName()] = newStatus.DeepCopy()
+}
+
+func (f *fakeDependencies) AddHydrationQueueItem(key HydrationQueueKey) {
+	f.queued = append(f.queued, key)
+}
+
+type fakeCommitService struct {
+	requests []*commit.ManifestsRequest
+	err      error
+}
+
+func (f *fakeCommitService) CommitHydratedManifests(_ context.Context, r *commit.ManifestsRequest) (*commit.ManifestsResponse, error) {
+	f.requests = append(f.requests, r)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &commit.ManifestsResponse{HydratedSHA: "hydrated-sha"}, nil
+}
+
+func newHydratedApp(name, path string) v1alpha1.Application {
+	return v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			SourceHydrator: &v1alpha1.SourceHydrator{
+				DrySource:  v1alpha1.DrySource{RepoURL: "https://example.com/repo.git", TargetRevision: "main", Path: path},
+				SyncSource: v1alpha1.SyncSource{TargetBranch: "env/prod", Path: path},
+			},
+		},
+	}
+}
+
+func Test_appNeedsHydration(t *testing.T) {
+	now := metav1.Now()
+	oneHourAgo := metav1.NewTime(now.Add(-1 * time.Hour))
+	app := newHydratedApp("app", "guestbook")
+
+	t.Run("no source hydrator", func(t *testing.T) {
+		needs, _ := appNeedsHydration(&v1alpha1.Application{}, time.Hour)
+		assert.False(t, needs)
+	})
+	t.Run("no previous operation", func(t *testing.T) {
+		needs, reason := appNeedsHydration(app.DeepCopy(), time.Hour)
+		assert.True(t, needs)
+		assert.Equal(t, "no previous hydrate operation", reason)
+	})
+	t.Run("recently hydrated", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, FinishedAt: &now, SourceHydrator: *a.Spec.SourceHydrator}
+		needs, _ := appNeedsHydration(a, time.Hour)
+		assert.False(t, needs)
+	})
+	t.Run("spec changed", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, FinishedAt: &now, SourceHydrator: *a.Spec.SourceHydrator}
+		a.Spec.SourceHydrator.DrySource.TargetRevision = "release"
+		needs, reason := appNeedsHydration(a, time.Hour)
+		assert.True(t, needs)
+		assert.Equal(t, "spec.sourceHydrator differs", reason)
+	})
+	t.Run("hydrate requested", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Annotations = map[string]string{v1alpha1.AnnotationKeyHydrate: "true"}
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, FinishedAt: &now, SourceHydrator: *a.Spec.SourceHydrator}
+		needs, reason := appNeedsHydration(a, time.Hour)
+		assert.True(t, needs)
+		assert.Equal(t, "hydrate requested", reason)
+	})
+	t.Run("refresh requested", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Annotations = map[string]string{v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal)}
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, FinishedAt: &now, SourceHydrator: *a.Spec.SourceHydrator}
+		needs, _ := appNeedsHydration(a, time.Hour)
+		assert.False(t, needs)
+	})
+	t.Run("recently failed", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseFailed, FinishedAt: &now, SourceHydrator: *a.Spec.SourceHydrator}
+		needs, _ := appNeedsHydration(a, time.Hour)
+		assert.False(t, needs)
+	})
+	t.Run("expired", func(t *testing.T) {
+		a := app.DeepCopy()
+		a.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, FinishedAt: &oneHourAgo, SourceHydrator: *a.Spec.SourceHydrator}
+		needs, reason := appNeedsHydration(a, time.Minute)
+		assert.True(t, needs)
+		assert.Equal(t, "hydration expired", reason)
+	})
+}
+
+func TestProcessAppHydrateQueueItem(t *testing.T) {
+	deps := &fakeDependencies{}
+	h := NewHydrator(deps, time.Hour, &fakeCommitService{})
+	app := newHydratedApp("app", "guestbook")
+
+	h.ProcessAppHydrateQueueItem(&app)
+
+	require.Contains(t, deps.persisted, "argocd/app")
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, deps.persisted["argocd/app"].CurrentOperation.Phase)
+	assert.Equal(t, []HydrationQueueKey{{SourceRepoURL: "https://example.com/repo.git", DestinationBranch: "env/prod"}}, deps.queued)
+}
+
+func TestProcessAppHydrateQueueItem_HydrateRequestedWhileHydrating(t *testing.T) {
+	deps := &fakeDependencies{}
+	h := NewHydrator(deps, time.Hour, &fakeCommitService{})
+	app := newHydratedApp("app", "guestbook")
+	app.Annotations = map[string]string{v1alpha1.AnnotationKeyHydrate: "true"}
+	app.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrating, SourceHydrator: *app.Spec.SourceHydrator}
+
+	h.ProcessAppHydrateQueueItem(&app)
+
+	// The status is persisted so that the hydrate annotation is removed.
+	require.Contains(t, deps.persisted, "argocd/app")
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, deps.persisted["argocd/app"].CurrentOperation.Phase)
+}
+
+func TestProcessHydrationQueueItem(t *testing.T) {
+	key := HydrationQueueKey{SourceRepoURL: "https://example.com/repo.git", DestinationBranch: "env/prod"}
+
+	t.Run("success", func(t *testing.T) {
+		other := newHydratedApp("other", "other")
+		other.Spec.SourceHydrator.SyncSource.TargetBranch = "env/staging"
+		deps := &fakeDependencies{apps: []v1alpha1.Application{newHydratedApp("app1", "app1"), newHydratedApp("app2", "app2"), other}}
+		commitService := &fakeCommitService{}
+		h := NewHydrator(deps, time.Hour, commitService)
+
+		h.ProcessHydrationQueueItem(key)
+
+		require.Len(t, commitService.requests, 1)
+		req := commitService.requests[0]
+		assert.Equal(t, "dry-sha", req.DrySHA)
+		assert.Equal(t, "env/prod", req.TargetBranch)
+		require.Len(t, req.Paths, 2)
+		assert.Equal(t, "app1", req.Paths[0].Path)
+		assert.Equal(t, []string{"kustomize build ."}, req.Paths[0].Commands)
+		for _, name := range []string{"argocd/app1", "argocd/app2"} {
+			status := deps.persisted[name]
+			require.NotNil(t, status)
+			assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, status.CurrentOperation.Phase)
+			assert.Equal(t, "hydrated-sha", status.LastSuccessfulOperation.HydratedSHA)
+		}
+		assert.NotContains(t, deps.persisted, "argocd/other")
+		assert.Equal(t, []string{"argocd/app1", "argocd/app2"}, deps.refreshRequested)
+	})
+
+	t.Run("unchanged hydrated SHA", func(t *testing.T) {
+		unchanged := newHydratedApp("app1", "app1")
+		unchanged.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{HydratedSHA: "hydrated-sha"}
+		deps := &fakeDependencies{apps: []v1alpha1.Application{unchanged, newHydratedApp("app2", "app2")}}
+		h := NewHydrator(deps, time.Hour, &fakeCommitService{})
+
+		h.ProcessHydrationQueueItem(key)
+
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, deps.persisted["argocd/app1"].CurrentOperation.Phase)
+		assert.Equal(t, []string{"argocd/app2"}, deps.refreshRequested)
+	})
+
+	t.Run("commit failure", func(t *testing.T) {
+		deps := &fakeDependencies{apps: []v1alpha1.Application{newHydratedApp("app1", "app1")}}
+		h := NewHydrator(deps, time.Hour, &fakeCommitService{err: errors.New("push rejected")})
+
+		h.ProcessHydrationQueueItem(key)
+
+		status := deps.persisted["argocd/app1"]
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, status.CurrentOperation.Phase)
+		assert.Contains(t, status.CurrentOperation.Message, "push rejected")
+		assert.Nil(t, status.LastSuccessfulOperation)
+		assert.Empty(t, deps.refreshRequested)
+	})
+
+	t.Run("mismatched sync branch", func(t *testing.T) {
+		mismatched := newHydratedApp("app2", "app2")
+		mismatched.Spec.SourceHydrator.SyncSource.TargetBranch = "env/staging"
+		mismatched.Spec.SourceHydrator.HydrateTo = &v1alpha1.HydrateTo{TargetBranch: "env/prod"}
+		deps := &fakeDependencies{apps: []v1alpha1.Application{newHydratedApp("app1", "app1"), mismatched}}
+		commitService := &fakeCommitService{}
+		h := NewHydrator(deps, time.Hour, commitService)
+
+		h.ProcessHydrationQueueItem(key)
+
+		assert.Empty(t, commitService.requests)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, deps.persisted["argocd/app2"].CurrentOperation.Phase)
+	})
+}
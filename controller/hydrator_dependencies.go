@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v2/controller/hydrator"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+/**
+This file implements the hydrator.Dependencies interface for the ApplicationController.
+
+Hydration logic does not belong in this file. The methods here should be "bookkeeping" methods that keep hydration work
+in the hydrator and app controller work in the app controller. The only purpose of this file is to provide the hydrator
+safe, minimal access to certain app controller functionality to avoid duplicate code.
+*/
+
+func (ctrl *ApplicationController) GetProcessableAppProj(app *appv1.Application) (*appv1.AppProject, error) {
+	return ctrl.getAppProj(app)
+}
+
+// GetProcessableApps returns a list of applications that are processable by the controller. Only the applications of
+// the shard of the controller are returned, so that the applications of a hydrated branch are each hydrated by a
+// single shard.
+func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList, error) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	appList := &appv1.ApplicationList{}
+	for _, app := range apps {
+		if ctrl.canProcessApp(app) {
+			appList.Items = append(appList.Items, *app.DeepCopy())
+		}
+	}
+	return appList, nil
+}
+
+func (ctrl *ApplicationController) GetRepoObjs(origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+	app := origApp.DeepCopy()
+	// The manifest-generate-paths annotation compares against the synced (hydrated) revision, which is unrelated to the
+	// dry source. Remove it so manifests are always generated from the requested dry revision.
+	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
+
+	// Tracking labels are added at sync time, so they must not be written to the hydrated branch.
+	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(app, []appv1.ApplicationSource{drySource}, "", []string{revision}, true, true, false, project, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
+	}
+	if len(resp) != 1 {
+		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+	}
+	return objs, resp[0], nil
+}
+
+func (ctrl *ApplicationController) GetRepository(ctx context.Context, repoURL, project string) (*appv1.Repository, error) {
+	return ctrl.db.GetRepository(ctx, repoURL, project)
+}
+
+func (ctrl *ApplicationController) RequestAppRefresh(appName string, appNamespace string) error {
+	// We request a refresh by setting the annotation instead of by adding it to the refresh queue, because there is no
+	// guarantee that the hydrator is running on the same controller shard as is processing the application.
+	_, err := argo.RefreshApp(ctrl.applicationClientset.ArgoprojV1alpha1().Applications(appNamespace), appName, appv1.RefreshTypeNormal)
+	if err != nil {
+		return fmt.Errorf("failed to request refresh of app %s/%s: %w", appNamespace, appName, err)
+	}
+	return nil
+}
+
+func (ctrl *ApplicationController) PersistAppHydratorStatus(orig *appv1.Application, newStatus *appv1.SourceHydratorStatus) {
+	status := orig.Status.DeepCopy()
+	status.SourceHydrator = *newStatus
+	ctrl.persistAppStatusAndRemoveAnnotations(orig, status, appv1.AnnotationKeyRefresh, appv1.AnnotationKeyHydrate)
+}
+
+func (ctrl *ApplicationController) AddHydrationQueueItem(key hydrator.HydrationQueueKey) {
+	ctrl.hydrationQueue.AddRateLimited(key)
+}
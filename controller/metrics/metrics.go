@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
@@ -274,6 +275,52 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// ClusterLoadStats holds the cumulative load related metrics of a cluster
+type ClusterLoadStats struct {
+	// EventsTotal is the number of processed resource events of the cluster
+	EventsTotal float64
+	// ReconcileSecondsTotal is the time spent reconciling the applications of the cluster
+	ReconcileSecondsTotal float64
+}
+
+// GetClusterLoadStats returns the cumulative values of the argocd_cluster_events_total and argocd_app_reconcile
+// metrics indexed by cluster server
+func (m *MetricsServer) GetClusterLoadStats() map[string]ClusterLoadStats {
+	stats := make(map[string]ClusterLoadStats)
+	collectMetrics(m.clusterEventsCounter, "server", func(server string, metric *dto.Metric) {
+		s := stats[server]
+		s.EventsTotal += metric.GetCounter().GetValue()
+		stats[server] = s
+	})
+	collectMetrics(m.reconcileHistogram, "dest_server", func(server string, metric *dto.Metric) {
+		s := stats[server]
+		s.ReconcileSecondsTotal += metric.GetHistogram().GetSampleSum()
+		stats[server] = s
+	})
+	return stats
+}
+
+// collectMetrics calls fn with the value of the given label for each metric of the collector
+func collectMetrics(collector prometheus.Collector, label string, fn func(value string, metric *dto.Metric)) {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+	for metric := range ch {
+		var m dto.Metric
+		if err := metric.Write(&m); err != nil {
+			log.Warnf("Failed to read metric: %v", err)
+			continue
+		}
+		for _, l := range m.GetLabel() {
+			if l.GetName() == label {
+				fn(l.GetValue(), &m)
+			}
+		}
+	}
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
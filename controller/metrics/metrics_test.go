@@ -508,6 +508,24 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestGetClusterLoadStats(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{})
+	require.NoError(t, err)
+
+	fakeApp := newFakeApp(fakeApp)
+	fakeApp.Spec.Destination.Server = "https://load-stats:6443"
+	metricsServ.IncReconcile(fakeApp, 2*time.Second)
+	metricsServ.IncReconcile(fakeApp, 3*time.Second)
+	metricsServ.IncClusterEventsCount("https://load-stats:6443", "apps", "Deployment")
+	metricsServ.IncClusterEventsCount("https://load-stats:6443", "", "Pod")
+	metricsServ.IncClusterEventsCount("https://load-stats:6443", "", "Pod")
+
+	stats := metricsServ.GetClusterLoadStats()
+	assert.Equal(t, ClusterLoadStats{EventsTotal: 3, ReconcileSecondsTotal: 5}, stats["https://load-stats:6443"])
+}
+
 func TestMetricsReset(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// scheduledSync initiates a sync of the application if a time of its sync schedule has passed since the last time
+// which was handled. It must only be called when sync windows and maintenance freezes allow automated syncs, so that
+// a scheduled sync which is blocked is performed as soon as syncs are allowed again. It returns a condition if the
+// sync could not be initiated, the time it took to set the operation and whether a sync was initiated.
+func (ctrl *ApplicationController) scheduledSync(app *appv1.Application, syncStatus *appv1.SyncStatus) (*appv1.ApplicationCondition, time.Duration, bool) {
+	schedule := app.Spec.SyncPolicy.GetSchedule()
+	if schedule == nil {
+		app.Status.LastScheduledSync = nil
+		return nil, 0, false
+	}
+	logCtx := getAppLog(app)
+	now := time.Now()
+
+	next, err := schedule.Next(scheduleStart(app))
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, 0, false
+	}
+	if next.After(now) {
+		// make sure the application is refreshed at the scheduled time
+		after := next.Sub(now)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &after)
+		return nil, 0, false
+	}
+
+	if app.Operation != nil {
+		logCtx.Infof("Postponing scheduled sync: another operation is in progress")
+		return nil, 0, false
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		logCtx.Infof("Skipping scheduled sync: deletion in progress")
+		return nil, 0, false
+	}
+	if depCond := ctrl.checkAppDependencies(app, dependencyWaitStart(app)); depCond != nil {
+		logCtx.Infof("Postponing scheduled sync: %s", depCond.Message)
+		retryAfter := dependencyRecheckInterval
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &retryAfter)
+		return depCond, 0, false
+	}
+
+	if syncStatus.Status != appv1.SyncStatusCodeOutOfSync {
+		logCtx.Infof("Skipping scheduled sync: application status is %s", syncStatus.Status)
+		ctrl.setScheduledSyncHandled(app, schedule, now)
+		return nil, 0, false
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:  syncStatus.Revision,
+			Revisions: syncStatus.Revisions,
+			Prune:     schedule.Prune,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+	}
+	op.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		if errors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.Warnf("Failed to initiate scheduled sync to %s: %v", syncStatus.Revision, err)
+			return nil, 0, false
+		}
+		logCtx.Errorf("Failed to initiate scheduled sync to %s: %v", syncStatus.Revision, err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, setOpTime, false
+	}
+	ctrl.writeBackToInformer(updatedApp)
+	ctrl.setScheduledSyncHandled(app, schedule, now)
+
+	target := syncStatus.Revision
+	if updatedApp.Spec.HasMultipleSources() {
+		target = strings.Join(syncStatus.Revisions, ", ")
+	}
+	message := fmt.Sprintf("Initiated scheduled sync to '%s'", target)
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message, context.TODO())
+	logCtx.Info(message)
+	return nil, setOpTime, true
+}
+
+// scheduleStart returns the time from which the times of the sync schedule are counted: the last time which was
+// handled or, if none was handled yet, the last deployment of the application or its creation. It does not move
+// until a time of the schedule is handled, so that the scheduled syncs are not postponed forever.
+func scheduleStart(app *appv1.Application) time.Time {
+	if app.Status.LastScheduledSync != nil {
+		return app.Status.LastScheduledSync.Time
+	}
+	if len(app.Status.History) > 0 {
+		return app.Status.History.LastRevisionHistory().DeployedAt.Time
+	}
+	return app.CreationTimestamp.Time
+}
+
+// setScheduledSyncHandled records that the times of the sync schedule up to now have been handled and makes sure the
+// application is refreshed at the next time of the schedule
+func (ctrl *ApplicationController) setScheduledSyncHandled(app *appv1.Application, schedule *appv1.SyncSchedule, now time.Time) {
+	app.Status.LastScheduledSync = &metav1.Time{Time: now}
+	if next, err := schedule.Next(now); err == nil {
+		after := next.Sub(now)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &after)
+	}
+}
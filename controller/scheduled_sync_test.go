@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeScheduledApp(lastScheduledSync *time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Schedule: &v1alpha1.SyncSchedule{Cron: "0 2 * * *", Prune: true}}
+	if lastScheduledSync != nil {
+		app.Status.LastScheduledSync = &metav1.Time{Time: *lastScheduledSync}
+	}
+	return app
+}
+
+func TestScheduledSync(t *testing.T) {
+	outOfSync := &v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	getOperation := func(t *testing.T, ctrl *ApplicationController) *v1alpha1.Operation {
+		t.Helper()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		return app.Operation
+	}
+
+	t.Run("no schedule", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.LastScheduledSync = &metav1.Time{Time: time.Now()}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		assert.Nil(t, cond)
+		assert.False(t, scheduled)
+		assert.Nil(t, app.Status.LastScheduledSync)
+	})
+
+	t.Run("schedule counted from the creation of the application", func(t *testing.T) {
+		app := newFakeScheduledApp(nil)
+		app.CreationTimestamp = metav1.Now()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		assert.Nil(t, cond)
+		assert.False(t, scheduled)
+		// the start of the schedule does not depend on the status being persisted
+		assert.Nil(t, app.Status.LastScheduledSync)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("schedule counted from the last deployment", func(t *testing.T) {
+		app := newFakeScheduledApp(nil)
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-50 * time.Hour))
+		app.Status.History = v1alpha1.RevisionHistories{{ID: 1, DeployedAt: metav1.NewTime(time.Now().Add(-25 * time.Hour))}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		assert.Nil(t, cond)
+		assert.True(t, scheduled)
+		assert.NotNil(t, app.Status.LastScheduledSync)
+		assert.NotNil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("schedule not due", func(t *testing.T) {
+		last := time.Now()
+		app := newFakeScheduledApp(&last)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		assert.Nil(t, cond)
+		assert.False(t, scheduled)
+		assert.Equal(t, last, app.Status.LastScheduledSync.Time)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("schedule due and application out of sync", func(t *testing.T) {
+		last := time.Now().Add(-25 * time.Hour)
+		app := newFakeScheduledApp(&last)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		assert.Nil(t, cond)
+		assert.True(t, scheduled)
+		assert.True(t, app.Status.LastScheduledSync.After(last))
+		op := getOperation(t, ctrl)
+		require.NotNil(t, op)
+		assert.True(t, op.InitiatedBy.Automated)
+		assert.True(t, op.Sync.Prune)
+		assert.Equal(t, outOfSync.Revision, op.Sync.Revision)
+	})
+
+	t.Run("schedule due and application synced", func(t *testing.T) {
+		last := time.Now().Add(-25 * time.Hour)
+		app := newFakeScheduledApp(&last)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced})
+		assert.Nil(t, cond)
+		assert.False(t, scheduled)
+		assert.True(t, app.Status.LastScheduledSync.After(last))
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		app := newFakeScheduledApp(nil)
+		app.Spec.SyncPolicy.Schedule.Cron = "invalid"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _, scheduled := ctrl.scheduledSync(app, outOfSync)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.False(t, scheduled)
+	})
+}
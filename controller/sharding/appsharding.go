@@ -0,0 +1,81 @@
+package sharding
+
+import (
+	"hash/fnv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// GetApplicationShardingMode returns how the Applications destined to the cluster are distributed across the shards,
+// or an empty string if they are processed by the shard of the cluster.
+func GetApplicationShardingMode(c *v1alpha1.Cluster) string {
+	if c == nil {
+		return ""
+	}
+	switch mode := c.Annotations[common.AnnotationKeyApplicationSharding]; mode {
+	case "":
+		return ""
+	case common.ApplicationShardingByNamespace, common.ApplicationShardingByApplication:
+		return mode
+	default:
+		log.Warnf("Unsupported application sharding mode %q for cluster %s. Supported modes are: [%s, %s]", mode, c.Server, common.ApplicationShardingByNamespace, common.ApplicationShardingByApplication)
+		return ""
+	}
+}
+
+// GetApplicationShard returns the shard processing an Application destined to a cluster using the given application
+// sharding mode.
+func GetApplicationShard(a *v1alpha1.Application, mode string, replicas int) int {
+	if replicas <= 0 {
+		return -1
+	}
+	key := a.Spec.Destination.Namespace
+	if mode == common.ApplicationShardingByApplication {
+		key = a.QualifiedName()
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(replicas))
+}
+
+func isApplicationDestinedTo(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if a.Spec.Destination.Server != "" {
+		return a.Spec.Destination.Server == c.Server
+	}
+	return a.Spec.Destination.Name != "" && a.Spec.Destination.Name == c.Name
+}
+
+// A read lock should be acquired before calling getApplicationShardingMode.
+func (sharding *ClusterSharding) getApplicationShardingMode(c *v1alpha1.Cluster) string {
+	if sharding.Replicas <= 1 || c == nil {
+		return ""
+	}
+	if known, ok := sharding.Clusters[c.Server]; ok {
+		c = known
+	}
+	return GetApplicationShardingMode(c)
+}
+
+// IsManagedApp returns whether or not the Application destined to the given cluster should be processed by the shard.
+func (sharding *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	sharding.lock.RLock()
+	mode := sharding.getApplicationShardingMode(c)
+	sharding.lock.RUnlock()
+	if mode == "" {
+		return sharding.IsManagedCluster(c)
+	}
+	return GetApplicationShard(a, mode, sharding.Replicas) == sharding.Shard
+}
+
+// IsWatchedCluster returns whether or not the resources of the cluster should be watched by the shard, either because
+// the cluster is managed by the shard or because the Applications destined to the cluster are distributed across
+// the shards.
+func (sharding *ClusterSharding) IsWatchedCluster(c *v1alpha1.Cluster) bool {
+	sharding.lock.RLock()
+	mode := sharding.getApplicationShardingMode(c)
+	sharding.lock.RUnlock()
+	return mode != "" || sharding.IsManagedCluster(c)
+}
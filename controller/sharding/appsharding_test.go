@@ -0,0 +1,115 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+// getNamespaceForShard returns a namespace whose Applications are processed by the given shard
+func getNamespaceForShard(shard, replicas int) string {
+	for i := 0; ; i++ {
+		ns := fmt.Sprintf("ns-%d", i)
+		app := v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: ns}}}
+		if GetApplicationShard(&app, common.ApplicationShardingByNamespace, replicas) == shard {
+			return ns
+		}
+	}
+}
+
+func createNamespacedApp(name, server, namespace string, resourceNamespaces ...string) v1alpha1.Application {
+	app := createApp(name, server)
+	app.Spec.Destination.Namespace = namespace
+	for _, ns := range resourceNamespaces {
+		app.Status.Resources = append(app.Status.Resources, v1alpha1.ResourceStatus{Kind: "ConfigMap", Namespace: ns, Name: name})
+	}
+	return app
+}
+
+func TestGetApplicationShardingMode(t *testing.T) {
+	assert.Equal(t, "", GetApplicationShardingMode(nil))
+	assert.Equal(t, "", GetApplicationShardingMode(&v1alpha1.Cluster{}))
+	assert.Equal(t, common.ApplicationShardingByNamespace, GetApplicationShardingMode(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "namespace"}}))
+	assert.Equal(t, common.ApplicationShardingByApplication, GetApplicationShardingMode(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "application"}}))
+	assert.Equal(t, "", GetApplicationShardingMode(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "unknown"}}))
+}
+
+func TestGetApplicationShard(t *testing.T) {
+	app1 := createNamespacedApp("app1", "https://cluster", "ns")
+	app2 := createNamespacedApp("app2", "https://cluster", "ns")
+	assert.Equal(t, GetApplicationShard(&app1, common.ApplicationShardingByNamespace, 3), GetApplicationShard(&app2, common.ApplicationShardingByNamespace, 3))
+	assert.Equal(t, 0, GetApplicationShard(&app1, common.ApplicationShardingByApplication, 1))
+	assert.Equal(t, -1, GetApplicationShard(&app1, common.ApplicationShardingByApplication, 0))
+
+	shards := map[int]bool{}
+	for i := 0; i < 20; i++ {
+		app := createNamespacedApp(fmt.Sprintf("app-%d", i), "https://cluster", "ns")
+		shards[GetApplicationShard(&app, common.ApplicationShardingByApplication, 2)] = true
+	}
+	assert.Equal(t, map[int]bool{0: true, 1: true}, shards)
+}
+
+func TestClusterSharding_ApplicationSharding(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	replicas := 2
+	ns0, ns1 := getNamespaceForShard(0, replicas), getNamespaceForShard(1, replicas)
+
+	sharded := v1alpha1.Cluster{ID: "1", Name: "sharded", Server: "https://sharded", Annotations: map[string]string{common.AnnotationKeyApplicationSharding: common.ApplicationShardingByNamespace}}
+	other := v1alpha1.Cluster{ID: "2", Name: "other", Server: "https://other"}
+	apps := []v1alpha1.Application{
+		createNamespacedApp("app1", sharded.Server, ns0, "extra"),
+		createNamespacedApp("app2", sharded.Server, ns1),
+		createNamespacedApp("app3", "", ns0, "named"),
+		createNamespacedApp("app4", other.Server, ns0),
+	}
+	apps[2].Spec.Destination.Name = sharded.Name
+
+	shardings := make([]*ClusterSharding, replicas)
+	for shard := range shardings {
+		shardings[shard] = NewClusterSharding(db, shard, replicas, common.DefaultShardingAlgorithm).(*ClusterSharding)
+		shardings[shard].Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{sharded, other}}, &v1alpha1.ApplicationList{Items: apps})
+	}
+	otherShard := shardings[0].GetDistribution()[other.Server]
+
+	for shard, sharding := range shardings {
+		// every shard watches the cluster whose applications are distributed across the shards
+		assert.True(t, sharding.IsWatchedCluster(&sharded))
+		assert.Equal(t, shard == otherShard, sharding.IsWatchedCluster(&other))
+		// the applications of the cluster are distributed by namespace
+		assert.Equal(t, shard == 0, sharding.IsManagedApp(&apps[0], &sharded))
+		assert.Equal(t, shard == 1, sharding.IsManagedApp(&apps[1], &sharded))
+		assert.Equal(t, shard == otherShard, sharding.IsManagedApp(&apps[3], &other))
+
+		_, ok := sharding.GetWatchedResources(other.Server)
+		assert.False(t, ok)
+		_, ok = sharding.GetWatchedResources("https://unknown")
+		assert.False(t, ok)
+	}
+
+	watched, ok := shardings[0].GetWatchedResources(sharded.Server)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{ns0, "extra", "named"}, watched.Namespaces)
+	assert.True(t, watched.ClusterResources)
+	watched, ok = shardings[1].GetWatchedResources(sharded.Server)
+	assert.True(t, ok)
+	assert.Equal(t, []string{ns1}, watched.Namespaces)
+
+	// the namespaces configured in the cluster restrict the watched namespaces
+	sharded.Namespaces = []string{ns0, "named"}
+	shardings[0].Update(&sharded, &sharded)
+	watched, _ = shardings[0].GetWatchedResources(sharded.Server)
+	assert.ElementsMatch(t, []string{ns0, "named"}, watched.Namespaces)
+	assert.False(t, watched.ClusterResources)
+
+	// applications are processed by the shard of the cluster with a single replica
+	single := NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm)
+	single.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{sharded}}, &v1alpha1.ApplicationList{Items: apps})
+	assert.True(t, single.IsManagedApp(&apps[1], &sharded))
+	_, ok = single.GetWatchedResources(sharded.Server)
+	assert.False(t, ok)
+}
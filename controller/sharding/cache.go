@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"maps"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/db"
 )
@@ -18,33 +20,51 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
+	IsWatchedCluster(c *v1alpha1.Cluster) bool
+	GetWatchedResources(server string) (*WatchedResources, bool)
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
+	GetShard() int
+	UsesClusterLoads() bool
+	GetLoadBasedAssignment(loads map[string]ClusterLoad) map[string]int
+	UpdateClusterAssignment(version int64, assignment map[string]int) bool
 }
 
 type ClusterSharding struct {
-	Shard           int
-	Replicas        int
-	Shards          map[string]int
-	Clusters        map[string]*v1alpha1.Cluster
-	Apps            map[string]*v1alpha1.Application
-	lock            sync.RWMutex
-	getClusterShard DistributionFunction
+	Shard    int
+	Replicas int
+	Shards   map[string]int
+	Clusters map[string]*v1alpha1.Cluster
+	Apps     map[string]*v1alpha1.Application
+	// ClusterAssignment contains the shard of the clusters assigned by the leader shard indexed by cluster server
+	ClusterAssignment map[string]int
+	// clusterAssignmentVersion is the version of the ClusterAssignment
+	clusterAssignmentVersion int64
+	lock                     sync.RWMutex
+	getClusterShard          DistributionFunction
+	shardingAlgorithm        string
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
 	log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 	clusterSharding := &ClusterSharding{
-		Shard:    shard,
-		Replicas: replicas,
-		Shards:   make(map[string]int),
-		Clusters: make(map[string]*v1alpha1.Cluster),
-		Apps:     make(map[string]*v1alpha1.Application),
+		Shard:             shard,
+		Replicas:          replicas,
+		Shards:            make(map[string]int),
+		Clusters:          make(map[string]*v1alpha1.Cluster),
+		Apps:              make(map[string]*v1alpha1.Application),
+		ClusterAssignment: make(map[string]int),
+		shardingAlgorithm: shardingAlgorithm,
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		if shardingAlgorithm == common.LoadBasedShardingAlgorithm {
+			distributionFunction = LoadBasedDistributionFunction(clusterSharding.getClusterAssignmentAccessor(), replicas)
+		} else {
+			distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		}
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -82,7 +102,7 @@ func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1al
 	newApps := make(map[string]*v1alpha1.Application, len(apps.Items))
 	for i := range apps.Items {
 		app := apps.Items[i]
-		newApps[app.Name] = &app
+		newApps[app.QualifiedName()] = &app
 	}
 	sharding.Apps = newApps
 	sharding.updateDistribution()
@@ -210,12 +230,63 @@ func (d *ClusterSharding) getAppAccessor() appAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getClusterAssignmentAccessor.
+func (d *ClusterSharding) getClusterAssignmentAccessor() clusterAssignmentAccessor {
+	return func() map[string]int {
+		return d.ClusterAssignment
+	}
+}
+
+// GetShard returns the shard processed by the controller
+func (sharding *ClusterSharding) GetShard() int {
+	return sharding.Shard
+}
+
+// UsesClusterLoads returns whether the cluster distribution depends on the measured load of the clusters
+func (sharding *ClusterSharding) UsesClusterLoads() bool {
+	return sharding.Replicas > 1 && sharding.shardingAlgorithm == common.LoadBasedShardingAlgorithm
+}
+
+// GetLoadBasedAssignment returns the shard of the clusters indexed by cluster server, balanced according to the
+// given loads of the clusters indexed by cluster server, starting from the current distribution.
+func (sharding *ClusterSharding) GetLoadBasedAssignment(loads map[string]ClusterLoad) map[string]int {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	shardIndexedByCluster := createLoadBasedDistribution(sharding.Replicas, sharding.getClusterAccessor(), loads, sharding.Shards)
+	assignment := make(map[string]int, len(shardIndexedByCluster))
+	for _, c := range sharding.Clusters {
+		if shard, ok := shardIndexedByCluster[c.ID]; ok {
+			assignment[c.Server] = shard
+		}
+	}
+	return assignment
+}
+
+// UpdateClusterAssignment updates the assignment of the clusters published by the leader shard, unless the given
+// version is older than the current one, and recomputes the cluster distribution if it depends on the assignment.
+// It returns true if the shard of any cluster has changed.
+func (sharding *ClusterSharding) UpdateClusterAssignment(version int64, assignment map[string]int) bool {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	if version <= sharding.clusterAssignmentVersion {
+		return false
+	}
+	sharding.ClusterAssignment = assignment
+	sharding.clusterAssignmentVersion = version
+	if !sharding.UsesClusterLoads() {
+		return false
+	}
+	previous := maps.Clone(sharding.Shards)
+	sharding.updateDistribution()
+	return !maps.Equal(previous, sharding.Shards)
+}
+
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
-	_, ok := sharding.Apps[a.Name]
-	sharding.Apps[a.Name] = a
+	_, ok := sharding.Apps[a.QualifiedName()]
+	sharding.Apps[a.QualifiedName()] = a
 	if !ok {
 		sharding.updateDistribution()
 	} else {
@@ -226,8 +297,8 @@ func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 func (sharding *ClusterSharding) DeleteApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
-	if _, ok := sharding.Apps[a.Name]; ok {
-		delete(sharding.Apps, a.Name)
+	if _, ok := sharding.Apps[a.QualifiedName()]; ok {
+		delete(sharding.Apps, a.QualifiedName())
 		sharding.updateDistribution()
 	}
 }
@@ -236,8 +307,8 @@ func (sharding *ClusterSharding) UpdateApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
-	_, ok := sharding.Apps[a.Name]
-	sharding.Apps[a.Name] = a
+	_, ok := sharding.Apps[a.QualifiedName()]
+	sharding.Apps[a.QualifiedName()] = a
 	if !ok {
 		sharding.updateDistribution()
 	} else {
@@ -0,0 +1,527 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+const (
+	// ShardClusterLoadKeyPrefix is the prefix of the keys of the shard mapping ConfigMap which store the load of the
+	// clusters managed by each shard
+	ShardClusterLoadKeyPrefix = "shardClusterLoad."
+	// ShardClusterAssignmentKey is the key of the shard mapping ConfigMap which stores the shards of the clusters, as
+	// assigned by the leader shard from the load of the clusters
+	ShardClusterAssignmentKey = "shardClusterAssignment"
+	// loadLeaderShard is the shard which assigns the clusters to the shards. Assigning the clusters in a single shard
+	// guarantees that all shards agree on the assignment, whereas the shards may read the published loads at different
+	// times.
+	loadLeaderShard = 0
+)
+
+var (
+	// LoadTolerance is the percentage by which the load of a shard may exceed the average load before clusters are
+	// moved to other shards
+	LoadTolerance = env.ParseNumFromEnv(common.EnvControllerShardingLoadTolerance, 20, 0, 1000)
+	// LoadPublishInterval is the interval at which each shard publishes the load of its clusters
+	LoadPublishInterval = env.ParseDurationFromEnv(common.EnvControllerShardingLoadPublishInterval, time.Minute, 10*time.Second, time.Hour)
+)
+
+// Make it overridable for testing
+var loadCurrentTime = metav1.Now
+
+type clusterAssignmentAccessor func() map[string]int
+
+// ClusterLoad is the measured cost of managing a cluster
+type ClusterLoad struct {
+	// Resources is the number of resources watched in the cluster
+	Resources int64
+	// EventsPerSecond is the rate of resource events received from the cluster
+	EventsPerSecond float64
+	// ReconcileSecondsPerMinute is the time spent per minute reconciling the applications of the cluster
+	ReconcileSecondsPerMinute float64
+}
+
+// shardClusterLoad stores the load of the clusters managed by a shard in the shard mapping ConfigMap.
+type shardClusterLoad struct {
+	ShardNumber int
+	UpdatedTime metav1.Time
+	// Clusters contains the load of the clusters managed by the shard indexed by cluster server
+	Clusters map[string]ClusterLoad
+}
+
+// clusterAssignment stores the shards of the clusters assigned by the leader shard in the shard mapping ConfigMap.
+type clusterAssignment struct {
+	// Version is incremented every time the assignment changes, so that the shards never go back to an older
+	// assignment
+	Version     int64
+	UpdatedTime metav1.Time
+	// Shards contains the shard of the clusters indexed by cluster server
+	Shards map[string]int
+}
+
+// LoadBasedDistributionFunction returns a DistributionFunction using the assignment of the clusters published by the
+// leader shard, which is based on the measured load of the clusters (see createLoadBasedDistribution). Clusters which
+// have not been assigned by the leader yet are distributed like with the legacy algorithm, which all shards agree on.
+func LoadBasedDistributionFunction(assignment clusterAssignmentAccessor, replicas int) DistributionFunction {
+	legacyDistribution := LegacyDistributionFunction(replicas)
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shard, ok := assignment()[c.Server]
+			if !ok || shard < 0 || shard >= replicas {
+				log.Debugf("Cluster with id=%s is not assigned by the leader shard yet", c.ID)
+				return legacyDistribution(c)
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// createLoadBasedDistribution returns the shard of the clusters indexed by cluster id based on their measured load:
+// the clusters stay on the shard which currently manages them, given by owners indexed by cluster server, unless the
+// load of this shard exceeds the average load of the shards by more than the LoadTolerance. In that case, clusters
+// are moved to the least loaded shards until the load is balanced. New clusters are assigned to the least loaded
+// shard.
+func createLoadBasedDistribution(replicas int, getCluster clusterAccessor, loads map[string]ClusterLoad, owners map[string]int) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	costs := getClusterCosts(clusters, loads)
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	shardLoads := make([]float64, replicas)
+	movable := make(map[string]bool, len(clusters))
+
+	// keep the clusters on the shard which currently manages them to avoid moving clusters without need
+	var unassigned []*v1alpha1.Cluster
+	for _, c := range clusters {
+		shard := -1
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			shard = int(*c.Shard)
+		} else if owner, ok := owners[c.Server]; ok && owner >= 0 && owner < replicas {
+			shard = owner
+			movable[c.ID] = true
+		}
+		if shard < 0 {
+			unassigned = append(unassigned, c)
+			continue
+		}
+		shardIndexedByCluster[c.ID] = shard
+		shardLoads[shard] += costs[c.ID]
+	}
+
+	// assign the new clusters to the least loaded shard, starting with the most expensive ones
+	sort.SliceStable(unassigned, func(i, j int) bool {
+		return costs[unassigned[i].ID] > costs[unassigned[j].ID]
+	})
+	for _, c := range unassigned {
+		shard := leastLoadedShard(shardLoads)
+		shardIndexedByCluster[c.ID] = shard
+		shardLoads[shard] += costs[c.ID]
+		movable[c.ID] = true
+	}
+
+	var total float64
+	for _, load := range shardLoads {
+		total += load
+	}
+	limit := total / float64(replicas) * (1 + float64(LoadTolerance)/100)
+
+	// move clusters away from the most loaded shard as long as it exceeds the tolerated load and a move reduces the
+	// load of the most loaded shard
+	for i := 0; i < len(clusters); i++ {
+		from, to := mostLoadedShard(shardLoads), leastLoadedShard(shardLoads)
+		if shardLoads[from] <= limit {
+			break
+		}
+		var candidate *v1alpha1.Cluster
+		maxLoad := shardLoads[from]
+		for _, c := range clusters {
+			if !movable[c.ID] || shardIndexedByCluster[c.ID] != from {
+				continue
+			}
+			if load := math.Max(shardLoads[from]-costs[c.ID], shardLoads[to]+costs[c.ID]); load < maxLoad {
+				candidate, maxLoad = c, load
+			}
+		}
+		if candidate == nil {
+			break
+		}
+		log.Debugf("Moving cluster with id=%s from shard %d to shard %d to balance the load", candidate.ID, from, to)
+		shardIndexedByCluster[candidate.ID] = to
+		shardLoads[from] -= costs[candidate.ID]
+		shardLoads[to] += costs[candidate.ID]
+	}
+	return shardIndexedByCluster
+}
+
+// getClusterCosts returns the cost of each cluster indexed by cluster id, which is the average share of the cluster
+// in each of the measured load metrics. Clusters which have not been measured yet are assumed to have the average
+// cost of the measured clusters.
+func getClusterCosts(clusters []*v1alpha1.Cluster, loads map[string]ClusterLoad) map[string]float64 {
+	var totalResources, totalEvents, totalReconcile float64
+	for _, c := range clusters {
+		if load, ok := loads[c.Server]; ok {
+			totalResources += float64(load.Resources)
+			totalEvents += load.EventsPerSecond
+			totalReconcile += load.ReconcileSecondsPerMinute
+		}
+	}
+
+	costs := make(map[string]float64, len(clusters))
+	var measuredCost float64
+	var measured int
+	for _, c := range clusters {
+		load, ok := loads[c.Server]
+		if !ok {
+			continue
+		}
+		var cost float64
+		var metricsCount int
+		for _, share := range []struct{ value, total float64 }{
+			{float64(load.Resources), totalResources},
+			{load.EventsPerSecond, totalEvents},
+			{load.ReconcileSecondsPerMinute, totalReconcile},
+		} {
+			if share.total > 0 {
+				cost += share.value / share.total
+				metricsCount++
+			}
+		}
+		if metricsCount == 0 {
+			cost = 1
+		} else {
+			cost /= float64(metricsCount)
+		}
+		costs[c.ID] = cost
+		measuredCost += cost
+		measured++
+	}
+
+	defaultCost := 1.0
+	if measured > 0 && measuredCost > 0 {
+		defaultCost = measuredCost / float64(measured)
+	}
+	for _, c := range clusters {
+		if _, ok := costs[c.ID]; !ok {
+			costs[c.ID] = defaultCost
+		}
+	}
+	return costs
+}
+
+func leastLoadedShard(shardLoads []float64) int {
+	shard := 0
+	for i := range shardLoads {
+		if shardLoads[i] < shardLoads[shard] {
+			shard = i
+		}
+	}
+	return shard
+}
+
+func mostLoadedShard(shardLoads []float64) int {
+	shard := 0
+	for i := range shardLoads {
+		if shardLoads[i] > shardLoads[shard] {
+			shard = i
+		}
+	}
+	return shard
+}
+
+// getClusterLoads returns the load of the clusters published by all shards in the shard mapping ConfigMap indexed by
+// cluster server. Loads which have not been updated for three publishing intervals are ignored, as the shard which
+// published them is not running anymore.
+func getClusterLoads(cm *v1.ConfigMap) (map[string]ClusterLoad, error) {
+	loads := make(map[string]ClusterLoad)
+	updated := make(map[string]time.Time)
+	staleTime := loadCurrentTime().Add(-3 * LoadPublishInterval)
+	for key, data := range cm.Data {
+		if !strings.HasPrefix(key, ShardClusterLoadKeyPrefix) {
+			continue
+		}
+		var shardLoad shardClusterLoad
+		if err := json.Unmarshal([]byte(data), &shardLoad); err != nil {
+			return nil, fmt.Errorf("error unmarshalling cluster load of key %s: %w", key, err)
+		}
+		if shardLoad.UpdatedTime.Time.Before(staleTime) {
+			continue
+		}
+		for server, load := range shardLoad.Clusters {
+			// a cluster moved to another shard may be published by both shards until the previous one publishes again
+			if t, ok := updated[server]; ok && !shardLoad.UpdatedTime.Time.After(t) {
+				continue
+			}
+			loads[server] = load
+			updated[server] = shardLoad.UpdatedTime.Time
+		}
+	}
+	return loads, nil
+}
+
+// getClusterAssignment returns the assignment of the clusters published by the leader shard in the shard mapping
+// ConfigMap, which is empty if the leader did not publish any assignment yet.
+func getClusterAssignment(cm *v1.ConfigMap) (*clusterAssignment, error) {
+	assignment := &clusterAssignment{}
+	data, ok := cm.Data[ShardClusterAssignmentKey]
+	if !ok {
+		return assignment, nil
+	}
+	if err := json.Unmarshal([]byte(data), assignment); err != nil {
+		return nil, fmt.Errorf("error unmarshalling cluster assignment: %w", err)
+	}
+	return assignment, nil
+}
+
+// ClusterLoadPublisher periodically publishes the load of the clusters managed by the shard in the shard mapping
+// ConfigMap. The publisher of the leader shard also assigns the clusters to the shards from the load published by all
+// shards, and the publishers of all shards update the cluster distribution with the published assignment.
+type ClusterLoadPublisher struct {
+	kubeClient    kubernetes.Interface
+	namespace     string
+	sharding      ClusterShardingCache
+	infoSource    metrics.HasClustersInfo
+	statsSource   func() map[string]metrics.ClusterLoadStats
+	onChange      func()
+	lastStats     map[string]metrics.ClusterLoadStats
+	lastStatsTime time.Time
+	// lastOwned contains the servers of the clusters managed by the shard at the last measure
+	lastOwned map[string]bool
+
+	lock sync.Mutex
+	// publishedLoads contains the last load published by any shard indexed by cluster server, as seen when the
+	// cluster assignment was applied
+	publishedLoads map[string]ClusterLoad
+}
+
+// NewClusterLoadPublisher creates a ClusterLoadPublisher measuring the number of watched resources using infoSource,
+// and the rate of resource events and the reconciliation time using statsSource. onChange is called when the shard
+// of any cluster has changed.
+func NewClusterLoadPublisher(kubeClient kubernetes.Interface, namespace string, sharding ClusterShardingCache, infoSource metrics.HasClustersInfo, statsSource func() map[string]metrics.ClusterLoadStats, onChange func()) *ClusterLoadPublisher {
+	return &ClusterLoadPublisher{
+		kubeClient:     kubeClient,
+		namespace:      namespace,
+		sharding:       sharding,
+		infoSource:     infoSource,
+		statsSource:    statsSource,
+		onChange:       onChange,
+		publishedLoads: map[string]ClusterLoad{},
+	}
+}
+
+// Run publishes the cluster load every LoadPublishInterval until the context is done, and applies the cluster
+// assignment as soon as the leader shard publishes it
+func (p *ClusterLoadPublisher) Run(ctx context.Context) {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(p.kubeClient, 0,
+		informers.WithNamespace(p.namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", common.ArgoCDAppControllerShardConfigMapName).String()
+		}))
+	_, err := informerFactory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if cm, ok := obj.(*v1.ConfigMap); ok {
+				p.applyAssignment(cm)
+			}
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			if cm, ok := obj.(*v1.ConfigMap); ok {
+				p.applyAssignment(cm)
+			}
+		},
+	})
+	if err != nil {
+		log.Warnf("Failed to watch the cluster assignment: %v", err)
+	}
+	informerFactory.Start(ctx.Done())
+
+	ticker := time.NewTicker(LoadPublishInterval)
+	defer ticker.Stop()
+	for {
+		if err := p.publish(ctx); err != nil {
+			log.Warnf("Failed to publish cluster load: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// measure returns the load of the clusters managed by the shard indexed by cluster server. A cluster is only measured
+// once the shard managed it for a full interval, i.e. after the cluster moved to the shard or the shard started, as
+// its rates and resources are not known before. Until then, the load last published by any shard, usually the previous
+// owner of the cluster, is published again, so that the leader does not move clusters back and forth because a moved
+// cluster appears to have no load.
+func (p *ClusterLoadPublisher) measure(now time.Time) map[string]ClusterLoad {
+	shard := p.sharding.GetShard()
+	distribution := p.sharding.GetDistribution()
+	stats := p.statsSource()
+	elapsed := now.Sub(p.lastStatsTime)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	loads := make(map[string]ClusterLoad)
+	owned := make(map[string]bool)
+	for _, info := range p.infoSource.GetClustersInfo() {
+		if s, ok := distribution[info.Server]; !ok || s != shard {
+			continue
+		}
+		owned[info.Server] = true
+		if !p.lastOwned[info.Server] || elapsed <= 0 {
+			if load, ok := p.publishedLoads[info.Server]; ok {
+				loads[info.Server] = load
+			}
+			continue
+		}
+		// the metrics of a cluster which has no stats at the last measure were created since then
+		current, last := stats[info.Server], p.lastStats[info.Server]
+		// metrics are reset when they expire
+		if current.EventsTotal < last.EventsTotal || current.ReconcileSecondsTotal < last.ReconcileSecondsTotal {
+			last = metrics.ClusterLoadStats{}
+		}
+		loads[info.Server] = ClusterLoad{
+			Resources:                 int64(info.ResourcesCount),
+			EventsPerSecond:           (current.EventsTotal - last.EventsTotal) / elapsed.Seconds(),
+			ReconcileSecondsPerMinute: (current.ReconcileSecondsTotal - last.ReconcileSecondsTotal) / elapsed.Minutes(),
+		}
+	}
+	// forget the loads of removed clusters
+	for server := range p.publishedLoads {
+		if _, ok := distribution[server]; !ok {
+			delete(p.publishedLoads, server)
+		}
+	}
+	p.lastStats = stats
+	p.lastStatsTime = now
+	p.lastOwned = owned
+	return loads
+}
+
+// publish stores the load of the clusters managed by the shard in the shard mapping ConfigMap, along with the
+// assignment of the clusters if the shard is the leader, and updates the cluster distribution with the assignment
+func (p *ClusterLoadPublisher) publish(ctx context.Context) error {
+	now := loadCurrentTime()
+	data, err := json.Marshal(shardClusterLoad{
+		ShardNumber: p.sharding.GetShard(),
+		UpdatedTime: now,
+		Clusters:    p.measure(now.Time),
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling cluster load: %w", err)
+	}
+	key := ShardClusterLoadKeyPrefix + strconv.Itoa(p.sharding.GetShard())
+
+	var shardMappingCM *v1.ConfigMap
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := p.kubeClient.CoreV1().ConfigMaps(p.namespace).Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			if !kubeerrors.IsNotFound(err) {
+				return fmt.Errorf("error getting sharding config map: %w", err)
+			}
+			cm = &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: p.namespace}}
+			cm.Data = map[string]string{key: string(data)}
+			shardMappingCM, err = p.kubeClient.CoreV1().ConfigMaps(p.namespace).Create(ctx, cm, metav1.CreateOptions{})
+			if kubeerrors.IsAlreadyExists(err) {
+				return kubeerrors.NewConflict(v1.Resource("configmaps"), cm.Name, err)
+			}
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[key] = string(data)
+		if p.sharding.GetShard() == loadLeaderShard {
+			if err := p.assign(cm, now); err != nil {
+				return err
+			}
+		}
+		shardMappingCM, err = p.kubeClient.CoreV1().ConfigMaps(p.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error updating cluster load in the shard mapping ConfigMap: %w", err)
+	}
+	p.applyAssignment(shardMappingCM)
+	return nil
+}
+
+// assign computes the assignment of the clusters from the loads published in the given shard mapping ConfigMap, and
+// stores it in the ConfigMap with a new version if it has changed. The clusters are assigned starting from the
+// current distribution, which all shards agree on.
+func (p *ClusterLoadPublisher) assign(cm *v1.ConfigMap, now metav1.Time) error {
+	loads, err := getClusterLoads(cm)
+	if err != nil {
+		return err
+	}
+	current, err := getClusterAssignment(cm)
+	if err != nil {
+		return err
+	}
+	shards := p.sharding.GetLoadBasedAssignment(loads)
+	if current.Version > 0 && maps.Equal(current.Shards, shards) {
+		return nil
+	}
+	data, err := json.Marshal(clusterAssignment{
+		Version:     current.Version + 1,
+		UpdatedTime: now,
+		Shards:      shards,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling cluster assignment: %w", err)
+	}
+	cm.Data[ShardClusterAssignmentKey] = string(data)
+	return nil
+}
+
+// applyAssignment updates the cluster distribution with the assignment published in the given shard mapping
+// ConfigMap, unless it is older than the assignment already applied. It also records the loads published in the
+// ConfigMap, which still contain the clusters moved by the assignment as published by their previous owner.
+func (p *ClusterLoadPublisher) applyAssignment(cm *v1.ConfigMap) {
+	if loads, err := getClusterLoads(cm); err != nil {
+		log.Warnf("Failed to get the cluster loads: %v", err)
+	} else {
+		p.lock.Lock()
+		maps.Copy(p.publishedLoads, loads)
+		p.lock.Unlock()
+	}
+	assignment, err := getClusterAssignment(cm)
+	if err != nil {
+		log.Warnf("Failed to get the cluster assignment: %v", err)
+		return
+	}
+	if p.sharding.UpdateClusterAssignment(assignment.Version, assignment.Shards) && p.onChange != nil {
+		p.onChange()
+	}
+}
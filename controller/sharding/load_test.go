@@ -0,0 +1,363 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+func getClusterAssignmentAccessor(assignment map[string]int) clusterAssignmentAccessor {
+	return func() map[string]int { return assignment }
+}
+
+func TestLoadBasedDistributionFunction(t *testing.T) {
+	clusters := []v1alpha1.Cluster{createCluster("cluster1", "1"), createCluster("cluster2", "2"), createCluster("cluster3", "3")}
+	shard := int64(0)
+	clusters[2].Shard = &shard
+	assignment := map[string]int{
+		clusters[0].Server: 1,
+		clusters[1].Server: 5,
+		clusters[2].Server: 1,
+	}
+	distributionFunction := LoadBasedDistributionFunction(getClusterAssignmentAccessor(assignment), 2)
+	legacyDistributionFunction := LegacyDistributionFunction(2)
+
+	assert.Equal(t, 0, distributionFunction(nil))
+	assert.Equal(t, 1, distributionFunction(&clusters[0]))
+	// the assignment of a shard which does not exist is ignored
+	assert.Equal(t, legacyDistributionFunction(&clusters[1]), distributionFunction(&clusters[1]))
+	// the fixed shard is kept
+	assert.Equal(t, 0, distributionFunction(&clusters[2]))
+	// clusters which are not assigned yet are distributed like with the legacy algorithm
+	unknown := createCluster("cluster4", "4")
+	assert.Equal(t, legacyDistributionFunction(&unknown), distributionFunction(&unknown))
+	assert.Equal(t, -1, LoadBasedDistributionFunction(getClusterAssignmentAccessor(assignment), 0)(&clusters[0]))
+}
+
+func TestLoadBasedDistributionFunction_NewClusters(t *testing.T) {
+	clusters := []v1alpha1.Cluster{createCluster("cluster1", "1"), createCluster("cluster2", "2"), createCluster("cluster3", "3"), createCluster("cluster4", "4")}
+	shard := int64(1)
+	clusters[3].Shard = &shard
+	loads := map[string]ClusterLoad{
+		clusters[0].Server: {Resources: 100},
+		clusters[1].Server: {Resources: 1000},
+		clusters[2].Server: {Resources: 100},
+	}
+	distribution := createLoadBasedDistribution(2, getClusterAccessor(clusters), loads, nil)
+
+	// the fixed shard is kept, the most expensive cluster is assigned first to the least loaded shard
+	assert.Equal(t, map[string]int{"1": 1, "2": 0, "3": 1, "4": 1}, distribution)
+}
+
+func TestLoadBasedDistributionFunction_KeepsOwnersWithinTolerance(t *testing.T) {
+	clusters := []v1alpha1.Cluster{createCluster("cluster1", "1"), createCluster("cluster2", "2"), createCluster("cluster3", "3"), createCluster("cluster4", "4")}
+	loads := map[string]ClusterLoad{
+		clusters[0].Server: {Resources: 100, EventsPerSecond: 1},
+		clusters[1].Server: {Resources: 110, EventsPerSecond: 1},
+		clusters[2].Server: {Resources: 100, EventsPerSecond: 1},
+		clusters[3].Server: {Resources: 90, EventsPerSecond: 1},
+	}
+	owners := map[string]int{
+		clusters[0].Server: 1,
+		clusters[1].Server: 1,
+		clusters[2].Server: 0,
+		clusters[3].Server: 0,
+	}
+	distribution := createLoadBasedDistribution(2, getClusterAccessor(clusters), loads, owners)
+
+	assert.Equal(t, map[string]int{"1": 1, "2": 1, "3": 0, "4": 0}, distribution)
+}
+
+func TestLoadBasedDistributionFunction_Rebalances(t *testing.T) {
+	clusters := []v1alpha1.Cluster{createCluster("cluster1", "1"), createCluster("cluster2", "2"), createCluster("cluster3", "3"), createCluster("cluster4", "4")}
+	loads := map[string]ClusterLoad{
+		clusters[0].Server: {Resources: 100},
+		clusters[1].Server: {Resources: 40000},
+		clusters[2].Server: {Resources: 100},
+		clusters[3].Server: {Resources: 100},
+	}
+	owners := map[string]int{
+		clusters[0].Server: 0,
+		clusters[1].Server: 0,
+		clusters[2].Server: 0,
+		clusters[3].Server: 0,
+	}
+	distribution := createLoadBasedDistribution(2, getClusterAccessor(clusters), loads, owners)
+
+	// only the expensive cluster is moved, as moving the other ones would not reduce the load of the most loaded shard
+	assert.Equal(t, map[string]int{"1": 0, "2": 1, "3": 0, "4": 0}, distribution)
+
+	// clusters manually assigned to a shard are never moved, the other clusters are moved away from their shard instead
+	shard := int64(0)
+	clusters[1].Shard = &shard
+	distribution = createLoadBasedDistribution(2, getClusterAccessor(clusters), loads, owners)
+	assert.Equal(t, map[string]int{"1": 1, "2": 0, "3": 1, "4": 1}, distribution)
+}
+
+func TestGetClusterCosts(t *testing.T) {
+	clusters := getClusterPointers([]v1alpha1.Cluster{createCluster("cluster1", "1"), createCluster("cluster2", "2"), createCluster("cluster3", "3")})
+
+	t.Run("Measured", func(t *testing.T) {
+		costs := getClusterCosts(clusters, map[string]ClusterLoad{
+			clusters[0].Server: {Resources: 300, EventsPerSecond: 1},
+			clusters[1].Server: {Resources: 100, EventsPerSecond: 3},
+		})
+		assert.InDelta(t, 0.5, costs["1"], 0.0001)
+		assert.InDelta(t, 0.5, costs["2"], 0.0001)
+		// the cluster which has not been measured yet has the average cost
+		assert.InDelta(t, 0.5, costs["3"], 0.0001)
+	})
+
+	t.Run("NotMeasured", func(t *testing.T) {
+		costs := getClusterCosts(clusters, map[string]ClusterLoad{})
+		assert.Equal(t, map[string]float64{"1": 1, "2": 1, "3": 1}, costs)
+	})
+}
+
+func TestGetClusterLoads(t *testing.T) {
+	now := metav1.Now()
+	defer func() { loadCurrentTime = metav1.Now }()
+	loadCurrentTime = func() metav1.Time { return now }
+
+	marshal := func(load shardClusterLoad) string {
+		data, err := json.Marshal(load)
+		require.NoError(t, err)
+		return string(data)
+	}
+	cm := &v1.ConfigMap{Data: map[string]string{
+		ShardControllerMappingKey: "[]",
+		ShardClusterLoadKeyPrefix + "0": marshal(shardClusterLoad{
+			ShardNumber: 0,
+			UpdatedTime: metav1.NewTime(now.Add(-time.Minute)),
+			Clusters:    map[string]ClusterLoad{"https://cluster1": {Resources: 1}, "https://cluster2": {Resources: 2}},
+		}),
+		ShardClusterLoadKeyPrefix + "1": marshal(shardClusterLoad{
+			ShardNumber: 1,
+			UpdatedTime: now,
+			Clusters:    map[string]ClusterLoad{"https://cluster2": {Resources: 3}},
+		}),
+		ShardClusterLoadKeyPrefix + "2": marshal(shardClusterLoad{
+			ShardNumber: 2,
+			UpdatedTime: metav1.NewTime(now.Add(-4 * LoadPublishInterval)),
+			Clusters:    map[string]ClusterLoad{"https://cluster3": {Resources: 4}},
+		}),
+	}}
+
+	loads, err := getClusterLoads(cm)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]ClusterLoad{"https://cluster1": {Resources: 1}, "https://cluster2": {Resources: 3}}, loads)
+
+	cm.Data[ShardClusterLoadKeyPrefix+"3"] = "invalid"
+	_, err = getClusterLoads(cm)
+	assert.Error(t, err)
+}
+
+func TestGetClusterAssignment(t *testing.T) {
+	assignment, err := getClusterAssignment(&v1.ConfigMap{})
+	require.NoError(t, err)
+	assert.Equal(t, &clusterAssignment{}, assignment)
+
+	assignment, err = getClusterAssignment(&v1.ConfigMap{Data: map[string]string{ShardClusterAssignmentKey: `{"Version":3,"Shards":{"https://cluster1":1}}`}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), assignment.Version)
+	assert.Equal(t, map[string]int{"https://cluster1": 1}, assignment.Shards)
+
+	_, err = getClusterAssignment(&v1.ConfigMap{Data: map[string]string{ShardClusterAssignmentKey: "invalid"}})
+	assert.Error(t, err)
+}
+
+func TestClusterSharding_UpdateClusterAssignment(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	sharding := NewClusterSharding(db, 0, 2, common.LoadBasedShardingAlgorithm).(*ClusterSharding)
+	assert.True(t, sharding.UsesClusterLoads())
+	cluster1, cluster2 := createCluster("cluster1", "1"), createCluster("cluster2", "2")
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2}}, &v1alpha1.ApplicationList{})
+	legacyDistributionFunction := LegacyDistributionFunction(2)
+	assert.Equal(t, map[string]int{cluster1.Server: legacyDistributionFunction(&cluster1), cluster2.Server: legacyDistributionFunction(&cluster2)}, sharding.GetDistribution())
+
+	assignment := map[string]int{cluster1.Server: 1, cluster2.Server: 0}
+	assert.True(t, sharding.UpdateClusterAssignment(1, assignment))
+	assert.Equal(t, assignment, sharding.GetDistribution())
+	assert.False(t, sharding.UpdateClusterAssignment(2, assignment))
+	// older assignments are ignored
+	assert.False(t, sharding.UpdateClusterAssignment(1, map[string]int{cluster1.Server: 0, cluster2.Server: 1}))
+	assert.Equal(t, assignment, sharding.GetDistribution())
+
+	// the assignment is balanced starting from the current distribution
+	loads := map[string]ClusterLoad{cluster1.Server: {Resources: 10}, cluster2.Server: {Resources: 10}}
+	assert.Equal(t, assignment, sharding.GetLoadBasedAssignment(loads))
+
+	assert.False(t, NewClusterSharding(db, 0, 1, common.LoadBasedShardingAlgorithm).UsesClusterLoads())
+	assert.False(t, NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm).UsesClusterLoads())
+}
+
+type fakeClustersInfo []cache.ClusterInfo
+
+func (f fakeClustersInfo) GetClustersInfo() []cache.ClusterInfo {
+	return f
+}
+
+func TestClusterLoadPublisher_Publish(t *testing.T) {
+	now := metav1.Now()
+	defer func() { loadCurrentTime = metav1.Now }()
+	loadCurrentTime = func() metav1.Time { return now }
+
+	db := &dbmocks.ArgoDB{}
+	cluster1, cluster2 := createCluster("cluster1", "1"), createCluster("cluster2", "2")
+	newSharding := func(shard int) ClusterShardingCache {
+		sharding := NewClusterSharding(db, shard, 2, common.LoadBasedShardingAlgorithm)
+		sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2}}, &v1alpha1.ApplicationList{})
+		return sharding
+	}
+	marshal := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{
+			ShardClusterAssignmentKey: marshal(clusterAssignment{Version: 1, Shards: map[string]int{cluster1.Server: 0, cluster2.Server: 1}}),
+		},
+	})
+	getShardMappingCM := func() *v1.ConfigMap {
+		cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		return cm
+	}
+	getShardLoad := func(shard string) shardClusterLoad {
+		var shardLoad shardClusterLoad
+		require.NoError(t, json.Unmarshal([]byte(getShardMappingCM().Data[ShardClusterLoadKeyPrefix+shard]), &shardLoad))
+		return shardLoad
+	}
+	getAssignment := func() *clusterAssignment {
+		assignment, err := getClusterAssignment(getShardMappingCM())
+		require.NoError(t, err)
+		return assignment
+	}
+
+	leaderSharding := newSharding(0)
+	infoSource := fakeClustersInfo{{Server: cluster1.Server, ResourcesCount: 100}, {Server: cluster2.Server, ResourcesCount: 100}}
+	stats := map[string]metrics.ClusterLoadStats{cluster1.Server: {EventsTotal: 10, ReconcileSecondsTotal: 5}}
+	changed := 0
+	leader := NewClusterLoadPublisher(kubeClient, "argocd", leaderSharding, infoSource, func() map[string]metrics.ClusterLoadStats { return stats }, func() { changed++ })
+	leader.applyAssignment(getShardMappingCM())
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1}, leaderSharding.GetDistribution())
+	changed = 0
+
+	require.NoError(t, leader.publish(context.Background()))
+	shardLoad := getShardLoad("0")
+	assert.Equal(t, 0, shardLoad.ShardNumber)
+	// the load is unknown until the shard managed the cluster for a full interval
+	assert.Empty(t, shardLoad.Clusters)
+
+	now = metav1.NewTime(now.Add(time.Minute))
+	stats = map[string]metrics.ClusterLoadStats{cluster1.Server: {EventsTotal: 70, ReconcileSecondsTotal: 35}}
+	require.NoError(t, leader.publish(context.Background()))
+	shardLoad = getShardLoad("0")
+	// only the clusters managed by the shard are published
+	assert.Equal(t, map[string]ClusterLoad{cluster1.Server: {Resources: 100, EventsPerSecond: 1, ReconcileSecondsPerMinute: 30}}, shardLoad.Clusters)
+	// the balanced assignment is not published again
+	assert.Equal(t, int64(1), getAssignment().Version)
+	assert.Equal(t, 0, changed)
+
+	// the leader moves a cluster away from an overloaded shard and publishes the new assignment
+	cm := getShardMappingCM()
+	cm.Data[ShardClusterAssignmentKey] = marshal(clusterAssignment{Version: 2, Shards: map[string]int{cluster1.Server: 0, cluster2.Server: 0}})
+	_, err := kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	leader.applyAssignment(getShardMappingCM())
+	assert.Equal(t, 1, changed)
+	require.NoError(t, leader.publish(context.Background()))
+	assignment := getAssignment()
+	assert.Equal(t, int64(3), assignment.Version)
+	assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 0}, assignment.Shards)
+	assert.Equal(t, assignment.Shards, leaderSharding.GetDistribution())
+	assert.Equal(t, 2, changed)
+
+	// the other shards only publish their load and apply the assignment of the leader
+	sharding := newSharding(1)
+	publisher := NewClusterLoadPublisher(kubeClient, "argocd", sharding, infoSource, func() map[string]metrics.ClusterLoadStats { return nil }, nil)
+	require.NoError(t, publisher.publish(context.Background()))
+	assert.Equal(t, 1, getShardLoad("1").ShardNumber)
+	assert.Equal(t, assignment, getAssignment())
+	assert.Equal(t, assignment.Shards, sharding.GetDistribution())
+}
+
+func TestClusterLoadPublisher_PublishAfterMove(t *testing.T) {
+	now := metav1.Now()
+	defer func() { loadCurrentTime = metav1.Now }()
+	loadCurrentTime = func() metav1.Time { return now }
+
+	db := &dbmocks.ArgoDB{}
+	cluster1, cluster2 := createCluster("cluster1", "1"), createCluster("cluster2", "2")
+	marshal := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return string(data)
+	}
+	previousLoad := ClusterLoad{Resources: 1000, EventsPerSecond: 5, ReconcileSecondsPerMinute: 20}
+	kubeClient := kubefake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{
+			ShardClusterAssignmentKey:       marshal(clusterAssignment{Version: 1, Shards: map[string]int{cluster1.Server: 0, cluster2.Server: 1}}),
+			ShardClusterLoadKeyPrefix + "0": marshal(shardClusterLoad{ShardNumber: 0, UpdatedTime: now, Clusters: map[string]ClusterLoad{cluster1.Server: previousLoad}}),
+		},
+	})
+	getShardMappingCM := func() *v1.ConfigMap {
+		cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		return cm
+	}
+	updateShardMappingCM := func(key string, value interface{}) {
+		cm := getShardMappingCM()
+		cm.Data[key] = marshal(value)
+		_, err := kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	sharding := NewClusterSharding(db, 1, 2, common.LoadBasedShardingAlgorithm)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2}}, &v1alpha1.ApplicationList{})
+	infoSource := fakeClustersInfo{{Server: cluster1.Server, ResourcesCount: 0}, {Server: cluster2.Server, ResourcesCount: 100}}
+	stats := map[string]metrics.ClusterLoadStats{cluster2.Server: {EventsTotal: 10}}
+	publisher := NewClusterLoadPublisher(kubeClient, "argocd", sharding, infoSource, func() map[string]metrics.ClusterLoadStats { return stats }, nil)
+	publisher.applyAssignment(getShardMappingCM())
+	require.NoError(t, publisher.publish(context.Background()))
+
+	// the leader moves cluster1 to shard 1, and shard 0 stops publishing its load
+	updateShardMappingCM(ShardClusterAssignmentKey, clusterAssignment{Version: 2, Shards: map[string]int{cluster1.Server: 1, cluster2.Server: 1}})
+	publisher.applyAssignment(getShardMappingCM())
+	updateShardMappingCM(ShardClusterLoadKeyPrefix+"0", shardClusterLoad{ShardNumber: 0, UpdatedTime: now, Clusters: map[string]ClusterLoad{}})
+
+	// in the interval right after the move, shard 1 publishes the load measured by shard 0
+	now = metav1.NewTime(now.Add(time.Minute))
+	stats = map[string]metrics.ClusterLoadStats{cluster2.Server: {EventsTotal: 70}}
+	require.NoError(t, publisher.publish(context.Background()))
+	loads, err := getClusterLoads(getShardMappingCM())
+	require.NoError(t, err)
+	assert.Equal(t, previousLoad, loads[cluster1.Server])
+	assert.Equal(t, ClusterLoad{Resources: 100, EventsPerSecond: 1}, loads[cluster2.Server])
+
+	// once shard 1 managed the cluster for a full interval, it publishes its own measure
+	now = metav1.NewTime(now.Add(time.Minute))
+	infoSource[0].ResourcesCount = 900
+	stats = map[string]metrics.ClusterLoadStats{cluster1.Server: {EventsTotal: 240, ReconcileSecondsTotal: 30}, cluster2.Server: {EventsTotal: 130}}
+	require.NoError(t, publisher.publish(context.Background()))
+	loads, err = getClusterLoads(getShardMappingCM())
+	require.NoError(t, err)
+	assert.Equal(t, ClusterLoad{Resources: 900, EventsPerSecond: 4, ReconcileSecondsPerMinute: 30}, loads[cluster1.Server])
+}
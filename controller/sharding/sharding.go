@@ -332,11 +332,13 @@ func GetOrUpdateShardFromConfigMap(kubeClient kubernetes.Interface, settingsMgr
 		return shard, nil
 	} else {
 		// Identify the available shard and update the ConfigMap
-		data := shardMappingCM.Data[ShardControllerMappingKey]
 		var shardMappingData []shardApplicationControllerMapping
-		err := json.Unmarshal([]byte(data), &shardMappingData)
-		if err != nil {
-			return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+		// the shard mapping may be missing if the configmap was created to publish the cluster load
+		if data, ok := shardMappingCM.Data[ShardControllerMappingKey]; ok {
+			err := json.Unmarshal([]byte(data), &shardMappingData)
+			if err != nil {
+				return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+			}
 		}
 
 		shard, shardMappingData := getOrUpdateShardNumberForController(shardMappingData, hostname, replicas, shard)
@@ -344,6 +346,9 @@ func GetOrUpdateShardFromConfigMap(kubeClient kubernetes.Interface, settingsMgr
 		if err != nil {
 			return -1, fmt.Errorf("error marshalling data of shard mapping ConfigMap: %w", err)
 		}
+		if shardMappingCM.Data == nil {
+			shardMappingCM.Data = map[string]string{}
+		}
 		shardMappingCM.Data[ShardControllerMappingKey] = string(updatedShardMappingData)
 
 		_, err = kubeClient.CoreV1().ConfigMaps(settingsMgr.GetNamespace()).Update(context.Background(), shardMappingCM, metav1.UpdateOptions{})
@@ -0,0 +1,133 @@
+package sharding
+
+import (
+	"slices"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// WatchedResources describes the resources of a cluster watched by the shard when they depend on the Applications
+// destined to the cluster.
+type WatchedResources struct {
+	// Namespaces contains the sorted namespaces to watch. Only the cluster level resources are watched if it is empty
+	// and ClusterResources is true.
+	Namespaces []string
+	// ClusterResources indicates whether cluster level resources should be watched
+	ClusterResources bool
+}
+
+// Equal returns true if both WatchedResources are equal
+func (w *WatchedResources) Equal(other *WatchedResources) bool {
+	if w == nil || other == nil {
+		return w == other
+	}
+	return w.ClusterResources == other.ClusterResources && slices.Equal(w.Namespaces, other.Namespaces)
+}
+
+// IsEmpty returns true if no resource should be watched
+func (w *WatchedResources) IsEmpty() bool {
+	return len(w.Namespaces) == 0 && !w.ClusterResources
+}
+
+// IsDynamicNamespaceWatch returns whether the resources watched in the cluster are restricted to the namespaces
+// needed by the Applications destined to the cluster.
+func IsDynamicNamespaceWatch(c *v1alpha1.Cluster) bool {
+	if c == nil {
+		return false
+	}
+	val, ok := c.Annotations[common.AnnotationKeyDynamicNamespaceWatch]
+	if !ok {
+		return false
+	}
+	dynamic, err := strconv.ParseBool(val)
+	if err != nil {
+		log.Warnf("Invalid value %q of annotation %s for cluster %s: %v", val, common.AnnotationKeyDynamicNamespaceWatch, c.Server, err)
+		return false
+	}
+	return dynamic
+}
+
+// GetApplicationNamespaces returns the sorted namespaces an Application needs to be watched: its destination
+// namespace and the namespaces of the resources it manages.
+func GetApplicationNamespaces(a *v1alpha1.Application) []string {
+	var namespaces []string
+	if a.Spec.Destination.Namespace != "" {
+		namespaces = append(namespaces, a.Spec.Destination.Namespace)
+	}
+	for _, res := range a.Status.Resources {
+		if res.Namespace != "" {
+			namespaces = append(namespaces, res.Namespace)
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces)
+}
+
+// managesClusterResources returns true if the Application manages cluster level resources
+func managesClusterResources(a *v1alpha1.Application) bool {
+	for _, res := range a.Status.Resources {
+		if res.Namespace == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasWatchedResourcesUpdates returns true if the resources to watch for the Application may have changed
+func HasWatchedResourcesUpdates(old, new *v1alpha1.Application) bool {
+	return old.Spec.Destination.Server != new.Spec.Destination.Server ||
+		old.Spec.Destination.Name != new.Spec.Destination.Name ||
+		managesClusterResources(old) != managesClusterResources(new) ||
+		!slices.Equal(GetApplicationNamespaces(old), GetApplicationNamespaces(new))
+}
+
+// HasWatchedResourcesModeUpdates returns true if the way the resources to watch in the cluster are selected has changed
+func HasWatchedResourcesModeUpdates(old, new *v1alpha1.Cluster) bool {
+	return GetApplicationShardingMode(old) != GetApplicationShardingMode(new) || IsDynamicNamespaceWatch(old) != IsDynamicNamespaceWatch(new)
+}
+
+// GetWatchedResources returns the resources of the cluster needed by the Applications processed by the shard if the
+// Applications destined to the cluster are distributed across the shards or if the cluster uses a dynamic namespace
+// watch. The second return value is false if all the resources allowed by the cluster configuration should be
+// watched.
+func (sharding *ClusterSharding) GetWatchedResources(server string) (*WatchedResources, bool) {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	c, ok := sharding.Clusters[server]
+	if !ok {
+		return nil, false
+	}
+	mode := sharding.getApplicationShardingMode(c)
+	dynamic := IsDynamicNamespaceWatch(c)
+	if mode == "" && !dynamic {
+		return nil, false
+	}
+	// the cluster configuration still restricts the resources which can be watched
+	clusterResourcesAllowed := len(c.Namespaces) == 0 || c.ClusterResources
+	watched := &WatchedResources{Namespaces: []string{}, ClusterResources: clusterResourcesAllowed && !dynamic}
+	managedClusterResources := false
+	for _, a := range sharding.Apps {
+		if !isApplicationDestinedTo(a, c) || (mode != "" && GetApplicationShard(a, mode, sharding.Replicas) != sharding.Shard) {
+			continue
+		}
+		for _, ns := range GetApplicationNamespaces(a) {
+			if len(c.Namespaces) == 0 || slices.Contains(c.Namespaces, ns) {
+				watched.Namespaces = append(watched.Namespaces, ns)
+			}
+		}
+		if clusterResourcesAllowed && managesClusterResources(a) {
+			managedClusterResources = true
+		}
+	}
+	slices.Sort(watched.Namespaces)
+	watched.Namespaces = slices.Compact(watched.Namespaces)
+	watched.ClusterResources = watched.ClusterResources || managedClusterResources
+	if len(watched.Namespaces) == 0 && !managedClusterResources {
+		watched.ClusterResources = false
+	}
+	return watched, true
+}
@@ -0,0 +1,138 @@
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+func TestIsDynamicNamespaceWatch(t *testing.T) {
+	assert.False(t, IsDynamicNamespaceWatch(nil))
+	assert.False(t, IsDynamicNamespaceWatch(&v1alpha1.Cluster{}))
+	assert.True(t, IsDynamicNamespaceWatch(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "true"}}))
+	assert.False(t, IsDynamicNamespaceWatch(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "false"}}))
+	assert.False(t, IsDynamicNamespaceWatch(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "invalid"}}))
+}
+
+func TestGetApplicationNamespaces(t *testing.T) {
+	app := createNamespacedApp("app", "https://cluster", "ns2", "ns1", "ns2", "", "ns1")
+	assert.Equal(t, []string{"ns1", "ns2"}, GetApplicationNamespaces(&app))
+
+	app = createNamespacedApp("app", "https://cluster", "")
+	assert.Empty(t, GetApplicationNamespaces(&app))
+}
+
+func TestHasWatchedResourcesUpdates(t *testing.T) {
+	app := createNamespacedApp("app", "https://cluster", "ns", "ns1")
+	assert.False(t, HasWatchedResourcesUpdates(&app, app.DeepCopy()))
+
+	updated := app.DeepCopy()
+	updated.Status.Resources = append(updated.Status.Resources, v1alpha1.ResourceStatus{Namespace: "ns2"})
+	assert.True(t, HasWatchedResourcesUpdates(&app, updated))
+
+	updated = app.DeepCopy()
+	updated.Status.Resources = append(updated.Status.Resources, v1alpha1.ResourceStatus{Kind: "ClusterRole"})
+	assert.True(t, HasWatchedResourcesUpdates(&app, updated))
+
+	updated = app.DeepCopy()
+	updated.Spec.Destination.Server = "https://other"
+	assert.True(t, HasWatchedResourcesUpdates(&app, updated))
+}
+
+func TestHasWatchedResourcesModeUpdates(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "https://cluster"}
+	assert.False(t, HasWatchedResourcesModeUpdates(cluster, cluster.DeepCopy()))
+
+	updated := cluster.DeepCopy()
+	updated.Annotations = map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "true"}
+	assert.True(t, HasWatchedResourcesModeUpdates(cluster, updated))
+
+	updated = cluster.DeepCopy()
+	updated.Annotations = map[string]string{common.AnnotationKeyApplicationSharding: common.ApplicationShardingByApplication}
+	assert.True(t, HasWatchedResourcesModeUpdates(cluster, updated))
+}
+
+func TestWatchedResources_Equal(t *testing.T) {
+	assert.True(t, (*WatchedResources)(nil).Equal(nil))
+	assert.False(t, (&WatchedResources{}).Equal(nil))
+	assert.True(t, (&WatchedResources{Namespaces: []string{"ns"}, ClusterResources: true}).Equal(&WatchedResources{Namespaces: []string{"ns"}, ClusterResources: true}))
+	assert.False(t, (&WatchedResources{Namespaces: []string{"ns"}}).Equal(&WatchedResources{Namespaces: []string{"ns"}, ClusterResources: true}))
+	assert.False(t, (&WatchedResources{Namespaces: []string{"ns"}}).Equal(&WatchedResources{Namespaces: []string{"ns", "ns2"}}))
+}
+
+func TestClusterSharding_GetWatchedResources_DynamicNamespaceWatch(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := v1alpha1.Cluster{ID: "1", Server: "https://cluster", Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "true"}}
+	apps := []v1alpha1.Application{
+		createNamespacedApp("app1", cluster.Server, "ns1", "ns2"),
+		createNamespacedApp("app2", cluster.Server, "ns3"),
+		createNamespacedApp("app3", "https://other", "ns4"),
+	}
+	sharding := NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster}}, &v1alpha1.ApplicationList{Items: apps})
+
+	// cluster level resources are not watched as long as no application manages them
+	watched, ok := sharding.GetWatchedResources(cluster.Server)
+	assert.True(t, ok)
+	assert.Equal(t, &WatchedResources{Namespaces: []string{"ns1", "ns2", "ns3"}}, watched)
+
+	apps[1].Status.Resources = append(apps[1].Status.Resources, v1alpha1.ResourceStatus{Kind: "ClusterRole", Name: "role"})
+	sharding.UpdateApp(&apps[1])
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.Equal(t, &WatchedResources{Namespaces: []string{"ns1", "ns2", "ns3"}, ClusterResources: true}, watched)
+
+	// applications in different namespaces may have the same name
+	sameName := createNamespacedApp("app1", cluster.Server, "ns5")
+	sameName.Namespace = "apps"
+	sharding.AddApp(&sameName)
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.Equal(t, []string{"ns1", "ns2", "ns3", "ns5"}, watched.Namespaces)
+
+	// only cluster level resources are watched if no namespaced resource is managed
+	for i := range apps {
+		sharding.DeleteApp(&apps[i])
+	}
+	sharding.DeleteApp(&sameName)
+	clusterApp := createNamespacedApp("cluster-app", cluster.Server, "")
+	clusterApp.Status.Resources = []v1alpha1.ResourceStatus{{Kind: "ClusterRole", Name: "role"}}
+	sharding.AddApp(&clusterApp)
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.Empty(t, watched.Namespaces)
+	assert.True(t, watched.ClusterResources)
+	assert.False(t, watched.IsEmpty())
+
+	// nothing is watched without applications
+	sharding.DeleteApp(&clusterApp)
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.True(t, watched.IsEmpty())
+}
+
+func TestClusterSharding_GetWatchedResources_NamespacedCluster(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	cluster := v1alpha1.Cluster{ID: "1", Server: "https://cluster", Namespaces: []string{"ns1"}, Annotations: map[string]string{common.AnnotationKeyDynamicNamespaceWatch: "true"}}
+	app := createNamespacedApp("app", cluster.Server, "ns1", "ns2")
+	app.Status.Resources = append(app.Status.Resources, v1alpha1.ResourceStatus{Kind: "ClusterRole", Name: "role"})
+	sharding := NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster}}, &v1alpha1.ApplicationList{Items: []v1alpha1.Application{app}})
+
+	// the cluster configuration restricts the namespaces and does not allow cluster level resources
+	watched, ok := sharding.GetWatchedResources(cluster.Server)
+	assert.True(t, ok)
+	assert.Equal(t, &WatchedResources{Namespaces: []string{"ns1"}}, watched)
+
+	cluster.ClusterResources = true
+	sharding.Update(&cluster, &cluster)
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.Equal(t, &WatchedResources{Namespaces: []string{"ns1"}, ClusterResources: true}, watched)
+
+	// the namespaces of the cluster configuration are not watched if no namespaced resource is managed
+	app.Spec.Destination.Namespace = ""
+	app.Status.Resources = []v1alpha1.ResourceStatus{{Kind: "ClusterRole", Name: "role"}}
+	sharding.UpdateApp(&app)
+	watched, _ = sharding.GetWatchedResources(cluster.Server)
+	assert.Equal(t, &WatchedResources{Namespaces: []string{}, ClusterResources: true}, watched)
+}
@@ -29,7 +29,9 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
+	"github.com/argoproj/argo-cd/v2/controller/healthplugin"
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	"github.com/argoproj/argo-cd/v2/controller/syncgate"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
@@ -42,6 +44,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/signature"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
 
@@ -118,6 +121,8 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	healthPlugins         *healthplugin.Manager
+	syncGates             *syncgate.Evaluator
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -249,27 +254,29 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 
 		log.Debugf("Generating Manifest for source %s revision %s", source, revision)
 		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-			Repo:               repo,
-			Repos:              permittedHelmRepos,
-			Revision:           revision,
-			NoCache:            noCache,
-			NoRevisionCache:    noRevisionCache,
-			AppLabelKey:        appLabelKey,
-			AppName:            app.InstanceName(m.namespace),
-			Namespace:          app.Spec.Destination.Namespace,
-			ApplicationSource:  &source,
-			KustomizeOptions:   kustomizeOptions,
-			KubeVersion:        serverVersion,
-			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-			VerifySignature:    verifySignature,
-			HelmRepoCreds:      permittedHelmCredentials,
-			TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
-			EnabledSourceTypes: enabledSourceTypes,
-			HelmOptions:        helmOptions,
-			HasMultipleSources: app.Spec.HasMultipleSources(),
-			RefSources:         refSources,
-			ProjectName:        proj.Name,
-			ProjectSourceRepos: proj.Spec.SourceRepos,
+			Repo:                  repo,
+			Repos:                 permittedHelmRepos,
+			Revision:              revision,
+			NoCache:               noCache,
+			NoRevisionCache:       noRevisionCache,
+			AppLabelKey:           appLabelKey,
+			AppName:               app.InstanceName(m.namespace),
+			Namespace:             app.Spec.Destination.Namespace,
+			ApplicationSource:     &source,
+			KustomizeOptions:      kustomizeOptions,
+			KubeVersion:           serverVersion,
+			ApiVersions:           argo.APIResourcesToStrings(apiResources, true),
+			VerifySignature:       verifySignature,
+			HelmRepoCreds:         permittedHelmCredentials,
+			TrackingMethod:        string(argo.GetTrackingMethod(m.settingsMgr)),
+			EnabledSourceTypes:    enabledSourceTypes,
+			HelmOptions:           helmOptions,
+			HasMultipleSources:    app.Spec.HasMultipleSources(),
+			RefSources:            refSources,
+			ProjectName:           proj.Name,
+			ProjectSourceRepos:    proj.Spec.SourceRepos,
+			ManifestGeneratePaths: path.GetAppRefreshPaths(app),
+			HelmChartVerification: apiclient.NewHelmChartVerification(proj),
 		})
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
@@ -408,6 +415,26 @@ func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifes
 	return conditions
 }
 
+// verifyCommitSignature verifies the result of the signature verification of a given git revision. SSH and X.509
+// signatures have already been verified by the repository server, so it only remains to check whether the signer is
+// allowed in the project.
+func verifyCommitSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+	commitSignature := manifestInfo.CommitSignature
+	if commitSignature == nil {
+		return verifyGnuPGSignature(revision, project, manifestInfo)
+	}
+	now := metav1.Now()
+	conditions := make([]v1alpha1.ApplicationCondition, 0)
+	if commitSignature.Error != "" {
+		msg := fmt.Sprintf("Found %s signature on revision '%s', but verification failed: '%s'", commitSignature.Type, revision, commitSignature.Error)
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	} else if result := commitSignature.Result(); !signature.IsPermitted(project, result) {
+		msg := fmt.Sprintf("Found good signature from %s, but it is not allowed in AppProject", result)
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+	return conditions
+}
+
 func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application) bool {
 	return ns != nil && ns.GetKind() == kubeutil.NamespaceKind && ns.GetName() == app.Spec.Destination.Namespace && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil
 }
@@ -445,10 +472,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 
 	// When signature keys are defined in the project spec, we need to verify the signature on the Git revision
-	verifySignature := false
-	if len(project.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled() {
-		verifySignature = true
-	}
+	verifySignature := signature.IsVerificationRequired(project)
 
 	// do best effort loading live and target state to present as much information about app state as possible
 	failedToLoadObjs := false
@@ -499,7 +523,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
 		// This is also enforced on API level, but as a last resort, we also enforce it here
-		if gpg.IsGPGEnabled() && verifySignature {
+		if verifySignature {
 			msg := "Cannot use local manifests when signature verification is required"
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -843,17 +867,17 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, m.healthPlugins, app, m.persistResourceHealth)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: fmt.Sprintf("error setting app health: %s", err.Error()), LastTransitionTime: &now})
 	}
 
-	// Git has already performed the signature verification via its GPG interface, and the result is available
-	// in the manifest info received from the repository server. We now need to form our opinion about the result
-	// and stop processing if we do not agree about the outcome.
+	// Git has already performed the signature verification via its GPG interface, or the repository server has verified
+	// the SSH or X.509 signature, and the result is available in the manifest info received from the repository server.
+	// We now need to form our opinion about the result and stop processing if we do not agree about the outcome.
 	for _, manifestInfo := range manifestInfos {
-		if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
-			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, project, manifestInfo)...)
+		if verifySignature && manifestInfo != nil {
+			conditions = append(conditions, verifyCommitSignature(manifestInfo.Revision, project, manifestInfo)...)
 		}
 	}
 
@@ -944,6 +968,7 @@ func (m *appStateManager) persistRevisionHistory(
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
+	manifestsSnapshot []byte,
 ) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -952,25 +977,28 @@ func (m *appStateManager) persistRevisionHistory(
 
 	if hasMultipleSources {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
-			DeployStartedAt: &startedAt,
-			ID:              nextID,
-			Sources:         sources,
-			Revisions:       revisions,
-			InitiatedBy:     initiatedBy,
+			DeployedAt:        metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:   &startedAt,
+			ID:                nextID,
+			Sources:           sources,
+			Revisions:         revisions,
+			InitiatedBy:       initiatedBy,
+			ManifestsSnapshot: manifestsSnapshot,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			Revision:        revision,
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
-			DeployStartedAt: &startedAt,
-			ID:              nextID,
-			Source:          source,
-			InitiatedBy:     initiatedBy,
+			Revision:          revision,
+			DeployedAt:        metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:   &startedAt,
+			ID:                nextID,
+			Source:            source,
+			InitiatedBy:       initiatedBy,
+			ManifestsSnapshot: manifestsSnapshot,
 		})
 	}
 
 	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
+	argo.TrimManifestsSnapshots(app.Status.History)
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
 		"status": {
@@ -984,6 +1012,30 @@ func (m *appStateManager) persistRevisionHistory(
 	return err
 }
 
+// getManifestsSnapshot returns the snapshot of the target manifests of the comparison to store in the revision
+// history, or nil if snapshots are disabled or the snapshot is too large
+func (m *appStateManager) getManifestsSnapshot(app *v1alpha1.Application, compareResult *comparisonResult) []byte {
+	logCtx := log.WithField("application", app.QualifiedName())
+	enabled, err := m.settingsMgr.GetIsHistoryManifestSnapshotsEnabled()
+	if err != nil {
+		logCtx.Warnf("Failed to get manifest snapshots setting: %v", err)
+		return nil
+	}
+	if !enabled {
+		return nil
+	}
+	snapshot, err := argo.NewManifestsSnapshot(compareResult.reconciliationResult.Target)
+	if err != nil {
+		logCtx.Warnf("Failed to create manifests snapshot: %v", err)
+		return nil
+	}
+	if len(snapshot) > argo.MaxManifestsSnapshotSize {
+		logCtx.Warnf("Not storing manifests snapshot in history, its size of %d bytes exceeds the maximum of %d bytes", len(snapshot), argo.MaxManifestsSnapshotSize)
+		return nil
+	}
+	return snapshot
+}
+
 // NewAppStateManager creates new instance of AppStateManager
 func NewAppStateManager(
 	db db.ArgoDB,
@@ -1002,6 +1054,8 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	syncGates *syncgate.Evaluator,
+	healthPlugins *healthplugin.Manager,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
@@ -1020,6 +1074,8 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		healthPlugins:         healthPlugins,
+		syncGates:             syncGates,
 	}
 }
 
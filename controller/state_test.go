@@ -872,7 +872,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{})
+		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{}, nil)
 		require.NoError(t, err)
 	}
 	addHistory()
@@ -908,7 +908,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{})
+	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{}, nil)
 	require.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 }
@@ -1236,6 +1236,34 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 	}
 }
 
+func TestVerifyCommitSignature(t *testing.T) {
+	proj := signedProj.DeepCopy()
+	proj.Spec.SSHSignatureKeys = []argoappv1.SSHSignatureKey{{PublicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMk2eAvVWpkbGaCYnWRX0F96tZDQZ3sBe/h3hT3B7n2S"}}
+	proj.Spec.KeylessSignatureIdentities = []argoappv1.KeylessSignatureIdentity{{Issuer: "https://accounts.google.com", SubjectRegex: `.*@example\.com`}}
+
+	t.Run("Permitted keyless signature", func(t *testing.T) {
+		conditions := verifyCommitSignature("abc123", proj, &apiclient.ManifestResponse{
+			CommitSignature: &apiclient.CommitSignature{Type: "x509", Signer: "alice@example.com", Issuer: "https://accounts.google.com"},
+		})
+		assert.Empty(t, conditions)
+	})
+	t.Run("Signer not permitted", func(t *testing.T) {
+		conditions := verifyCommitSignature("abc123", proj, &apiclient.ManifestResponse{
+			CommitSignature: &apiclient.CommitSignature{Type: "x509", Signer: "mallory@example.org", Issuer: "https://accounts.google.com"},
+		})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionComparisonError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "mallory@example.org issued by https://accounts.google.com, but it is not allowed")
+	})
+	t.Run("Verification failed", func(t *testing.T) {
+		conditions := verifyCommitSignature("abc123", proj, &apiclient.ManifestResponse{
+			CommitSignature: &apiclient.CommitSignature{Type: "ssh", Error: "bad SSH signature"},
+		})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "Found ssh signature on revision 'abc123', but verification failed: 'bad SSH signature'")
+	})
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := &argoappv1.HealthStatus{Status: health.HealthStatusMissing}
 	res := comparisonResult{
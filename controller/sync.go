@@ -34,7 +34,6 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo/diff"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
-	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/rand"
 )
 
@@ -173,7 +172,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	} else if syncWindowPreventsSync(app, proj) {
 		// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 		if state.Phase == common.OperationRunning {
-			state.Message = "Sync operation blocked by sync window"
+			if freeze := app.Spec.SyncPolicy.GetFreeze(); freeze.IsActive() {
+				state.Message = fmt.Sprintf("Sync operation blocked by maintenance freeze: %s", freeze.Reason)
+			} else {
+				state.Message = "Sync operation blocked by sync window"
+			}
 		}
 		return
 	}
@@ -223,6 +226,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	syncGateSettings, err := m.settingsMgr.GetSyncGateSettings()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to load sync gate settings: %v", err)
+		return
+	}
+
 	atomic.AddUint64(&syncIdPrefix, 1)
 	randSuffix, err := rand.String(5)
 	if err != nil {
@@ -306,7 +316,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(m.syncGates.HealthOverride(m.healthPlugins.HealthOverride(resourceOverrides, app), syncGateSettings, syncRes)),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
@@ -415,7 +425,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy, m.getManifestsSnapshot(app, compareResult))
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -549,6 +559,10 @@ func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) err
 }
 
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) bool {
+	// a maintenance freeze of the application prevents manual syncs as well
+	if app.Spec.SyncPolicy.GetFreeze().IsActive() {
+		return true
+	}
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
 	if app.Status.OperationState != nil {
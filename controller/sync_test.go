@@ -325,6 +325,39 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 	})
 }
 
+func TestSyncFreezeDeniesSync(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Freeze: &v1alpha1.SyncFreeze{Reason: "database migration"}}
+	project := defaultProj.DeepCopy()
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+			},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
+		},
+		Phase: common.OperationRunning,
+	}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationRunning, opState.Phase)
+	assert.Equal(t, "Sync operation blocked by maintenance freeze: database migration", opState.Message)
+}
+
 func TestNormalizeTargetResources(t *testing.T) {
 	type fixture struct {
 		comparisonResult *comparisonResult
@@ -0,0 +1,164 @@
+package syncgate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// checkTimeout is the maximum amount of time a single check of a sync gate may take
+const checkTimeout = 10 * time.Second
+
+// Evaluator evaluates the sync gates of the resources applied by sync operations. The state of the gates is kept in
+// the result of the sync operation, so that it survives restarts of the application controller.
+type Evaluator struct {
+	client *http.Client
+}
+
+// NewEvaluator returns a new sync gate Evaluator
+func NewEvaluator() *Evaluator {
+	return &Evaluator{
+		client: &http.Client{Timeout: checkTimeout},
+	}
+}
+
+// HealthOverride returns a health.HealthOverride which reports resources with a sync gate as Progressing until the gate
+// passed, and as Degraded if the gate did not pass in time and its failure policy is Fail. Since a sync only proceeds to
+// the next sync wave once all resources of the current wave are healthy, this pauses the sync. The health of resources
+// without a sync gate is assessed by the given override. The state of the gates is read from and recorded in the given
+// sync result. A nil Evaluator ignores sync gates.
+func (e *Evaluator) HealthOverride(override health.HealthOverride, syncGateSettings *settings.SyncGateSettings, syncRes *v1alpha1.SyncOperationResult) health.HealthOverride {
+	if e == nil {
+		return override
+	}
+	return &gateHealthOverride{evaluator: e, override: override, settings: syncGateSettings, syncRes: syncRes}
+}
+
+// RecheckAfter returns the duration after which the next pending sync gate of the given sync result has to be checked,
+// and whether there is a pending sync gate at all.
+func RecheckAfter(syncRes *v1alpha1.SyncOperationResult) (time.Duration, bool) {
+	if syncRes == nil {
+		return 0, false
+	}
+	pending := false
+	var recheckAfter time.Duration
+	for _, gate := range syncRes.Gates {
+		if gate.Completed() {
+			continue
+		}
+		after := time.Until(gate.NextCheckAt.Time)
+		if after < 0 {
+			after = 0
+		}
+		if !pending || after < recheckAfter {
+			recheckAfter = after
+		}
+		pending = true
+	}
+	return recheckAfter, pending
+}
+
+type gateHealthOverride struct {
+	evaluator *Evaluator
+	override  health.HealthOverride
+	settings  *settings.SyncGateSettings
+
+	lock    sync.Mutex
+	syncRes *v1alpha1.SyncOperationResult
+}
+
+func (o *gateHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	spec, ok := obj.GetAnnotations()[common.AnnotationKeySyncGate]
+	if !ok {
+		if o.override == nil {
+			return nil, nil
+		}
+		return o.override.GetResourceHealth(obj)
+	}
+	// the gate is only evaluated once the annotated resource itself is healthy
+	resHealth, err := health.GetResourceHealth(obj, o.override)
+	if err != nil {
+		return nil, err
+	}
+	if resHealth != nil && resHealth.Status != health.HealthStatusHealthy {
+		return resHealth, nil
+	}
+	return o.evaluate(obj, spec), nil
+}
+
+// getState returns the index of the state of the gate of the given resource in the sync result, adding a new state if
+// the gate has not been checked yet. Must be called with the lock held.
+func (o *gateHealthOverride) getState(key kube.ResourceKey, now metav1.Time) int {
+	for i, gate := range o.syncRes.Gates {
+		if gate.Group == key.Group && gate.Kind == key.Kind && gate.Namespace == key.Namespace && gate.Name == key.Name {
+			return i
+		}
+	}
+	o.syncRes.Gates = append(o.syncRes.Gates, v1alpha1.SyncGateStatus{
+		Group:       key.Group,
+		Kind:        key.Kind,
+		Namespace:   key.Namespace,
+		Name:        key.Name,
+		StartedAt:   now,
+		NextCheckAt: &now,
+	})
+	return len(o.syncRes.Gates) - 1
+}
+
+func (o *gateHealthOverride) evaluate(obj *unstructured.Unstructured, spec string) *health.HealthStatus {
+	gate, err := ParseGate(spec)
+	if err != nil {
+		return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("invalid sync gate: %v", err)}
+	}
+	now := metav1.Now()
+	o.lock.Lock()
+	i := o.getState(kube.GetResourceKey(obj), now)
+	state := o.syncRes.Gates[i]
+	o.lock.Unlock()
+	if state.Completed() || now.Before(state.NextCheckAt) {
+		return &health.HealthStatus{Status: state.Status, Message: state.Message}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	passed, message, err := gate.check(ctx, o.evaluator.client, o.settings, obj)
+	if err != nil {
+		message = err.Error()
+	}
+
+	result := &health.HealthStatus{Status: health.HealthStatusProgressing, Message: fmt.Sprintf("waiting for sync gate: %s", message)}
+	completed := true
+	switch {
+	case passed:
+		result = &health.HealthStatus{Status: health.HealthStatusHealthy, Message: fmt.Sprintf("sync gate passed: %s", message)}
+	case now.Sub(state.StartedAt.Time) < gate.timeout:
+		completed = false
+	case gate.FailurePolicy == FailurePolicyContinue:
+		result = &health.HealthStatus{Status: health.HealthStatusHealthy, Message: fmt.Sprintf("sync gate did not pass within %v, continuing: %s", gate.timeout, message)}
+	default:
+		result = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("sync gate did not pass within %v: %s", gate.timeout, message)}
+	}
+
+	state.Status = result.Status
+	state.Message = result.Message
+	state.NextCheckAt = nil
+	if !completed {
+		nextCheckAt := metav1.NewTime(now.Add(gate.interval))
+		state.NextCheckAt = &nextCheckAt
+	}
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.syncRes.Gates[i] = state
+	return result
+}
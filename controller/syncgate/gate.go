@@ -0,0 +1,234 @@
+package syncgate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/util/lua"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// DefaultInterval is the default interval at which the check of a sync gate is performed
+	DefaultInterval = 30 * time.Second
+	// DefaultTimeout is the default amount of time to wait for a sync gate to pass
+	DefaultTimeout = 10 * time.Minute
+)
+
+// FailurePolicy determines what happens to a sync if its gate did not pass within the timeout
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail fails the sync
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyContinue proceeds with the next sync wave
+	FailurePolicyContinue FailurePolicy = "Continue"
+)
+
+// Gate is a check which has to pass before a sync proceeds past the sync wave of the annotated resource. Exactly one of
+// Prometheus, Webhook and Lua has to be specified.
+type Gate struct {
+	// Prometheus passes if the query returns at least one sample and all samples are non-zero
+	Prometheus *PrometheusCheck `json:"prometheus,omitempty"`
+	// Webhook passes if the URL responds with a 2xx status code
+	Webhook *WebhookCheck `json:"webhook,omitempty"`
+	// Lua is a script which returns true if the gate passes. The live state of the annotated resource is available as obj.
+	Lua string `json:"lua,omitempty"`
+	// Interval is the interval at which the check is performed, e.g. "30s". Defaults to 30 seconds.
+	Interval string `json:"interval,omitempty"`
+	// Timeout is the maximum amount of time to wait for the check to pass, e.g. "10m". Defaults to 10 minutes.
+	Timeout string `json:"timeout,omitempty"`
+	// FailurePolicy is either Fail (default) or Continue
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// PrometheusCheck is an instant query against a Prometheus compatible HTTP API
+type PrometheusCheck struct {
+	// Name is the name of the Prometheus API in the sync gate settings of argocd-cm
+	Name string `json:"name"`
+	// Query is the PromQL query
+	Query string `json:"query"`
+}
+
+// WebhookCheck is an HTTP GET request
+type WebhookCheck struct {
+	// Name is the name of the webhook in the sync gate settings of argocd-cm
+	Name string `json:"name"`
+}
+
+// ParseGate parses and validates the gate defined by the value of a sync gate annotation
+func ParseGate(spec string) (*Gate, error) {
+	gate := &Gate{}
+	if err := yaml.UnmarshalStrict([]byte(spec), gate); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sync gate: %w", err)
+	}
+	checks := 0
+	if gate.Prometheus != nil {
+		if gate.Prometheus.Name == "" || gate.Prometheus.Query == "" {
+			return nil, errors.New("prometheus check requires name and query")
+		}
+		checks++
+	}
+	if gate.Webhook != nil {
+		if gate.Webhook.Name == "" {
+			return nil, errors.New("webhook check requires name")
+		}
+		checks++
+	}
+	if gate.Lua != "" {
+		checks++
+	}
+	if checks != 1 {
+		return nil, errors.New("exactly one of prometheus, webhook and lua must be specified")
+	}
+	switch gate.FailurePolicy {
+	case "":
+		gate.FailurePolicy = FailurePolicyFail
+	case FailurePolicyFail, FailurePolicyContinue:
+	default:
+		return nil, fmt.Errorf("invalid failure policy %q, must be %s or %s", gate.FailurePolicy, FailurePolicyFail, FailurePolicyContinue)
+	}
+	var err error
+	if gate.interval, err = parseDuration(gate.Interval, DefaultInterval); err != nil {
+		return nil, fmt.Errorf("invalid interval: %w", err)
+	}
+	if gate.timeout, err = parseDuration(gate.Timeout, DefaultTimeout); err != nil {
+		return nil, fmt.Errorf("invalid timeout: %w", err)
+	}
+	return gate, nil
+}
+
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// check performs the check of the gate. It returns whether the gate passed and a message describing the result.
+func (g *Gate) check(ctx context.Context, client *http.Client, syncGateSettings *settings.SyncGateSettings, obj *unstructured.Unstructured) (bool, string, error) {
+	switch {
+	case g.Prometheus != nil:
+		address, ok := syncGateSettings.Prometheus[g.Prometheus.Name]
+		if !ok {
+			return false, "", fmt.Errorf("prometheus %q is not configured in the sync gate settings", g.Prometheus.Name)
+		}
+		return g.Prometheus.check(ctx, client, address)
+	case g.Webhook != nil:
+		webhookURL, ok := syncGateSettings.Webhooks[g.Webhook.Name]
+		if !ok {
+			return false, "", fmt.Errorf("webhook %q is not configured in the sync gate settings", g.Webhook.Name)
+		}
+		return g.Webhook.check(ctx, client, webhookURL)
+	default:
+		passed, err := lua.VM{}.ExecutePredicateLua(obj, g.Lua)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to execute Lua predicate: %w", err)
+		}
+		return passed, fmt.Sprintf("Lua predicate returned %t", passed), nil
+	}
+}
+
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+func (c *PrometheusCheck) check(ctx context.Context, client *http.Client, address string) (bool, string, error) {
+	queryURL := fmt.Sprintf("%s/api/v1/query?query=%s", strings.TrimSuffix(address, "/"), url.QueryEscape(c.Query))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, nil)
+	if err != nil {
+		return false, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+	var result prometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, "", fmt.Errorf("failed to decode Prometheus response with status %s: %w", resp.Status, err)
+	}
+	if result.Status != "success" {
+		return false, "", fmt.Errorf("query failed: %s", result.Error)
+	}
+	values, err := sampleValues(result.Data.ResultType, result.Data.Result)
+	if err != nil {
+		return false, "", err
+	}
+	if len(values) == 0 {
+		return false, "query returned no data", nil
+	}
+	passed := true
+	for _, value := range values {
+		if f, err := strconv.ParseFloat(value, 64); err != nil || f == 0 || math.IsNaN(f) {
+			passed = false
+		}
+	}
+	return passed, fmt.Sprintf("query returned [%s]", strings.Join(values, ", ")), nil
+}
+
+// sampleValues returns the values of all samples of an instant query result
+func sampleValues(resultType string, raw json.RawMessage) ([]string, error) {
+	switch resultType {
+	case "vector":
+		var samples []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &samples); err != nil {
+			return nil, fmt.Errorf("failed to decode vector result: %w", err)
+		}
+		values := make([]string, 0, len(samples))
+		for _, sample := range samples {
+			if len(sample.Value) == 2 {
+				values = append(values, fmt.Sprint(sample.Value[1]))
+			}
+		}
+		return values, nil
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(raw, &sample); err != nil {
+			return nil, fmt.Errorf("failed to decode scalar result: %w", err)
+		}
+		if len(sample) != 2 {
+			return nil, nil
+		}
+		return []string{fmt.Sprint(sample[1])}, nil
+	default:
+		return nil, fmt.Errorf("unsupported query result type %q", resultType)
+	}
+}
+
+func (c *WebhookCheck) check(ctx context.Context, client *http.Client, webhookURL string) (bool, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, webhookURL, nil)
+	if err != nil {
+		return false, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	passed := resp.StatusCode >= 200 && resp.StatusCode < 300
+	return passed, fmt.Sprintf("webhook returned %s", resp.Status), nil
+}
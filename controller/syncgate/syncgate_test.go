@@ -0,0 +1,203 @@
+package syncgate
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newGateResource(spec string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("error-rate-gate")
+	obj.SetNamespace("default")
+	obj.SetAnnotations(map[string]string{common.AnnotationKeySyncGate: spec})
+	return obj
+}
+
+func newPrometheus(t *testing.T, value string, calls *int32) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		assert.Equal(t, "error_rate < bool 0.01", r.URL.Query().Get("query"))
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"%s"]}]}}`, value)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestParseGate(t *testing.T) {
+	gate, err := ParseGate(`
+webhook:
+  name: smoke-test
+`)
+	require.NoError(t, err)
+	assert.Equal(t, FailurePolicyFail, gate.FailurePolicy)
+	assert.Equal(t, DefaultInterval, gate.interval)
+	assert.Equal(t, DefaultTimeout, gate.timeout)
+
+	gate, err = ParseGate(`
+lua: return true
+interval: 5s
+timeout: 1m
+failurePolicy: Continue
+`)
+	require.NoError(t, err)
+	assert.Equal(t, FailurePolicyContinue, gate.FailurePolicy)
+	assert.Equal(t, 5*time.Second, gate.interval)
+	assert.Equal(t, time.Minute, gate.timeout)
+
+	for _, spec := range []string{
+		``,
+		`lua: return true
+webhook:
+  name: smoke-test`,
+		`prometheus:
+  name: monitoring`,
+		`webhook:
+  url: http://example.com`,
+		`lua: return true
+failurePolicy: Ignore`,
+		`lua: return true
+timeout: soon`,
+		`lua: return true
+unknown: field`,
+	} {
+		_, err := ParseGate(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestEvaluator(t *testing.T) {
+	t.Run("prometheus passes", func(t *testing.T) {
+		var calls int32
+		syncGateSettings := &settings.SyncGateSettings{Prometheus: map[string]string{"monitoring": newPrometheus(t, "1", &calls)}}
+		obj := newGateResource("prometheus:\n  name: monitoring\n  query: error_rate < bool 0.01\n")
+		status, err := NewEvaluator().HealthOverride(nil, syncGateSettings, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+		assert.Equal(t, "sync gate passed: query returned [1]", status.Message)
+	})
+
+	t.Run("prometheus is checked at interval", func(t *testing.T) {
+		var calls int32
+		syncGateSettings := &settings.SyncGateSettings{Prometheus: map[string]string{"monitoring": newPrometheus(t, "0", &calls)}}
+		obj := newGateResource("prometheus:\n  name: monitoring\n  query: error_rate < bool 0.01\ninterval: 1h\n")
+		syncRes := &v1alpha1.SyncOperationResult{}
+		for i := 0; i < 2; i++ {
+			// the state of the gate is kept in the sync result, so it is shared by the overrides of an operation
+			status, err := NewEvaluator().HealthOverride(nil, syncGateSettings, syncRes).GetResourceHealth(obj)
+			require.NoError(t, err)
+			assert.Equal(t, health.HealthStatusProgressing, status.Status)
+			assert.Equal(t, "waiting for sync gate: query returned [0]", status.Message)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		require.Len(t, syncRes.Gates, 1)
+		assert.Equal(t, "error-rate-gate", syncRes.Gates[0].Name)
+		assert.Equal(t, health.HealthStatusProgressing, syncRes.Gates[0].Status)
+		assert.False(t, syncRes.Gates[0].Completed())
+
+		recheckAfter, pending := RecheckAfter(syncRes)
+		assert.True(t, pending)
+		assert.Greater(t, recheckAfter, 59*time.Minute)
+
+		// a new operation or retry starts over with an empty sync result
+		_, err := NewEvaluator().HealthOverride(nil, syncGateSettings, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		_, pending = RecheckAfter(nil)
+		assert.False(t, pending)
+	})
+
+	t.Run("prometheus is not configured", func(t *testing.T) {
+		obj := newGateResource("prometheus:\n  name: monitoring\n  query: error_rate < bool 0.01\n")
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, status.Status)
+		assert.Equal(t, `waiting for sync gate: prometheus "monitoring" is not configured in the sync gate settings`, status.Message)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		code := http.StatusServiceUnavailable
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+		defer server.Close()
+		syncGateSettings := &settings.SyncGateSettings{Webhooks: map[string]string{"smoke-test": server.URL}}
+		obj := newGateResource("webhook:\n  name: smoke-test\ninterval: 0s\n")
+		override := NewEvaluator().HealthOverride(nil, syncGateSettings, &v1alpha1.SyncOperationResult{})
+
+		status, err := override.GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, status.Status)
+		assert.Equal(t, "waiting for sync gate: webhook returned 503 Service Unavailable", status.Message)
+
+		code = http.StatusOK
+		status, err = override.GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+	})
+
+	t.Run("lua", func(t *testing.T) {
+		obj := newGateResource(`lua: return obj.data.ready == "true"`)
+		obj.Object["data"] = map[string]interface{}{"ready": "true"}
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+	})
+
+	t.Run("timeout fails sync", func(t *testing.T) {
+		obj := newGateResource("lua: return false\ntimeout: 0s\n")
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, status.Status)
+		assert.Equal(t, "sync gate did not pass within 0s: Lua predicate returned false", status.Message)
+	})
+
+	t.Run("timeout continues sync", func(t *testing.T) {
+		obj := newGateResource("lua: return false\ntimeout: 0s\nfailurePolicy: Continue\n")
+		syncRes := &v1alpha1.SyncOperationResult{}
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, syncRes).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+		_, pending := RecheckAfter(syncRes)
+		assert.False(t, pending)
+	})
+
+	t.Run("invalid gate", func(t *testing.T) {
+		obj := newGateResource("timeout: 1m")
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	})
+
+	t.Run("resource is not healthy yet", func(t *testing.T) {
+		obj := newGateResource("lua: return true")
+		obj.SetAPIVersion("batch/v1")
+		obj.SetKind("Job")
+		status, err := NewEvaluator().HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}).GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, status.Status)
+		assert.NotContains(t, status.Message, "sync gate")
+	})
+
+	t.Run("nil evaluator", func(t *testing.T) {
+		var evaluator *Evaluator
+		assert.Nil(t, evaluator.HealthOverride(nil, &settings.SyncGateSettings{}, &v1alpha1.SyncOperationResult{}))
+	})
+}
@@ -0,0 +1,54 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// Clientset represents health plugin server api clients
+type Clientset interface {
+	NewHealthPluginClient(ctx context.Context) (io.Closer, HealthPluginServiceClient, error)
+}
+
+type clientSet struct {
+	address string
+}
+
+func (c *clientSet) NewHealthPluginClient(ctx context.Context) (io.Closer, HealthPluginServiceClient, error) {
+	conn, err := NewConnection(ctx, c.address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewHealthPluginServiceClient(conn), nil
+}
+
+// NewConnection connects to the health plugin at the given address. Addresses with the unix:// prefix refer to a unix
+// domain socket, all others to a TCP host and port.
+func NewConnection(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	network := "tcp"
+	if strings.HasPrefix(address, "unix://") {
+		network = "unix"
+		address = strings.TrimPrefix(address, "unix://")
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(grpc_util.OTELUnaryClientInterceptor()),
+	}
+	conn, err := grpc_util.BlockingDial(ctx, network, address, nil, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to health plugin with address %s: %w", address, err)
+	}
+	return conn, nil
+}
+
+// NewHealthPluginClientSet creates new instance of health plugin server Clientset
+func NewHealthPluginClientSet(address string) Clientset {
+	return &clientSet{address: address}
+}
@@ -390,12 +390,14 @@ func (m *ApplicationResourceEventsQuery) GetProject() string {
 
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	Name            *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	AppNamespace    *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	// historyId generates the manifests of the sources and revisions of the given revision history entry
+	HistoryId            *int64   `protobuf:"varint,7,opt,name=historyId" json:"historyId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -476,6 +478,13 @@ func (m *ApplicationManifestQuery) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationManifestQuery) GetHistoryId() int64 {
+	if m != nil && m.HistoryId != nil {
+		return *m.HistoryId
+	}
+	return 0
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2296,12 +2305,16 @@ func (m *ApplicationSyncWindowsQuery) GetProject() string {
 }
 
 type ApplicationSyncWindowsResponse struct {
-	ActiveWindows        []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
-	AssignedWindows      []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
-	CanSync              *bool                    `protobuf:"varint,3,req,name=canSync" json:"canSync,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ActiveWindows   []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
+	AssignedWindows []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
+	CanSync         *bool                    `protobuf:"varint,3,req,name=canSync" json:"canSync,omitempty"`
+	// freeze is the maintenance freeze which currently prevents syncs of the application, if any
+	Freeze *ApplicationSyncFreeze `protobuf:"bytes,4,opt,name=freeze" json:"freeze,omitempty"`
+	// nextScheduledSync is the time of the next sync of the sync schedule of the application, in RFC3339 format
+	NextScheduledSync    *string  `protobuf:"bytes,5,opt,name=nextScheduledSync" json:"nextScheduledSync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncWindowsResponse{} }
@@ -2358,6 +2371,20 @@ func (m *ApplicationSyncWindowsResponse) GetCanSync() bool {
 	return false
 }
 
+func (m *ApplicationSyncWindowsResponse) GetFreeze() *ApplicationSyncFreeze {
+	if m != nil {
+		return m.Freeze
+	}
+	return nil
+}
+
+func (m *ApplicationSyncWindowsResponse) GetNextScheduledSync() string {
+	if m != nil && m.NextScheduledSync != nil {
+		return *m.NextScheduledSync
+	}
+	return ""
+}
+
 type ApplicationSyncWindow struct {
 	Kind                 *string  `protobuf:"bytes,1,req,name=kind" json:"kind,omitempty"`
 	Schedule             *string  `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
@@ -2799,6 +2826,78 @@ func (m *ListAppLinksRequest) GetProject() string {
 	return ""
 }
 
+// ApplicationSyncFreeze is a maintenance freeze of an application, times are in RFC3339 format
+type ApplicationSyncFreeze struct {
+	Reason               *string  `protobuf:"bytes,1,opt,name=reason" json:"reason,omitempty"`
+	SetBy                *string  `protobuf:"bytes,2,opt,name=setBy" json:"setBy,omitempty"`
+	SetAt                *string  `protobuf:"bytes,3,opt,name=setAt" json:"setAt,omitempty"`
+	ExpiresAt            *string  `protobuf:"bytes,4,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSyncFreeze) Reset()         { *m = ApplicationSyncFreeze{} }
+func (m *ApplicationSyncFreeze) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncFreeze) ProtoMessage()    {}
+func (*ApplicationSyncFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
+}
+func (m *ApplicationSyncFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSyncFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncFreeze.Merge(m, src)
+}
+func (m *ApplicationSyncFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSyncFreeze proto.InternalMessageInfo
+
+func (m *ApplicationSyncFreeze) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+func (m *ApplicationSyncFreeze) GetSetBy() string {
+	if m != nil && m.SetBy != nil {
+		return *m.SetBy
+	}
+	return ""
+}
+
+func (m *ApplicationSyncFreeze) GetSetAt() string {
+	if m != nil && m.SetAt != nil {
+		return *m.SetAt
+	}
+	return ""
+}
+
+func (m *ApplicationSyncFreeze) GetExpiresAt() string {
+	if m != nil && m.ExpiresAt != nil {
+		return *m.ExpiresAt
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
 	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
@@ -2835,6 +2934,7 @@ func init() {
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
+	proto.RegisterType((*ApplicationSyncFreeze)(nil), "application.ApplicationSyncFreeze")
 }
 
 func init() {
@@ -2842,179 +2942,185 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1b, 0x49,
-	0x15, 0xa6, 0xec, 0xf1, 0x8c, 0xe7, 0x79, 0x26, 0x93, 0xd4, 0x26, 0x83, 0xd7, 0x99, 0x0d, 0xde,
-	0x4e, 0xb2, 0x71, 0x26, 0x19, 0x3b, 0x31, 0x01, 0x65, 0x67, 0x77, 0x05, 0xc9, 0xe4, 0x17, 0x26,
-	0xd9, 0xd0, 0x93, 0x10, 0xb4, 0x1c, 0xa0, 0xb6, 0xbb, 0xc6, 0xd3, 0x4c, 0xbb, 0xbb, 0xd3, 0xdd,
-	0x76, 0x18, 0x85, 0x5c, 0x16, 0xed, 0x05, 0xad, 0x40, 0xc0, 0x1e, 0x10, 0x42, 0x80, 0x16, 0xad,
-	0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x20, 0x38, 0x20, 0xad, 0xe0, 0xc8, 0x05,
-	0x45, 0x88, 0x23, 0x5c, 0xf6, 0x8c, 0x50, 0x55, 0x57, 0x75, 0x57, 0xfb, 0xa7, 0xed, 0xc1, 0x46,
-	0x9b, 0x5b, 0xbf, 0x72, 0xd5, 0x7b, 0xdf, 0x7b, 0xf5, 0xea, 0xbd, 0x57, 0xaf, 0x0c, 0x27, 0x02,
-	0xea, 0x77, 0xa9, 0xdf, 0x20, 0x9e, 0x67, 0x5b, 0x06, 0x09, 0x2d, 0xd7, 0x51, 0xbf, 0xeb, 0x9e,
-	0xef, 0x86, 0x2e, 0x2e, 0x29, 0x43, 0x95, 0x95, 0x96, 0xeb, 0xb6, 0x6c, 0xda, 0x20, 0x9e, 0xd5,
-	0x20, 0x8e, 0xe3, 0x86, 0x7c, 0x38, 0x88, 0xa6, 0x56, 0xb4, 0xdd, 0x8b, 0x41, 0xdd, 0x72, 0xf9,
-	0xaf, 0x86, 0xeb, 0xd3, 0x46, 0xf7, 0x7c, 0xa3, 0x45, 0x1d, 0xea, 0x93, 0x90, 0x9a, 0x62, 0xce,
-	0x85, 0x64, 0x4e, 0x9b, 0x18, 0x3b, 0x96, 0x43, 0xfd, 0xbd, 0x86, 0xb7, 0xdb, 0x62, 0x03, 0x41,
-	0xa3, 0x4d, 0x43, 0x32, 0x68, 0xd5, 0x66, 0xcb, 0x0a, 0x77, 0x3a, 0xaf, 0xd7, 0x0d, 0xb7, 0xdd,
-	0x20, 0x7e, 0xcb, 0xf5, 0x7c, 0xf7, 0x4b, 0xfc, 0x63, 0xcd, 0x30, 0x1b, 0xdd, 0x66, 0xc2, 0x40,
-	0xd5, 0xa5, 0x7b, 0x9e, 0xd8, 0xde, 0x0e, 0xe9, 0xe7, 0x76, 0x75, 0x04, 0x37, 0x9f, 0x7a, 0xae,
-	0xb0, 0x0d, 0xff, 0xb4, 0x42, 0xd7, 0xdf, 0x53, 0x3e, 0x23, 0x36, 0xda, 0xfb, 0x08, 0x0e, 0x5e,
-	0x4a, 0xe4, 0x7d, 0xa6, 0x43, 0xfd, 0x3d, 0x8c, 0x61, 0xc6, 0x21, 0x6d, 0x5a, 0x46, 0x55, 0x54,
-	0x9b, 0xd7, 0xf9, 0x37, 0x2e, 0xc3, 0x9c, 0x4f, 0xb7, 0x7d, 0x1a, 0xec, 0x94, 0x73, 0x7c, 0x58,
-	0x92, 0xb8, 0x02, 0x45, 0x26, 0x9c, 0x1a, 0x61, 0x50, 0xce, 0x57, 0xf3, 0xb5, 0x79, 0x3d, 0xa6,
-	0x71, 0x0d, 0x96, 0x7c, 0x1a, 0xb8, 0x1d, 0xdf, 0xa0, 0x9f, 0xa5, 0x7e, 0x60, 0xb9, 0x4e, 0x79,
-	0x86, 0xaf, 0xee, 0x1d, 0x66, 0x5c, 0x02, 0x6a, 0x53, 0x23, 0x74, 0xfd, 0x72, 0x81, 0x4f, 0x89,
-	0x69, 0x86, 0x87, 0x01, 0x2f, 0xcf, 0x46, 0x78, 0xd8, 0x37, 0xd6, 0x60, 0x81, 0x78, 0xde, 0x6d,
-	0xd2, 0xa6, 0x81, 0x47, 0x0c, 0x5a, 0x9e, 0xe3, 0xbf, 0xa5, 0xc6, 0x18, 0x66, 0x81, 0xa4, 0x5c,
-	0xe4, 0xc0, 0x24, 0xa9, 0x6d, 0xc0, 0xfc, 0x6d, 0xd7, 0xa4, 0xc3, 0xd5, 0xed, 0x65, 0x9f, 0xeb,
-	0x67, 0xaf, 0xfd, 0x1e, 0xc1, 0x11, 0x9d, 0x76, 0x2d, 0x86, 0xff, 0x16, 0x0d, 0x89, 0x49, 0x42,
-	0xd2, 0xcb, 0x31, 0x17, 0x73, 0xac, 0x40, 0xd1, 0x17, 0x93, 0xcb, 0x39, 0x3e, 0x1e, 0xd3, 0x7d,
-	0xd2, 0xf2, 0xd9, 0xca, 0x44, 0x26, 0x94, 0x24, 0xae, 0x42, 0x29, 0xb2, 0xe5, 0x4d, 0xc7, 0xa4,
-	0x5f, 0xe6, 0xd6, 0x2b, 0xe8, 0xea, 0x10, 0x5e, 0x81, 0xf9, 0x6e, 0x64, 0xe7, 0x9b, 0x26, 0xb7,
-	0x62, 0x41, 0x4f, 0x06, 0xb4, 0x7f, 0x22, 0x38, 0xa6, 0xf8, 0x80, 0x2e, 0x76, 0xe6, 0x6a, 0x97,
-	0x3a, 0x61, 0x30, 0x5c, 0xa1, 0xb3, 0x70, 0x48, 0x6e, 0x62, 0xaf, 0x9d, 0xfa, 0x7f, 0x60, 0x2a,
-	0xaa, 0x83, 0x52, 0x45, 0x75, 0x8c, 0x29, 0x22, 0xe9, 0x7b, 0x37, 0xaf, 0x08, 0x35, 0xd5, 0xa1,
-	0x3e, 0x43, 0x15, 0xb2, 0x0d, 0x35, 0x9b, 0x32, 0x94, 0xf6, 0x1e, 0x82, 0xb2, 0xa2, 0xe8, 0x2d,
-	0xe2, 0x58, 0xdb, 0x34, 0x08, 0xc7, 0xdd, 0x33, 0x34, 0xc5, 0x3d, 0xab, 0xc1, 0x52, 0xa4, 0xd5,
-	0x1d, 0x76, 0x1e, 0x59, 0xfc, 0x29, 0x17, 0xaa, 0xf9, 0x5a, 0x5e, 0xef, 0x1d, 0x66, 0x7b, 0x27,
-	0x65, 0x06, 0xe5, 0x59, 0xee, 0xc6, 0xc9, 0x80, 0xf6, 0x3c, 0xcc, 0x5f, 0xb3, 0x6c, 0xba, 0xb1,
-	0xd3, 0x71, 0x76, 0xf1, 0x61, 0x28, 0x18, 0xec, 0x83, 0xeb, 0xb0, 0xa0, 0x47, 0x84, 0xf6, 0x4d,
-	0x04, 0xcf, 0x0f, 0xd3, 0xfa, 0xbe, 0x15, 0xee, 0xb0, 0xf5, 0xc1, 0x30, 0xf5, 0x8d, 0x1d, 0x6a,
-	0xec, 0x06, 0x9d, 0xb6, 0x74, 0x59, 0x49, 0x4f, 0xa6, 0xbe, 0xf6, 0x13, 0x04, 0xb5, 0x91, 0x98,
-	0xee, 0xfb, 0xc4, 0xf3, 0xa8, 0x8f, 0xaf, 0x41, 0xe1, 0x01, 0xfb, 0x81, 0x1f, 0xd0, 0x52, 0xb3,
-	0x5e, 0x57, 0x03, 0xfc, 0x48, 0x2e, 0x37, 0x3e, 0xa4, 0x47, 0xcb, 0x71, 0x5d, 0x9a, 0x27, 0xc7,
-	0xf9, 0x2c, 0xa7, 0xf8, 0xc4, 0x56, 0x64, 0xf3, 0xf9, 0xb4, 0xcb, 0xb3, 0x30, 0xe3, 0x11, 0x3f,
-	0xd4, 0x8e, 0xc0, 0x33, 0xe9, 0xe3, 0xe1, 0xb9, 0x4e, 0x40, 0xb5, 0x5f, 0xa7, 0xbd, 0x69, 0xc3,
-	0xa7, 0x24, 0xa4, 0x3a, 0x7d, 0xd0, 0xa1, 0x41, 0x88, 0x77, 0x41, 0xcd, 0x39, 0xdc, 0xaa, 0xa5,
-	0xe6, 0xcd, 0x7a, 0x12, 0xb4, 0xeb, 0x32, 0x68, 0xf3, 0x8f, 0x2f, 0x18, 0x66, 0xbd, 0xdb, 0xac,
-	0x7b, 0xbb, 0xad, 0x3a, 0x4b, 0x01, 0x29, 0x64, 0x32, 0x05, 0xa8, 0xaa, 0xea, 0x2a, 0x77, 0xbc,
-	0x0c, 0xb3, 0x1d, 0x2f, 0xa0, 0x7e, 0xc8, 0x35, 0x2b, 0xea, 0x82, 0x62, 0xfb, 0xd7, 0x25, 0xb6,
-	0x65, 0x92, 0x30, 0xda, 0x9f, 0xa2, 0x1e, 0xd3, 0xda, 0x6f, 0xd2, 0xe8, 0xef, 0x79, 0xe6, 0x07,
-	0x85, 0x5e, 0x45, 0x99, 0x4b, 0xa3, 0x54, 0x3d, 0x28, 0x9f, 0xf6, 0xa0, 0x5f, 0xa4, 0xf1, 0x5f,
-	0xa1, 0x36, 0x4d, 0xf0, 0x0f, 0x72, 0xe6, 0x32, 0xcc, 0x19, 0x24, 0x30, 0x88, 0x29, 0xa5, 0x48,
-	0x92, 0x05, 0x32, 0xcf, 0x77, 0x3d, 0xd2, 0xe2, 0x9c, 0xee, 0xb8, 0xb6, 0x65, 0xec, 0x09, 0x71,
-	0xfd, 0x3f, 0xf4, 0x39, 0xfe, 0x4c, 0xb6, 0xe3, 0x17, 0xd2, 0xb0, 0x8f, 0x43, 0x69, 0x6b, 0xcf,
-	0x31, 0x5e, 0xf5, 0xa2, 0xc3, 0x7d, 0x18, 0x0a, 0x56, 0x48, 0xdb, 0x41, 0x19, 0xf1, 0x83, 0x1d,
-	0x11, 0xda, 0x7f, 0x0a, 0xb0, 0xac, 0xe8, 0xc6, 0x16, 0x64, 0x69, 0x96, 0x15, 0xa5, 0x96, 0x61,
-	0xd6, 0xf4, 0xf7, 0xf4, 0x8e, 0x23, 0x1c, 0x40, 0x50, 0x4c, 0xb0, 0xe7, 0x77, 0x9c, 0x08, 0x7e,
-	0x51, 0x8f, 0x08, 0xbc, 0x0d, 0xc5, 0x20, 0x64, 0x55, 0x46, 0x6b, 0x8f, 0x03, 0x2f, 0x35, 0x3f,
-	0x35, 0xd9, 0xa6, 0x33, 0xe8, 0x5b, 0x82, 0xa3, 0x1e, 0xf3, 0xc6, 0x0f, 0x58, 0x4c, 0x8b, 0x02,
-	0x5d, 0x50, 0x9e, 0xab, 0xe6, 0x6b, 0xa5, 0xe6, 0xd6, 0xe4, 0x82, 0x5e, 0xf5, 0x58, 0x85, 0xa4,
-	0x64, 0x30, 0x3d, 0x91, 0xc2, 0xc2, 0x68, 0x5b, 0xc4, 0x87, 0x40, 0x54, 0x03, 0xc9, 0x00, 0xfe,
-	0x1c, 0x14, 0x2c, 0x67, 0xdb, 0x0d, 0xca, 0xf3, 0x1c, 0xcc, 0xe5, 0xc9, 0xc0, 0xdc, 0x74, 0xb6,
-	0x5d, 0x3d, 0x62, 0x88, 0x1f, 0xc0, 0xa2, 0x4f, 0x43, 0x7f, 0x4f, 0x5a, 0xa1, 0x0c, 0xdc, 0xae,
-	0x9f, 0x9e, 0x4c, 0x82, 0xae, 0xb2, 0xd4, 0xd3, 0x12, 0xf0, 0x3a, 0x94, 0x82, 0xc4, 0xc7, 0xca,
-	0x25, 0x2e, 0xb0, 0x9c, 0x62, 0xa4, 0xf8, 0xa0, 0xae, 0x4e, 0xee, 0xf3, 0xee, 0x85, 0x6c, 0xef,
-	0x5e, 0x1c, 0x99, 0xd5, 0x0e, 0x8c, 0x91, 0xd5, 0x96, 0x7a, 0xb3, 0xda, 0xbf, 0x11, 0xac, 0xf4,
-	0x05, 0xa7, 0x2d, 0x8f, 0x66, 0x1e, 0x03, 0x02, 0x33, 0x81, 0x47, 0x0d, 0x9e, 0xa9, 0x4a, 0xcd,
-	0x5b, 0x53, 0x8b, 0x56, 0x5c, 0x2e, 0x67, 0x9d, 0x15, 0x50, 0x27, 0x8c, 0x0b, 0x3f, 0x40, 0xf0,
-	0x61, 0x45, 0xe6, 0x1d, 0x12, 0x1a, 0x3b, 0x59, 0xca, 0xb2, 0xf3, 0xcb, 0xe6, 0x88, 0xbc, 0x1c,
-	0x11, 0xcc, 0xaa, 0xfc, 0xe3, 0xee, 0x9e, 0xc7, 0x00, 0xb2, 0x5f, 0x92, 0x81, 0x09, 0x8b, 0xa7,
-	0x9f, 0x22, 0xa8, 0xa8, 0x31, 0xdc, 0xb5, 0xed, 0xd7, 0x89, 0xb1, 0x9b, 0x05, 0xf2, 0x00, 0xe4,
-	0x2c, 0x93, 0x23, 0xcc, 0xeb, 0x39, 0xcb, 0xdc, 0x67, 0x30, 0xea, 0x85, 0x3b, 0x9b, 0x0d, 0x77,
-	0x2e, 0x0d, 0xf7, 0xfd, 0x1e, 0xb8, 0x32, 0x24, 0x64, 0xc0, 0x5d, 0x81, 0x79, 0xa7, 0xa7, 0x90,
-	0x4d, 0x06, 0x06, 0x14, 0xb0, 0xb9, 0xbe, 0x02, 0xb6, 0x0c, 0x73, 0xdd, 0xf8, 0x9a, 0xc3, 0x7e,
-	0x96, 0x24, 0x53, 0xb1, 0xe5, 0xbb, 0x1d, 0x4f, 0x18, 0x3d, 0x22, 0x18, 0x8a, 0x5d, 0xcb, 0x61,
-	0x25, 0x39, 0x47, 0xc1, 0xbe, 0xf7, 0x7f, 0xb1, 0x49, 0xa9, 0xfd, 0xb3, 0x1c, 0x7c, 0x64, 0x80,
-	0xda, 0x23, 0xfd, 0xe9, 0xe9, 0xd0, 0x3d, 0xf6, 0xea, 0xb9, 0xa1, 0x5e, 0x5d, 0x1c, 0xe5, 0xd5,
-	0xf3, 0xd9, 0xf6, 0x82, 0xb4, 0xbd, 0x7e, 0x9c, 0x83, 0xea, 0x00, 0x7b, 0x8d, 0x2e, 0x27, 0x9e,
-	0x1a, 0x83, 0x6d, 0xbb, 0xbe, 0xf0, 0x92, 0xa2, 0x1e, 0x11, 0xec, 0x9c, 0xb9, 0xbe, 0xb7, 0x43,
-	0x1c, 0xee, 0x1d, 0x45, 0x5d, 0x50, 0x13, 0x9a, 0xea, 0x6b, 0x39, 0x28, 0x4b, 0xfb, 0x5c, 0x32,
-	0xb8, 0xb5, 0x3a, 0xce, 0xd3, 0x6f, 0xa2, 0x65, 0x98, 0x25, 0x1c, 0xad, 0x70, 0x2a, 0x41, 0xf5,
-	0x19, 0xa3, 0x98, 0x6d, 0x8c, 0xf9, 0xb4, 0x31, 0xde, 0x44, 0x70, 0x34, 0x6d, 0x8c, 0x60, 0xd3,
-	0x0a, 0x42, 0x79, 0x39, 0xc0, 0xdb, 0x30, 0x17, 0xc9, 0x89, 0x4a, 0xbb, 0x52, 0x73, 0x73, 0xd2,
-	0x84, 0x9f, 0x32, 0xbc, 0x64, 0xae, 0xbd, 0x08, 0x47, 0x07, 0x46, 0x39, 0x01, 0xa3, 0x02, 0x45,
-	0x59, 0xe4, 0x88, 0xad, 0x89, 0x69, 0xed, 0xcd, 0x99, 0x74, 0xca, 0x71, 0xcd, 0x4d, 0xb7, 0x95,
-	0x71, 0xdf, 0xcf, 0xde, 0x4e, 0x66, 0x2a, 0xd7, 0x54, 0xae, 0xf6, 0x92, 0x64, 0xeb, 0x0c, 0xd7,
-	0x09, 0x89, 0xe5, 0x50, 0x5f, 0x64, 0xc5, 0x64, 0x80, 0x6d, 0x43, 0x60, 0x39, 0x06, 0xdd, 0xa2,
-	0x86, 0xeb, 0x98, 0x01, 0xdf, 0xcf, 0xbc, 0x9e, 0x1a, 0xc3, 0x37, 0x60, 0x9e, 0xd3, 0x77, 0xad,
-	0x76, 0x94, 0x06, 0x4a, 0xcd, 0xd5, 0x7a, 0xd4, 0x83, 0xab, 0xab, 0x3d, 0xb8, 0xc4, 0x86, 0x6d,
-	0x1a, 0x92, 0x7a, 0xf7, 0x7c, 0x9d, 0xad, 0xd0, 0x93, 0xc5, 0x0c, 0x4b, 0x48, 0x2c, 0x7b, 0xd3,
-	0x72, 0x78, 0xe1, 0xc9, 0x44, 0x25, 0x03, 0xcc, 0x55, 0xb6, 0x5d, 0xdb, 0x76, 0x1f, 0xca, 0x73,
-	0x13, 0x51, 0x6c, 0x55, 0xc7, 0x09, 0x2d, 0x9b, 0xcb, 0x8f, 0x1c, 0x21, 0x19, 0xe0, 0xab, 0x2c,
-	0x3b, 0xa4, 0xbe, 0x38, 0x30, 0x82, 0x8a, 0x9d, 0xb1, 0x14, 0xb5, 0x95, 0xe4, 0x79, 0x8d, 0xdc,
-	0x76, 0x41, 0x75, 0xdb, 0xde, 0xa3, 0xb0, 0x38, 0xa0, 0x37, 0xc2, 0xbb, 0x6c, 0xb4, 0x6b, 0xb9,
-	0x1d, 0x56, 0x53, 0xf1, 0xd2, 0x43, 0xd2, 0x7d, 0xae, 0xbc, 0x94, 0xed, 0xca, 0x07, 0xd3, 0xae,
-	0xfc, 0x5b, 0x04, 0xc5, 0x4d, 0xb7, 0x75, 0xd5, 0x09, 0xfd, 0x3d, 0x7e, 0x4b, 0x72, 0x9d, 0x90,
-	0x3a, 0xd2, 0x5f, 0x24, 0xc9, 0x36, 0x21, 0xb4, 0xda, 0x74, 0x2b, 0x24, 0x6d, 0x4f, 0xd4, 0x58,
-	0xfb, 0xda, 0x84, 0x78, 0x31, 0x33, 0x8c, 0x4d, 0x82, 0x90, 0x9f, 0xf8, 0xa2, 0xce, 0xbf, 0x99,
-	0x0a, 0xf1, 0x84, 0xad, 0xd0, 0x17, 0xc7, 0x3d, 0x35, 0xa6, 0xba, 0x58, 0x21, 0xc2, 0x26, 0x48,
-	0xad, 0x0d, 0xcf, 0xc6, 0xc5, 0xff, 0x5d, 0xea, 0xb7, 0x2d, 0x87, 0x64, 0x47, 0xef, 0x31, 0xda,
-	0x7b, 0x19, 0x77, 0x4f, 0x37, 0x75, 0xe8, 0x58, 0x2d, 0x7d, 0xdf, 0x72, 0x4c, 0xf7, 0x61, 0xc6,
-	0xe1, 0x99, 0x4c, 0xe0, 0x5f, 0xd2, 0x1d, 0x3a, 0x45, 0x62, 0x7c, 0xd2, 0x6f, 0xc0, 0x22, 0x8b,
-	0x09, 0x5d, 0x2a, 0x7e, 0x10, 0x61, 0x47, 0x1b, 0xd6, 0x2c, 0x49, 0x78, 0xe8, 0xe9, 0x85, 0x78,
-	0x13, 0x96, 0x48, 0x10, 0x58, 0x2d, 0x87, 0x9a, 0x92, 0x57, 0x6e, 0x6c, 0x5e, 0xbd, 0x4b, 0xa3,
-	0x6b, 0x37, 0x9f, 0x21, 0xf6, 0x5b, 0x92, 0xda, 0x57, 0x11, 0x1c, 0x19, 0xc8, 0x24, 0x3e, 0x39,
-	0x48, 0x09, 0xe3, 0x15, 0x28, 0x06, 0xc6, 0x0e, 0x35, 0x3b, 0x36, 0x95, 0xbd, 0x28, 0x49, 0xb3,
-	0xdf, 0xcc, 0x4e, 0xb4, 0xfb, 0x22, 0x8d, 0xc4, 0x34, 0x3e, 0x06, 0xd0, 0x26, 0x4e, 0x87, 0xd8,
-	0x1c, 0xc2, 0x0c, 0x87, 0xa0, 0x8c, 0x68, 0x2b, 0x50, 0x19, 0xe4, 0x3a, 0xa2, 0xc7, 0xf3, 0x2f,
-	0x04, 0x07, 0x64, 0x50, 0x15, 0xbb, 0x5b, 0x83, 0x25, 0xc5, 0x0c, 0xb7, 0x93, 0x8d, 0xee, 0x1d,
-	0x1e, 0x11, 0x30, 0xa5, 0x97, 0xe4, 0xd3, 0x4d, 0xf6, 0x6e, 0xaa, 0x4d, 0x3e, 0x76, 0xbe, 0x43,
-	0x53, 0xaa, 0x1f, 0xbf, 0x02, 0xe5, 0x5b, 0xc4, 0x21, 0x2d, 0x6a, 0xc6, 0x6a, 0xc7, 0x2e, 0xf6,
-	0x45, 0xb5, 0x59, 0x31, 0x71, 0x6b, 0x20, 0x2e, 0xb5, 0xac, 0xed, 0x6d, 0xd9, 0xf8, 0xf0, 0xa1,
-	0xb8, 0x69, 0x39, 0xbb, 0xec, 0xfe, 0xcc, 0x34, 0x0e, 0xad, 0xd0, 0x96, 0xd6, 0x8d, 0x08, 0x7c,
-	0x10, 0xf2, 0x1d, 0xdf, 0x16, 0x1e, 0xc0, 0x3e, 0x71, 0x15, 0x4a, 0x26, 0x0d, 0x0c, 0xdf, 0xf2,
-	0xc4, 0xfe, 0xf3, 0xa6, 0xb1, 0x32, 0xc4, 0xf6, 0xc1, 0x32, 0x5c, 0x67, 0xc3, 0x26, 0x41, 0x20,
-	0x13, 0x50, 0x3c, 0xa0, 0xbd, 0x0c, 0x8b, 0x4c, 0x66, 0xa2, 0xe6, 0x99, 0xb4, 0x9a, 0x47, 0x52,
-	0xf0, 0x25, 0x3c, 0x89, 0x98, 0xc0, 0x33, 0x2c, 0xef, 0x5f, 0xf2, 0x3c, 0xc1, 0x64, 0xcc, 0x72,
-	0x28, 0x3f, 0x28, 0x7f, 0x0e, 0xec, 0x95, 0x36, 0xff, 0x76, 0x1c, 0xb0, 0x7a, 0x4e, 0xa8, 0xdf,
-	0xb5, 0x0c, 0x8a, 0xbf, 0x85, 0x60, 0x86, 0x89, 0xc6, 0xcf, 0x0d, 0x3b, 0x96, 0xdc, 0x5f, 0x2b,
-	0xd3, 0xbb, 0x08, 0x33, 0x69, 0xda, 0xca, 0x1b, 0x7f, 0xfd, 0xc7, 0xb7, 0x73, 0xcb, 0xf8, 0x30,
-	0x7f, 0x21, 0xeb, 0x9e, 0x57, 0x5f, 0xab, 0x02, 0xfc, 0x16, 0x02, 0x2c, 0xea, 0x20, 0xe5, 0x0d,
-	0x01, 0x9f, 0x19, 0x06, 0x71, 0xc0, 0x5b, 0x43, 0xe5, 0x39, 0x25, 0xab, 0xd4, 0x0d, 0xd7, 0xa7,
-	0x2c, 0x87, 0xf0, 0x09, 0x1c, 0xc0, 0x2a, 0x07, 0x70, 0x02, 0x6b, 0x83, 0x00, 0x34, 0x1e, 0x31,
-	0x8b, 0x3e, 0x6e, 0xd0, 0x48, 0xee, 0x3b, 0x08, 0x0a, 0xf7, 0xf9, 0x1d, 0x62, 0x84, 0x91, 0xb6,
-	0xa6, 0x66, 0x24, 0x2e, 0x8e, 0xa3, 0xd5, 0x8e, 0x73, 0xa4, 0xcf, 0xe1, 0xa3, 0x12, 0x69, 0x10,
-	0xfa, 0x94, 0xb4, 0x53, 0x80, 0xcf, 0x21, 0xfc, 0x2e, 0x82, 0xd9, 0xa8, 0x79, 0x8c, 0x4f, 0x0e,
-	0x43, 0x99, 0x6a, 0x2e, 0x57, 0xa6, 0xd7, 0x89, 0xd5, 0x4e, 0x73, 0x8c, 0xc7, 0xb5, 0x81, 0xdb,
-	0xb9, 0x9e, 0xea, 0xd3, 0xbe, 0x8d, 0x20, 0x7f, 0x9d, 0x8e, 0xf4, 0xb7, 0x29, 0x82, 0xeb, 0x33,
-	0xe0, 0x80, 0xad, 0xc6, 0x3f, 0x42, 0xf0, 0xec, 0x75, 0x1a, 0x0e, 0x4e, 0x8f, 0xb8, 0x36, 0x3a,
-	0x67, 0x09, 0xb7, 0x3b, 0x33, 0xc6, 0xcc, 0x38, 0x2f, 0x34, 0x38, 0xb2, 0xd3, 0xf8, 0x54, 0x96,
-	0x13, 0x06, 0x7b, 0x8e, 0xf1, 0x50, 0xe0, 0xf8, 0x13, 0x82, 0x83, 0xbd, 0x6f, 0x85, 0x38, 0x9d,
-	0x50, 0x07, 0x3e, 0x25, 0x56, 0x6e, 0x4f, 0x1a, 0x65, 0xd3, 0x4c, 0xb5, 0x4b, 0x1c, 0xf9, 0x4b,
-	0xf8, 0xc5, 0x2c, 0xe4, 0x71, 0x27, 0xae, 0xf1, 0x48, 0x7e, 0x3e, 0xe6, 0xef, 0xda, 0x1c, 0xf6,
-	0x9f, 0x11, 0x1c, 0x96, 0x7c, 0x37, 0x76, 0x88, 0x1f, 0x5e, 0xa1, 0xac, 0x86, 0x0e, 0xc6, 0xd2,
-	0x67, 0xc2, 0xac, 0xa1, 0xca, 0xd3, 0xae, 0x72, 0x5d, 0x3e, 0x81, 0x5f, 0xd9, 0xb7, 0x2e, 0x06,
-	0x63, 0x63, 0x0a, 0xd8, 0x6f, 0x20, 0x58, 0xb8, 0x4e, 0xc3, 0x5b, 0x71, 0x37, 0xf8, 0xe4, 0x58,
-	0x2f, 0x4c, 0x95, 0x95, 0xba, 0xf2, 0x9c, 0x2e, 0x7f, 0x8a, 0x5d, 0x64, 0x8d, 0x83, 0x3b, 0x85,
-	0x4f, 0x66, 0x81, 0x4b, 0x3a, 0xd0, 0xef, 0x20, 0x38, 0xa2, 0x82, 0x48, 0x5e, 0xe6, 0x3e, 0xb6,
-	0xbf, 0xf7, 0x2e, 0xf1, 0x6a, 0x36, 0x02, 0x5d, 0x93, 0xa3, 0x3b, 0xab, 0x0d, 0x76, 0xe0, 0x76,
-	0x1f, 0x8a, 0x75, 0xb4, 0x5a, 0x43, 0xf8, 0x77, 0x08, 0x66, 0xa3, 0x66, 0xec, 0x70, 0x1b, 0xa5,
-	0x5e, 0x92, 0xa6, 0x19, 0x0d, 0xc4, 0x6e, 0x57, 0xce, 0x0d, 0x36, 0xa8, 0xba, 0x5e, 0xba, 0x6a,
-	0x9d, 0x5b, 0x39, 0x1d, 0xc6, 0x7e, 0x89, 0x00, 0x92, 0x86, 0x32, 0x3e, 0x9d, 0xad, 0x87, 0xd2,
-	0x74, 0xae, 0x4c, 0xb7, 0xa5, 0xac, 0xd5, 0xb9, 0x3e, 0xb5, 0x4a, 0x35, 0x33, 0x86, 0x78, 0xd4,
-	0x58, 0x8f, 0x9a, 0xcf, 0x3f, 0x44, 0x50, 0xe0, 0x7d, 0x3c, 0x7c, 0x62, 0x18, 0x66, 0xb5, 0xcd,
-	0x37, 0x4d, 0xd3, 0xbf, 0xc0, 0xa1, 0x56, 0x9b, 0x59, 0x81, 0x78, 0x1d, 0xad, 0xe2, 0x2e, 0xcc,
-	0x46, 0x9d, 0xb3, 0xe1, 0xee, 0x91, 0xea, 0xac, 0x55, 0xaa, 0x19, 0x85, 0x41, 0xe4, 0xa8, 0x22,
-	0x07, 0xac, 0x8e, 0xca, 0x01, 0x33, 0x2c, 0x4c, 0xe3, 0xe3, 0x59, 0x41, 0xfc, 0xff, 0x60, 0x98,
-	0x33, 0x1c, 0xdd, 0x49, 0xad, 0x3a, 0x2a, 0x0f, 0x30, 0xeb, 0x7c, 0x07, 0xc1, 0xc1, 0xde, 0xe2,
-	0x1a, 0x1f, 0xed, 0x89, 0x99, 0xea, 0x5d, 0xa3, 0x92, 0xb6, 0xe2, 0xb0, 0xc2, 0x5c, 0xfb, 0x24,
-	0x47, 0xb1, 0x8e, 0x2f, 0x8e, 0x3c, 0x19, 0xb7, 0x65, 0xd4, 0x61, 0x8c, 0xd6, 0x92, 0xd7, 0xb1,
-	0x5f, 0x21, 0x58, 0x90, 0x7c, 0xef, 0xfa, 0x94, 0x66, 0xc3, 0x9a, 0xde, 0x41, 0x60, 0xb2, 0xb4,
-	0x97, 0x39, 0xfc, 0x8f, 0xe3, 0x0b, 0x63, 0xc2, 0x97, 0xb0, 0xd7, 0x42, 0x86, 0xf4, 0x0f, 0x08,
-	0x0e, 0xdd, 0x8f, 0xfc, 0xfe, 0x03, 0xc2, 0xbf, 0xc1, 0xf1, 0xbf, 0x82, 0x5f, 0xca, 0xa8, 0xf3,
-	0x46, 0xa9, 0x71, 0x0e, 0xe1, 0x9f, 0x23, 0x28, 0xca, 0x57, 0x15, 0x7c, 0x6a, 0xe8, 0xc1, 0x48,
-	0xbf, 0xbb, 0x4c, 0xd3, 0x99, 0x45, 0x51, 0xa3, 0x9d, 0xc8, 0x4c, 0xa7, 0x42, 0x3e, 0x73, 0xe8,
-	0xb7, 0x11, 0xe0, 0xf8, 0xce, 0x1c, 0xdf, 0xa2, 0xf1, 0x0b, 0x29, 0x51, 0x43, 0x1b, 0x33, 0x95,
-	0x53, 0x23, 0xe7, 0xa5, 0x53, 0xe9, 0x6a, 0x66, 0x2a, 0x75, 0x63, 0xf9, 0x5f, 0x47, 0x50, 0xba,
-	0x4e, 0xe3, 0x3b, 0x48, 0x86, 0x2d, 0xd3, 0x8f, 0x42, 0x95, 0xda, 0xe8, 0x89, 0x02, 0xd1, 0x59,
-	0x8e, 0xe8, 0x05, 0x9c, 0x6d, 0x2a, 0x09, 0xe0, 0x7b, 0x08, 0x16, 0xef, 0xa8, 0x2e, 0x8a, 0xcf,
-	0x8e, 0x92, 0x94, 0x8a, 0xe4, 0xe3, 0xe3, 0xfa, 0x28, 0xc7, 0xb5, 0xa6, 0x8d, 0x85, 0x6b, 0x5d,
-	0xbc, 0xaf, 0x7c, 0x1f, 0x45, 0x97, 0xd8, 0x9e, 0x7e, 0xf6, 0xff, 0x6a, 0xb7, 0x8c, 0xb6, 0xb8,
-	0x76, 0x81, 0xe3, 0xab, 0xe3, 0xb3, 0xe3, 0xe0, 0x6b, 0x88, 0x26, 0x37, 0xfe, 0x2e, 0x82, 0x43,
-	0xfc, 0xad, 0x41, 0x65, 0xdc, 0x93, 0x62, 0x86, 0xbd, 0x4c, 0x8c, 0x91, 0x62, 0x44, 0xfc, 0xd1,
-	0xf6, 0x05, 0x6a, 0x5d, 0xbe, 0x23, 0x7c, 0x03, 0xc1, 0x01, 0x99, 0xd4, 0xc4, 0xee, 0xae, 0x8d,
-	0x32, 0xdc, 0x7e, 0x93, 0xa0, 0x70, 0xb7, 0xd5, 0xf1, 0xdc, 0xed, 0x5d, 0x04, 0x73, 0xa2, 0x9b,
-	0x9f, 0x51, 0x2a, 0x28, 0xed, 0xfe, 0x4a, 0x4f, 0x8f, 0x43, 0x34, 0x83, 0xb5, 0xcf, 0x73, 0xb1,
-	0xf7, 0x70, 0x23, 0x4b, 0xac, 0xe7, 0x9a, 0x41, 0xe3, 0x91, 0xe8, 0xc4, 0x3e, 0x6e, 0xd8, 0x6e,
-	0x2b, 0x78, 0x4d, 0xc3, 0x99, 0x09, 0x91, 0xcd, 0x39, 0x87, 0x70, 0x08, 0xf3, 0xcc, 0x39, 0x78,
-	0xe3, 0x04, 0x57, 0x7b, 0xda, 0x2c, 0x7d, 0x3d, 0x95, 0x4a, 0xa5, 0xaf, 0x11, 0x93, 0x64, 0x40,
-	0x71, 0x8d, 0xc5, 0xcf, 0x67, 0x8a, 0xe5, 0x82, 0xde, 0x42, 0x70, 0x48, 0xf5, 0xf6, 0x48, 0xfc,
-	0xd8, 0xbe, 0x9e, 0x85, 0x42, 0x14, 0xd5, 0x78, 0x75, 0x2c, 0x47, 0xe2, 0x70, 0x2e, 0x5f, 0xfb,
-	0xe3, 0x93, 0x63, 0xe8, 0xbd, 0x27, 0xc7, 0xd0, 0xdf, 0x9f, 0x1c, 0x43, 0xaf, 0x5d, 0x1c, 0xef,
-	0x3f, 0xc2, 0x86, 0x6d, 0x51, 0x27, 0x54, 0xd9, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x45, 0x63,
-	0x3b, 0x00, 0x09, 0x2d, 0x00, 0x00,
+	// 2840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5f, 0x8c, 0x1b, 0x47,
+	0x19, 0x67, 0xec, 0xb3, 0xcf, 0xf7, 0xf9, 0x2e, 0x97, 0x4c, 0x93, 0xc3, 0x75, 0xae, 0xc1, 0xdd,
+	0x24, 0x8d, 0x7b, 0xc9, 0xd9, 0x89, 0x29, 0xa8, 0xbd, 0xb6, 0x82, 0xcb, 0x35, 0x49, 0x0f, 0x2e,
+	0x69, 0xd8, 0x4b, 0x09, 0x2a, 0x0f, 0xb0, 0xdd, 0x9d, 0xb3, 0x97, 0xb3, 0x77, 0x37, 0x3b, 0x6b,
+	0xb7, 0xa6, 0xf4, 0xa5, 0xa8, 0x2f, 0xa8, 0x02, 0x01, 0x7d, 0x40, 0x08, 0x01, 0x2a, 0xaa, 0x84,
+	0x10, 0x88, 0x17, 0x84, 0x90, 0x00, 0x09, 0x1e, 0x40, 0xf0, 0x80, 0x84, 0xc4, 0x23, 0x2f, 0x28,
+	0x42, 0x3c, 0x96, 0x97, 0x3e, 0x23, 0x34, 0xb3, 0x33, 0xbb, 0xb3, 0xfe, 0xb3, 0xf6, 0x61, 0xa3,
+	0xe6, 0x6d, 0xbf, 0xf1, 0xcc, 0xf7, 0xfd, 0xbe, 0x6f, 0xbe, 0xf9, 0xbe, 0x6f, 0xbe, 0x31, 0x9c,
+	0xa3, 0xc4, 0xef, 0x11, 0xbf, 0x6e, 0x78, 0x5e, 0xdb, 0x36, 0x8d, 0xc0, 0x76, 0x1d, 0xf5, 0xbb,
+	0xe6, 0xf9, 0x6e, 0xe0, 0xe2, 0xa2, 0x32, 0x54, 0x5e, 0x6f, 0xba, 0x6e, 0xb3, 0x4d, 0xea, 0x86,
+	0x67, 0xd7, 0x0d, 0xc7, 0x71, 0x03, 0x3e, 0x4c, 0xc3, 0xa9, 0x65, 0xed, 0xf0, 0x49, 0x5a, 0xb3,
+	0x5d, 0xfe, 0xab, 0xe9, 0xfa, 0xa4, 0xde, 0xbb, 0x52, 0x6f, 0x12, 0x87, 0xf8, 0x46, 0x40, 0x2c,
+	0x31, 0xe7, 0x89, 0x78, 0x4e, 0xc7, 0x30, 0x5b, 0xb6, 0x43, 0xfc, 0x7e, 0xdd, 0x3b, 0x6c, 0xb2,
+	0x01, 0x5a, 0xef, 0x90, 0xc0, 0x18, 0xb5, 0x6a, 0xaf, 0x69, 0x07, 0xad, 0xee, 0xcb, 0x35, 0xd3,
+	0xed, 0xd4, 0x0d, 0xbf, 0xe9, 0x7a, 0xbe, 0xfb, 0x25, 0xfe, 0xb1, 0x69, 0x5a, 0xf5, 0x5e, 0x23,
+	0x66, 0xa0, 0xea, 0xd2, 0xbb, 0x62, 0xb4, 0xbd, 0x96, 0x31, 0xcc, 0xed, 0xda, 0x04, 0x6e, 0x3e,
+	0xf1, 0x5c, 0x61, 0x1b, 0xfe, 0x69, 0x07, 0xae, 0xdf, 0x57, 0x3e, 0x43, 0x36, 0xda, 0xfb, 0x08,
+	0x8e, 0x6f, 0xc7, 0xf2, 0x3e, 0xd3, 0x25, 0x7e, 0x1f, 0x63, 0x58, 0x70, 0x8c, 0x0e, 0x29, 0xa1,
+	0x0a, 0xaa, 0x2e, 0xe9, 0xfc, 0x1b, 0x97, 0x60, 0xd1, 0x27, 0x07, 0x3e, 0xa1, 0xad, 0x52, 0x86,
+	0x0f, 0x4b, 0x12, 0x97, 0xa1, 0xc0, 0x84, 0x13, 0x33, 0xa0, 0xa5, 0x6c, 0x25, 0x5b, 0x5d, 0xd2,
+	0x23, 0x1a, 0x57, 0x61, 0xd5, 0x27, 0xd4, 0xed, 0xfa, 0x26, 0xf9, 0x2c, 0xf1, 0xa9, 0xed, 0x3a,
+	0xa5, 0x05, 0xbe, 0x7a, 0x70, 0x98, 0x71, 0xa1, 0xa4, 0x4d, 0xcc, 0xc0, 0xf5, 0x4b, 0x39, 0x3e,
+	0x25, 0xa2, 0x19, 0x1e, 0x06, 0xbc, 0x94, 0x0f, 0xf1, 0xb0, 0x6f, 0xac, 0xc1, 0xb2, 0xe1, 0x79,
+	0xb7, 0x8c, 0x0e, 0xa1, 0x9e, 0x61, 0x92, 0xd2, 0x22, 0xff, 0x2d, 0x31, 0xc6, 0x30, 0x0b, 0x24,
+	0xa5, 0x02, 0x07, 0x26, 0x49, 0x6d, 0x07, 0x96, 0x6e, 0xb9, 0x16, 0x19, 0xaf, 0xee, 0x20, 0xfb,
+	0xcc, 0x30, 0x7b, 0xed, 0x0f, 0x08, 0x4e, 0xe9, 0xa4, 0x67, 0x33, 0xfc, 0x37, 0x49, 0x60, 0x58,
+	0x46, 0x60, 0x0c, 0x72, 0xcc, 0x44, 0x1c, 0xcb, 0x50, 0xf0, 0xc5, 0xe4, 0x52, 0x86, 0x8f, 0x47,
+	0xf4, 0x90, 0xb4, 0x6c, 0xba, 0x32, 0xa1, 0x09, 0x25, 0x89, 0x2b, 0x50, 0x0c, 0x6d, 0xb9, 0xeb,
+	0x58, 0xe4, 0x55, 0x6e, 0xbd, 0x9c, 0xae, 0x0e, 0xe1, 0x75, 0x58, 0xea, 0x85, 0x76, 0xde, 0xb5,
+	0xb8, 0x15, 0x73, 0x7a, 0x3c, 0xa0, 0xfd, 0x0b, 0xc1, 0x19, 0xc5, 0x07, 0x74, 0xb1, 0x33, 0xd7,
+	0x7a, 0xc4, 0x09, 0xe8, 0x78, 0x85, 0x2e, 0xc1, 0x09, 0xb9, 0x89, 0x83, 0x76, 0x1a, 0xfe, 0x81,
+	0xa9, 0xa8, 0x0e, 0x4a, 0x15, 0xd5, 0x31, 0xa6, 0x88, 0xa4, 0x5f, 0xdc, 0x7d, 0x4e, 0xa8, 0xa9,
+	0x0e, 0x0d, 0x19, 0x2a, 0x97, 0x6e, 0xa8, 0x7c, 0xc2, 0x50, 0xda, 0x7b, 0x08, 0x4a, 0x8a, 0xa2,
+	0x37, 0x0d, 0xc7, 0x3e, 0x20, 0x34, 0x98, 0x76, 0xcf, 0xd0, 0x1c, 0xf7, 0xac, 0x0a, 0xab, 0xa1,
+	0x56, 0xb7, 0xd9, 0x79, 0x64, 0xf1, 0xa7, 0x94, 0xab, 0x64, 0xab, 0x59, 0x7d, 0x70, 0x98, 0xed,
+	0x9d, 0x94, 0x49, 0x4b, 0x79, 0xee, 0xc6, 0xf1, 0x00, 0xfb, 0xb5, 0x65, 0x53, 0x76, 0xa0, 0x77,
+	0x2d, 0x7e, 0x06, 0xb2, 0x7a, 0x3c, 0xa0, 0x3d, 0x0a, 0x4b, 0xd7, 0xed, 0x36, 0xd9, 0x69, 0x75,
+	0x9d, 0x43, 0x7c, 0x12, 0x72, 0x26, 0xfb, 0xe0, 0x1a, 0x2e, 0xeb, 0x21, 0xa1, 0x7d, 0x13, 0xc1,
+	0xa3, 0xe3, 0x6c, 0x72, 0xd7, 0x0e, 0x5a, 0x6c, 0x3d, 0x1d, 0x67, 0x1c, 0xb3, 0x45, 0xcc, 0x43,
+	0xda, 0xed, 0x48, 0x87, 0x96, 0xf4, 0x6c, 0xc6, 0xd1, 0x7e, 0x82, 0xa0, 0x3a, 0x11, 0xd3, 0x5d,
+	0xdf, 0xf0, 0x3c, 0xe2, 0xe3, 0xeb, 0x90, 0xbb, 0xc7, 0x7e, 0xe0, 0xc7, 0xb7, 0xd8, 0xa8, 0xd5,
+	0xd4, 0xf0, 0x3f, 0x91, 0xcb, 0xf3, 0x1f, 0xd2, 0xc3, 0xe5, 0xb8, 0x26, 0xcd, 0x93, 0xe1, 0x7c,
+	0xd6, 0x12, 0x7c, 0x22, 0x2b, 0xb2, 0xf9, 0x7c, 0xda, 0xd5, 0x3c, 0x2c, 0x78, 0x86, 0x1f, 0x68,
+	0xa7, 0xe0, 0xa1, 0xe4, 0xe1, 0xf1, 0x5c, 0x87, 0x12, 0xed, 0xd7, 0x49, 0x5f, 0xdb, 0xf1, 0x89,
+	0x11, 0x10, 0x9d, 0xdc, 0xeb, 0x12, 0x1a, 0xe0, 0x43, 0x50, 0x33, 0x12, 0xb7, 0x6a, 0xb1, 0xb1,
+	0x5b, 0x8b, 0x43, 0x7a, 0x4d, 0x86, 0x74, 0xfe, 0xf1, 0x05, 0xd3, 0xaa, 0xf5, 0x1a, 0x35, 0xef,
+	0xb0, 0x59, 0x63, 0x09, 0x22, 0x81, 0x4c, 0x26, 0x08, 0x55, 0x55, 0x5d, 0xe5, 0x8e, 0xd7, 0x20,
+	0xdf, 0xf5, 0x28, 0xf1, 0x03, 0xae, 0x59, 0x41, 0x17, 0x14, 0xdb, 0xbf, 0x9e, 0xd1, 0xb6, 0x2d,
+	0x23, 0x08, 0xf7, 0xa7, 0xa0, 0x47, 0xb4, 0xf6, 0xdb, 0x24, 0xfa, 0x17, 0x3d, 0xeb, 0x83, 0x42,
+	0xaf, 0xa2, 0xcc, 0x24, 0x51, 0xaa, 0x1e, 0x94, 0x4d, 0x7a, 0xd0, 0x2f, 0x92, 0xf8, 0x9f, 0x23,
+	0x6d, 0x12, 0xe3, 0x1f, 0xe5, 0xcc, 0x25, 0x58, 0x34, 0x0d, 0x6a, 0x1a, 0x96, 0x94, 0x22, 0x49,
+	0x16, 0xe6, 0x3c, 0xdf, 0xf5, 0x8c, 0x26, 0xe7, 0x74, 0xdb, 0x6d, 0xdb, 0x66, 0x5f, 0x88, 0x1b,
+	0xfe, 0x61, 0xc8, 0xf1, 0x17, 0xd2, 0x1d, 0x3f, 0x97, 0x84, 0x7d, 0x16, 0x8a, 0xfb, 0x7d, 0xc7,
+	0x7c, 0xc1, 0x0b, 0x8f, 0xfe, 0x49, 0xc8, 0xd9, 0x01, 0xe9, 0xd0, 0x12, 0xe2, 0xc7, 0x3e, 0x24,
+	0xb4, 0xff, 0xe4, 0x60, 0x4d, 0xd1, 0x8d, 0x2d, 0x48, 0xd3, 0x2c, 0x2d, 0x86, 0xad, 0x41, 0xde,
+	0xf2, 0xfb, 0x7a, 0xd7, 0x11, 0x0e, 0x20, 0x28, 0x26, 0xd8, 0xf3, 0xbb, 0x4e, 0x08, 0xbf, 0xa0,
+	0x87, 0x04, 0x3e, 0x80, 0x02, 0x0d, 0x58, 0x0d, 0xd2, 0xec, 0x73, 0xe0, 0xc5, 0xc6, 0xa7, 0x66,
+	0xdb, 0x74, 0x06, 0x7d, 0x5f, 0x70, 0xd4, 0x23, 0xde, 0xf8, 0x1e, 0x8b, 0x78, 0x61, 0x18, 0xa4,
+	0xa5, 0xc5, 0x4a, 0xb6, 0x5a, 0x6c, 0xec, 0xcf, 0x2e, 0xe8, 0x05, 0x8f, 0xd5, 0x4f, 0x4a, 0x7e,
+	0xd3, 0x63, 0x29, 0x2c, 0x8c, 0x76, 0x44, 0x7c, 0xa0, 0xa2, 0x56, 0x88, 0x07, 0xf0, 0xe7, 0x20,
+	0x67, 0x3b, 0x07, 0x2e, 0x2d, 0x2d, 0x71, 0x30, 0x57, 0x67, 0x03, 0xb3, 0xeb, 0x1c, 0xb8, 0x7a,
+	0xc8, 0x10, 0xdf, 0x83, 0x15, 0x9f, 0x04, 0x7e, 0x5f, 0x5a, 0xa1, 0x04, 0xdc, 0xae, 0x9f, 0x9e,
+	0x4d, 0x82, 0xae, 0xb2, 0xd4, 0x93, 0x12, 0xf0, 0x16, 0x14, 0x69, 0xec, 0x63, 0xa5, 0x22, 0x17,
+	0x58, 0x4a, 0x30, 0x52, 0x7c, 0x50, 0x57, 0x27, 0x0f, 0x79, 0xf7, 0x72, 0xba, 0x77, 0xaf, 0x4c,
+	0xcc, 0x79, 0xc7, 0xa6, 0xc8, 0x79, 0xab, 0x03, 0x39, 0x4f, 0xfb, 0x37, 0x82, 0xf5, 0xa1, 0xe0,
+	0xb4, 0xef, 0x91, 0xd4, 0x63, 0x60, 0xc0, 0x02, 0xf5, 0x88, 0xc9, 0x33, 0x55, 0xb1, 0x71, 0x73,
+	0x6e, 0xd1, 0x8a, 0xcb, 0xe5, 0xac, 0xd3, 0x02, 0xea, 0x8c, 0x71, 0xe1, 0x07, 0x08, 0x3e, 0xac,
+	0xc8, 0xbc, 0x6d, 0x04, 0x66, 0x2b, 0x4d, 0x59, 0x76, 0x7e, 0xd9, 0x1c, 0x91, 0x97, 0x43, 0x82,
+	0x59, 0x95, 0x7f, 0xdc, 0xe9, 0x7b, 0x0c, 0x20, 0xfb, 0x25, 0x1e, 0x98, 0xb1, 0xb4, 0xfa, 0x29,
+	0x82, 0xb2, 0x1a, 0xc3, 0xdd, 0x76, 0xfb, 0x65, 0xc3, 0x3c, 0x4c, 0x03, 0x79, 0x0c, 0x32, 0xb6,
+	0xc5, 0x11, 0x66, 0xf5, 0x8c, 0x6d, 0x1d, 0x31, 0x18, 0x0d, 0xc2, 0xcd, 0xa7, 0xc3, 0x5d, 0x4c,
+	0xc2, 0x7d, 0x7f, 0x00, 0xae, 0x0c, 0x09, 0x29, 0x70, 0xd7, 0x61, 0xc9, 0x19, 0x28, 0x73, 0xe3,
+	0x81, 0x11, 0xe5, 0x6d, 0x66, 0xa8, 0xbc, 0x2d, 0xc1, 0x62, 0x2f, 0xba, 0x04, 0xb1, 0x9f, 0x25,
+	0xc9, 0x54, 0x6c, 0xfa, 0x6e, 0xd7, 0x13, 0x46, 0x0f, 0x09, 0x86, 0xe2, 0xd0, 0x76, 0x58, 0xc1,
+	0xce, 0x51, 0xb0, 0xef, 0xa3, 0x5f, 0x7b, 0x12, 0x6a, 0xff, 0x2c, 0x03, 0x1f, 0x19, 0xa1, 0xf6,
+	0x44, 0x7f, 0x7a, 0x30, 0x74, 0x8f, 0xbc, 0x7a, 0x71, 0xac, 0x57, 0x17, 0x26, 0x79, 0xf5, 0x52,
+	0xba, 0xbd, 0x20, 0x69, 0xaf, 0x1f, 0x67, 0xa0, 0x32, 0xc2, 0x5e, 0x93, 0xcb, 0x89, 0x07, 0xc6,
+	0x60, 0x07, 0xae, 0x2f, 0xbc, 0xa4, 0xa0, 0x87, 0x04, 0x3b, 0x67, 0xae, 0xef, 0xb5, 0x0c, 0x87,
+	0x7b, 0x47, 0x41, 0x17, 0xd4, 0x8c, 0xa6, 0xfa, 0x5a, 0x06, 0x4a, 0xd2, 0x3e, 0xdb, 0x26, 0xb7,
+	0x56, 0xd7, 0x79, 0xf0, 0x4d, 0xb4, 0x06, 0x79, 0x83, 0xa3, 0x15, 0x4e, 0x25, 0xa8, 0x21, 0x63,
+	0x14, 0xd2, 0x8d, 0xb1, 0x94, 0x34, 0xc6, 0x9b, 0x08, 0x4e, 0x27, 0x8d, 0x41, 0xf7, 0x6c, 0x1a,
+	0xc8, 0xcb, 0x01, 0x3e, 0x80, 0xc5, 0x50, 0x4e, 0x58, 0xda, 0x15, 0x1b, 0x7b, 0xb3, 0x26, 0xfc,
+	0x84, 0xe1, 0x25, 0x73, 0xed, 0x29, 0x38, 0x3d, 0x32, 0xca, 0x09, 0x18, 0x65, 0x28, 0xc8, 0x22,
+	0x47, 0x6c, 0x4d, 0x44, 0x6b, 0x6f, 0x2e, 0x24, 0x53, 0x8e, 0x6b, 0xed, 0xb9, 0xcd, 0x94, 0x6e,
+	0x40, 0xfa, 0x76, 0x32, 0x53, 0xb9, 0x96, 0x72, 0xf1, 0x97, 0x24, 0x5b, 0x67, 0xba, 0x4e, 0x60,
+	0xd8, 0x0e, 0xf1, 0x45, 0x56, 0x8c, 0x07, 0xd8, 0x36, 0x50, 0xdb, 0x31, 0xc9, 0x3e, 0x31, 0x5d,
+	0xc7, 0xa2, 0x7c, 0x3f, 0xb3, 0x7a, 0x62, 0x0c, 0x3f, 0x0f, 0x4b, 0x9c, 0xbe, 0x63, 0x77, 0xc2,
+	0x34, 0x50, 0x6c, 0x6c, 0xd4, 0xc2, 0x0e, 0x5d, 0x4d, 0xed, 0xd0, 0xc5, 0x36, 0xec, 0x90, 0xc0,
+	0xa8, 0xf5, 0xae, 0xd4, 0xd8, 0x0a, 0x3d, 0x5e, 0xcc, 0xb0, 0x04, 0x86, 0xdd, 0xde, 0xb3, 0x1d,
+	0x5e, 0x78, 0xf2, 0xcb, 0x74, 0x34, 0xc0, 0x5c, 0xe5, 0xc0, 0x6d, 0xb7, 0xdd, 0x57, 0xe4, 0xb9,
+	0x09, 0x29, 0xb6, 0xaa, 0xeb, 0x04, 0x76, 0x9b, 0xcb, 0x0f, 0x1d, 0x21, 0x1e, 0xe0, 0xab, 0xec,
+	0x76, 0x40, 0x7c, 0x71, 0x60, 0x04, 0x15, 0x39, 0x63, 0x31, 0x6c, 0x3a, 0xc9, 0xf3, 0x1a, 0xba,
+	0xed, 0xb2, 0xea, 0xb6, 0x83, 0x47, 0x61, 0x65, 0x44, 0xe7, 0x84, 0xf7, 0xe0, 0x48, 0xcf, 0x76,
+	0xbb, 0xac, 0xa6, 0xe2, 0xa5, 0x87, 0xa4, 0x87, 0x5c, 0x79, 0x35, 0xdd, 0x95, 0x8f, 0x27, 0x5d,
+	0xf9, 0x77, 0x08, 0x0a, 0x7b, 0x6e, 0xf3, 0x9a, 0x13, 0xf8, 0x7d, 0x7e, 0x4b, 0x72, 0x9d, 0x80,
+	0x38, 0xd2, 0x5f, 0x24, 0xc9, 0x36, 0x21, 0xb0, 0x3b, 0x64, 0x3f, 0x30, 0x3a, 0x9e, 0xa8, 0xb1,
+	0x8e, 0xb4, 0x09, 0xd1, 0x62, 0x66, 0x98, 0xb6, 0x41, 0x03, 0x7e, 0xe2, 0x0b, 0x3a, 0xff, 0x66,
+	0x2a, 0x44, 0x13, 0xf6, 0x03, 0x5f, 0x1c, 0xf7, 0xc4, 0x98, 0xea, 0x62, 0xb9, 0x10, 0x9b, 0x20,
+	0xb5, 0x0e, 0x3c, 0x1c, 0x15, 0xff, 0x77, 0x88, 0xdf, 0xb1, 0x1d, 0x23, 0x3d, 0x7a, 0x4f, 0xd1,
+	0xfc, 0x4b, 0xb9, 0x7b, 0xba, 0x89, 0x43, 0xc7, 0x6a, 0xe9, 0xbb, 0xb6, 0x63, 0xb9, 0xaf, 0xa4,
+	0x1c, 0x9e, 0xd9, 0x04, 0xfe, 0x26, 0x93, 0xe8, 0xdf, 0x29, 0x12, 0xa3, 0x93, 0xfe, 0x3c, 0xac,
+	0xb0, 0x98, 0xd0, 0x23, 0xe2, 0x07, 0x11, 0x76, 0xb4, 0x71, 0xcd, 0x92, 0x98, 0x87, 0x9e, 0x5c,
+	0x88, 0xf7, 0x60, 0xd5, 0xa0, 0xd4, 0x6e, 0x3a, 0xc4, 0x92, 0xbc, 0x32, 0x53, 0xf3, 0x1a, 0x5c,
+	0x1a, 0x5e, 0xbb, 0xf9, 0x0c, 0xb1, 0xdf, 0x92, 0xc4, 0x5b, 0x90, 0x3f, 0xf0, 0x09, 0xf9, 0x72,
+	0x58, 0xf6, 0x4d, 0x60, 0x7f, 0x9d, 0xcf, 0xd4, 0xc5, 0x0a, 0x76, 0x65, 0x77, 0xc8, 0xab, 0xc1,
+	0xbe, 0xd9, 0x22, 0x56, 0xb7, 0x4d, 0x2c, 0xce, 0x3f, 0x4c, 0x05, 0xc3, 0x3f, 0x68, 0x5f, 0x45,
+	0x70, 0x6a, 0x24, 0xdc, 0xe8, 0x8c, 0x22, 0x25, 0x61, 0x94, 0xa1, 0x40, 0xc5, 0x72, 0xd9, 0xf5,
+	0x92, 0x34, 0xfb, 0xcd, 0xea, 0x86, 0x7e, 0x26, 0x12, 0x56, 0x44, 0xe3, 0x33, 0x00, 0x1d, 0xc3,
+	0xe9, 0x1a, 0x6d, 0x0e, 0x66, 0x81, 0x2b, 0xab, 0x8c, 0x68, 0xeb, 0x50, 0x1e, 0xe5, 0xa4, 0xa2,
+	0x9b, 0xf4, 0x1e, 0x82, 0x63, 0x32, 0x7c, 0x0b, 0x3f, 0xaa, 0xc2, 0xaa, 0x62, 0x91, 0x5b, 0xb1,
+	0x4b, 0x0d, 0x0e, 0x4f, 0x08, 0xcd, 0xd2, 0x1f, 0xb3, 0xc9, 0x66, 0x7f, 0x2f, 0xd1, 0xae, 0x9f,
+	0x3a, 0xb3, 0xa2, 0x39, 0x55, 0xaa, 0x5f, 0x81, 0xd2, 0x4d, 0xc3, 0x31, 0x9a, 0xc4, 0x8a, 0xd4,
+	0x8e, 0x9c, 0xf9, 0x8b, 0x6a, 0x5b, 0x64, 0xe6, 0x26, 0x44, 0x54, 0xd4, 0xd9, 0x07, 0x07, 0xb2,
+	0xc5, 0xe2, 0x43, 0x61, 0xcf, 0x76, 0x0e, 0xd9, 0x4d, 0x9d, 0x69, 0x1c, 0xd8, 0x41, 0x5b, 0x5a,
+	0x37, 0x24, 0xf0, 0x71, 0xc8, 0x76, 0xfd, 0xb6, 0xf0, 0x00, 0xf6, 0x89, 0x2b, 0x50, 0xb4, 0x08,
+	0x35, 0x7d, 0xdb, 0x13, 0xfb, 0xcf, 0x9b, 0xd7, 0xca, 0x10, 0xdb, 0x07, 0xdb, 0x74, 0x9d, 0x9d,
+	0xb6, 0x41, 0xa9, 0x4c, 0x75, 0xd1, 0x80, 0xf6, 0x0c, 0xac, 0x30, 0x99, 0xb1, 0x9a, 0x17, 0x93,
+	0x6a, 0x9e, 0x4a, 0xc0, 0x97, 0xf0, 0x24, 0x62, 0x03, 0x1e, 0x62, 0x15, 0xc6, 0xb6, 0xe7, 0x09,
+	0x26, 0x53, 0x16, 0x5e, 0xd9, 0x51, 0x99, 0x7a, 0x74, 0x57, 0xb6, 0x3f, 0x74, 0x4c, 0xc2, 0x63,
+	0xc7, 0x52, 0x9c, 0x4f, 0x0c, 0xca, 0x5b, 0x81, 0x3c, 0xc5, 0x85, 0x14, 0xb3, 0x1c, 0x25, 0xc1,
+	0xd5, 0xbe, 0xf0, 0xb9, 0x90, 0x10, 0xa3, 0xdb, 0x32, 0x8a, 0x85, 0x04, 0x03, 0x45, 0x5e, 0xf5,
+	0x6c, 0x9f, 0xd0, 0x6d, 0x29, 0x38, 0x1e, 0x68, 0xfc, 0xfd, 0x2c, 0x60, 0x55, 0x36, 0xf1, 0x7b,
+	0xb6, 0x49, 0xf0, 0xb7, 0x10, 0x2c, 0x30, 0xad, 0xf1, 0x23, 0xe3, 0x82, 0x03, 0x3f, 0x2a, 0xe5,
+	0xf9, 0xdd, 0xf6, 0x99, 0x34, 0x6d, 0xfd, 0x8d, 0xbf, 0xfd, 0xf3, 0xdb, 0x99, 0x35, 0x7c, 0x92,
+	0x3f, 0x12, 0xf6, 0xae, 0xa8, 0x0f, 0x76, 0x14, 0xbf, 0x85, 0x00, 0x8b, 0x62, 0x4f, 0x79, 0x46,
+	0xc1, 0x17, 0xc7, 0x41, 0x1c, 0xf1, 0xdc, 0x52, 0x7e, 0x44, 0x49, 0x9d, 0x35, 0xd3, 0xf5, 0x09,
+	0x4b, 0x94, 0x7c, 0x02, 0x07, 0xb0, 0xc1, 0x01, 0x9c, 0xc3, 0xda, 0x28, 0x00, 0xf5, 0xd7, 0xd8,
+	0x66, 0xbe, 0x5e, 0x27, 0xa1, 0xdc, 0x77, 0x10, 0xe4, 0xee, 0xf2, 0x8b, 0xd2, 0x04, 0x23, 0xed,
+	0xcf, 0xcd, 0x48, 0x5c, 0x1c, 0x47, 0xab, 0x9d, 0xe5, 0x48, 0x1f, 0xc1, 0xa7, 0x25, 0x52, 0x1a,
+	0xf8, 0xc4, 0xe8, 0x24, 0x00, 0x5f, 0x46, 0xf8, 0x5d, 0x04, 0xf9, 0xb0, 0x43, 0x8e, 0xcf, 0x8f,
+	0x43, 0x99, 0xe8, 0xa0, 0x97, 0xe7, 0xd7, 0x6e, 0xd6, 0x1e, 0xe7, 0x18, 0xcf, 0x6a, 0x23, 0xb7,
+	0x73, 0x2b, 0xd1, 0x8c, 0x7e, 0x1b, 0x41, 0xf6, 0x06, 0x99, 0xe8, 0x6f, 0x73, 0x04, 0x37, 0x64,
+	0xc0, 0x11, 0x5b, 0x8d, 0x7f, 0x84, 0xe0, 0xe1, 0x1b, 0x24, 0x18, 0x5d, 0x03, 0xe0, 0xea, 0xe4,
+	0xc4, 0x2c, 0xdc, 0xee, 0xe2, 0x14, 0x33, 0xa3, 0x94, 0x54, 0xe7, 0xc8, 0x1e, 0xc7, 0x17, 0xd2,
+	0x9c, 0x90, 0xf6, 0x1d, 0xf3, 0x15, 0x81, 0xe3, 0xcf, 0x08, 0x8e, 0x0f, 0x3e, 0x97, 0xe2, 0x64,
+	0x5a, 0x1f, 0xf9, 0x9a, 0x5a, 0xbe, 0x35, 0x6b, 0x80, 0x4f, 0x32, 0xd5, 0xb6, 0x39, 0xf2, 0xa7,
+	0xf1, 0x53, 0x69, 0xc8, 0xa3, 0x76, 0x63, 0xfd, 0x35, 0xf9, 0xf9, 0x3a, 0x7f, 0xda, 0xe7, 0xb0,
+	0xff, 0x82, 0xe0, 0xa4, 0xe4, 0xbb, 0xd3, 0x32, 0xfc, 0xe0, 0x39, 0xc2, 0x2e, 0x0a, 0x74, 0x2a,
+	0x7d, 0x66, 0x4c, 0x58, 0xaa, 0x3c, 0xed, 0x1a, 0xd7, 0xe5, 0x13, 0xf8, 0xd9, 0x23, 0xeb, 0x62,
+	0x32, 0x36, 0x96, 0x80, 0xfd, 0x06, 0x82, 0xe5, 0x1b, 0x24, 0xb8, 0x19, 0xb5, 0xbc, 0xcf, 0x4f,
+	0xf5, 0x8c, 0x56, 0x5e, 0xaf, 0x29, 0xff, 0x28, 0x90, 0x3f, 0x45, 0x2e, 0xb2, 0xc9, 0xc1, 0x5d,
+	0xc0, 0xe7, 0xd3, 0xc0, 0xc5, 0x6d, 0xf6, 0x77, 0x10, 0x9c, 0x52, 0x41, 0xc4, 0xcf, 0x8f, 0x1f,
+	0x3b, 0xda, 0xa3, 0x9e, 0x78, 0x1a, 0x9c, 0x80, 0xae, 0xc1, 0xd1, 0x5d, 0xd2, 0x46, 0x3b, 0x70,
+	0x67, 0x08, 0xc5, 0x16, 0xda, 0xa8, 0x22, 0xfc, 0x7b, 0x04, 0xf9, 0xb0, 0xe3, 0x3c, 0xde, 0x46,
+	0x89, 0xe7, 0xb2, 0x79, 0x46, 0x03, 0xb1, 0xdb, 0xe5, 0xcb, 0xa3, 0x0d, 0xaa, 0xae, 0x97, 0xae,
+	0x5a, 0xe3, 0x56, 0x4e, 0x86, 0xb1, 0x5f, 0x22, 0x80, 0xb8, 0x6b, 0x8e, 0x1f, 0x4f, 0xd7, 0x43,
+	0xe9, 0xac, 0x97, 0xe7, 0xdb, 0x37, 0xd7, 0x6a, 0x5c, 0x9f, 0x6a, 0xb9, 0x92, 0x1a, 0x43, 0x3c,
+	0x62, 0x6e, 0x85, 0x1d, 0xf6, 0x1f, 0x22, 0xc8, 0xf1, 0x66, 0x25, 0x3e, 0x37, 0x0e, 0xb3, 0xda,
+	0xcb, 0x9c, 0xa7, 0xe9, 0x1f, 0xe3, 0x50, 0x2b, 0x8d, 0xb4, 0x40, 0xbc, 0x85, 0x36, 0x70, 0x0f,
+	0xf2, 0x61, 0x7b, 0x70, 0xbc, 0x7b, 0x24, 0xda, 0x87, 0xe5, 0x4a, 0x4a, 0x61, 0x10, 0x3a, 0xaa,
+	0xc8, 0x01, 0x1b, 0x93, 0x72, 0xc0, 0x02, 0xbf, 0x38, 0x9d, 0x4d, 0x0b, 0xe2, 0xff, 0x07, 0xc3,
+	0x5c, 0xe4, 0xe8, 0xce, 0x6b, 0x95, 0x49, 0x79, 0x80, 0x59, 0xe7, 0x3b, 0x08, 0x8e, 0x0f, 0xd6,
+	0xf5, 0xf8, 0xf4, 0x40, 0xcc, 0x54, 0xaf, 0x39, 0xe5, 0xa4, 0x15, 0xc7, 0xdd, 0x09, 0xb4, 0x4f,
+	0x72, 0x14, 0x5b, 0xf8, 0xc9, 0x89, 0x27, 0xe3, 0x96, 0x8c, 0x3a, 0x8c, 0xd1, 0x66, 0xfc, 0x04,
+	0xf8, 0x2b, 0x04, 0xcb, 0x92, 0xef, 0x1d, 0x9f, 0x90, 0x74, 0x58, 0xf3, 0x3b, 0x08, 0x4c, 0x96,
+	0xf6, 0x0c, 0x87, 0xff, 0x71, 0xfc, 0xc4, 0x94, 0xf0, 0x25, 0xec, 0xcd, 0x80, 0x21, 0xfd, 0x23,
+	0x82, 0x13, 0x77, 0x43, 0xbf, 0xff, 0x80, 0xf0, 0xef, 0x70, 0xfc, 0xcf, 0xe2, 0xa7, 0x53, 0xea,
+	0xbc, 0x49, 0x6a, 0x5c, 0x46, 0xf8, 0xe7, 0x08, 0x0a, 0xf2, 0xe9, 0x08, 0x5f, 0x18, 0x7b, 0x30,
+	0x92, 0x8f, 0x4b, 0xf3, 0x74, 0x66, 0x51, 0xd4, 0x68, 0xe7, 0x52, 0xd3, 0xa9, 0x90, 0xcf, 0x1c,
+	0xfa, 0x6d, 0x04, 0x38, 0xba, 0xae, 0x47, 0x17, 0x78, 0xfc, 0x58, 0x42, 0xd4, 0xd8, 0xee, 0x53,
+	0xf9, 0xc2, 0xc4, 0x79, 0xc9, 0x54, 0xba, 0x91, 0x9a, 0x4a, 0xdd, 0x48, 0xfe, 0xd7, 0x11, 0x14,
+	0x6f, 0x90, 0xe8, 0x0e, 0x92, 0x62, 0xcb, 0xe4, 0xcb, 0x57, 0xb9, 0x3a, 0x79, 0xa2, 0x40, 0x74,
+	0x89, 0x23, 0x7a, 0x0c, 0xa7, 0x9b, 0x4a, 0x02, 0xf8, 0x1e, 0x82, 0x95, 0xdb, 0xaa, 0x8b, 0xe2,
+	0x4b, 0x93, 0x24, 0x25, 0x22, 0xf9, 0xf4, 0xb8, 0x3e, 0xca, 0x71, 0x6d, 0x6a, 0x53, 0xe1, 0xda,
+	0x12, 0x8f, 0x48, 0xdf, 0x47, 0xe1, 0xfd, 0x79, 0xa0, 0x69, 0xff, 0xbf, 0xda, 0x2d, 0xa5, 0xf7,
+	0xaf, 0x3d, 0xc1, 0xf1, 0xd5, 0xf0, 0xa5, 0x69, 0xf0, 0xd5, 0x45, 0x27, 0x1f, 0x7f, 0x17, 0xc1,
+	0x09, 0xfe, 0xa0, 0xa2, 0x32, 0x1e, 0x48, 0x31, 0xe3, 0x9e, 0x5f, 0xa6, 0x48, 0x31, 0x22, 0xfe,
+	0x68, 0x47, 0x02, 0xb5, 0x25, 0x1f, 0x4b, 0xbe, 0x81, 0xe0, 0x98, 0x4c, 0x6a, 0x62, 0x77, 0x37,
+	0x27, 0x19, 0xee, 0xa8, 0x49, 0x50, 0xb8, 0xdb, 0xc6, 0x74, 0xee, 0xf6, 0x2e, 0x82, 0x45, 0xf1,
+	0x64, 0x91, 0x52, 0x2a, 0x28, 0x6f, 0x1a, 0xe5, 0x81, 0xf6, 0x8a, 0xe8, 0x78, 0x6b, 0x9f, 0xe7,
+	0x62, 0x5f, 0xc4, 0xf5, 0x34, 0xb1, 0x9e, 0x6b, 0xd1, 0xfa, 0x6b, 0xa2, 0xdd, 0xfc, 0x7a, 0xbd,
+	0xed, 0x36, 0xe9, 0x4b, 0x1a, 0x4e, 0x4d, 0x88, 0x6c, 0xce, 0x65, 0x84, 0x03, 0x58, 0x62, 0xce,
+	0xc1, 0x7b, 0x36, 0xb8, 0x32, 0xd0, 0xe1, 0x19, 0x6a, 0xe7, 0x94, 0xcb, 0x43, 0x3d, 0xa0, 0x38,
+	0x03, 0x8a, 0x6b, 0x2c, 0x7e, 0x34, 0x55, 0x2c, 0x17, 0xf4, 0x16, 0x82, 0x13, 0xaa, 0xb7, 0x87,
+	0xe2, 0xa7, 0xf6, 0xf5, 0x34, 0x14, 0xa2, 0xa8, 0xc6, 0x1b, 0x53, 0x39, 0x12, 0x87, 0x73, 0xf5,
+	0xfa, 0x9f, 0xee, 0x9f, 0x41, 0x7f, 0xbd, 0x7f, 0x06, 0xfd, 0xe3, 0xfe, 0x19, 0xf4, 0xd2, 0x93,
+	0xd3, 0xfd, 0x4d, 0xda, 0x6c, 0xdb, 0xc4, 0x09, 0x54, 0xf6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0x94, 0xb5, 0x1a, 0x64, 0x0c, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4533,6 +4639,11 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HistoryId != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.HistoryId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -6231,6 +6342,25 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NextScheduledSync != nil {
+		i -= len(*m.NextScheduledSync)
+		copy(dAtA[i:], *m.NextScheduledSync)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.NextScheduledSync)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.CanSync == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
 	} else {
@@ -6643,6 +6773,61 @@ func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSyncFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != nil {
+		i -= len(*m.ExpiresAt)
+		copy(dAtA[i:], *m.ExpiresAt)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SetAt != nil {
+		i -= len(*m.SetAt)
+		copy(dAtA[i:], *m.SetAt)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.SetAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SetBy != nil {
+		i -= len(*m.SetBy)
+		copy(dAtA[i:], *m.SetBy)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.SetBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplication(v)
 	base := offset
@@ -6825,6 +7010,9 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.HistoryId != nil {
+		n += 1 + sovApplication(uint64(*m.HistoryId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7591,6 +7779,14 @@ func (m *ApplicationSyncWindowsResponse) Size() (n int) {
 	if m.CanSync != nil {
 		n += 2
 	}
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.NextScheduledSync != nil {
+		l = len(*m.NextScheduledSync)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7768,6 +7964,34 @@ func (m *ListAppLinksRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSyncFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SetBy != nil {
+		l = len(*m.SetBy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SetAt != nil {
+		l = len(*m.SetAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = len(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplication(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8961,6 +9185,26 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryId", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryId = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14074,6 +14318,75 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			b := bool(v != 0)
 			m.CanSync = &b
 			hasFields[0] |= uint64(0x00000001)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freeze == nil {
+				m.Freeze = &ApplicationSyncFreeze{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledSync", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NextScheduledSync = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15175,6 +15488,189 @@ func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSyncFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SetBy = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SetAt = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExpiresAt = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplication(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -426,7 +426,13 @@ func (m *EmptyResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
 type SyncWindowsQuery struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// from is the start (RFC3339) of the time range of the sync windows timeline, defaults to the current time
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the end (RFC3339) of the time range of the sync windows timeline, defaults to one week after from
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// application restricts the timeline to the windows matching the application and computes its next allowed sync time
+	Application          string   `protobuf:"bytes,4,opt,name=application,proto3" json:"application,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -472,11 +478,40 @@ func (m *SyncWindowsQuery) GetName() string {
 	return ""
 }
 
+func (m *SyncWindowsQuery) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SyncWindowsQuery) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SyncWindowsQuery) GetApplication() string {
+	if m != nil {
+		return m.Application
+	}
+	return ""
+}
+
 type SyncWindowsResponse struct {
-	Windows              []*v1alpha1.SyncWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Windows []*v1alpha1.SyncWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	// timeline contains the time ranges during which the sync windows are active, if a time range or an application was queried
+	Timeline []*SyncWindowOccurrence `protobuf:"bytes,2,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// overlaps contains the time ranges during which both an allow and a deny window are active
+	Overlaps []*SyncWindowOverlap `protobuf:"bytes,3,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	// nextSyncTime is the next time (RFC3339) at which automated syncs of the queried application are allowed
+	NextSyncTime string `protobuf:"bytes,4,opt,name=nextSyncTime,proto3" json:"nextSyncTime,omitempty"`
+	// nextManualSyncTime is the next time (RFC3339) at which manual syncs of the queried application are allowed
+	NextManualSyncTime   string   `protobuf:"bytes,5,opt,name=nextManualSyncTime,proto3" json:"nextManualSyncTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
@@ -519,6 +554,34 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+func (m *SyncWindowsResponse) GetTimeline() []*SyncWindowOccurrence {
+	if m != nil {
+		return m.Timeline
+	}
+	return nil
+}
+
+func (m *SyncWindowsResponse) GetOverlaps() []*SyncWindowOverlap {
+	if m != nil {
+		return m.Overlaps
+	}
+	return nil
+}
+
+func (m *SyncWindowsResponse) GetNextSyncTime() string {
+	if m != nil {
+		return m.NextSyncTime
+	}
+	return ""
+}
+
+func (m *SyncWindowsResponse) GetNextManualSyncTime() string {
+	if m != nil {
+		return m.NextManualSyncTime
+	}
+	return ""
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -684,6 +747,169 @@ func (m *ListProjectLinksRequest) GetName() string {
 	return ""
 }
 
+// SyncWindowOccurrence is a time range during which a sync window is active
+type SyncWindowOccurrence struct {
+	// windowID is the index of the window in the sync windows of the project
+	WindowID int32  `protobuf:"varint,1,opt,name=windowID,proto3" json:"windowID,omitempty"`
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Start    string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End      string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// applications contains the names of the applications matched by the window
+	Applications         []string `protobuf:"bytes,5,rep,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowOccurrence) Reset()         { *m = SyncWindowOccurrence{} }
+func (m *SyncWindowOccurrence) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowOccurrence) ProtoMessage()    {}
+func (*SyncWindowOccurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
+}
+func (m *SyncWindowOccurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowOccurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowOccurrence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowOccurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowOccurrence.Merge(m, src)
+}
+func (m *SyncWindowOccurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowOccurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowOccurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowOccurrence proto.InternalMessageInfo
+
+func (m *SyncWindowOccurrence) GetWindowID() int32 {
+	if m != nil {
+		return m.WindowID
+	}
+	return 0
+}
+
+func (m *SyncWindowOccurrence) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *SyncWindowOccurrence) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *SyncWindowOccurrence) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *SyncWindowOccurrence) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+// SyncWindowOverlap is a time range during which both an allow and a deny window are active
+type SyncWindowOverlap struct {
+	AllowWindowID int32  `protobuf:"varint,1,opt,name=allowWindowID,proto3" json:"allowWindowID,omitempty"`
+	DenyWindowID  int32  `protobuf:"varint,2,opt,name=denyWindowID,proto3" json:"denyWindowID,omitempty"`
+	Start         string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// applications contains the names of the applications matched by both windows, whose syncs are denied
+	Applications         []string `protobuf:"bytes,5,rep,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowOverlap) Reset()         { *m = SyncWindowOverlap{} }
+func (m *SyncWindowOverlap) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowOverlap) ProtoMessage()    {}
+func (*SyncWindowOverlap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
+}
+func (m *SyncWindowOverlap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowOverlap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowOverlap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowOverlap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowOverlap.Merge(m, src)
+}
+func (m *SyncWindowOverlap) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowOverlap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowOverlap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowOverlap proto.InternalMessageInfo
+
+func (m *SyncWindowOverlap) GetAllowWindowID() int32 {
+	if m != nil {
+		return m.AllowWindowID
+	}
+	return 0
+}
+
+func (m *SyncWindowOverlap) GetDenyWindowID() int32 {
+	if m != nil {
+		return m.DenyWindowID
+	}
+	return 0
+}
+
+func (m *SyncWindowOverlap) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *SyncWindowOverlap) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *SyncWindowOverlap) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProjectCreateRequest)(nil), "project.ProjectCreateRequest")
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
@@ -697,75 +923,90 @@ func init() {
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
+	proto.RegisterType((*SyncWindowOccurrence)(nil), "project.SyncWindowOccurrence")
+	proto.RegisterType((*SyncWindowOverlap)(nil), "project.SyncWindowOverlap")
 }
 
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0x9a, 0x82, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xad, 0xb7, 0xad, 0x09, 0x9f, 0x95, 0xf1, 0xa9, 0xc1, 0xa7, 0x9e, 0x6f,
-	0x0d, 0x5b, 0x56, 0xdc, 0xeb, 0x5a, 0x52, 0xbd, 0x35, 0xcd, 0x92, 0xa9, 0xb7, 0xde, 0x8a, 0x63,
-	0xcd, 0xe3, 0x64, 0xc0, 0x64, 0x13, 0x6a, 0x83, 0x98, 0x63, 0x22, 0xcc, 0x4a, 0xc3, 0x68, 0xde,
-	0x76, 0xf4, 0x13, 0xed, 0xc1, 0x96, 0x8e, 0x7d, 0x3f, 0xea, 0x61, 0xf8, 0x18, 0x03, 0x9c, 0x08,
-	0x33, 0x8b, 0xc2, 0x56, 0x26, 0x70, 0x04, 0x96, 0x92, 0x28, 0x40, 0x05, 0xb6, 0xe2, 0xa8, 0x31,
-	0xd9, 0x80, 0x2a, 0x73, 0x85, 0x59, 0x6d, 0x18, 0xcd, 0xaa, 0x23, 0x87, 0x64, 0x1d, 0x2a, 0xcc,
-	0x37, 0x97, 0x54, 0x4c, 0x85, 0xf9, 0xf4, 0x07, 0xa3, 0xc8, 0x56, 0x2c, 0x43, 0x39, 0x5b, 0x03,
-	0x56, 0x7d, 0xe4, 0x5e, 0xc2, 0x62, 0x99, 0xa8, 0x26, 0x9d, 0x9e, 0xca, 0xf5, 0x54, 0xa7, 0xf4,
-	0x6c, 0xc3, 0x0a, 0x5e, 0xc4, 0x2c, 0x41, 0xfe, 0x4e, 0xa8, 0x44, 0x54, 0x9d, 0xc9, 0x84, 0xd6,
-	0xb6, 0x9c, 0x6b, 0x7b, 0x35, 0x5f, 0x1c, 0x25, 0xcd, 0x41, 0x1e, 0x47, 0x21, 0x47, 0x72, 0x17,
-	0x96, 0x85, 0x9c, 0xd0, 0x9a, 0xd2, 0x07, 0x4a, 0x61, 0x4d, 0x47, 0xbf, 0x37, 0xc0, 0x64, 0x24,
-	0xf9, 0x43, 0xb7, 0x8f, 0x3a, 0x48, 0x8d, 0xe9, 0xe7, 0x39, 0xe2, 0x07, 0xb1, 0xff, 0xff, 0x2e,
-	0x37, 0x7d, 0x1e, 0x9e, 0x3b, 0xed, 0xc7, 0x62, 0x94, 0xa5, 0x41, 0xf7, 0x61, 0xe3, 0x7c, 0x14,
-	0x7a, 0x1f, 0xb2, 0xd0, 0x8f, 0x9e, 0xf1, 0x72, 0xd1, 0x23, 0xb8, 0x33, 0x15, 0x97, 0x57, 0xa1,
-	0x03, 0xb7, 0x9e, 0xa5, 0x53, 0xa6, 0xd1, 0xa8, 0xde, 0x5c, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9,
-	0x05, 0x6c, 0xb6, 0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x27, 0xb0, 0xcc, 0x04, 0xf6,
-	0x17, 0xc4, 0x3d, 0x55, 0xaf, 0x14, 0x96, 0xfe, 0x5c, 0x05, 0xf3, 0x31, 0x0a, 0x97, 0x05, 0xe8,
-	0xcf, 0x91, 0xc7, 0xb0, 0xde, 0x2d, 0xc8, 0x5a, 0xb8, 0x8a, 0x19, 0xfc, 0xe9, 0x06, 0xa9, 0xfc,
-	0x57, 0x7e, 0x10, 0xc0, 0x5a, 0x82, 0x71, 0xc4, 0x99, 0x88, 0x12, 0x86, 0xdc, 0xac, 0x2e, 0x22,
-	0x27, 0x27, 0x43, 0x1c, 0x39, 0x05, 0x74, 0xe2, 0xc2, 0x6d, 0x2f, 0x18, 0x70, 0x81, 0x09, 0x37,
-	0x97, 0x14, 0xd3, 0xe9, 0xcd, 0x98, 0x4e, 0x52, 0x34, 0x27, 0x87, 0xa5, 0x87, 0x70, 0xff, 0x8c,
-	0x71, 0xa1, 0x13, 0x3d, 0x63, 0x61, 0x8f, 0x67, 0x1b, 0xee, 0x9a, 0x3e, 0x6f, 0xfd, 0xb8, 0x06,
-	0xeb, 0x3a, 0xf6, 0x1c, 0x93, 0x21, 0xf3, 0x90, 0x7c, 0x63, 0xc0, 0x6a, 0xea, 0x48, 0xca, 0x01,
-	0x08, 0xb5, 0xb2, 0xd3, 0xa9, 0xd4, 0xb3, 0xea, 0x3b, 0xd7, 0xc6, 0xe4, 0xbb, 0xee, 0xe1, 0x17,
-	0xbf, 0xfd, 0xf1, 0x7d, 0xa5, 0x45, 0x0f, 0xd5, 0x59, 0x35, 0x3c, 0xca, 0xce, 0x3b, 0x6e, 0x8f,
-	0xf5, 0xe8, 0xd2, 0x96, 0x5e, 0xc5, 0xed, 0xb1, 0xfc, 0xb9, 0xb4, 0x95, 0xbb, 0xbc, 0x61, 0x1c,
-	0x90, 0xaf, 0x0c, 0x58, 0x4d, 0xcd, 0xf8, 0xaf, 0xc4, 0x14, 0xec, 0xba, 0xbe, 0x99, 0xc7, 0x14,
-	0xf7, 0xfe, 0x9b, 0x4a, 0xc5, 0xeb, 0x07, 0xc7, 0xff, 0x4a, 0x85, 0x3d, 0x66, 0xae, 0xb8, 0x24,
-	0xdf, 0x1a, 0x50, 0x4b, 0x73, 0x26, 0x73, 0xc9, 0x16, 0x6b, 0xb1, 0xb0, 0x2e, 0xa5, 0x2f, 0x2a,
-	0xc1, 0xf7, 0xe8, 0xc6, 0xac, 0x60, 0x59, 0x99, 0x2f, 0x0d, 0x58, 0x92, 0x2b, 0x4d, 0xee, 0xcd,
-	0xca, 0x51, 0xae, 0x56, 0x3f, 0x5b, 0x94, 0x0c, 0x49, 0x42, 0x4d, 0x25, 0x85, 0x90, 0x39, 0x29,
-	0xe4, 0x02, 0x48, 0x1b, 0xc5, 0x8c, 0x6d, 0x94, 0x89, 0x7a, 0x29, 0x9f, 0x2e, 0xf3, 0x19, 0xda,
-	0x54, 0x4c, 0x94, 0x34, 0xe6, 0x57, 0x49, 0x76, 0xec, 0xa5, 0xed, 0xeb, 0x2f, 0xc9, 0xd7, 0x06,
-	0x54, 0xdb, 0x58, 0xca, 0xb5, 0xb8, 0x75, 0xd8, 0x53, 0x92, 0xb6, 0xc8, 0xfd, 0x12, 0x49, 0x64,
-	0x0c, 0x2f, 0xb4, 0x51, 0x14, 0x5d, 0xbb, 0x4c, 0xd6, 0x5e, 0x3e, 0x7d, 0xbd, 0xcb, 0x53, 0x4b,
-	0xb1, 0x35, 0xc9, 0x7e, 0x59, 0x01, 0x52, 0x9b, 0xcc, 0x17, 0xe0, 0x27, 0x03, 0x6a, 0xe9, 0xc9,
-	0x3a, 0xdf, 0x99, 0x85, 0x13, 0x77, 0x81, 0x15, 0x39, 0x56, 0x1a, 0x0f, 0xeb, 0xcd, 0xd2, 0xad,
-	0x64, 0xf5, 0x51, 0xb8, 0xbe, 0x2b, 0x5c, 0x4b, 0x89, 0x96, 0x1d, 0xfb, 0x11, 0xd4, 0xd2, 0x8d,
-	0x5a, 0x56, 0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0x7f, 0x50, 0x5a, 0xff, 0xa7, 0x00, 0xb2, 0x4b, 0x4f,
-	0x87, 0x18, 0x96, 0x17, 0x7e, 0xc7, 0x4a, 0xef, 0xcb, 0x32, 0x43, 0x4b, 0xde, 0x97, 0xad, 0xe1,
-	0x91, 0xa5, 0x3e, 0x51, 0x1d, 0xbe, 0xaf, 0x48, 0x1a, 0x64, 0xb7, 0xac, 0xec, 0x98, 0xa2, 0x8f,
-	0xe1, 0x4e, 0x1b, 0xc5, 0xd4, 0xe5, 0xe0, 0x5c, 0xc8, 0xd2, 0x6f, 0xe5, 0xa4, 0xb3, 0xf7, 0x8b,
-	0xfa, 0xf6, 0x75, 0xaf, 0xf2, 0xe4, 0x5e, 0x51, 0xbc, 0x0f, 0xc8, 0xcb, 0x65, 0xbc, 0x7c, 0x14,
-	0x7a, 0xfa, 0x6e, 0x40, 0x62, 0x58, 0x91, 0x62, 0x95, 0xad, 0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf8,
-	0xf5, 0x7a, 0x61, 0x21, 0xf5, 0x2b, 0xcd, 0xfb, 0x40, 0xf1, 0xee, 0x91, 0x9d, 0x32, 0xde, 0x40,
-	0x86, 0x3f, 0x7a, 0xf4, 0xcb, 0xd5, 0xae, 0xf1, 0xeb, 0xd5, 0xae, 0xf1, 0xfb, 0xd5, 0xae, 0xf1,
-	0xf1, 0x6b, 0xff, 0xec, 0xef, 0x84, 0x17, 0x30, 0x0c, 0xf3, 0x7f, 0x35, 0x9d, 0x9a, 0xba, 0xf8,
-	0x1f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x28, 0x9d, 0xea, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1c, 0x45,
+	0x13, 0xd6, 0xec, 0xda, 0x8e, 0xdd, 0x76, 0xf2, 0x26, 0x9d, 0xc4, 0x59, 0xcf, 0xeb, 0x8f, 0xa5,
+	0x21, 0xd1, 0xca, 0xe0, 0x19, 0xd9, 0x06, 0x14, 0xe0, 0x44, 0x6c, 0xcb, 0x44, 0x32, 0x02, 0xc6,
+	0x41, 0x41, 0x1c, 0x40, 0xed, 0x99, 0x62, 0xd3, 0xd9, 0xd9, 0xe9, 0x61, 0xba, 0x77, 0xed, 0xc5,
+	0xf2, 0x05, 0x09, 0x90, 0x38, 0x70, 0x80, 0x13, 0x17, 0x8e, 0x48, 0xfc, 0x0b, 0xb8, 0x71, 0x44,
+	0xe2, 0x0f, 0x20, 0x8b, 0x1f, 0x82, 0xba, 0xa7, 0x67, 0x76, 0xc6, 0xbb, 0xc3, 0x87, 0xbc, 0x70,
+	0xda, 0xee, 0xda, 0xea, 0x7a, 0x9e, 0xaa, 0xea, 0xaa, 0xea, 0x41, 0xcb, 0x02, 0x92, 0x3e, 0x24,
+	0x6e, 0x9c, 0xf0, 0xa7, 0xe0, 0xcb, 0xec, 0xd7, 0x89, 0x13, 0x2e, 0x39, 0xbe, 0x62, 0xb6, 0xf6,
+	0x72, 0x9b, 0xf3, 0x76, 0x08, 0x2e, 0x8d, 0x99, 0x4b, 0xa3, 0x88, 0x4b, 0x2a, 0x19, 0x8f, 0x44,
+	0xaa, 0x66, 0x93, 0xce, 0x7d, 0xe1, 0x30, 0xae, 0xff, 0xf5, 0x79, 0x02, 0x6e, 0x7f, 0xd3, 0x6d,
+	0x43, 0x04, 0x09, 0x95, 0x10, 0x18, 0x9d, 0x83, 0x36, 0x93, 0x4f, 0x7a, 0x47, 0x8e, 0xcf, 0xbb,
+	0x2e, 0x4d, 0xda, 0x5c, 0x59, 0xd6, 0x8b, 0x0d, 0x3f, 0x70, 0xfb, 0x5b, 0x6e, 0xdc, 0x69, 0xab,
+	0xf3, 0xc2, 0xa5, 0x71, 0x1c, 0x32, 0x5f, 0xdb, 0x77, 0xfb, 0x9b, 0x34, 0x8c, 0x9f, 0xd0, 0x51,
+	0x6b, 0x3b, 0x7f, 0x61, 0xcd, 0x78, 0x55, 0xb4, 0x55, 0x58, 0xa7, 0x46, 0xc8, 0xd7, 0x16, 0xba,
+	0xf5, 0x76, 0xea, 0xe0, 0x4e, 0x02, 0x54, 0x82, 0x07, 0x1f, 0xf7, 0x40, 0x48, 0x7c, 0x84, 0x32,
+	0xc7, 0x1b, 0x56, 0xd3, 0x6a, 0xcd, 0x6f, 0xbd, 0xe1, 0x0c, 0xf1, 0x9c, 0x0c, 0x4f, 0x2f, 0x3e,
+	0xf4, 0x03, 0xa7, 0xbf, 0xe5, 0xc4, 0x9d, 0xb6, 0xa3, 0xd8, 0x3b, 0x45, 0x94, 0x8c, 0xbd, 0xf3,
+	0x7a, 0x1c, 0x1b, 0x1c, 0x2f, 0x33, 0x8c, 0x17, 0xd1, 0x4c, 0x2f, 0x16, 0x90, 0xc8, 0x46, 0xad,
+	0x69, 0xb5, 0x66, 0x3d, 0xb3, 0x23, 0x1d, 0xb4, 0x64, 0x74, 0x1f, 0xf1, 0x0e, 0x44, 0xbb, 0x10,
+	0xc2, 0x90, 0x58, 0xa3, 0x4c, 0x6c, 0x6e, 0x68, 0x0e, 0xa3, 0xa9, 0x84, 0x87, 0xa0, 0x8d, 0xcd,
+	0x79, 0x7a, 0x8d, 0xaf, 0xa3, 0x3a, 0xa3, 0xb2, 0x51, 0x6f, 0x5a, 0xad, 0xba, 0xa7, 0x96, 0xf8,
+	0x1a, 0xaa, 0xb1, 0xa0, 0x31, 0xa5, 0x75, 0x6a, 0x2c, 0x20, 0xdf, 0x5a, 0x65, 0xb4, 0x72, 0x18,
+	0xaa, 0xd1, 0x9a, 0x68, 0x3e, 0x00, 0xe1, 0x27, 0x2c, 0x56, 0x8e, 0x1a, 0xd0, 0xa2, 0x28, 0xe7,
+	0x53, 0x2f, 0xf0, 0x59, 0x46, 0x73, 0x70, 0x12, 0xb3, 0x04, 0xc4, 0xc3, 0x48, 0x93, 0xa8, 0x7b,
+	0x43, 0x81, 0xe1, 0x36, 0x9d, 0x73, 0x7b, 0x21, 0x4f, 0x8e, 0xa6, 0xe6, 0x81, 0x88, 0x79, 0x24,
+	0x00, 0xdf, 0x42, 0xd3, 0x52, 0x09, 0x0c, 0xa7, 0x74, 0x43, 0x08, 0x5a, 0x30, 0xda, 0xef, 0xf4,
+	0x20, 0x19, 0x28, 0xfc, 0x88, 0x76, 0xc1, 0x28, 0xe9, 0x35, 0xf9, 0x24, 0xb7, 0xf8, 0x6e, 0x1c,
+	0xfc, 0xb7, 0xe9, 0x26, 0xff, 0x43, 0x57, 0xf7, 0xba, 0xb1, 0x1c, 0x64, 0x6e, 0x90, 0x10, 0x5d,
+	0x3f, 0x1c, 0x44, 0xfe, 0x63, 0x16, 0x05, 0xfc, 0x58, 0x54, 0x92, 0x56, 0xb2, 0x8f, 0x12, 0xde,
+	0xcd, 0x12, 0xab, 0xd6, 0x2a, 0x54, 0x92, 0x9b, 0xd0, 0xd6, 0x24, 0x57, 0xe9, 0x28, 0x10, 0x31,
+	0xf9, 0x2d, 0x8a, 0xc8, 0x8f, 0x35, 0x74, 0xb3, 0x00, 0x97, 0x07, 0xf3, 0x08, 0x5d, 0x39, 0x4e,
+	0x45, 0x0d, 0xab, 0x59, 0xbf, 0xbc, 0xeb, 0x43, 0x0c, 0x2f, 0x33, 0x8c, 0x5f, 0x41, 0xb3, 0x92,
+	0x75, 0x21, 0x64, 0x91, 0xba, 0x9e, 0x0a, 0x64, 0xc5, 0xc9, 0xda, 0xcc, 0x50, 0xff, 0x2d, 0xdf,
+	0xef, 0x25, 0x09, 0x44, 0x3e, 0x78, 0xb9, 0x3a, 0x7e, 0x19, 0xcd, 0xf2, 0x3e, 0x24, 0x21, 0x8d,
+	0x45, 0xa3, 0xae, 0x8f, 0xda, 0xe3, 0x8e, 0xa6, 0x2a, 0x5e, 0xae, 0x8b, 0x09, 0x5a, 0x88, 0xe0,
+	0x44, 0x2a, 0x95, 0x47, 0xac, 0x0b, 0x26, 0x22, 0x25, 0x19, 0x76, 0x10, 0x56, 0xfb, 0x37, 0x69,
+	0xd4, 0xa3, 0x61, 0xae, 0x99, 0xde, 0xbf, 0x31, 0xff, 0x90, 0x13, 0xb4, 0xb8, 0x1f, 0xf2, 0x23,
+	0x1a, 0x9a, 0xdc, 0x0e, 0x83, 0xf8, 0x01, 0x9a, 0x66, 0x12, 0xba, 0x13, 0x0a, 0x61, 0xe1, 0xf6,
+	0xa4, 0x66, 0xc9, 0x4f, 0x75, 0xd4, 0xd8, 0x05, 0x49, 0x59, 0x08, 0xc1, 0x08, 0x78, 0x8c, 0xae,
+	0xb5, 0x4b, 0xb4, 0x26, 0xce, 0xe2, 0x82, 0xfd, 0x62, 0xb9, 0xd4, 0xfe, 0xad, 0xee, 0x18, 0xa2,
+	0x85, 0x04, 0x62, 0x2e, 0x98, 0xe4, 0x09, 0x83, 0x2c, 0xf9, 0x97, 0x04, 0xf2, 0x32, 0x8b, 0x03,
+	0xaf, 0x64, 0x1d, 0x53, 0x34, 0xeb, 0x87, 0x3d, 0x21, 0x21, 0x11, 0x8d, 0x29, 0x8d, 0xb4, 0x77,
+	0x39, 0xa4, 0x9d, 0xd4, 0x9a, 0x97, 0x9b, 0x25, 0x1b, 0xe8, 0xce, 0x01, 0x13, 0xd2, 0x38, 0x7a,
+	0xc0, 0xa2, 0x8e, 0xc8, 0xda, 0xcf, 0xb8, 0x56, 0xa5, 0x46, 0xd3, 0xb8, 0xda, 0xc0, 0x36, 0x9a,
+	0x4d, 0xeb, 0xea, 0xe1, 0xae, 0x3e, 0x30, 0xed, 0xe5, 0x7b, 0x65, 0xa8, 0xc3, 0xa2, 0x20, 0x6b,
+	0x15, 0x6a, 0xad, 0xba, 0xa5, 0x90, 0x34, 0x91, 0xa6, 0x5b, 0xa4, 0x1b, 0x35, 0x19, 0x20, 0xca,
+	0x06, 0x81, 0x5a, 0xaa, 0x8a, 0x29, 0x78, 0x22, 0x1a, 0xd3, 0xcd, 0xba, 0xaa, 0x98, 0xa2, 0x8c,
+	0xfc, 0x60, 0xa1, 0x1b, 0x23, 0x55, 0x87, 0x9f, 0x43, 0x57, 0x69, 0x18, 0xf2, 0xe3, 0xc7, 0x65,
+	0x5a, 0x65, 0xa1, 0xb2, 0x1f, 0x40, 0x34, 0xc8, 0x95, 0x6a, 0x5a, 0xa9, 0x24, 0x9b, 0x24, 0xd7,
+	0xad, 0xef, 0x16, 0xd0, 0x35, 0x13, 0xec, 0x43, 0x48, 0xfa, 0xcc, 0x07, 0xfc, 0xa5, 0x85, 0xe6,
+	0xd3, 0x01, 0xa7, 0x07, 0x0a, 0x26, 0x79, 0x2b, 0xa9, 0x1c, 0x81, 0xf6, 0xca, 0x58, 0x9d, 0xbc,
+	0x89, 0xdf, 0xff, 0xf4, 0xd7, 0xdf, 0xbf, 0xa9, 0x6d, 0x91, 0x0d, 0xfd, 0xf4, 0xe9, 0x6f, 0x66,
+	0xcf, 0x27, 0xe1, 0x9e, 0x9a, 0xd5, 0x99, 0xab, 0x46, 0x9f, 0x70, 0x4f, 0xd5, 0xcf, 0x99, 0xab,
+	0x87, 0xd5, 0xab, 0xd6, 0x3a, 0xfe, 0xdc, 0x42, 0xf3, 0xe9, 0x6c, 0xff, 0x33, 0x32, 0xa5, 0xe9,
+	0x6f, 0x2f, 0xe6, 0x3a, 0xe5, 0x51, 0xf2, 0x9a, 0x66, 0xf1, 0xd2, 0xfa, 0xf6, 0x3f, 0x62, 0xe1,
+	0x9e, 0x32, 0x2a, 0xcf, 0xf0, 0x57, 0x16, 0x9a, 0x49, 0x7d, 0xc6, 0x23, 0xce, 0x96, 0x63, 0x31,
+	0xb1, 0x32, 0x27, 0xff, 0xd7, 0x84, 0x6f, 0x93, 0xeb, 0x17, 0x09, 0xab, 0xc8, 0x7c, 0x66, 0xa1,
+	0x29, 0x55, 0x2a, 0xf8, 0xf6, 0x45, 0x3a, 0x7a, 0x48, 0xda, 0x07, 0x93, 0xa2, 0xa1, 0x40, 0x48,
+	0x43, 0x53, 0xc1, 0x78, 0x84, 0x0a, 0x3e, 0x41, 0x78, 0x1f, 0xe4, 0x85, 0xbe, 0x5b, 0x45, 0xea,
+	0x99, 0x5c, 0x5c, 0xd5, 0xa8, 0x49, 0x4b, 0x23, 0x11, 0xdc, 0x1c, 0xcd, 0x92, 0x2a, 0xf9, 0x33,
+	0x37, 0x30, 0x27, 0xf1, 0x17, 0x16, 0xaa, 0xef, 0x43, 0x25, 0xd6, 0xe4, 0xf2, 0xb0, 0xa6, 0x29,
+	0x2d, 0xe1, 0x3b, 0x15, 0x94, 0xf0, 0x29, 0xba, 0xb1, 0x0f, 0xb2, 0x3c, 0xf6, 0xaa, 0x68, 0xad,
+	0xe5, 0xe2, 0xf1, 0x63, 0x92, 0x38, 0x1a, 0xad, 0x85, 0xef, 0x55, 0x05, 0x20, 0x9d, 0x33, 0x79,
+	0x02, 0xbe, 0xb7, 0xd0, 0x4c, 0xfa, 0x50, 0x1b, 0xbd, 0x99, 0xa5, 0x07, 0xdc, 0x04, 0x23, 0xb2,
+	0xad, 0x39, 0x6e, 0xd8, 0xad, 0xca, 0x52, 0x72, 0xba, 0x20, 0x69, 0x40, 0x25, 0x75, 0x34, 0x69,
+	0x75, 0x63, 0xdf, 0x43, 0x33, 0x69, 0xa1, 0x56, 0x85, 0xa6, 0xaa, 0x70, 0x4d, 0xfc, 0xd7, 0x2b,
+	0xe3, 0xff, 0x14, 0x21, 0x75, 0x4b, 0xf7, 0xfa, 0x10, 0x55, 0x07, 0x7e, 0xc5, 0x49, 0x3f, 0xbf,
+	0x94, 0x87, 0x8e, 0xfa, 0xfc, 0x72, 0xfa, 0x9b, 0x8e, 0x3e, 0xa2, 0x6f, 0xf8, 0x3d, 0x0d, 0xd2,
+	0xc4, 0xab, 0x55, 0x61, 0x87, 0xd4, 0xfa, 0x29, 0xba, 0xb9, 0x0f, 0xb2, 0xf0, 0x48, 0x3c, 0x94,
+	0x2a, 0xf4, 0x4b, 0x63, 0x1e, 0x5c, 0xe9, 0x73, 0xd5, 0x5e, 0x1e, 0xf7, 0x57, 0xee, 0xdc, 0xf3,
+	0x1a, 0xf7, 0x2e, 0x7e, 0xb6, 0x0a, 0x57, 0x0c, 0x22, 0x3f, 0x7b, 0x23, 0xc6, 0x68, 0x4e, 0x91,
+	0xd5, 0x73, 0x11, 0x37, 0x73, 0xbb, 0x15, 0x23, 0xd3, 0xb6, 0x4b, 0x89, 0x34, 0x7f, 0x19, 0xdc,
+	0xbb, 0x1a, 0x77, 0x0d, 0xaf, 0x54, 0xe1, 0x86, 0x4a, 0xfd, 0xc1, 0x83, 0x9f, 0xcf, 0x57, 0xad,
+	0x5f, 0xce, 0x57, 0xad, 0xdf, 0xce, 0x57, 0xad, 0xf7, 0x5f, 0xfc, 0x7b, 0x5f, 0xa7, 0x7e, 0xc8,
+	0x20, 0xca, 0x3f, 0x92, 0x8f, 0x66, 0xf4, 0x77, 0xe4, 0xf6, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x4d, 0x83, 0x6f, 0x1e, 0x45, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1588,6 +1829,27 @@ func (m *SyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Application) > 0 {
+		i -= len(m.Application)
+		copy(dAtA[i:], m.Application)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Application)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -1622,6 +1884,48 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextManualSyncTime) > 0 {
+		i -= len(m.NextManualSyncTime)
+		copy(dAtA[i:], m.NextManualSyncTime)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.NextManualSyncTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NextSyncTime) > 0 {
+		i -= len(m.NextSyncTime)
+		copy(dAtA[i:], m.NextSyncTime)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.NextSyncTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Overlaps) > 0 {
+		for iNdEx := len(m.Overlaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overlaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Timeline) > 0 {
+		for iNdEx := len(m.Timeline) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timeline[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Windows) > 0 {
 		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1795,6 +2099,128 @@ func (m *ListProjectLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowOccurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowOccurrence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowOccurrence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WindowID != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.WindowID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowOverlap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowOverlap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowOverlap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DenyWindowID != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.DenyWindowID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowWindowID != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.AllowWindowID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProject(v)
 	base := offset
@@ -1953,8 +2379,20 @@ func (m *SyncWindowsQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Application)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -1971,6 +2409,26 @@ func (m *SyncWindowsResponse) Size() (n int) {
 			n += 1 + l + sovProject(uint64(l))
 		}
 	}
+	if len(m.Timeline) > 0 {
+		for _, e := range m.Timeline {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if len(m.Overlaps) > 0 {
+		for _, e := range m.Overlaps {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	l = len(m.NextSyncTime)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.NextManualSyncTime)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2045,6 +2503,71 @@ func (m *ListProjectLinksRequest) Size() (n int) {
 	return n
 }
 
+func (m *SyncWindowOccurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowID != 0 {
+		n += 1 + sovProject(uint64(m.WindowID))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncWindowOverlap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowWindowID != 0 {
+		n += 1 + sovProject(uint64(m.AllowWindowID))
+	}
+	if m.DenyWindowID != 0 {
+		n += 1 + sovProject(uint64(m.DenyWindowID))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovProject(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2887,62 +3410,11 @@ func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProject(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProject
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProject
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncWindowsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -2952,82 +3424,61 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProject
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProject
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Windows = append(m.Windows, &v1alpha1.SyncWindow{})
-			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProject(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProject
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProject
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalProjectsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -3037,25 +3488,23 @@ func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProject
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProject
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &v1alpha1.AppProject{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3079,7 +3528,7 @@ func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3102,15 +3551,15 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetailedProjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetailedProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalProjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3137,14 +3586,14 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalProjects = append(m.GlobalProjects, &v1alpha1.AppProject{})
-			if err := m.GlobalProjects[len(m.GlobalProjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, &v1alpha1.SyncWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3171,16 +3620,14 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &v1alpha1.AppProject{}
-			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Timeline = append(m.Timeline, &SyncWindowOccurrence{})
+			if err := m.Timeline[len(m.Timeline)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3207,16 +3654,16 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repositories = append(m.Repositories, &v1alpha1.Repository{})
-			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Overlaps = append(m.Overlaps, &SyncWindowOverlap{})
+			if err := m.Overlaps[len(m.Overlaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -3226,27 +3673,612 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProject
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProject
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Clusters = append(m.Clusters, &v1alpha1.Cluster{})
-			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NextSyncTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextManualSyncTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextManualSyncTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalProjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.AppProject{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetailedProjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetailedProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalProjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalProjects = append(m.GlobalProjects, &v1alpha1.AppProject{})
+			if err := m.GlobalProjects[len(m.GlobalProjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &v1alpha1.AppProject{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repositories = append(m.Repositories, &v1alpha1.Repository{})
+			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &v1alpha1.Cluster{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProjectLinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProjectLinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProjectLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowOccurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowOccurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowOccurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowID", wireType)
+			}
+			m.WindowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
 			if err != nil {
@@ -3268,7 +4300,7 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListProjectLinksRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncWindowOverlap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,15 +4323,53 @@ func (m *ListProjectLinksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListProjectLinksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindowOverlap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProjectLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindowOverlap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowWindowID", wireType)
+			}
+			m.AllowWindowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowWindowID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyWindowID", wireType)
+			}
+			m.DenyWindowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenyWindowID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3327,7 +4397,71 @@ func (m *ListProjectLinksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
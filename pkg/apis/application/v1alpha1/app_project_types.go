@@ -1,7 +1,10 @@
 package v1alpha1
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -10,6 +13,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/glob"
 
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -282,6 +286,46 @@ func (p *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	sshSignatureKeys := make(map[string]bool)
+	for _, key := range p.Spec.SSHSignatureKeys {
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "SSH signature key '%s' is invalid: %v", key.PublicKey, err)
+		}
+		fingerprint := ssh.FingerprintSHA256(publicKey)
+		if _, ok := sshSignatureKeys[fingerprint]; ok {
+			return status.Errorf(codes.AlreadyExists, "SSH signature key '%s' already added", fingerprint)
+		}
+		sshSignatureKeys[fingerprint] = true
+	}
+
+	keylessIdentities := make(map[string]bool)
+	for _, identity := range p.Spec.KeylessSignatureIdentities {
+		if identity.Issuer == "" || identity.SubjectRegex == "" {
+			return status.Errorf(codes.InvalidArgument, "keyless signature identity requires an issuer and a subject regex")
+		}
+		if _, err := regexp.Compile(identity.SubjectRegex); err != nil {
+			return status.Errorf(codes.InvalidArgument, "subject regex '%s' of keyless signature identity is invalid: %v", identity.SubjectRegex, err)
+		}
+		key := fmt.Sprintf("%s/%s", identity.Issuer, identity.SubjectRegex)
+		if _, ok := keylessIdentities[key]; ok {
+			return status.Errorf(codes.AlreadyExists, "keyless signature identity '%s' '%s' already added", identity.Issuer, identity.SubjectRegex)
+		}
+		keylessIdentities[key] = true
+	}
+
+	if p.Spec.HelmChartVerification != nil {
+		for _, key := range p.Spec.HelmChartVerification.CosignPublicKeys {
+			block, _ := pem.Decode([]byte(key))
+			if block == nil {
+				return status.Errorf(codes.InvalidArgument, "cosign public key is not PEM encoded")
+			}
+			if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				return status.Errorf(codes.InvalidArgument, "cosign public key is invalid: %v", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,10 @@ const (
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
 
+	// AnnotationKeyHydrate is the annotation key which indicates that the manifests of an app with a source hydrator need
+	// to be hydrated. Removed by application controller once the hydration is started.
+	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
+
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
@@ -92,6 +92,15 @@ type ApplicationSetRolloutStrategy struct {
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// SoakDuration is how long the Applications of the step must stay Healthy before the rollout continues with the next step
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty" protobuf:"bytes,3,opt,name=soakDuration"`
+	// RequireApproval pauses the rollout before the step until the rollout is promoted
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,4,opt,name=requireApproval"`
+	// FailureThreshold is the number or percentage of the Applications of the step which may be Degraded or fail to sync
+	// before the rollout is aborted. The rollout is never aborted if unset.
+	FailureThreshold *intstr.IntOrString `json:"failureThreshold,omitempty" protobuf:"bytes,5,opt,name=failureThreshold"`
+	// RollbackOnFailure rolls back the Applications of the step which did not become Healthy when the rollout is aborted
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty" protobuf:"varint,6,opt,name=rollbackOnFailure"`
 }
 
 type ApplicationMatchExpression struct {
@@ -199,6 +208,12 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	Registry *RegistryGenerator `json:"registry,omitempty" protobuf:"bytes,11,name=registry"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,12,name=http"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -221,6 +236,12 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	Registry *RegistryGenerator `json:"registry,omitempty" protobuf:"bytes,11,name=registry"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,12,name=http"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -240,6 +261,12 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	Registry *RegistryGenerator `json:"registry,omitempty" protobuf:"bytes,9,name=registry"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,10,name=http"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,11,name=kubernetesResource"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -258,6 +285,9 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
+			Registry:                terminalGenerator.Registry,
+			HTTP:                    terminalGenerator.HTTP,
+			KubernetesResource:      terminalGenerator.KubernetesResource,
 			Selector:                terminalGenerator.Selector,
 		}
 	}
@@ -607,6 +637,21 @@ type PullRequestGenerator struct {
 	// Additional provider to use and config for it.
 	AzureDevOps *PullRequestGeneratorAzureDevOps `json:"azuredevops,omitempty" protobuf:"bytes,9,opt,name=azuredevops"`
 	// If you add a new SCM provider, update CustomApiUrl below.
+	// Feedback configures the feedback posted back to the pull requests about the applications generated for them.
+	Feedback *PullRequestGeneratorFeedback `json:"feedback,omitempty" protobuf:"bytes,10,opt,name=feedback"`
+}
+
+// PullRequestGeneratorFeedback configures the feedback posted back to the pull requests about the sync and health
+// status of the applications generated for them. Feedback is only supported for pull request generators which are not
+// nested in a matrix or merge generator.
+type PullRequestGeneratorFeedback struct {
+	// CommitStatus sets a status reflecting the sync and health status of the application on the head commit of the
+	// pull request.
+	CommitStatus bool `json:"commitStatus,omitempty" protobuf:"varint,1,opt,name=commitStatus"`
+	// Comment posts a comment with the sync and health status of the application, a link to the application and a
+	// summary of the changes of its manifests against the target branch on the pull request. The comment is updated
+	// as the status of the application changes.
+	Comment bool `json:"comment,omitempty" protobuf:"varint,2,opt,name=comment"`
 }
 
 func (p *PullRequestGenerator) CustomApiUrl() string {
@@ -781,6 +826,145 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// RegistryGenerator generates parameters from the tags of container image repositories, listed using the OCI
+// distribution API.
+type RegistryGenerator struct {
+	// Repositories whose tags are listed. Required.
+	Repositories []RegistryGeneratorRepository `json:"repositories" protobuf:"bytes,1,rep,name=repositories"`
+	// Filters for which tags should be considered.
+	Filters []RegistryGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,2,rep,name=filters"`
+	// SortBy determines the order of the tags of every repository, always newest first: "semver" (default) sorts by
+	// semantic version, with the tags which are not a semantic version last, "name" sorts by name and "created" sorts by
+	// image creation time.
+	SortBy string `json:"sortBy,omitempty" protobuf:"bytes,3,opt,name=sortBy"`
+	// Limit is the maximum number of tags generated for every repository, after filtering and sorting.
+	Limit *int64 `json:"limit,omitempty" protobuf:"varint,4,opt,name=limit"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
+// RegistryGeneratorRepository defines a container image repository and how to connect to its registry.
+type RegistryGeneratorRepository struct {
+	// Repository reference without tag, e.g. ghcr.io/argoproj/argocd. Required.
+	Repository string `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+	// Credentials for Basic auth
+	BasicAuth *BasicAuthRegistry `json:"basicAuth,omitempty" protobuf:"bytes,2,opt,name=basicAuth"`
+	// Credentials for Bearer auth
+	BearerToken *BearerTokenRegistry `json:"bearerToken,omitempty" protobuf:"bytes,3,opt,name=bearerToken"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,4,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,5,opt,name=caRef"`
+}
+
+// BasicAuthRegistry defines the username/password for Basic auth against a container registry.
+type BasicAuthRegistry struct {
+	// Username for Basic auth
+	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
+	// Password (or personal access token) reference.
+	PasswordRef *SecretRef `json:"passwordRef" protobuf:"bytes,2,opt,name=passwordRef"`
+}
+
+// BearerTokenRegistry defines the token for Bearer auth against a container registry.
+type BearerTokenRegistry struct {
+	// Token reference.
+	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,1,opt,name=tokenRef"`
+}
+
+// RegistryGeneratorFilter is a single tag filter.
+// If multiple filter types are set on a single struct, they will be AND'd together. All filters must
+// pass for a tag to be included.
+type RegistryGeneratorFilter struct {
+	// TagMatch is a regular expression the tag must match.
+	TagMatch *string `json:"tagMatch,omitempty" protobuf:"bytes,1,opt,name=tagMatch"`
+	// SemverConstraint is a semantic version constraint, e.g. ">=1.2.0-0", the tag must satisfy.
+	SemverConstraint *string `json:"semverConstraint,omitempty" protobuf:"bytes,2,opt,name=semverConstraint"`
+}
+
+// HTTPGenerator generates parameters from the items of a list in the JSON response of an HTTP endpoint.
+type HTTPGenerator struct {
+	// URL of the endpoint. Required.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method of the request, GET (default) or POST.
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers sent with every request.
+	Headers []HTTPGeneratorHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Body sent with POST requests.
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,6,opt,name=caRef"`
+	// ItemsPath is the JSONPath expression of the list of items in the response, e.g. {.items}. If empty, the response
+	// must be a list.
+	ItemsPath string `json:"itemsPath,omitempty" protobuf:"bytes,7,opt,name=itemsPath"`
+	// Params maps the names of the generated parameters to JSONPath expressions evaluated against every item, e.g.
+	// {.metadata.name}. If empty, the fields of every item are the parameters.
+	Params map[string]string `json:"params,omitempty" protobuf:"bytes,8,name=params"`
+	// Pagination defines how the further pages of the list are requested.
+	Pagination *HTTPGeneratorPagination `json:"pagination,omitempty" protobuf:"bytes,9,opt,name=pagination"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,10,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,11,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,12,name=values"`
+}
+
+// HTTPGeneratorHeader is a header sent with the requests of the HTTP generator. Exactly one of Value and ValueRef
+// should be set.
+type HTTPGeneratorHeader struct {
+	// Name of the header. Required.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value of the header.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// Reference to a Secret key holding the value of the header, e.g. an authorization token.
+	ValueRef *SecretRef `json:"valueRef,omitempty" protobuf:"bytes,3,opt,name=valueRef"`
+}
+
+// HTTPGeneratorPagination defines how the further pages of a list are requested. If neither LinkHeader nor
+// NextTokenPath are set, only the first page is requested.
+type HTTPGeneratorPagination struct {
+	// LinkHeader follows the link with rel="next" of the Link header of the responses.
+	LinkHeader bool `json:"linkHeader,omitempty" protobuf:"varint,1,opt,name=linkHeader"`
+	// NextTokenPath is the JSONPath expression of the token of the next page in the response, e.g. {.nextPageToken}.
+	// The list ends once the token is missing or empty.
+	NextTokenPath string `json:"nextTokenPath,omitempty" protobuf:"bytes,2,opt,name=nextTokenPath"`
+	// NextTokenParam is the query parameter the token of the next page is passed in; default: pageToken
+	NextTokenParam string `json:"nextTokenParam,omitempty" protobuf:"bytes,3,opt,name=nextTokenParam"`
+	// MaxPages is the maximum number of pages requested. Exceeding it is an error, so that applications are not
+	// deleted because the list was truncated; default: 100
+	MaxPages *int64 `json:"maxPages,omitempty" protobuf:"varint,4,opt,name=maxPages"`
+}
+
+// KubernetesResourceGenerator generates parameters from the objects of a kind in the local cluster or in a cluster
+// registered in Argo CD.
+type KubernetesResourceGenerator struct {
+	// APIVersion of the objects, e.g. v1 or example.com/v1alpha1. Required.
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind of the objects, e.g. Namespace. Required.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace the objects of a namespaced kind are listed from. If empty, the objects of all namespaces are listed.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// LabelSelector selects the objects by label.
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,name=labelSelector"`
+	// FieldSelector selects the objects by field, e.g. status.phase=Active.
+	FieldSelector string `json:"fieldSelector,omitempty" protobuf:"bytes,5,opt,name=fieldSelector"`
+	// Cluster is the name or the server URL of the cluster registered in Argo CD the objects are listed from. If
+	// empty, the objects are listed from the local cluster.
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,6,opt,name=cluster"`
+	// Params maps the names of additional parameters to JSONPath expressions evaluated against every object, e.g.
+	// {.spec.owner}.
+	Params map[string]string `json:"params,omitempty" protobuf:"bytes,7,name=params"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,8,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,9,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -789,6 +973,21 @@ type ApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+	// Rollout is the state of the gates of the RollingSync progressive sync
+	Rollout *ApplicationSetRolloutStatus `json:"rollout,omitempty" protobuf:"bytes,4,opt,name=rollout"`
+}
+
+// ApplicationSetRolloutStatus contains the approvals given to and the abort of the RollingSync rollout in progress
+type ApplicationSetRolloutStatus struct {
+	// PromotedStep is the last step promoted past its approval and soak gates. It is reset once the rollout completes.
+	PromotedStep int64 `json:"promotedStep,omitempty" protobuf:"varint,1,opt,name=promotedStep"`
+	// Aborted is true once the rollout is aborted, until the rollout is promoted
+	Aborted bool `json:"aborted,omitempty" protobuf:"varint,2,opt,name=aborted"`
+	// Message describes why the rollout is paused or aborted
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// AcceptedFailedStep is the last step whose failures were accepted by promoting the rollout they aborted. The
+	// failure thresholds of the steps up to it do not abort the rollout again. It is reset once the rollout completes.
+	AcceptedFailedStep int64 `json:"acceptedFailedStep,omitempty" protobuf:"varint,4,opt,name=acceptedFailedStep"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -865,6 +1064,9 @@ type ApplicationSetApplicationStatus struct {
 	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
 	// TargetRevision tracks the desired revisions the Application should be synced to.
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
+	// PreviousDeploymentID is the ID of the history entry the Application was deployed to before the rollout updated
+	// it, which the Application is rolled back to when the rollout is aborted
+	PreviousDeploymentID int64 `json:"previousDeploymentID,omitempty" protobuf:"varint,7,opt,name=previousDeploymentID"`
 }
 
 // ApplicationSetList contains a list of ApplicationSet
@@ -901,6 +1103,11 @@ func (a *ApplicationSet) RefreshRequired() bool {
 	return found
 }
 
+// RolloutAction returns the action requested on the RollingSync rollout of the ApplicationSet, if any
+func (a *ApplicationSet) RolloutAction() string {
+	return a.Annotations[common.AnnotationApplicationSetRollout]
+}
+
 // SetConditions updates the applicationset status conditions for a subset of evaluated types.
 // If the applicationset has a pre-existing condition of a type that is not in the evaluated list,
 // it will be preserved. If the applicationset has a pre-existing condition of a type, status, reason that
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -84,6 +85,95 @@ type ApplicationSpec struct {
 
 	// Sources is a reference to the location of the application's manifests or chart
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+
+	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
+	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn specifies Applications which have to be Synced and Healthy before this application is synced
+	DependsOn *ApplicationDependencies `json:"dependsOn,omitempty" protobuf:"bytes,10,opt,name=dependsOn"`
+}
+
+// SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
+// hydrated manifests, and an optional hydrateTo location to act as a "staging" area for hydrated manifests.
+type SourceHydrator struct {
+	// DrySource specifies where the dry "don't repeat yourself" manifest source lives.
+	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
+	// SyncSource specifies where to sync hydrated manifests from.
+	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
+	// have to move manifests to the SyncSource, e.g. by pull request.
+	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+}
+
+// GetSyncSource gets the source from which we should sync when a source hydrator is configured.
+func (s SourceHydrator) GetSyncSource() ApplicationSource {
+	return ApplicationSource{
+		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
+		RepoURL:        s.DrySource.RepoURL,
+		Path:           s.SyncSource.Path,
+		TargetRevision: s.SyncSource.TargetBranch,
+	}
+}
+
+// GetDrySource gets the dry source when a source hydrator is configured.
+func (s SourceHydrator) GetDrySource() ApplicationSource {
+	return ApplicationSource{
+		RepoURL:        s.DrySource.RepoURL,
+		Path:           s.DrySource.Path,
+		TargetRevision: s.DrySource.TargetRevision,
+	}
+}
+
+// GetHydrateToBranch returns the branch the hydrated manifests should be pushed to. Unless hydrateTo is set, this is
+// the branch the application syncs from.
+func (s SourceHydrator) GetHydrateToBranch() string {
+	if s.HydrateTo != nil {
+		return s.HydrateTo.TargetBranch
+	}
+	return s.SyncSource.TargetBranch
+}
+
+// DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
+func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
+	return s.DrySource == hydrator.DrySource && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+}
+
+// DrySource specifies a location for dry "don't repeat yourself" manifest source information.
+type DrySource struct {
+	// RepoURL is the URL to the git repository that contains the application manifests
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// TargetRevision defines the revision of the source to hydrate
+	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
+	// Path is a directory path within the Git repository where the manifests are located
+	Path string `json:"path" protobuf:"bytes,3,name=path"`
+}
+
+// SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
+// associated DrySource config in the SourceHydrator.
+type SyncSource struct {
+	// TargetBranch is the branch to which hydrated manifests should be committed
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Path is a directory path within the git repository where hydrated manifests should be committed to and synced
+	// from. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.
+	Path string `json:"path" protobuf:"bytes,2,name=path"`
+}
+
+// HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
+// the SyncSource. The RepoURL and Path are assumed based on the associated SyncSource config in the SourceHydrator.
+type HydrateTo struct {
+	// TargetBranch is the branch to which hydrated manifests should be committed
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+}
+
+// DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
+func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
+	if in == nil {
+		return to == nil
+	}
+	if to == nil {
+		return false
+	}
+	return in.TargetBranch == to.TargetBranch
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -212,6 +302,9 @@ func (a ApplicationSources) IsZero() bool {
 }
 
 func (a *ApplicationSpec) GetSource() ApplicationSource {
+	if a.SourceHydrator != nil {
+		return a.SourceHydrator.GetSyncSource()
+	}
 	// if Application has multiple sources, return the first source in sources
 	if a.HasMultipleSources() {
 		return a.Sources[0]
@@ -223,6 +316,9 @@ func (a *ApplicationSpec) GetSource() ApplicationSource {
 }
 
 func (a *ApplicationSpec) GetSources() ApplicationSources {
+	if a.SourceHydrator != nil {
+		return ApplicationSources{a.SourceHydrator.GetSyncSource()}
+	}
 	if a.HasMultipleSources() {
 		return a.Sources
 	}
@@ -233,7 +329,7 @@ func (a *ApplicationSpec) GetSources() ApplicationSources {
 }
 
 func (a *ApplicationSpec) HasMultipleSources() bool {
-	return len(a.Sources) > 0
+	return a.SourceHydrator == nil && len(a.Sources) > 0
 }
 
 func (a *ApplicationSpec) GetSourcePtrByPosition(sourcePosition int) *ApplicationSource {
@@ -1035,6 +1131,75 @@ type ApplicationStatus struct {
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
 	// ControllerNamespace indicates the namespace in which the application controller is located
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
+	// SourceHydrator stores information about the current state of source hydration
+	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// AutomatedRollback contains information about the most recent automated rollback of the application
+	AutomatedRollback *AutomatedRollbackStatus `json:"automatedRollback,omitempty" protobuf:"bytes,15,opt,name=automatedRollback"`
+	// LastScheduledSync is the time the controller last handled a time of the sync schedule of the application
+	LastScheduledSync *metav1.Time `json:"lastScheduledSync,omitempty" protobuf:"bytes,16,opt,name=lastScheduledSync"`
+}
+
+// AutomatedRollbackStatus contains information about an automated rollback of an application. Automated sync does not
+// sync the application to the rolled back revision again.
+type AutomatedRollbackStatus struct {
+	// Revision is the revision which was rolled back
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// Revisions holds the revision of each source which was rolled back
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,opt,name=revisions"`
+	// HistoryID is the ID of the revision history entry the application was rolled back to
+	HistoryID int64 `json:"historyID" protobuf:"bytes,3,opt,name=historyID"`
+	// RolledBackAt is the time the rollback was initiated
+	RolledBackAt metav1.Time `json:"rolledBackAt" protobuf:"bytes,4,opt,name=rolledBackAt"`
+	// Message describes why the application was rolled back
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// SourceHydratorStatus contains information about the current state of source hydration
+type SourceHydratorStatus struct {
+	// LastSuccessfulOperation holds info about the most recent successful hydration
+	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
+	// CurrentOperation holds the status of the hydrate operation
+	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+}
+
+// HydrateOperationPhase is the phase of a hydrate operation
+type HydrateOperationPhase string
+
+const (
+	// HydrateOperationPhaseHydrating indicates the hydration is in progress
+	HydrateOperationPhaseHydrating HydrateOperationPhase = "Hydrating"
+	// HydrateOperationPhaseFailed indicates the hydration failed
+	HydrateOperationPhaseFailed HydrateOperationPhase = "Failed"
+	// HydrateOperationPhaseHydrated indicates the hydration was successful
+	HydrateOperationPhaseHydrated HydrateOperationPhase = "Hydrated"
+)
+
+// HydrateOperation contains information about the most recent hydrate operation
+type HydrateOperation struct {
+	// StartedAt indicates when the hydrate operation started
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,1,opt,name=startedAt"`
+	// FinishedAt indicates when the hydrate operation finished
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,2,opt,name=finishedAt"`
+	// Phase indicates the status of the hydrate operation
+	Phase HydrateOperationPhase `json:"phase" protobuf:"bytes,3,opt,name=phase"`
+	// Message contains a message describing the current status of the hydrate operation
+	Message string `json:"message" protobuf:"bytes,4,opt,name=message"`
+	// DrySHA holds the resolved revision (sha) of the dry source as of the most recent reconciliation
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,5,opt,name=drySHA"`
+	// HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
+	// SourceHydrator holds the hydrator config used for the hydrate operation
+	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+}
+
+// SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
+type SuccessfulHydrateOperation struct {
+	// DrySHA holds the resolved revision (sha) of the dry source as of the most recent reconciliation
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,5,opt,name=drySHA"`
+	// HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
+	// SourceHydrator holds the hydrator config used for the hydrate operation
+	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
 }
 
 // GetRevisions will return the current revision associated with the Application.
@@ -1240,6 +1405,39 @@ type ManagedNamespaceMetadata struct {
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
 }
 
+// DefaultDependencyTimeout is the default amount of time to wait for the dependencies of an application
+const DefaultDependencyTimeout = 30 * time.Minute
+
+// ApplicationDependencies specifies the Applications which have to be Synced and Healthy before an application is synced
+type ApplicationDependencies struct {
+	// Applications is a list of references to the Applications this application depends on
+	Applications []ApplicationDependency `json:"applications,omitempty" protobuf:"bytes,1,rep,name=applications"`
+	// Timeout is the maximum amount of time to wait for the dependencies, e.g. "30m". Defaults to 30 minutes.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// GetTimeout returns the maximum amount of time to wait for the dependencies
+func (d *ApplicationDependencies) GetTimeout() (time.Duration, error) {
+	if d == nil || d.Timeout == "" {
+		return DefaultDependencyTimeout, nil
+	}
+	timeout, err := time.ParseDuration(d.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dependency timeout %q: %w", d.Timeout, err)
+	}
+	return timeout, nil
+}
+
+// ApplicationDependency references the Applications an application depends on, either by name or by label selector
+type ApplicationDependency struct {
+	// Name is the name of the Application
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Application(s). Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Selector selects Applications by their labels. Mutually exclusive with Name.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,3,opt,name=selector"`
+}
+
 // SyncPolicy controls when a sync will be performed in response to updates in git
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
@@ -1250,12 +1448,129 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Rollback controls the automated rollback of the application if it does not become healthy after a sync
+	Rollback *SyncPolicyRollback `json:"rollback,omitempty" protobuf:"bytes,5,opt,name=rollback"`
+	// Schedule triggers syncs of the application at the times of a cron schedule
+	Schedule *SyncSchedule `json:"schedule,omitempty" protobuf:"bytes,6,opt,name=schedule"`
+	// Freeze prevents all syncs of the application, e.g. during maintenance
+	Freeze *SyncFreeze `json:"freeze,omitempty" protobuf:"bytes,7,opt,name=freeze"`
 	// If you add a field here, be sure to update IsZero.
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Rollback == nil && p.Schedule == nil && p.Freeze == nil)
+}
+
+// GetSchedule returns the sync schedule of the sync policy, or nil if syncs are not scheduled
+func (p *SyncPolicy) GetSchedule() *SyncSchedule {
+	if p == nil {
+		return nil
+	}
+	return p.Schedule
+}
+
+// GetFreeze returns the maintenance freeze of the sync policy, or nil if the application is not frozen
+func (p *SyncPolicy) GetFreeze() *SyncFreeze {
+	if p == nil {
+		return nil
+	}
+	return p.Freeze
+}
+
+// SyncSchedule triggers syncs of an application at the times of a cron schedule, e.g. to deploy nightly
+type SyncSchedule struct {
+	// Cron is the schedule of the syncs in cron format, e.g. "0 2 * * *"
+	Cron string `json:"cron" protobuf:"bytes,1,opt,name=cron"`
+	// TimeZone is the time zone of the schedule, e.g. "Europe/Berlin". Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+	// Prune specifies whether resources which are not part of the desired state anymore are deleted by scheduled syncs
+	Prune bool `json:"prune,omitempty" protobuf:"bytes,3,opt,name=prune"`
+}
+
+// Validate checks whether the sync schedule has a valid cron expression and time zone
+func (s *SyncSchedule) Validate() error {
+	_, err := s.Next(time.Now())
+	return err
+}
+
+// Next returns the first time of the sync schedule after the given time
+func (s *SyncSchedule) Next(t time.Time) (time.Time, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(s.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse sync schedule '%s': %w", s.Cron, err)
+	}
+	loc := time.UTC
+	if s.TimeZone != "" {
+		loc, err = time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time zone '%s' of sync schedule: %w", s.TimeZone, err)
+		}
+	}
+	return schedule.Next(t.In(loc)), nil
+}
+
+// SyncFreeze prevents all syncs of an application, including manual syncs, until it is removed or expires
+type SyncFreeze struct {
+	// Reason describes why the application is frozen
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// SetBy is the user who set the freeze. It is recorded by the API server.
+	SetBy string `json:"setBy,omitempty" protobuf:"bytes,2,opt,name=setBy"`
+	// SetAt is the time the freeze was set. It is recorded by the API server.
+	SetAt *metav1.Time `json:"setAt,omitempty" protobuf:"bytes,3,opt,name=setAt"`
+	// ExpiresAt is the time the freeze ends. The freeze does not expire if it is not set.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,4,opt,name=expiresAt"`
+}
+
+// IsActive returns true if the freeze currently prevents syncs
+func (f *SyncFreeze) IsActive() bool {
+	return f.isActive(time.Now())
+}
+
+func (f *SyncFreeze) isActive(now time.Time) bool {
+	return f != nil && (f.ExpiresAt == nil || now.Before(f.ExpiresAt.Time))
+}
+
+const (
+	// DefaultRollbackWindow is the default amount of time after a sync during which an application is rolled back if it degrades
+	DefaultRollbackWindow = 10 * time.Minute
+	// DefaultRollbackProgressDeadline is the default amount of time after a sync an application may stay Progressing before it is rolled back
+	DefaultRollbackProgressDeadline = 5 * time.Minute
+)
+
+// SyncPolicyRollback controls the automated rollback of an application to the previous entry of its revision history
+type SyncPolicyRollback struct {
+	// Window is the amount of time after a successful sync during which the application is rolled back if it becomes
+	// Degraded, e.g. "10m". Defaults to 10 minutes.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+	// ProgressDeadline is the amount of time after a successful sync after which the application is rolled back if it
+	// is still Progressing, e.g. "5m". Defaults to 5 minutes. Only takes effect if it is shorter than the window.
+	ProgressDeadline string `json:"progressDeadline,omitempty" protobuf:"bytes,2,opt,name=progressDeadline"`
+}
+
+// GetWindow returns the amount of time after a sync during which the application is rolled back if it degrades
+func (r *SyncPolicyRollback) GetWindow() (time.Duration, error) {
+	if r == nil || r.Window == "" {
+		return DefaultRollbackWindow, nil
+	}
+	window, err := time.ParseDuration(r.Window)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rollback window %q: %w", r.Window, err)
+	}
+	return window, nil
+}
+
+// GetProgressDeadline returns the amount of time after a sync the application may stay Progressing
+func (r *SyncPolicyRollback) GetProgressDeadline() (time.Duration, error) {
+	if r == nil || r.ProgressDeadline == "" {
+		return DefaultRollbackProgressDeadline, nil
+	}
+	deadline, err := time.ParseDuration(r.ProgressDeadline)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rollback progress deadline %q: %w", r.ProgressDeadline, err)
+	}
+	return deadline, nil
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1407,6 +1722,34 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Gates contains the state of the sync gates of the resources applied by the sync operation
+	Gates []SyncGateStatus `json:"gates,omitempty" protobuf:"bytes,7,rep,name=gates"`
+}
+
+// SyncGateStatus holds the state of the sync gate of a resource during a sync operation
+type SyncGateStatus struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the target namespace of the resource
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// StartedAt is the time at which the sync gate was checked for the first time
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,5,opt,name=startedAt"`
+	// NextCheckAt is the time at which the sync gate is checked again, unset once the sync gate completed
+	NextCheckAt *metav1.Time `json:"nextCheckAt,omitempty" protobuf:"bytes,6,opt,name=nextCheckAt"`
+	// Status is the health the resource is reported with: Progressing while the sync gate is pending, Healthy once
+	// it passed, and Degraded if it failed
+	Status health.HealthStatusCode `json:"status,omitempty" protobuf:"bytes,7,opt,name=status"`
+	// Message is a human readable description of the result of the last check
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+}
+
+// Completed returns whether the sync gate passed or failed, i.e. whether it does not have to be checked again
+func (s *SyncGateStatus) Completed() bool {
+	return s.NextCheckAt == nil
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1484,6 +1827,9 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// ManifestsSnapshot holds the gzip compressed manifests which were synced, with the data of secrets hidden. It is
+	// only stored if manifest snapshots are enabled, and lets the desired state be inspected after the revision is gone.
+	ManifestsSnapshot []byte `json:"manifestsSnapshot,omitempty" protobuf:"bytes,11,opt,name=manifestsSnapshot"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1551,6 +1897,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyPendingWarning indicates that application sync is waiting for its dependencies to be synced and healthy
+	ApplicationConditionDependencyPendingWarning = "DependencyPendingWarning"
+	// ApplicationConditionDependencyError indicates that application dependencies are invalid or were not ready in time
+	ApplicationConditionDependencyError = "DependencyError"
+	// ApplicationConditionAutomatedRollbackWarning indicates that application was automatically rolled back and is not automatically synced to the rolled back revision
+	ApplicationConditionAutomatedRollbackWarning = "AutomatedRollbackWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1844,6 +2196,22 @@ type ResourceDiff struct {
 	PredictedLiveState string `json:"predictedLiveState,omitempty" protobuf:"bytes,10,opt,name=predictedLiveState"`
 	ResourceVersion    string `json:"resourceVersion,omitempty" protobuf:"bytes,11,opt,name=resourceVersion"`
 	Modified           bool   `json:"modified,omitempty" protobuf:"bytes,12,opt,name=modified"`
+	// FieldChanges attributes the fields which differ between the live and the desired state to the field managers which changed them
+	FieldChanges []ResourceFieldChange `json:"fieldChanges,omitempty" protobuf:"bytes,13,rep,name=fieldChanges"`
+}
+
+// ResourceFieldChange attributes a field of a live resource which differs from its desired state to the field manager
+// which changed it, as recorded in the managed fields of the resource
+type ResourceFieldChange struct {
+	// Path is the JSON pointer of the field
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Manager is the name of the field manager owning the live value of the field. It is empty if the field was removed
+	// from the live resource.
+	Manager string `json:"manager,omitempty" protobuf:"bytes,2,opt,name=manager"`
+	// Operation is the type of the operation the field manager used to change the field: Apply or Update
+	Operation string `json:"operation,omitempty" protobuf:"bytes,3,opt,name=operation"`
+	// ChangedAt is the time of the last change of the fields owned by the field manager
+	ChangedAt *metav1.Time `json:"changedAt,omitempty" protobuf:"bytes,4,opt,name=changedAt"`
 }
 
 // FullName returns full name of a node that was used for diffing in the format "group/kind/namespace/name"
@@ -2077,23 +2445,48 @@ type OverrideIgnoreDiff struct {
 }
 
 type rawResourceOverride struct {
-	HealthLua             string           `json:"health.lua,omitempty"`
-	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
-	Actions               string           `json:"actions,omitempty"`
-	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
-	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
-	KnownTypeFields       []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthLua             string                `json:"health.lua,omitempty"`
+	UseOpenLibs           bool                  `json:"health.lua.useOpenLibs,omitempty"`
+	Actions               string                `json:"actions,omitempty"`
+	IgnoreDifferences     string                `json:"ignoreDifferences,omitempty"`
+	IgnoreResourceUpdates string                `json:"ignoreResourceUpdates,omitempty"`
+	KnownTypeFields       []KnownTypeField      `json:"knownTypeFields,omitempty"`
+	HealthPlugin          *ResourceHealthPlugin `json:"health.plugin,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
 // TODO: describe the members of this type
 type ResourceOverride struct {
-	HealthLua             string             `protobuf:"bytes,1,opt,name=healthLua"`
-	UseOpenLibs           bool               `protobuf:"bytes,5,opt,name=useOpenLibs"`
-	Actions               string             `protobuf:"bytes,3,opt,name=actions"`
-	IgnoreDifferences     OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
-	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
-	KnownTypeFields       []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	HealthLua             string                `protobuf:"bytes,1,opt,name=healthLua"`
+	UseOpenLibs           bool                  `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	Actions               string                `protobuf:"bytes,3,opt,name=actions"`
+	IgnoreDifferences     OverrideIgnoreDiff    `protobuf:"bytes,2,opt,name=ignoreDifferences"`
+	IgnoreResourceUpdates OverrideIgnoreDiff    `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
+	KnownTypeFields       []KnownTypeField      `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	HealthPlugin          *ResourceHealthPlugin `protobuf:"bytes,7,opt,name=healthPlugin"`
+}
+
+// DefaultHealthPluginTimeout is the default maximum duration of a call to a health plugin
+const DefaultHealthPluginTimeout = 5 * time.Second
+
+// ResourceHealthPlugin configures an external gRPC service which assesses the health of resources
+type ResourceHealthPlugin struct {
+	// Address is the address of the health plugin, either host:port or unix:///path/to/socket
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Timeout is the maximum duration of a single health assessment, e.g. "2s". Defaults to 5s.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// GetTimeout returns the maximum duration of a single health assessment
+func (p *ResourceHealthPlugin) GetTimeout() (time.Duration, error) {
+	if p.Timeout == "" {
+		return DefaultHealthPluginTimeout, nil
+	}
+	timeout, err := time.ParseDuration(p.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health plugin timeout %q: %w", p.Timeout, err)
+	}
+	return timeout, nil
 }
 
 // TODO: describe this method
@@ -2106,6 +2499,7 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
 	s.Actions = raw.Actions
+	s.HealthPlugin = raw.HealthPlugin
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 	if err != nil {
 		return err
@@ -2127,7 +2521,7 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), s.KnownTypeFields}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), s.KnownTypeFields, s.HealthPlugin}
 	return json.Marshal(raw)
 }
 
@@ -2318,6 +2712,32 @@ type SignatureKey struct {
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
 }
 
+// SSHSignatureKey is an entry of an SSH allowed signers file, which permits an SSH key to sign commits
+type SSHSignatureKey struct {
+	// Principals is the comma separated list of principals of the key, e.g. the email address of its owner
+	Principals string `json:"principals,omitempty" protobuf:"bytes,1,opt,name=principals"`
+	// PublicKey is the public key in OpenSSH authorized keys format, e.g. "ssh-ed25519 AAAAC3Nza..."
+	PublicKey string `json:"publicKey" protobuf:"bytes,2,name=publicKey"`
+}
+
+// KeylessSignatureIdentity is an identity which is permitted to sign commits using keyless Sigstore signatures
+type KeylessSignatureIdentity struct {
+	// Issuer is the OIDC issuer which authenticated the signer, e.g. https://token.actions.githubusercontent.com
+	Issuer string `json:"issuer" protobuf:"bytes,1,name=issuer"`
+	// SubjectRegex is a regular expression the whole subject of the signing certificate, e.g. the email address of the signer, must match
+	SubjectRegex string `json:"subjectRegex" protobuf:"bytes,2,name=subjectRegex"`
+}
+
+// HelmChartVerification holds the requirements Helm charts must meet before manifests are generated from them
+type HelmChartVerification struct {
+	// Provenance requires charts to have a provenance file signed by one of the project's GnuPG signature keys, or by
+	// any key in the GnuPG keyring if the project has none
+	Provenance bool `json:"provenance,omitempty" protobuf:"varint,1,opt,name=provenance"`
+	// CosignPublicKeys are PEM encoded public keys. If set, charts from OCI registries must have a cosign signature
+	// made by one of them
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty" protobuf:"bytes,2,rep,name=cosignPublicKeys"`
+}
+
 // AppProjectSpec is the specification of an AppProject
 type AppProjectSpec struct {
 	// SourceRepos contains list of repository URLs which can be used for deployment
@@ -2348,6 +2768,17 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// SSHSignatureKeys contains a list of SSH keys that commits in Git may be signed with in order to be allowed for sync
+	SSHSignatureKeys []SSHSignatureKey `json:"sshSignatureKeys,omitempty" protobuf:"bytes,15,opt,name=sshSignatureKeys"`
+	// KeylessSignatureIdentities contains a list of identities that commits in Git may be signed by using keyless Sigstore signatures, e.g. made with gitsign, in order to be allowed for sync
+	KeylessSignatureIdentities []KeylessSignatureIdentity `json:"keylessSignatureIdentities,omitempty" protobuf:"bytes,16,opt,name=keylessSignatureIdentities"`
+	// HelmChartVerification configures the verification of Helm charts before manifests are generated from them
+	HelmChartVerification *HelmChartVerification `json:"helmChartVerification,omitempty" protobuf:"bytes,17,opt,name=helmChartVerification"`
+}
+
+// HasSignatureKeys returns true if commits in Git must be signed with one of the keys or identities of the project
+func (spec AppProjectSpec) HasSignatureKeys() bool {
+	return len(spec.SignatureKeys) > 0 || len(spec.SSHSignatureKeys) > 0 || len(spec.KeylessSignatureIdentities) > 0
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2549,8 +2980,11 @@ func (w *SyncWindows) CanSync(isManual bool) bool {
 	if !w.HasWindows() {
 		return true
 	}
+	return canSync(w.Active(), w.InactiveAllows(), isManual)
+}
 
-	active := w.Active()
+// canSync returns true if a sync is allowed given the currently active windows and the currently inactive allow windows.
+func canSync(active *SyncWindows, inactiveAllows *SyncWindows, isManual bool) bool {
 	hasActiveDeny, manualEnabled := active.hasDeny()
 
 	if hasActiveDeny {
@@ -2565,7 +2999,6 @@ func (w *SyncWindows) CanSync(isManual bool) bool {
 		return true
 	}
 
-	inactiveAllows := w.InactiveAllows()
 	if inactiveAllows.HasWindows() {
 		if isManual && inactiveAllows.manualEnabled() {
 			return true
@@ -2649,6 +3082,166 @@ func (w SyncWindow) active(currentTime time.Time) bool {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration))
 }
 
+// SyncWindowOccurrence is a time range during which a sync window is active
+// +k8s:deepcopy-gen=false
+// +protobuf=false
+type SyncWindowOccurrence struct {
+	// Window is the sync window which is active during the time range
+	Window *SyncWindow
+	// Start is the time at which the window becomes active
+	Start time.Time `protobuf:"-"`
+	// End is the time at which the window becomes inactive
+	End time.Time `protobuf:"-"`
+}
+
+// SyncWindowOverlap is a time range during which both an allow and a deny window are active
+// +k8s:deepcopy-gen=false
+// +protobuf=false
+type SyncWindowOverlap struct {
+	// Allow is the active allow window
+	Allow *SyncWindow
+	// Deny is the active deny window, which takes precedence over the allow window
+	Deny *SyncWindow
+	// Start is the time at which both windows become active
+	Start time.Time `protobuf:"-"`
+	// End is the time at which one of the windows becomes inactive
+	End time.Time `protobuf:"-"`
+}
+
+// SyncWindowTimeline is a list of sync window occurrences sorted by their start time
+// +k8s:deepcopy-gen=false
+// +protobuf=false
+type SyncWindowTimeline []SyncWindowOccurrence
+
+// Occurrences returns the time ranges overlapping [from, to) during which the sync window is active, evaluating
+// the schedule in the time zone of the window. Overlapping activations of the window are merged into a single range.
+func (w *SyncWindow) Occurrences(from time.Time, to time.Time) ([]SyncWindowOccurrence, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	}
+	loc := time.UTC
+	if w.TimeZone != "" {
+		if loc, err = time.LoadLocation(w.TimeZone); err != nil {
+			return nil, fmt.Errorf("cannot load time zone '%s': %w", w.TimeZone, err)
+		}
+	}
+	if duration <= 0 {
+		return nil, nil
+	}
+
+	var occurrences []SyncWindowOccurrence
+	for start := schedule.Next(from.Add(-duration).In(loc)); !start.IsZero() && start.Before(to); start = schedule.Next(start) {
+		end := start.Add(duration)
+		if !end.After(from) {
+			continue
+		}
+		if n := len(occurrences); n > 0 && !start.After(occurrences[n-1].End) {
+			if end.After(occurrences[n-1].End) {
+				occurrences[n-1].End = end.UTC()
+			}
+			continue
+		}
+		occurrences = append(occurrences, SyncWindowOccurrence{Window: w, Start: start.UTC(), End: end.UTC()})
+	}
+	return occurrences, nil
+}
+
+// Timeline returns the time ranges overlapping [from, to) during which the sync windows are active
+func (s *SyncWindows) Timeline(from time.Time, to time.Time) (SyncWindowTimeline, error) {
+	var timeline SyncWindowTimeline
+	if !s.HasWindows() {
+		return timeline, nil
+	}
+	// Matches may return the same window more than once
+	seen := map[*SyncWindow]bool{}
+	for _, w := range *s {
+		if seen[w] {
+			continue
+		}
+		seen[w] = true
+		occurrences, err := w.Occurrences(from, to)
+		if err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, occurrences...)
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Start.Before(timeline[j].Start)
+	})
+	return timeline, nil
+}
+
+// Overlaps returns the time ranges during which both an allow and a deny window of the timeline are active. Syncs are
+// denied during these time ranges, as deny windows take precedence over allow windows.
+func (t SyncWindowTimeline) Overlaps() []SyncWindowOverlap {
+	var overlaps []SyncWindowOverlap
+	for i := range t {
+		for j := i + 1; j < len(t) && t[j].Start.Before(t[i].End); j++ {
+			allow, deny := t[i], t[j]
+			if allow.Window.Kind == "deny" {
+				allow, deny = deny, allow
+			}
+			if allow.Window.Kind != "allow" || deny.Window.Kind != "deny" {
+				continue
+			}
+			end := t[i].End
+			if t[j].End.Before(end) {
+				end = t[j].End
+			}
+			overlaps = append(overlaps, SyncWindowOverlap{Allow: allow.Window, Deny: deny.Window, Start: t[j].Start, End: end})
+		}
+	}
+	return overlaps
+}
+
+// NextSyncTime returns the first time within [from, to) at which the sync windows allow a sync. isManual indicates
+// whether the sync would be triggered manually. The second return value is false if syncs are denied during the whole
+// time range.
+func (s *SyncWindows) NextSyncTime(from time.Time, to time.Time, isManual bool) (time.Time, bool, error) {
+	if !s.HasWindows() {
+		return from, true, nil
+	}
+	timeline, err := s.Timeline(from, to)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	// The state of the windows only changes when a window becomes active or inactive
+	candidates := []time.Time{from}
+	for _, o := range timeline {
+		for _, c := range []time.Time{o.Start, o.End} {
+			if c.After(from) && c.Before(to) {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+	for _, c := range candidates {
+		var active, inactiveAllows SyncWindows
+		for _, o := range timeline {
+			if !o.Start.After(c) && o.End.After(c) {
+				active = append(active, o.Window)
+			}
+		}
+		for _, w := range *s {
+			if w.Kind == "allow" && !slices.Contains(active, w) {
+				inactiveAllows = append(inactiveAllows, w)
+			}
+		}
+		if canSync(&active, &inactiveAllows, isManual) {
+			return c.UTC(), true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string) error {
 	if len(s) == 0 && len(d) == 0 && len(a) == 0 && len(n) == 0 && len(c) == 0 {
@@ -2802,6 +3395,12 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// IsHydrateRequested returns whether a hydration of the manifests has been requested for an application.
+func (app *Application) IsHydrateRequested() bool {
+	_, ok := app.GetAnnotations()[AnnotationKeyHydrate]
+	return ok
+}
+
 func (app *Application) HasPostDeleteFinalizer(stage ...string) bool {
 	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/")) > -1
 }
@@ -11,9 +11,16 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	argocdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
@@ -2649,6 +2656,100 @@ func TestSyncWindow_Active(t *testing.T) {
 	}
 }
 
+func TestSyncWindow_Occurrences(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+
+	t.Run("Daily", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 22 * * *", Duration: "4h"}
+		occurrences, err := window.Occurrences(from, to)
+		require.NoError(t, err)
+		require.Len(t, occurrences, 3)
+		assert.Equal(t, time.Date(2024, 2, 29, 22, 0, 0, 0, time.UTC), occurrences[0].Start)
+		assert.Equal(t, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC), occurrences[0].End)
+		assert.Equal(t, time.Date(2024, 3, 2, 22, 0, 0, 0, time.UTC), occurrences[2].Start)
+		assert.Same(t, window, occurrences[0].Window)
+	})
+	t.Run("TimeZone", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 10 * * *", Duration: "1h", TimeZone: "Asia/Tokyo"}
+		occurrences, err := window.Occurrences(from, to)
+		require.NoError(t, err)
+		require.Len(t, occurrences, 2)
+		assert.Equal(t, time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC), occurrences[0].Start)
+	})
+	t.Run("Merged", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "* 10 * * *", Duration: "30m"}
+		occurrences, err := window.Occurrences(from, from.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, occurrences, 1)
+		assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), occurrences[0].Start)
+		assert.Equal(t, time.Date(2024, 3, 1, 11, 29, 0, 0, time.UTC), occurrences[0].End)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := (&SyncWindow{Schedule: "invalid", Duration: "1h"}).Occurrences(from, to)
+		require.Error(t, err)
+		_, err = (&SyncWindow{Schedule: "* * * * *", Duration: "1h", TimeZone: "Invalid/Zone"}).Occurrences(from, to)
+		require.Error(t, err)
+	})
+}
+
+func TestSyncWindows_Timeline(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	allow := &SyncWindow{Kind: "allow", Schedule: "0 8 * * *", Duration: "10h"}
+	deny := &SyncWindow{Kind: "deny", Schedule: "0 12 * * *", Duration: "2h"}
+	windows := SyncWindows{deny, allow, allow}
+
+	timeline, err := windows.Timeline(from, from.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Same(t, allow, timeline[0].Window)
+	assert.Same(t, deny, timeline[1].Window)
+
+	overlaps := timeline.Overlaps()
+	require.Len(t, overlaps, 1)
+	assert.Same(t, allow, overlaps[0].Allow)
+	assert.Same(t, deny, overlaps[0].Deny)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), overlaps[0].Start)
+	assert.Equal(t, time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC), overlaps[0].End)
+}
+
+func TestSyncWindows_NextSyncTime(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	allow := &SyncWindow{Kind: "allow", Schedule: "0 8 * * *", Duration: "10h"}
+	deny := &SyncWindow{Kind: "deny", Schedule: "0 8 * * *", Duration: "2h", ManualSync: true}
+
+	t.Run("NoWindows", func(t *testing.T) {
+		next, ok, err := (&SyncWindows{}).NextSyncTime(from, to, false)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, from, next)
+	})
+	t.Run("Allow", func(t *testing.T) {
+		next, ok, err := (&SyncWindows{allow}).NextSyncTime(from, to, false)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC), next)
+	})
+	t.Run("AllowAndDeny", func(t *testing.T) {
+		next, ok, err := (&SyncWindows{allow, deny}).NextSyncTime(from, to, false)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), next)
+	})
+	t.Run("DenyManual", func(t *testing.T) {
+		next, ok, err := (&SyncWindows{deny}).NextSyncTime(from.Add(9*time.Hour), to, true)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, from.Add(9*time.Hour), next)
+	})
+	t.Run("NeverAllowed", func(t *testing.T) {
+		_, ok, err := (&SyncWindows{allow}).NextSyncTime(from, from.Add(time.Hour), false)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
@@ -2704,6 +2805,85 @@ func TestSyncWindow_Validate(t *testing.T) {
 	})
 }
 
+func TestSyncSchedule_Next(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	t.Run("UTC", func(t *testing.T) {
+		next, err := (&SyncSchedule{Cron: "0 2 * * *"}).Next(now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 2, 2, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("TimeZone", func(t *testing.T) {
+		next, err := (&SyncSchedule{Cron: "0 2 * * *", TimeZone: "Asia/Tokyo"}).Next(now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 1, 17, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("IncorrectCron", func(t *testing.T) {
+		require.Error(t, (&SyncSchedule{Cron: "* * *"}).Validate())
+	})
+	t.Run("IncorrectTimeZone", func(t *testing.T) {
+		require.Error(t, (&SyncSchedule{Cron: "0 2 * * *", TimeZone: "Nowhere/Nothing"}).Validate())
+	})
+}
+
+func TestSyncFreeze_IsActive(t *testing.T) {
+	now := time.Now()
+	var freeze *SyncFreeze
+	assert.False(t, freeze.isActive(now))
+	assert.True(t, (&SyncFreeze{Reason: "maintenance"}).isActive(now))
+	assert.True(t, (&SyncFreeze{ExpiresAt: &metav1.Time{Time: now.Add(time.Hour)}}).isActive(now))
+	assert.False(t, (&SyncFreeze{ExpiresAt: &metav1.Time{Time: now.Add(-time.Hour)}}).isActive(now))
+}
+
+// TestApplication_SyncScheduleRoundTrip makes sure the sync schedule and the maintenance freeze of an application are
+// neither dropped by the API nor pruned by the schema of the CRD
+func TestApplication_SyncScheduleRoundTrip(t *testing.T) {
+	now := metav1.Unix(1709294400, 0)
+	app := Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: application.ApplicationKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec: ApplicationSpec{
+			Destination: ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+			SyncPolicy: &SyncPolicy{
+				Schedule: &SyncSchedule{Cron: "0 2 * * *", TimeZone: "Europe/Berlin", Prune: true},
+				Freeze:   &SyncFreeze{Reason: "maintenance", ExpiresAt: &now, SetBy: "admin", SetAt: &now},
+			},
+		},
+		Status: ApplicationStatus{LastScheduledSync: &now},
+	}
+
+	t.Run("API", func(t *testing.T) {
+		data, err := app.Marshal()
+		require.NoError(t, err)
+		var decoded Application
+		require.NoError(t, decoded.Unmarshal(data))
+		assert.Equal(t, app.Spec.SyncPolicy, decoded.Spec.SyncPolicy)
+		assert.True(t, app.Status.LastScheduledSync.Equal(decoded.Status.LastScheduledSync))
+	})
+
+	t.Run("CRD", func(t *testing.T) {
+		data, err := os.ReadFile("../../../../manifests/crds/application-crd.yaml")
+		require.NoError(t, err)
+		var crd apiextensionsv1.CustomResourceDefinition
+		require.NoError(t, yaml.Unmarshal(data, &crd))
+		require.Len(t, crd.Spec.Versions, 1)
+		var props apiextensions.JSONSchemaProps
+		require.NoError(t, apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(crd.Spec.Versions[0].Schema.OpenAPIV3Schema, &props, nil))
+		structural, err := structuralschema.NewStructural(&props)
+		require.NoError(t, err)
+
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&app)
+		require.NoError(t, err)
+		pruned := pruning.PruneWithOptions(obj, structural, true, structuralschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+		assert.Empty(t, pruned)
+		data, err = json.Marshal(obj)
+		require.NoError(t, err)
+		var decoded Application
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, app.Spec.SyncPolicy, decoded.Spec.SyncPolicy)
+		assert.True(t, app.Status.LastScheduledSync.Equal(decoded.Status.LastScheduledSync))
+	})
+}
+
 func TestApplicationStatus_GetConditions(t *testing.T) {
 	status := ApplicationStatus{
 		Conditions: []ApplicationCondition{
@@ -3959,3 +4139,40 @@ func TestApplicationTree_Merge(t *testing.T) {
 		},
 	}, tree)
 }
+
+func TestApplicationDependencies_GetTimeout(t *testing.T) {
+	var deps *ApplicationDependencies
+	timeout, err := deps.GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDependencyTimeout, timeout)
+
+	timeout, err = (&ApplicationDependencies{Timeout: "5m"}).GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	_, err = (&ApplicationDependencies{Timeout: "five minutes"}).GetTimeout()
+	require.Error(t, err)
+}
+
+func TestSyncPolicyRollback_GetWindow(t *testing.T) {
+	var rollback *SyncPolicyRollback
+	window, err := rollback.GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRollbackWindow, window)
+	deadline, err := rollback.GetProgressDeadline()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRollbackProgressDeadline, deadline)
+
+	rollback = &SyncPolicyRollback{Window: "30m", ProgressDeadline: "15m"}
+	window, err = rollback.GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, window)
+	deadline, err = rollback.GetProgressDeadline()
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, deadline)
+
+	_, err = (&SyncPolicyRollback{Window: "thirty minutes"}).GetWindow()
+	require.Error(t, err)
+	_, err = (&SyncPolicyRollback{ProgressDeadline: "soon"}).GetProgressDeadline()
+	require.Error(t, err)
+}
@@ -161,6 +161,21 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.SSHSignatureKeys != nil {
+		in, out := &in.SSHSignatureKeys, &out.SSHSignatureKeys
+		*out = make([]SSHSignatureKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeylessSignatureIdentities != nil {
+		in, out := &in.KeylessSignatureIdentities, &out.KeylessSignatureIdentities
+		*out = make([]KeylessSignatureIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.HelmChartVerification != nil {
+		in, out := &in.HelmChartVerification, &out.HelmChartVerification
+		*out = new(HelmChartVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -250,6 +265,50 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependencies) DeepCopyInto(out *ApplicationDependencies) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependencies.
+func (in *ApplicationDependencies) DeepCopy() *ApplicationDependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -488,6 +547,21 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -609,6 +683,21 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -670,6 +759,22 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStatus) DeepCopyInto(out *ApplicationSetRolloutStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStatus.
+func (in *ApplicationSetRolloutStatus) DeepCopy() *ApplicationSetRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -685,6 +790,16 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	return
 }
 
@@ -801,6 +916,11 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ApplicationSetRolloutStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -952,6 +1072,21 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1329,6 +1464,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SourceHydrator != nil {
+		in, out := &in.SourceHydrator, &out.SourceHydrator
+		*out = new(SourceHydrator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(ApplicationDependencies)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1387,6 +1532,16 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = make([]ApplicationSourceType, len(*in))
 		copy(*out, *in)
 	}
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScheduledSync != nil {
+		in, out := &in.LastScheduledSync, &out.LastScheduledSync
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1480,6 +1635,28 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollbackStatus) DeepCopyInto(out *AutomatedRollbackStatus) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollbackStatus.
+func (in *AutomatedRollbackStatus) DeepCopy() *AutomatedRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1522,6 +1699,27 @@ func (in *BasicAuthBitbucketServer) DeepCopy() *BasicAuthBitbucketServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuthRegistry) DeepCopyInto(out *BasicAuthRegistry) {
+	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthRegistry.
+func (in *BasicAuthRegistry) DeepCopy() *BasicAuthRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuthRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BearerTokenBitbucketCloud) DeepCopyInto(out *BearerTokenBitbucketCloud) {
 	*out = *in
@@ -1543,6 +1741,27 @@ func (in *BearerTokenBitbucketCloud) DeepCopy() *BearerTokenBitbucketCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BearerTokenRegistry) DeepCopyInto(out *BearerTokenRegistry) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BearerTokenRegistry.
+func (in *BearerTokenRegistry) DeepCopy() *BearerTokenRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(BearerTokenRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDetails) DeepCopyInto(out *ChartDetails) {
 	*out = *in
@@ -1846,6 +2065,22 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrySource) DeepCopyInto(out *DrySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrySource.
+func (in *DrySource) DeepCopy() *DrySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DrySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 	*out = *in
@@ -2071,6 +2306,101 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGenerator) DeepCopyInto(out *HTTPGenerator) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPGeneratorHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Pagination != nil {
+		in, out := &in.Pagination, &out.Pagination
+		*out = new(HTTPGeneratorPagination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGenerator.
+func (in *HTTPGenerator) DeepCopy() *HTTPGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGeneratorHeader) DeepCopyInto(out *HTTPGeneratorHeader) {
+	*out = *in
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGeneratorHeader.
+func (in *HTTPGeneratorHeader) DeepCopy() *HTTPGeneratorHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGeneratorHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGeneratorPagination) DeepCopyInto(out *HTTPGeneratorPagination) {
+	*out = *in
+	if in.MaxPages != nil {
+		in, out := &in.MaxPages, &out.MaxPages
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGeneratorPagination.
+func (in *HTTPGeneratorPagination) DeepCopy() *HTTPGeneratorPagination {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGeneratorPagination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -2087,6 +2417,27 @@ func (in *HealthStatus) DeepCopy() *HealthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartVerification) DeepCopyInto(out *HelmChartVerification) {
+	*out = *in
+	if in.CosignPublicKeys != nil {
+		in, out := &in.CosignPublicKeys, &out.CosignPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartVerification.
+func (in *HelmChartVerification) DeepCopy() *HelmChartVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
 	*out = *in
@@ -2179,35 +2530,73 @@ func (in *HostResourceInfo) DeepCopy() *HostResourceInfo {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
-	{
-		in := &in
-		*out = make(IgnoreDifferences, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-		return
+func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
 	}
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreDifferences.
-func (in IgnoreDifferences) DeepCopy() IgnoreDifferences {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateOperation.
+func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(IgnoreDifferences)
+	out := new(HydrateOperation)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Info) DeepCopyInto(out *Info) {
+func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Info.
-func (in *Info) DeepCopy() *Info {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateTo.
+func (in *HydrateTo) DeepCopy() *HydrateTo {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
+	{
+		in := &in
+		*out = make(IgnoreDifferences, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreDifferences.
+func (in IgnoreDifferences) DeepCopy() IgnoreDifferences {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnoreDifferences)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Info) DeepCopyInto(out *Info) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Info.
+func (in *Info) DeepCopy() *Info {
 	if in == nil {
 		return nil
 	}
@@ -2285,6 +2674,22 @@ func (in *JsonnetVar) DeepCopy() *JsonnetVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessSignatureIdentity) DeepCopyInto(out *KeylessSignatureIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessSignatureIdentity.
+func (in *KeylessSignatureIdentity) DeepCopy() *KeylessSignatureIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessSignatureIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KnownTypeField) DeepCopyInto(out *KnownTypeField) {
 	*out = *in
@@ -2301,6 +2706,43 @@ func (in *KnownTypeField) DeepCopy() *KnownTypeField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesResourceGenerator) DeepCopyInto(out *KubernetesResourceGenerator) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourceGenerator.
+func (in *KubernetesResourceGenerator) DeepCopy() *KubernetesResourceGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesResourceGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeGvk) DeepCopyInto(out *KustomizeGvk) {
 	*out = *in
@@ -2995,6 +3437,11 @@ func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 		*out = new(PullRequestGeneratorAzureDevOps)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Feedback != nil {
+		in, out := &in.Feedback, &out.Feedback
+		*out = new(PullRequestGeneratorFeedback)
+		**out = **in
+	}
 	return
 }
 
@@ -3081,6 +3528,22 @@ func (in *PullRequestGeneratorBitbucketServer) DeepCopy() *PullRequestGeneratorB
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorFeedback) DeepCopyInto(out *PullRequestGeneratorFeedback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorFeedback.
+func (in *PullRequestGeneratorFeedback) DeepCopy() *PullRequestGeneratorFeedback {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorFeedback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGeneratorFilter) DeepCopyInto(out *PullRequestGeneratorFilter) {
 	*out = *in
@@ -3227,6 +3690,111 @@ func (in RefTargetRevisionMapping) DeepCopy() RefTargetRevisionMapping {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryGenerator) DeepCopyInto(out *RegistryGenerator) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]RegistryGeneratorRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]RegistryGeneratorFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryGenerator.
+func (in *RegistryGenerator) DeepCopy() *RegistryGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryGeneratorFilter) DeepCopyInto(out *RegistryGeneratorFilter) {
+	*out = *in
+	if in.TagMatch != nil {
+		in, out := &in.TagMatch, &out.TagMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.SemverConstraint != nil {
+		in, out := &in.SemverConstraint, &out.SemverConstraint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryGeneratorFilter.
+func (in *RegistryGeneratorFilter) DeepCopy() *RegistryGeneratorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryGeneratorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryGeneratorRepository) DeepCopyInto(out *RegistryGeneratorRepository) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuthRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(BearerTokenRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryGeneratorRepository.
+func (in *RegistryGeneratorRepository) DeepCopy() *RegistryGeneratorRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryGeneratorRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoCreds) DeepCopyInto(out *RepoCreds) {
 	*out = *in
@@ -3458,6 +4026,13 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
+	if in.FieldChanges != nil {
+		in, out := &in.FieldChanges, &out.FieldChanges
+		*out = make([]ResourceFieldChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3471,6 +4046,42 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFieldChange) DeepCopyInto(out *ResourceFieldChange) {
+	*out = *in
+	if in.ChangedAt != nil {
+		in, out := &in.ChangedAt, &out.ChangedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFieldChange.
+func (in *ResourceFieldChange) DeepCopy() *ResourceFieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthPlugin) DeepCopyInto(out *ResourceHealthPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthPlugin.
+func (in *ResourceHealthPlugin) DeepCopy() *ResourceHealthPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealthPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3605,6 +4216,11 @@ func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 		*out = make([]KnownTypeField, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthPlugin != nil {
+		in, out := &in.HealthPlugin, &out.HealthPlugin
+		*out = new(ResourceHealthPlugin)
+		**out = **in
+	}
 	return
 }
 
@@ -3762,6 +4378,11 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.ManifestsSnapshot != nil {
+		in, out := &in.ManifestsSnapshot, &out.ManifestsSnapshot
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4067,6 +4688,22 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSignatureKey) DeepCopyInto(out *SSHSignatureKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSignatureKey.
+func (in *SSHSignatureKey) DeepCopy() *SSHSignatureKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSignatureKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4099,6 +4736,117 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
+	*out = *in
+	out.DrySource = in.DrySource
+	out.SyncSource = in.SyncSource
+	if in.HydrateTo != nil {
+		in, out := &in.HydrateTo, &out.HydrateTo
+		*out = new(HydrateTo)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceHydrator.
+func (in *SourceHydrator) DeepCopy() *SourceHydrator {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceHydrator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
+	*out = *in
+	if in.LastSuccessfulOperation != nil {
+		in, out := &in.LastSuccessfulOperation, &out.LastSuccessfulOperation
+		*out = new(SuccessfulHydrateOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CurrentOperation != nil {
+		in, out := &in.CurrentOperation, &out.CurrentOperation
+		*out = new(HydrateOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceHydratorStatus.
+func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceHydratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
+	*out = *in
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuccessfulHydrateOperation.
+func (in *SuccessfulHydrateOperation) DeepCopy() *SuccessfulHydrateOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(SuccessfulHydrateOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncFreeze) DeepCopyInto(out *SyncFreeze) {
+	*out = *in
+	if in.SetAt != nil {
+		in, out := &in.SetAt, &out.SetAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncFreeze.
+func (in *SyncFreeze) DeepCopy() *SyncFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncGateStatus) DeepCopyInto(out *SyncGateStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.NextCheckAt != nil {
+		in, out := &in.NextCheckAt, &out.NextCheckAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncGateStatus.
+func (in *SyncGateStatus) DeepCopy() *SyncGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4200,6 +4948,13 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = make([]SyncGateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4256,6 +5011,21 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(SyncPolicyRollback)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(SyncSchedule)
+		**out = **in
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(SyncFreeze)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4285,6 +5055,54 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyRollback) DeepCopyInto(out *SyncPolicyRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyRollback.
+func (in *SyncPolicyRollback) DeepCopy() *SyncPolicyRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSchedule) DeepCopyInto(out *SyncSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSchedule.
+func (in *SyncSchedule) DeepCopy() *SyncSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSource) DeepCopyInto(out *SyncSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSource.
+func (in *SyncSource) DeepCopy() *SyncSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
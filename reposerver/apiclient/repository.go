@@ -1,5 +1,12 @@
 package apiclient
 
+import (
+	"slices"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/signature"
+)
+
 func (q *ManifestRequest) GetValuesFileSchemes() []string {
 	if q.HelmOptions == nil {
 		return nil
@@ -13,3 +20,34 @@ func (q *RepoServerAppDetailsQuery) GetValuesFileSchemes() []string {
 	}
 	return q.HelmOptions.ValuesFileSchemes
 }
+
+// Result returns the result of a successful verification of the signature
+func (s *CommitSignature) Result() *signature.Result {
+	return &signature.Result{Type: signature.Type(s.Type), Signer: s.Signer, Issuer: s.Issuer}
+}
+
+// NewHelmChartVerification returns the requirements of the given project for Helm charts, or nil if it has none
+func NewHelmChartVerification(proj *v1alpha1.AppProject) *HelmChartVerification {
+	verification := proj.Spec.HelmChartVerification
+	if verification == nil || (!verification.Provenance && len(verification.CosignPublicKeys) == 0) {
+		return nil
+	}
+	res := &HelmChartVerification{Provenance: verification.Provenance, CosignPublicKeys: verification.CosignPublicKeys}
+	if verification.Provenance {
+		for _, key := range proj.Spec.SignatureKeys {
+			res.ProvenanceKeys = append(res.ProvenanceKeys, key.KeyID)
+		}
+	}
+	return res
+}
+
+// IsSatisfiedBy returns true if a chart which has been verified against the given requirements meets these requirements
+func (v *HelmChartVerification) IsSatisfiedBy(verified *HelmChartVerification) bool {
+	if v == nil || (!v.Provenance && len(v.CosignPublicKeys) == 0) {
+		return true
+	}
+	return verified != nil &&
+		v.Provenance == verified.Provenance &&
+		slices.Equal(v.ProvenanceKeys, verified.ProvenanceKeys) &&
+		slices.Equal(v.CosignPublicKeys, verified.CosignPublicKeys)
+}
@@ -59,10 +59,14 @@ type ManifestRequest struct {
 	// This is used to surface "source not permitted" errors for Helm repositories
 	ProjectSourceRepos []string `protobuf:"bytes,24,rep,name=projectSourceRepos,proto3" json:"projectSourceRepos,omitempty"`
 	// This is used to surface "source not permitted" errors for Helm repositories
-	ProjectName          string   `protobuf:"bytes,25,opt,name=projectName,proto3" json:"projectName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ProjectName string `protobuf:"bytes,25,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	// ManifestGeneratePaths are the repository relative paths of the manifest-generate-paths annotation of the application
+	ManifestGeneratePaths []string `protobuf:"bytes,26,rep,name=manifestGeneratePaths,proto3" json:"manifestGeneratePaths,omitempty"`
+	// HelmChartVerification holds the requirements the Helm chart of the source must meet
+	HelmChartVerification *HelmChartVerification `protobuf:"bytes,27,opt,name=helmChartVerification,proto3" json:"helmChartVerification,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -252,6 +256,87 @@ func (m *ManifestRequest) GetProjectName() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetManifestGeneratePaths() []string {
+	if m != nil {
+		return m.ManifestGeneratePaths
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetHelmChartVerification() *HelmChartVerification {
+	if m != nil {
+		return m.HelmChartVerification
+	}
+	return nil
+}
+
+// HelmChartVerification holds the requirements a Helm chart must meet before manifests are generated from it
+type HelmChartVerification struct {
+	// Provenance requires the chart to have a provenance file signed by a GnuPG key of the keyring
+	Provenance bool `protobuf:"varint,1,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	// ProvenanceKeys are the IDs of the GnuPG keys which may sign provenance files, any key is accepted if empty
+	ProvenanceKeys []string `protobuf:"bytes,2,rep,name=provenanceKeys,proto3" json:"provenanceKeys,omitempty"`
+	// CosignPublicKeys are PEM encoded public keys, one of which must have signed charts from OCI registries
+	CosignPublicKeys     []string `protobuf:"bytes,3,rep,name=cosignPublicKeys,proto3" json:"cosignPublicKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelmChartVerification) Reset()         { *m = HelmChartVerification{} }
+func (m *HelmChartVerification) String() string { return proto.CompactTextString(m) }
+func (*HelmChartVerification) ProtoMessage()    {}
+func (*HelmChartVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{1}
+}
+func (m *HelmChartVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmChartVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelmChartVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HelmChartVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmChartVerification.Merge(m, src)
+}
+func (m *HelmChartVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmChartVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmChartVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmChartVerification proto.InternalMessageInfo
+
+func (m *HelmChartVerification) GetProvenance() bool {
+	if m != nil {
+		return m.Provenance
+	}
+	return false
+}
+
+func (m *HelmChartVerification) GetProvenanceKeys() []string {
+	if m != nil {
+		return m.ProvenanceKeys
+	}
+	return nil
+}
+
+func (m *HelmChartVerification) GetCosignPublicKeys() []string {
+	if m != nil {
+		return m.CosignPublicKeys
+	}
+	return nil
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -267,7 +352,7 @@ func (m *ManifestRequestWithFiles) Reset()         { *m = ManifestRequestWithFil
 func (m *ManifestRequestWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequestWithFiles) ProtoMessage()    {}
 func (*ManifestRequestWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{1}
+	return fileDescriptor_dd8723cfcc820480, []int{2}
 }
 func (m *ManifestRequestWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +452,7 @@ func (m *ManifestFileMetadata) Reset()         { *m = ManifestFileMetadata{} }
 func (m *ManifestFileMetadata) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileMetadata) ProtoMessage()    {}
 func (*ManifestFileMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{2}
+	return fileDescriptor_dd8723cfcc820480, []int{3}
 }
 func (m *ManifestFileMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +506,7 @@ func (m *ManifestFileChunk) Reset()         { *m = ManifestFileChunk{} }
 func (m *ManifestFileChunk) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileChunk) ProtoMessage()    {}
 func (*ManifestFileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{3}
+	return fileDescriptor_dd8723cfcc820480, []int{4}
 }
 func (m *ManifestFileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +554,7 @@ func (m *TestRepositoryRequest) Reset()         { *m = TestRepositoryRequest{} }
 func (m *TestRepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryRequest) ProtoMessage()    {}
 func (*TestRepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{4}
+	return fileDescriptor_dd8723cfcc820480, []int{5}
 }
 func (m *TestRepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +603,7 @@ func (m *TestRepositoryResponse) Reset()         { *m = TestRepositoryResponse{}
 func (m *TestRepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryResponse) ProtoMessage()    {}
 func (*TestRepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{5}
+	return fileDescriptor_dd8723cfcc820480, []int{6}
 }
 func (m *TestRepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +654,7 @@ func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{}
 func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionRequest) ProtoMessage()    {}
 func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{7}
 }
 func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +725,7 @@ func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse
 func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionResponse) ProtoMessage()    {}
 func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,17 +778,21 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Result of the verification of an SSH or X.509 signature of the revision (always nil for GnuPG signatures and Helm)
+	CommitSignature *CommitSignature `protobuf:"bytes,9,opt,name=commitSignature,proto3" json:"commitSignature,omitempty"`
+	// Requirements the Helm chart was verified against
+	HelmChartVerification *HelmChartVerification `protobuf:"bytes,10,opt,name=helmChartVerification,proto3" json:"helmChartVerification,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,6 +870,96 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetCommitSignature() *CommitSignature {
+	if m != nil {
+		return m.CommitSignature
+	}
+	return nil
+}
+
+func (m *ManifestResponse) GetHelmChartVerification() *HelmChartVerification {
+	if m != nil {
+		return m.HelmChartVerification
+	}
+	return nil
+}
+
+// CommitSignature is the result of the verification of an SSH or X.509 (e.g. keyless Sigstore) commit signature
+type CommitSignature struct {
+	// Type of the signature, either ssh or x509
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// SHA256 fingerprint of the SSH key, or subject of the X.509 certificate the revision was signed with
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// OIDC issuer which authenticated the signer of a keyless X.509 signature
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Error is set if the signature could not be verified
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitSignature) Reset()         { *m = CommitSignature{} }
+func (m *CommitSignature) String() string { return proto.CompactTextString(m) }
+func (*CommitSignature) ProtoMessage()    {}
+func (*CommitSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{10}
+}
+func (m *CommitSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSignature.Merge(m, src)
+}
+func (m *CommitSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitSignature proto.InternalMessageInfo
+
+func (m *CommitSignature) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CommitSignature) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *CommitSignature) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *CommitSignature) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -792,7 +971,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,7 +1020,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1077,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,7 +1139,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1007,7 +1186,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1234,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1292,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,7 +1414,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1494,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,7 +1560,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1629,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1527,7 +1706,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1753,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1634,7 +1813,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1738,7 +1917,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +1964,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,7 +2012,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2066,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1931,16 +2110,20 @@ type GitFilesRequest struct {
 	NewGitFileGlobbingEnabled bool                 `protobuf:"varint,5,opt,name=NewGitFileGlobbingEnabled,proto3" json:"NewGitFileGlobbingEnabled,omitempty"`
 	NoRevisionCache           bool                 `protobuf:"varint,6,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
 	VerifyCommit              bool                 `protobuf:"varint,7,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}             `json:"-"`
-	XXX_unrecognized          []byte               `json:"-"`
-	XXX_sizecache             int32                `json:"-"`
+	// SSH keys permitted to sign the revision when verifyCommit is set
+	SshSignatureKeys []*v1alpha1.SSHSignatureKey `protobuf:"bytes,8,rep,name=sshSignatureKeys,proto3" json:"sshSignatureKeys,omitempty"`
+	// Identities permitted to sign the revision with keyless Sigstore signatures when verifyCommit is set
+	KeylessSignatureIdentities []*v1alpha1.KeylessSignatureIdentity `protobuf:"bytes,9,rep,name=keylessSignatureIdentities,proto3" json:"keylessSignatureIdentities,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                             `json:"-"`
+	XXX_unrecognized           []byte                               `json:"-"`
+	XXX_sizecache              int32                                `json:"-"`
 }
 
 func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2018,6 +2201,20 @@ func (m *GitFilesRequest) GetVerifyCommit() bool {
 	return false
 }
 
+func (m *GitFilesRequest) GetSshSignatureKeys() []*v1alpha1.SSHSignatureKey {
+	if m != nil {
+		return m.SshSignatureKeys
+	}
+	return nil
+}
+
+func (m *GitFilesRequest) GetKeylessSignatureIdentities() []*v1alpha1.KeylessSignatureIdentity {
+	if m != nil {
+		return m.KeylessSignatureIdentities
+	}
+	return nil
+}
+
 type GitFilesResponse struct {
 	// Map consisting of path of the path to its contents in bytes
 	Map                  map[string][]byte `protobuf:"bytes,1,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -2030,7 +2227,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,21 +2264,25 @@ func (m *GitFilesResponse) GetMap() map[string][]byte {
 }
 
 type GitDirectoriesRequest struct {
-	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	SubmoduleEnabled     bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
-	Revision             string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoRevisionCache      bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	VerifyCommit         bool                 `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Repo             *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	SubmoduleEnabled bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
+	Revision         string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoRevisionCache  bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	VerifyCommit     bool                 `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
+	// SSH keys permitted to sign the revision when verifyCommit is set
+	SshSignatureKeys []*v1alpha1.SSHSignatureKey `protobuf:"bytes,6,rep,name=sshSignatureKeys,proto3" json:"sshSignatureKeys,omitempty"`
+	// Identities permitted to sign the revision with keyless Sigstore signatures when verifyCommit is set
+	KeylessSignatureIdentities []*v1alpha1.KeylessSignatureIdentity `protobuf:"bytes,7,rep,name=keylessSignatureIdentities,proto3" json:"keylessSignatureIdentities,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                             `json:"-"`
+	XXX_unrecognized           []byte                               `json:"-"`
+	XXX_sizecache              int32                                `json:"-"`
 }
 
 func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2145,6 +2346,20 @@ func (m *GitDirectoriesRequest) GetVerifyCommit() bool {
 	return false
 }
 
+func (m *GitDirectoriesRequest) GetSshSignatureKeys() []*v1alpha1.SSHSignatureKey {
+	if m != nil {
+		return m.SshSignatureKeys
+	}
+	return nil
+}
+
+func (m *GitDirectoriesRequest) GetKeylessSignatureIdentities() []*v1alpha1.KeylessSignatureIdentity {
+	if m != nil {
+		return m.KeylessSignatureIdentities
+	}
+	return nil
+}
+
 type GitDirectoriesResponse struct {
 	// A set of directory paths
 	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
@@ -2157,7 +2372,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2217,7 +2432,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2356,7 +2571,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2403,6 +2618,7 @@ func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*HelmChartVerification)(nil), "repository.HelmChartVerification")
 	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
 	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
 	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
@@ -2411,6 +2627,7 @@ func init() {
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*CommitSignature)(nil), "repository.CommitSignature")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2449,153 +2666,167 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2332 bytes of a gzipped FileDescriptorProto
+	// 2547 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
-	0x15, 0xd7, 0x7e, 0x6a, 0xf7, 0xad, 0x2c, 0xad, 0xda, 0xb6, 0x3c, 0xde, 0xd8, 0x2a, 0x65, 0xc0,
-	0x2e, 0xc7, 0x4e, 0x76, 0xcb, 0x72, 0x25, 0x06, 0x27, 0x84, 0x52, 0x14, 0x5b, 0x72, 0x6c, 0xd9,
-	0x62, 0xec, 0x84, 0x32, 0x18, 0xa8, 0xde, 0xd9, 0xde, 0xd9, 0xc9, 0xce, 0x47, 0x7b, 0xa6, 0x47,
-	0x61, 0x5d, 0xc5, 0x09, 0x8a, 0x0b, 0x77, 0x0e, 0x5c, 0xf9, 0x1b, 0x28, 0x8e, 0x1c, 0x28, 0x0a,
-	0x8e, 0x14, 0x17, 0xaa, 0xb8, 0x40, 0xf9, 0xc8, 0x5f, 0x41, 0x75, 0x4f, 0xcf, 0xe7, 0xce, 0xae,
-	0x15, 0xd6, 0x56, 0x20, 0x17, 0x69, 0xfa, 0x75, 0xf7, 0x7b, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0xf7,
-	0xba, 0x17, 0x2e, 0x7b, 0x84, 0xba, 0x3e, 0xf1, 0x8e, 0x88, 0xd7, 0x13, 0x9f, 0x26, 0x73, 0xbd,
-	0x49, 0xea, 0xb3, 0x4b, 0x3d, 0x97, 0xb9, 0x08, 0x12, 0x4a, 0xe7, 0xbe, 0x61, 0xb2, 0x51, 0xd0,
-	0xef, 0xea, 0xae, 0xdd, 0xc3, 0x9e, 0xe1, 0x52, 0xcf, 0xfd, 0x5c, 0x7c, 0xbc, 0xa3, 0x0f, 0x7a,
-	0x47, 0xdb, 0x3d, 0x3a, 0x36, 0x7a, 0x98, 0x9a, 0x7e, 0x0f, 0x53, 0x6a, 0x99, 0x3a, 0x66, 0xa6,
-	0xeb, 0xf4, 0x8e, 0xae, 0x63, 0x8b, 0x8e, 0xf0, 0xf5, 0x9e, 0x41, 0x1c, 0xe2, 0x61, 0x46, 0x06,
-	0x21, 0xe7, 0xce, 0x1b, 0x86, 0xeb, 0x1a, 0x16, 0xe9, 0x89, 0x56, 0x3f, 0x18, 0xf6, 0x88, 0x4d,
-	0x99, 0x14, 0xab, 0xfe, 0x7b, 0x05, 0xd6, 0x0e, 0xb0, 0x63, 0x0e, 0x89, 0xcf, 0x34, 0xf2, 0x2c,
-	0x20, 0x3e, 0x43, 0x4f, 0xa1, 0xca, 0x95, 0x51, 0x4a, 0x5b, 0xa5, 0x2b, 0xad, 0xed, 0xfd, 0x6e,
-	0xa2, 0x4d, 0x37, 0xd2, 0x46, 0x7c, 0xfc, 0x44, 0x1f, 0x74, 0x8f, 0xb6, 0xbb, 0x74, 0x6c, 0x74,
-	0xb9, 0x36, 0xdd, 0x94, 0x36, 0xdd, 0x48, 0x9b, 0xae, 0x16, 0x2f, 0x4b, 0x13, 0x5c, 0x51, 0x07,
-	0x1a, 0x1e, 0x39, 0x32, 0x7d, 0xd3, 0x75, 0x94, 0xf2, 0x56, 0xe9, 0x4a, 0x53, 0x8b, 0xdb, 0x48,
-	0x81, 0x65, 0xc7, 0xdd, 0xc5, 0xfa, 0x88, 0x28, 0x95, 0xad, 0xd2, 0x95, 0x86, 0x16, 0x35, 0xd1,
-	0x16, 0xb4, 0x30, 0xa5, 0xf7, 0x71, 0x9f, 0x58, 0xf7, 0xc8, 0x44, 0xa9, 0x8a, 0x89, 0x69, 0x12,
-	0x9f, 0x8b, 0x29, 0x7d, 0x80, 0x6d, 0xa2, 0xd4, 0x44, 0x6f, 0xd4, 0x44, 0x17, 0xa0, 0xe9, 0x60,
-	0x9b, 0xf8, 0x14, 0xeb, 0x44, 0x69, 0x88, 0xbe, 0x84, 0x80, 0x7e, 0x06, 0xeb, 0x29, 0xc5, 0x1f,
-	0xb9, 0x81, 0xa7, 0x13, 0x05, 0xc4, 0xd2, 0x1f, 0x2e, 0xb6, 0xf4, 0x9d, 0x3c, 0x5b, 0x6d, 0x5a,
-	0x12, 0xfa, 0x31, 0xd4, 0xc4, 0xce, 0x2b, 0xad, 0xad, 0xca, 0x2b, 0xb5, 0x76, 0xc8, 0x16, 0x39,
-	0xb0, 0x4c, 0xad, 0xc0, 0x30, 0x1d, 0x5f, 0x59, 0x11, 0x12, 0x1e, 0x2f, 0x26, 0x61, 0xd7, 0x75,
-	0x86, 0xa6, 0x71, 0x80, 0x1d, 0x6c, 0x10, 0x9b, 0x38, 0xec, 0x50, 0x30, 0xd7, 0x22, 0x21, 0xe8,
-	0x39, 0xb4, 0xc7, 0x81, 0xcf, 0x5c, 0xdb, 0x7c, 0x4e, 0x1e, 0x52, 0x3e, 0xd7, 0x57, 0x4e, 0x09,
-	0x6b, 0x3e, 0x58, 0x4c, 0xf0, 0xbd, 0x1c, 0x57, 0x6d, 0x4a, 0x0e, 0x77, 0x92, 0x71, 0xd0, 0x27,
-	0x9f, 0x11, 0x4f, 0x78, 0xd7, 0x6a, 0xe8, 0x24, 0x29, 0x52, 0xe8, 0x46, 0xa6, 0x6c, 0xf9, 0xca,
-	0xda, 0x56, 0x25, 0x74, 0xa3, 0x98, 0x84, 0xae, 0xc0, 0xda, 0x11, 0xf1, 0xcc, 0xe1, 0xe4, 0x91,
-	0x69, 0x38, 0x98, 0x05, 0x1e, 0x51, 0xda, 0xc2, 0x15, 0xf3, 0x64, 0x64, 0xc3, 0xa9, 0x11, 0xb1,
-	0x6c, 0x6e, 0xf2, 0x5d, 0x8f, 0x0c, 0x7c, 0x65, 0x5d, 0xd8, 0x77, 0x6f, 0xf1, 0x1d, 0x14, 0xec,
-	0xb4, 0x2c, 0x77, 0xae, 0x98, 0xe3, 0x6a, 0x32, 0x52, 0xc2, 0x18, 0x41, 0xa1, 0x62, 0x39, 0x32,
-	0xba, 0x0c, 0xab, 0xcc, 0xc3, 0xfa, 0xd8, 0x74, 0x8c, 0x03, 0xc2, 0x46, 0xee, 0x40, 0x39, 0x2d,
-	0x2c, 0x91, 0xa3, 0x22, 0x1d, 0x10, 0x71, 0x70, 0xdf, 0x22, 0x83, 0xd0, 0x17, 0x1f, 0x4f, 0x28,
-	0xf1, 0x95, 0x33, 0x62, 0x15, 0x37, 0xba, 0xa9, 0x0c, 0x95, 0x4b, 0x10, 0xdd, 0xdb, 0x53, 0xb3,
-	0x6e, 0x3b, 0xcc, 0x9b, 0x68, 0x05, 0xec, 0xd0, 0x18, 0x5a, 0x7c, 0x1d, 0x91, 0x2b, 0x9c, 0x15,
-	0xae, 0x70, 0x77, 0x31, 0x1b, 0xed, 0x27, 0x0c, 0xb5, 0x34, 0x77, 0xd4, 0x05, 0x34, 0xc2, 0xfe,
-	0x41, 0x60, 0x31, 0x93, 0x5a, 0x24, 0x54, 0xc3, 0x57, 0x36, 0x84, 0x99, 0x0a, 0x7a, 0xd0, 0x3d,
-	0x00, 0x8f, 0x0c, 0xa3, 0x71, 0xe7, 0xc4, 0xca, 0xaf, 0xcd, 0x5b, 0xb9, 0x16, 0x8f, 0x0e, 0x57,
-	0x9c, 0x9a, 0xce, 0x85, 0xf3, 0x65, 0x10, 0x9d, 0xc9, 0x68, 0x17, 0x61, 0xad, 0x08, 0x17, 0x2b,
-	0xe8, 0xe1, 0xbe, 0x28, 0xa9, 0x22, 0x69, 0x9d, 0x0f, 0xbd, 0x35, 0x45, 0xea, 0xdc, 0x86, 0x73,
-	0x33, 0x4c, 0x8d, 0xda, 0x50, 0x19, 0x93, 0x89, 0x48, 0xd1, 0x4d, 0x8d, 0x7f, 0xa2, 0x33, 0x50,
-	0x3b, 0xc2, 0x56, 0x40, 0x44, 0x52, 0x6d, 0x68, 0x61, 0xe3, 0x56, 0xf9, 0x5b, 0xa5, 0xce, 0x2f,
-	0x4b, 0xb0, 0x96, 0x53, 0xbc, 0x60, 0xfe, 0x8f, 0xd2, 0xf3, 0x5f, 0x81, 0x1b, 0x0f, 0x1f, 0x63,
-	0xcf, 0x20, 0x2c, 0xa5, 0x88, 0xfa, 0xb7, 0x12, 0x28, 0x39, 0x8b, 0x7e, 0xdf, 0x64, 0xa3, 0x3b,
-	0xa6, 0x45, 0x7c, 0x74, 0x13, 0x96, 0xbd, 0x90, 0x26, 0x0f, 0x9e, 0x37, 0xe6, 0x6c, 0xc4, 0xfe,
-	0x92, 0x16, 0x8d, 0x46, 0x1f, 0x42, 0xc3, 0x26, 0x0c, 0x0f, 0x30, 0xc3, 0x52, 0xf7, 0xad, 0xa2,
-	0x99, 0x5c, 0xca, 0x81, 0x1c, 0xb7, 0xbf, 0xa4, 0xc5, 0x73, 0xd0, 0xbb, 0x50, 0xd3, 0x47, 0x81,
-	0x33, 0x16, 0x47, 0x4e, 0x6b, 0xfb, 0xe2, 0xac, 0xc9, 0xbb, 0x7c, 0xd0, 0xfe, 0x92, 0x16, 0x8e,
-	0xfe, 0xa8, 0x0e, 0x55, 0x8a, 0x3d, 0xa6, 0xde, 0x81, 0x33, 0x45, 0x22, 0xf8, 0x39, 0xa7, 0x8f,
-	0x88, 0x3e, 0xf6, 0x03, 0x5b, 0x9a, 0x39, 0x6e, 0x23, 0x04, 0x55, 0xdf, 0x7c, 0x1e, 0x9a, 0xba,
-	0xa2, 0x89, 0x6f, 0xf5, 0x2d, 0x58, 0x9f, 0x92, 0xc6, 0x37, 0x35, 0xd4, 0x8d, 0x73, 0x58, 0x91,
-	0xa2, 0xd5, 0x00, 0xce, 0x3e, 0x16, 0xb6, 0x88, 0x93, 0xfd, 0x49, 0x9c, 0xdc, 0xea, 0x3e, 0x6c,
-	0xe4, 0xc5, 0xfa, 0xd4, 0x75, 0x7c, 0xc2, 0x5d, 0x5f, 0x64, 0x47, 0x93, 0x0c, 0x92, 0x5e, 0xa1,
-	0x45, 0x43, 0x2b, 0xe8, 0x51, 0x7f, 0x5b, 0x86, 0x0d, 0x8d, 0xf8, 0xae, 0x75, 0x44, 0xa2, 0xd4,
-	0x75, 0x32, 0xe0, 0xe3, 0x87, 0x50, 0xc1, 0x94, 0x4a, 0x37, 0xb9, 0xfb, 0xca, 0x8e, 0x77, 0x8d,
-	0x73, 0x45, 0x6f, 0xc3, 0x3a, 0xb6, 0xfb, 0xa6, 0x11, 0xb8, 0x81, 0x1f, 0x2d, 0x4b, 0x38, 0x55,
-	0x53, 0x9b, 0xee, 0xe0, 0xe1, 0xef, 0x8b, 0x88, 0xbc, 0xeb, 0x0c, 0xc8, 0x4f, 0x05, 0xa2, 0xa9,
-	0x68, 0x69, 0x92, 0xaa, 0xc3, 0xb9, 0x29, 0x23, 0x49, 0x83, 0xa7, 0x41, 0x54, 0x29, 0x07, 0xa2,
-	0x0a, 0xd5, 0x28, 0xcf, 0x50, 0x43, 0x7d, 0x51, 0x82, 0x76, 0x12, 0x5c, 0x92, 0xfd, 0x05, 0x68,
-	0xda, 0x92, 0xe6, 0x2b, 0x25, 0x91, 0xc1, 0x12, 0x42, 0x16, 0x4f, 0x95, 0xf3, 0x78, 0x6a, 0x03,
-	0xea, 0x21, 0xdc, 0x95, 0x4b, 0x97, 0xad, 0x8c, 0xca, 0xd5, 0x9c, 0xca, 0x9b, 0x00, 0x7e, 0x9c,
-	0xe1, 0x94, 0xba, 0xe8, 0x4d, 0x51, 0x90, 0x0a, 0x2b, 0xe1, 0xe9, 0xab, 0x11, 0x3f, 0xb0, 0x98,
-	0xb2, 0x2c, 0x46, 0x64, 0x68, 0x22, 0xde, 0x5c, 0xdb, 0xc6, 0xce, 0xc0, 0x57, 0x1a, 0x42, 0xe5,
-	0xb8, 0xad, 0xba, 0xb0, 0x76, 0xdf, 0xe4, 0xeb, 0x1b, 0xfa, 0x27, 0x13, 0x2a, 0xef, 0x41, 0x95,
-	0x0b, 0xe3, 0x4a, 0xf5, 0x3d, 0xec, 0xe8, 0x23, 0x12, 0xd9, 0x31, 0x6e, 0xf3, 0x24, 0xc0, 0xb0,
-	0xe1, 0x2b, 0x65, 0x41, 0x17, 0xdf, 0xea, 0xef, 0xcb, 0xa1, 0xa6, 0x3b, 0x94, 0xfa, 0x5f, 0x3d,
-	0x1c, 0x2f, 0x06, 0x08, 0x95, 0x69, 0x80, 0x90, 0x53, 0xf9, 0xcb, 0x00, 0x84, 0x57, 0x74, 0xc8,
-	0xa9, 0x01, 0x2c, 0xef, 0x50, 0xca, 0x15, 0x41, 0xd7, 0xa1, 0x8a, 0x29, 0x0d, 0x0d, 0x9e, 0xcb,
-	0xe7, 0x72, 0x08, 0xff, 0x2f, 0x55, 0x12, 0x43, 0x3b, 0x37, 0xa1, 0x19, 0x93, 0x5e, 0x26, 0xb6,
-	0x99, 0x16, 0xbb, 0x05, 0x10, 0x22, 0xe0, 0xbb, 0xce, 0xd0, 0xe5, 0x5b, 0xca, 0x03, 0x41, 0x4e,
-	0x15, 0xdf, 0xea, 0xad, 0x68, 0x84, 0xd0, 0xed, 0x6d, 0xa8, 0x99, 0x8c, 0xd8, 0x91, 0x72, 0x1b,
-	0x69, 0xe5, 0x12, 0x46, 0x5a, 0x38, 0x48, 0xfd, 0x73, 0x03, 0xce, 0xf3, 0x1d, 0x7b, 0x24, 0x42,
-	0x68, 0x87, 0xd2, 0x8f, 0x09, 0xc3, 0xa6, 0xe5, 0x7f, 0x2f, 0x20, 0xde, 0xe4, 0x35, 0x3b, 0x86,
-	0x01, 0xf5, 0x30, 0x02, 0x65, 0xb6, 0x7c, 0xe5, 0xc5, 0x90, 0x64, 0x9f, 0x54, 0x40, 0x95, 0xd7,
-	0x53, 0x01, 0x15, 0x55, 0x24, 0xd5, 0x13, 0xaa, 0x48, 0x66, 0x17, 0xa5, 0xa9, 0x52, 0xb7, 0x9e,
-	0x2d, 0x75, 0x0b, 0x80, 0xfe, 0xf2, 0x71, 0x81, 0x7e, 0xa3, 0x10, 0xe8, 0xdb, 0x85, 0x71, 0xdc,
-	0x14, 0xe6, 0xfe, 0x4e, 0xda, 0x03, 0x67, 0xfa, 0xda, 0x22, 0x90, 0x1f, 0x5e, 0x2b, 0xe4, 0xff,
-	0x34, 0x03, 0xe1, 0xc3, 0x22, 0xfa, 0xdd, 0xe3, 0xad, 0x69, 0x0e, 0x98, 0xff, 0xda, 0x41, 0xef,
-	0x5f, 0x08, 0xc4, 0x45, 0xdd, 0xc4, 0x06, 0xf1, 0x61, 0xcf, 0xcf, 0x21, 0x7e, 0xec, 0xca, 0xa4,
-	0xc5, 0xbf, 0xd1, 0x35, 0xa8, 0x72, 0x23, 0x4b, 0x48, 0x7c, 0x2e, 0x6d, 0x4f, 0xbe, 0x13, 0x3b,
-	0x94, 0x3e, 0xa2, 0x44, 0xd7, 0xc4, 0x20, 0x74, 0x0b, 0x9a, 0xb1, 0xe3, 0xcb, 0xc8, 0xba, 0x90,
-	0x9e, 0x11, 0xc7, 0x49, 0x34, 0x2d, 0x19, 0xce, 0xe7, 0x0e, 0x4c, 0x8f, 0xe8, 0x02, 0x30, 0xd6,
-	0xa6, 0xe7, 0x7e, 0x1c, 0x75, 0xc6, 0x73, 0xe3, 0xe1, 0xe8, 0x3a, 0xd4, 0xc3, 0x5b, 0x07, 0x11,
-	0x41, 0xad, 0xed, 0xf3, 0xd3, 0xc9, 0x34, 0x9a, 0x25, 0x07, 0xaa, 0x7f, 0x2a, 0xc1, 0x9b, 0x89,
-	0x43, 0x44, 0xd1, 0x14, 0x61, 0xf6, 0xaf, 0xfe, 0xc4, 0xbd, 0x0c, 0xab, 0xa2, 0x48, 0x48, 0x2e,
-	0x1f, 0xc2, 0x7b, 0xb0, 0x1c, 0x55, 0xfd, 0x5d, 0x09, 0x2e, 0x4d, 0xaf, 0x63, 0x77, 0x84, 0x3d,
-	0x16, 0x6f, 0xef, 0x49, 0xac, 0x25, 0x3a, 0xf0, 0xca, 0xc9, 0x81, 0x97, 0x59, 0x5f, 0x25, 0xbb,
-	0x3e, 0xf5, 0x0f, 0x65, 0x68, 0xa5, 0x1c, 0xa8, 0xe8, 0xc0, 0xe4, 0x60, 0x50, 0xf8, 0xad, 0x28,
-	0x0b, 0xc5, 0xa1, 0xd0, 0xd4, 0x52, 0x14, 0x34, 0x06, 0xa0, 0xd8, 0xc3, 0x36, 0x61, 0xc4, 0xe3,
-	0x99, 0x9c, 0x47, 0xfc, 0xbd, 0xc5, 0xb3, 0xcb, 0x61, 0xc4, 0x53, 0x4b, 0xb1, 0xe7, 0x68, 0x56,
-	0x88, 0xf6, 0x65, 0xfe, 0x96, 0x2d, 0xf4, 0x05, 0xac, 0x0e, 0x4d, 0x8b, 0x1c, 0x26, 0x8a, 0xd4,
-	0x85, 0x22, 0x0f, 0x17, 0x57, 0xe4, 0x4e, 0x9a, 0xaf, 0x96, 0x13, 0xa3, 0x5e, 0x85, 0x76, 0x3e,
-	0x9e, 0xb8, 0x92, 0xa6, 0x8d, 0x8d, 0xd8, 0x5a, 0xb2, 0xa5, 0x22, 0x68, 0xe7, 0xe3, 0x47, 0xfd,
-	0x67, 0x19, 0xce, 0xc6, 0xec, 0x76, 0x1c, 0xc7, 0x0d, 0x1c, 0x5d, 0x5c, 0xe4, 0x15, 0xee, 0xc5,
-	0x19, 0xa8, 0x31, 0x93, 0x59, 0x31, 0xf0, 0x11, 0x0d, 0x7e, 0x76, 0x31, 0xd7, 0xb5, 0x98, 0x49,
-	0xe5, 0x06, 0x47, 0xcd, 0x70, 0xef, 0x9f, 0x05, 0xa6, 0x47, 0x06, 0x22, 0x13, 0x34, 0xb4, 0xb8,
-	0xcd, 0xfb, 0x38, 0xaa, 0x11, 0x10, 0x3f, 0x34, 0x66, 0xdc, 0x16, 0x7e, 0xef, 0x5a, 0x16, 0xd1,
-	0xb9, 0x39, 0x52, 0x45, 0x40, 0x8e, 0x2a, 0x8a, 0x0b, 0xe6, 0x99, 0x8e, 0x21, 0x4b, 0x00, 0xd9,
-	0xe2, 0x7a, 0x62, 0xcf, 0xc3, 0x13, 0x89, 0xfc, 0xc3, 0x06, 0xfa, 0x00, 0x2a, 0x36, 0xa6, 0xf2,
-	0xa0, 0xbb, 0x9a, 0xc9, 0x0e, 0x45, 0x16, 0xe8, 0x1e, 0x60, 0x1a, 0x9e, 0x04, 0x7c, 0x5a, 0xe7,
-	0x3d, 0x68, 0x44, 0x84, 0x2f, 0x05, 0x09, 0x3f, 0x87, 0x53, 0x99, 0xe4, 0x83, 0x9e, 0xc0, 0x46,
-	0xe2, 0x51, 0x69, 0x81, 0x12, 0x04, 0xbe, 0xf9, 0x52, 0xcd, 0xb4, 0x19, 0x0c, 0xd4, 0x67, 0xb0,
-	0xce, 0x5d, 0x46, 0x04, 0xfe, 0x09, 0x95, 0x36, 0xef, 0x43, 0x33, 0x16, 0x59, 0xe8, 0x33, 0x1d,
-	0x68, 0x1c, 0x45, 0x17, 0xac, 0x61, 0x6d, 0x13, 0xb7, 0xd5, 0x1d, 0x40, 0x69, 0x7d, 0xe5, 0x09,
-	0x74, 0x2d, 0x0b, 0x8a, 0xcf, 0xe6, 0x8f, 0x1b, 0x31, 0x3c, 0xc2, 0xc4, 0x7f, 0x2f, 0xc3, 0xda,
-	0x9e, 0x29, 0xee, 0x48, 0x4e, 0x28, 0xc9, 0x5d, 0x85, 0xb6, 0x1f, 0xf4, 0x6d, 0x77, 0x10, 0x58,
-	0x44, 0x82, 0x02, 0x79, 0xd2, 0x4f, 0xd1, 0xe7, 0x25, 0x3f, 0x6e, 0x2c, 0x8a, 0xd9, 0x48, 0x56,
-	0xbf, 0xe2, 0x1b, 0x7d, 0x00, 0xe7, 0x1f, 0x90, 0x2f, 0xe4, 0x7a, 0xf6, 0x2c, 0xb7, 0xdf, 0x37,
-	0x1d, 0x23, 0x12, 0x52, 0x13, 0x42, 0x66, 0x0f, 0x28, 0x82, 0x8a, 0xf5, 0x62, 0xa8, 0x18, 0x57,
-	0xd0, 0xbb, 0xae, 0x6d, 0x9b, 0x4c, 0x22, 0xca, 0x0c, 0x4d, 0xfd, 0x79, 0x09, 0xda, 0x89, 0x65,
-	0xe5, 0xde, 0xdc, 0x0c, 0x63, 0x28, 0xdc, 0x99, 0x4b, 0xe9, 0x9d, 0xc9, 0x0f, 0xfd, 0xef, 0xc3,
-	0x67, 0x25, 0x1d, 0x3e, 0xbf, 0x2a, 0xc3, 0xd9, 0x3d, 0x93, 0x45, 0x89, 0xcb, 0xfc, 0x7f, 0xdb,
-	0xe5, 0x82, 0x3d, 0xa9, 0x1e, 0x6f, 0x4f, 0x6a, 0x05, 0x7b, 0xd2, 0x85, 0x8d, 0xbc, 0x31, 0xe4,
-	0xc6, 0x9c, 0x81, 0x1a, 0xf7, 0xa0, 0xe8, 0x5e, 0x21, 0x6c, 0xa8, 0xff, 0xa8, 0xc3, 0xc5, 0x4f,
-	0xe9, 0x00, 0xb3, 0xf8, 0xce, 0xe8, 0x8e, 0xeb, 0x1d, 0xf2, 0xae, 0x93, 0xb1, 0x62, 0xee, 0x9d,
-	0xae, 0x3c, 0xf7, 0x9d, 0xae, 0x32, 0xe7, 0x9d, 0xae, 0x7a, 0xac, 0x77, 0xba, 0xda, 0x89, 0xbd,
-	0xd3, 0x4d, 0xd7, 0x5a, 0xf5, 0xc2, 0x5a, 0xeb, 0x49, 0xa6, 0x1e, 0x59, 0x16, 0x61, 0xf3, 0xed,
-	0x74, 0xd8, 0xcc, 0xdd, 0x9d, 0xb9, 0x0f, 0x0c, 0xb9, 0xe7, 0xad, 0xc6, 0x4b, 0x9f, 0xb7, 0x9a,
-	0xd3, 0xcf, 0x5b, 0xc5, 0x2f, 0x24, 0x30, 0xf3, 0x85, 0xe4, 0x32, 0xac, 0xfa, 0x13, 0x47, 0x27,
-	0x83, 0xf8, 0x26, 0xb1, 0x15, 0x2e, 0x3b, 0x4b, 0xcd, 0x44, 0xc4, 0x4a, 0x2e, 0x22, 0x62, 0x4f,
-	0x3d, 0x95, 0xf2, 0xd4, 0xa2, 0x38, 0x59, 0x2d, 0x8c, 0x93, 0xff, 0x9d, 0x22, 0xea, 0x33, 0xd8,
-	0x9c, 0xb5, 0x7b, 0x32, 0x28, 0x15, 0x58, 0xd6, 0x47, 0xd8, 0x31, 0xc4, 0x75, 0x9f, 0xa8, 0xea,
-	0x65, 0x73, 0x1e, 0xea, 0xdf, 0xfe, 0x23, 0xc0, 0x7a, 0x82, 0xe6, 0xf9, 0x5f, 0x53, 0x27, 0xe8,
-	0x21, 0xb4, 0xf7, 0xe4, 0x53, 0x7e, 0x74, 0x41, 0x8b, 0xe6, 0xbd, 0x89, 0x74, 0x2e, 0x14, 0x77,
-	0x86, 0xaa, 0xa9, 0x4b, 0x48, 0x87, 0xf3, 0x79, 0x86, 0xc9, 0xf3, 0xcb, 0x37, 0xe7, 0x70, 0x8e,
-	0x47, 0xbd, 0x4c, 0xc4, 0x95, 0x12, 0x7a, 0x02, 0xab, 0xd9, 0x47, 0x02, 0x94, 0x81, 0x37, 0x85,
-	0xef, 0x16, 0x1d, 0x75, 0xde, 0x90, 0x58, 0xff, 0xa7, 0xdc, 0x0d, 0x32, 0xf7, 0xe1, 0x48, 0xcd,
-	0x56, 0xfa, 0x45, 0x2f, 0x0a, 0x9d, 0x6f, 0xcc, 0x1d, 0x13, 0x73, 0x7f, 0x1f, 0x1a, 0xd1, 0x1d,
-	0x71, 0xd6, 0xcc, 0xb9, 0x9b, 0xe3, 0x4e, 0x3b, 0xcb, 0x6f, 0xe8, 0xab, 0x4b, 0xe8, 0xc3, 0x70,
-	0xf2, 0x0e, 0xa5, 0x05, 0x93, 0x53, 0x37, 0xa3, 0x9d, 0xd3, 0x05, 0xb7, 0x91, 0xea, 0x12, 0xfa,
-	0x2e, 0xb4, 0xf8, 0xd7, 0xa1, 0x7c, 0x44, 0xdf, 0xe8, 0x86, 0xbf, 0xd9, 0xe8, 0x46, 0xbf, 0xd9,
-	0xe8, 0xde, 0xb6, 0x29, 0x9b, 0x74, 0x0a, 0xae, 0x0b, 0x25, 0x83, 0xa7, 0x70, 0x6a, 0x8f, 0xb0,
-	0xa4, 0xba, 0x47, 0x97, 0x8e, 0x75, 0x07, 0xd2, 0x51, 0xf3, 0xc3, 0xa6, 0x2f, 0x08, 0xd4, 0x25,
-	0xf4, 0xeb, 0x12, 0x9c, 0xde, 0x23, 0x2c, 0x5f, 0x2f, 0xa3, 0x77, 0x8a, 0x85, 0xcc, 0xa8, 0xab,
-	0x3b, 0x0f, 0x16, 0x8d, 0xc9, 0x2c, 0x5b, 0x75, 0x09, 0xfd, 0xa6, 0x04, 0xe7, 0x52, 0x8a, 0xa5,
-	0x0b, 0x60, 0x74, 0x7d, 0xbe, 0x72, 0x05, 0xc5, 0x72, 0xe7, 0x93, 0x05, 0x7f, 0x1b, 0x91, 0x62,
-	0xa9, 0x2e, 0xa1, 0x43, 0xb1, 0x27, 0x09, 0xde, 0x45, 0x17, 0x0b, 0x81, 0x6d, 0x2c, 0x7d, 0x73,
-	0x56, 0x77, 0xbc, 0x0f, 0x9f, 0x40, 0x6b, 0x8f, 0xb0, 0x08, 0x78, 0x65, 0x3d, 0x2d, 0x87, 0x89,
-	0xb3, 0xa1, 0x9a, 0xc7, 0x6a, 0xc2, 0x63, 0xd6, 0x43, 0x5e, 0x29, 0x70, 0x91, 0x8d, 0xd5, 0x42,
-	0x14, 0x96, 0xf5, 0x98, 0x62, 0x6c, 0xa2, 0x2e, 0xa1, 0x67, 0xb0, 0x51, 0x9c, 0x2a, 0xd1, 0x5b,
-	0xc7, 0x3e, 0x0c, 0x3b, 0x57, 0x8f, 0x33, 0x34, 0x12, 0xf9, 0xd1, 0xce, 0x5f, 0x5e, 0x6c, 0x96,
-	0xfe, 0xfa, 0x62, 0xb3, 0xf4, 0xaf, 0x17, 0x9b, 0xa5, 0x1f, 0xdc, 0x78, 0xc9, 0x6f, 0xa8, 0x52,
-	0x3f, 0xcb, 0xc2, 0xd4, 0xd4, 0x2d, 0x93, 0x38, 0xac, 0x5f, 0x17, 0xf1, 0x76, 0xe3, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xe6, 0x4d, 0x67, 0x16, 0xb5, 0x25, 0x00, 0x00,
+	0x15, 0xd7, 0x6a, 0x3f, 0xb4, 0xfb, 0x64, 0x49, 0xab, 0x8e, 0x25, 0x8f, 0x37, 0xb6, 0x4a, 0x1e,
+	0xb0, 0xcb, 0xb1, 0x93, 0x55, 0x59, 0x26, 0x31, 0x38, 0x21, 0x94, 0xa2, 0xd8, 0x92, 0x63, 0xcb,
+	0x16, 0x23, 0xc7, 0x29, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xcd, 0x4e, 0x76, 0x76, 0xa6, 0x3d, 0xd3,
+	0xa3, 0xb0, 0xae, 0xe2, 0x02, 0x14, 0x17, 0xee, 0x1c, 0xb8, 0x70, 0xe0, 0x6f, 0xa0, 0x38, 0x72,
+	0xa0, 0x28, 0x38, 0x52, 0x1c, 0xb9, 0x40, 0xf9, 0xca, 0x5f, 0xc0, 0x8d, 0xea, 0x8f, 0xf9, 0xdc,
+	0xd9, 0xb5, 0xc2, 0xda, 0x72, 0xe0, 0x22, 0x4d, 0xbf, 0xee, 0x7e, 0xef, 0xf5, 0xeb, 0x5f, 0xbf,
+	0x7e, 0xef, 0xf5, 0xc2, 0x25, 0x9f, 0x50, 0x2f, 0x20, 0xfe, 0x11, 0xf1, 0x37, 0xc4, 0xa7, 0xcd,
+	0x3c, 0x7f, 0x98, 0xfa, 0x6c, 0x53, 0xdf, 0x63, 0x1e, 0x82, 0x84, 0xd2, 0xba, 0x67, 0xd9, 0xac,
+	0x17, 0x76, 0xda, 0xa6, 0x37, 0xd8, 0xc0, 0xbe, 0xe5, 0x51, 0xdf, 0xfb, 0x5c, 0x7c, 0xbc, 0x63,
+	0x76, 0x37, 0x8e, 0x36, 0x37, 0x68, 0xdf, 0xda, 0xc0, 0xd4, 0x0e, 0x36, 0x30, 0xa5, 0x8e, 0x6d,
+	0x62, 0x66, 0x7b, 0xee, 0xc6, 0xd1, 0x35, 0xec, 0xd0, 0x1e, 0xbe, 0xb6, 0x61, 0x11, 0x97, 0xf8,
+	0x98, 0x91, 0xae, 0xe4, 0xdc, 0x7a, 0xd3, 0xf2, 0x3c, 0xcb, 0x21, 0x1b, 0xa2, 0xd5, 0x09, 0x0f,
+	0x37, 0xc8, 0x80, 0x32, 0x25, 0x56, 0xff, 0xf7, 0x02, 0x2c, 0xed, 0x61, 0xd7, 0x3e, 0x24, 0x01,
+	0x33, 0xc8, 0xd3, 0x90, 0x04, 0x0c, 0x3d, 0x81, 0x0a, 0x57, 0x46, 0x2b, 0xad, 0x97, 0x2e, 0xcf,
+	0x6f, 0xee, 0xb6, 0x13, 0x6d, 0xda, 0x91, 0x36, 0xe2, 0xe3, 0x47, 0x66, 0xb7, 0x7d, 0xb4, 0xd9,
+	0xa6, 0x7d, 0xab, 0xcd, 0xb5, 0x69, 0xa7, 0xb4, 0x69, 0x47, 0xda, 0xb4, 0x8d, 0x78, 0x59, 0x86,
+	0xe0, 0x8a, 0x5a, 0x50, 0xf7, 0xc9, 0x91, 0x1d, 0xd8, 0x9e, 0xab, 0xcd, 0xae, 0x97, 0x2e, 0x37,
+	0x8c, 0xb8, 0x8d, 0x34, 0x98, 0x73, 0xbd, 0x6d, 0x6c, 0xf6, 0x88, 0x56, 0x5e, 0x2f, 0x5d, 0xae,
+	0x1b, 0x51, 0x13, 0xad, 0xc3, 0x3c, 0xa6, 0xf4, 0x1e, 0xee, 0x10, 0xe7, 0x2e, 0x19, 0x6a, 0x15,
+	0x31, 0x31, 0x4d, 0xe2, 0x73, 0x31, 0xa5, 0xf7, 0xf1, 0x80, 0x68, 0x55, 0xd1, 0x1b, 0x35, 0xd1,
+	0x39, 0x68, 0xb8, 0x78, 0x40, 0x02, 0x8a, 0x4d, 0xa2, 0xd5, 0x45, 0x5f, 0x42, 0x40, 0x3f, 0x81,
+	0xe5, 0x94, 0xe2, 0x07, 0x5e, 0xe8, 0x9b, 0x44, 0x03, 0xb1, 0xf4, 0x07, 0xd3, 0x2d, 0x7d, 0x2b,
+	0xcf, 0xd6, 0x18, 0x95, 0x84, 0x7e, 0x08, 0x55, 0xb1, 0xf3, 0xda, 0xfc, 0x7a, 0xf9, 0xa5, 0x5a,
+	0x5b, 0xb2, 0x45, 0x2e, 0xcc, 0x51, 0x27, 0xb4, 0x6c, 0x37, 0xd0, 0x4e, 0x09, 0x09, 0x0f, 0xa7,
+	0x93, 0xb0, 0xed, 0xb9, 0x87, 0xb6, 0xb5, 0x87, 0x5d, 0x6c, 0x91, 0x01, 0x71, 0xd9, 0xbe, 0x60,
+	0x6e, 0x44, 0x42, 0xd0, 0x33, 0x68, 0xf6, 0xc3, 0x80, 0x79, 0x03, 0xfb, 0x19, 0x79, 0x40, 0xf9,
+	0xdc, 0x40, 0x5b, 0x10, 0xd6, 0xbc, 0x3f, 0x9d, 0xe0, 0xbb, 0x39, 0xae, 0xc6, 0x88, 0x1c, 0x0e,
+	0x92, 0x7e, 0xd8, 0x21, 0x8f, 0x88, 0x2f, 0xd0, 0xb5, 0x28, 0x41, 0x92, 0x22, 0x49, 0x18, 0xd9,
+	0xaa, 0x15, 0x68, 0x4b, 0xeb, 0x65, 0x09, 0xa3, 0x98, 0x84, 0x2e, 0xc3, 0xd2, 0x11, 0xf1, 0xed,
+	0xc3, 0xe1, 0x81, 0x6d, 0xb9, 0x98, 0x85, 0x3e, 0xd1, 0x9a, 0x02, 0x8a, 0x79, 0x32, 0x1a, 0xc0,
+	0x42, 0x8f, 0x38, 0x03, 0x6e, 0xf2, 0x6d, 0x9f, 0x74, 0x03, 0x6d, 0x59, 0xd8, 0x77, 0x67, 0xfa,
+	0x1d, 0x14, 0xec, 0x8c, 0x2c, 0x77, 0xae, 0x98, 0xeb, 0x19, 0xea, 0xa4, 0xc8, 0x33, 0x82, 0xa4,
+	0x62, 0x39, 0x32, 0xba, 0x04, 0x8b, 0xcc, 0xc7, 0x66, 0xdf, 0x76, 0xad, 0x3d, 0xc2, 0x7a, 0x5e,
+	0x57, 0x7b, 0x43, 0x58, 0x22, 0x47, 0x45, 0x26, 0x20, 0xe2, 0xe2, 0x8e, 0x43, 0xba, 0x12, 0x8b,
+	0x0f, 0x87, 0x94, 0x04, 0xda, 0x69, 0xb1, 0x8a, 0xeb, 0xed, 0x94, 0x87, 0xca, 0x39, 0x88, 0xf6,
+	0xad, 0x91, 0x59, 0xb7, 0x5c, 0xe6, 0x0f, 0x8d, 0x02, 0x76, 0xa8, 0x0f, 0xf3, 0x7c, 0x1d, 0x11,
+	0x14, 0x56, 0x04, 0x14, 0xee, 0x4c, 0x67, 0xa3, 0xdd, 0x84, 0xa1, 0x91, 0xe6, 0x8e, 0xda, 0x80,
+	0x7a, 0x38, 0xd8, 0x0b, 0x1d, 0x66, 0x53, 0x87, 0x48, 0x35, 0x02, 0x6d, 0x55, 0x98, 0xa9, 0xa0,
+	0x07, 0xdd, 0x05, 0xf0, 0xc9, 0x61, 0x34, 0xee, 0x8c, 0x58, 0xf9, 0xd5, 0x49, 0x2b, 0x37, 0xe2,
+	0xd1, 0x72, 0xc5, 0xa9, 0xe9, 0x5c, 0x38, 0x5f, 0x06, 0x31, 0x99, 0x3a, 0xed, 0xe2, 0x58, 0x6b,
+	0x02, 0x62, 0x05, 0x3d, 0x1c, 0x8b, 0x8a, 0x2a, 0x9c, 0xd6, 0x59, 0x89, 0xd6, 0x14, 0x09, 0x7d,
+	0x03, 0x56, 0x06, 0x4a, 0x81, 0x1d, 0xe5, 0xd4, 0xf7, 0x31, 0xeb, 0x05, 0x5a, 0x4b, 0x30, 0x2d,
+	0xee, 0x44, 0x9f, 0xc1, 0x0a, 0xb7, 0xc9, 0x76, 0x0f, 0xfb, 0xec, 0x11, 0xc7, 0xac, 0xb2, 0x9f,
+	0xf6, 0xa6, 0xb0, 0xfd, 0x85, 0xf4, 0xfa, 0x76, 0x8b, 0x06, 0x1a, 0xc5, 0xf3, 0x5b, 0xb7, 0xe0,
+	0xcc, 0x98, 0x9d, 0x47, 0x4d, 0x28, 0xf7, 0xc9, 0x50, 0xdc, 0x18, 0x0d, 0x83, 0x7f, 0xa2, 0xd3,
+	0x50, 0x3d, 0xc2, 0x4e, 0x48, 0x84, 0x8f, 0xaf, 0x1b, 0xb2, 0x71, 0x73, 0xf6, 0x9b, 0xa5, 0xd6,
+	0x2f, 0x4a, 0xb0, 0x94, 0xb3, 0x63, 0xc1, 0xfc, 0x1f, 0xa4, 0xe7, 0xbf, 0x84, 0x53, 0x75, 0xf8,
+	0x10, 0xfb, 0x16, 0x61, 0x29, 0x45, 0xf4, 0x5f, 0x96, 0x60, 0xa5, 0xd0, 0x00, 0x68, 0x0d, 0x80,
+	0xfa, 0xde, 0x11, 0x71, 0xb1, 0x6b, 0x12, 0xa1, 0x55, 0xdd, 0x48, 0x51, 0xf8, 0x09, 0x4b, 0x5a,
+	0x77, 0xc9, 0x30, 0xd0, 0x66, 0xc5, 0x8e, 0xe4, 0xa8, 0xe8, 0x0a, 0x34, 0x4d, 0x2f, 0xb0, 0x2d,
+	0x77, 0x3f, 0xec, 0x38, 0xb6, 0x29, 0x46, 0x96, 0xc5, 0xc8, 0x11, 0xba, 0xfe, 0xb7, 0x12, 0x68,
+	0x39, 0xb8, 0x7d, 0x66, 0xb3, 0xde, 0x6d, 0xdb, 0x21, 0x01, 0xba, 0x01, 0x73, 0xbe, 0xa4, 0xa9,
+	0x5b, 0xf9, 0xcd, 0x09, 0x28, 0xdd, 0x9d, 0x31, 0xa2, 0xd1, 0xe8, 0x43, 0xa8, 0x0f, 0x08, 0xc3,
+	0x5d, 0xcc, 0xb0, 0xb2, 0xe4, 0x7a, 0xd1, 0x4c, 0x2e, 0x65, 0x4f, 0x8d, 0xdb, 0x9d, 0x31, 0xe2,
+	0x39, 0xe8, 0x5d, 0xa8, 0x9a, 0xbd, 0xd0, 0xed, 0x8b, 0xfb, 0x78, 0x7e, 0xf3, 0xfc, 0xb8, 0xc9,
+	0xdb, 0x7c, 0xd0, 0xee, 0x8c, 0x21, 0x47, 0x7f, 0x54, 0x83, 0x0a, 0xc5, 0x3e, 0xd3, 0x6f, 0xc3,
+	0xe9, 0x22, 0x11, 0x3c, 0x08, 0x30, 0x7b, 0xc4, 0xec, 0x07, 0xe1, 0x40, 0x6d, 0x7a, 0xdc, 0x46,
+	0x08, 0x2a, 0x81, 0xfd, 0x4c, 0x6e, 0x7c, 0xd9, 0x10, 0xdf, 0xfa, 0x5b, 0xb0, 0x3c, 0x22, 0x8d,
+	0x43, 0x4c, 0xea, 0xc6, 0x39, 0x9c, 0x52, 0xa2, 0xf5, 0x10, 0x56, 0x1e, 0x0a, 0x5b, 0xc4, 0x37,
+	0xe1, 0x49, 0x84, 0x35, 0xfa, 0x2e, 0xac, 0xe6, 0xc5, 0x06, 0xd4, 0x73, 0x03, 0xc2, 0xfd, 0x82,
+	0xb8, 0x3a, 0x6c, 0xd2, 0x4d, 0x7a, 0x15, 0xa8, 0x0a, 0x7a, 0xf4, 0xdf, 0xce, 0xc2, 0xaa, 0x41,
+	0x02, 0xcf, 0x39, 0x22, 0x91, 0x5f, 0x3f, 0x99, 0xc8, 0xec, 0xfb, 0x50, 0xc6, 0x94, 0x2a, 0x98,
+	0xdc, 0x79, 0x69, 0xb1, 0x8f, 0xc1, 0xb9, 0xa2, 0xb7, 0x61, 0x19, 0x0f, 0x3a, 0xb6, 0x15, 0x7a,
+	0x61, 0x10, 0x2d, 0x4b, 0x80, 0xaa, 0x61, 0x8c, 0x76, 0x70, 0xdf, 0x18, 0x08, 0xff, 0x70, 0xc7,
+	0xed, 0x92, 0x1f, 0x8b, 0x70, 0xaf, 0x6c, 0xa4, 0x49, 0xba, 0x09, 0x67, 0x46, 0x8c, 0xa4, 0x0c,
+	0x9e, 0x8e, 0x30, 0x4b, 0xb9, 0x08, 0xb3, 0x50, 0x8d, 0xd9, 0x31, 0x6a, 0xe8, 0x3f, 0x2d, 0x43,
+	0x33, 0x39, 0x5c, 0x8a, 0xfd, 0x39, 0x68, 0x44, 0x8e, 0x37, 0xd0, 0x4a, 0xe2, 0x34, 0x27, 0x84,
+	0x6c, 0xb0, 0x39, 0x9b, 0x0f, 0x36, 0x57, 0xa1, 0x26, 0x73, 0x01, 0xb5, 0x74, 0xd5, 0xca, 0xa8,
+	0x5c, 0xc9, 0xa9, 0xbc, 0x06, 0x10, 0xc4, 0xfe, 0x56, 0xab, 0x89, 0xde, 0x14, 0x05, 0xe9, 0x70,
+	0x4a, 0x86, 0x26, 0x06, 0x09, 0x42, 0x87, 0x69, 0x73, 0x62, 0x44, 0x86, 0x26, 0xce, 0x9b, 0x37,
+	0x18, 0x60, 0xb7, 0x1b, 0x68, 0x75, 0xa1, 0x72, 0xdc, 0x46, 0xb7, 0x60, 0x89, 0x7f, 0xdb, 0x2c,
+	0x89, 0x78, 0x1a, 0xa3, 0x3e, 0x66, 0x3b, 0x3b, 0xc4, 0xc8, 0xcf, 0x19, 0x7f, 0xed, 0xc0, 0x74,
+	0xd7, 0x8e, 0xde, 0x87, 0xa5, 0x9c, 0x70, 0xee, 0x22, 0x18, 0x37, 0x86, 0xdc, 0x5d, 0xf1, 0x2d,
+	0x4c, 0x6b, 0x5b, 0x2e, 0xf1, 0x95, 0xd5, 0x55, 0x8b, 0xd3, 0xed, 0x20, 0x08, 0x13, 0x93, 0xcb,
+	0x16, 0xf7, 0x1e, 0xc4, 0xf7, 0x3d, 0x5f, 0xd9, 0x5b, 0x36, 0x74, 0x0f, 0x96, 0xee, 0xd9, 0x7c,
+	0xb3, 0x0f, 0x83, 0x93, 0xf1, 0x1b, 0xef, 0x41, 0x85, 0x0b, 0xe3, 0x3b, 0xd4, 0xf1, 0xb1, 0x6b,
+	0xf6, 0x48, 0x04, 0xaa, 0xb8, 0x2d, 0x96, 0x8b, 0xad, 0xe8, 0x92, 0x11, 0xdf, 0xfa, 0xef, 0x67,
+	0xa5, 0xa6, 0x5b, 0x94, 0x06, 0xaf, 0x3f, 0x71, 0x2b, 0x0e, 0x25, 0xcb, 0xa3, 0xa1, 0x64, 0x4e,
+	0xe5, 0x2f, 0x13, 0x4a, 0xbe, 0xa4, 0xf8, 0x43, 0x0f, 0x61, 0x6e, 0x8b, 0x52, 0xae, 0x08, 0xba,
+	0x06, 0x15, 0x4c, 0xa9, 0x34, 0x78, 0xee, 0x72, 0x53, 0x43, 0xf8, 0x7f, 0xa5, 0x92, 0x18, 0xda,
+	0xba, 0x01, 0x8d, 0x98, 0xf4, 0x22, 0xb1, 0x8d, 0xb4, 0xd8, 0x75, 0x00, 0x99, 0x2b, 0xdd, 0x71,
+	0x0f, 0x3d, 0xbe, 0xa5, 0xdc, 0x2b, 0x44, 0x08, 0xe6, 0xdf, 0xfa, 0xcd, 0x68, 0x84, 0xd0, 0xed,
+	0x6d, 0xa8, 0xda, 0x8c, 0x0c, 0x22, 0xe5, 0x56, 0xd3, 0xca, 0x25, 0x8c, 0x0c, 0x39, 0x48, 0xff,
+	0x73, 0x1d, 0xce, 0xf2, 0x1d, 0x3b, 0x10, 0xfe, 0x64, 0x8b, 0xd2, 0x8f, 0x09, 0xc3, 0xb6, 0x13,
+	0x7c, 0x37, 0x24, 0xfe, 0xf0, 0x15, 0x03, 0xc3, 0x82, 0x9a, 0x74, 0x47, 0xea, 0xea, 0x78, 0xe9,
+	0x69, 0xb3, 0x62, 0x9f, 0xe4, 0xca, 0xe5, 0x57, 0x93, 0x2b, 0x17, 0xe5, 0xae, 0x95, 0x13, 0xca,
+	0x5d, 0xc7, 0x97, 0x2f, 0x52, 0x45, 0x91, 0x5a, 0xb6, 0x28, 0x52, 0x90, 0x12, 0xce, 0x1d, 0x37,
+	0x25, 0xac, 0x17, 0xa6, 0x84, 0x83, 0xc2, 0x73, 0xdc, 0x10, 0xe6, 0xfe, 0x76, 0x1a, 0x81, 0x63,
+	0xb1, 0x36, 0x4d, 0x72, 0x08, 0xaf, 0x34, 0x39, 0xfc, 0x34, 0x93, 0xec, 0xc9, 0x72, 0xcb, 0xbb,
+	0xc7, 0x5b, 0xd3, 0x84, 0xb4, 0xef, 0xff, 0x2e, 0x2b, 0xfa, 0xb9, 0x08, 0x3f, 0xa9, 0x97, 0xd8,
+	0x20, 0x8e, 0x7c, 0x8a, 0xae, 0xdd, 0xab, 0x50, 0xe1, 0x46, 0x56, 0xf9, 0xc1, 0x99, 0xfc, 0x2d,
+	0xbf, 0x45, 0xe9, 0x01, 0x25, 0xa6, 0x21, 0x06, 0xa1, 0x9b, 0xd0, 0x88, 0x81, 0xaf, 0x4e, 0xd6,
+	0xb9, 0xf4, 0x8c, 0xf8, 0x9c, 0x44, 0xd3, 0x92, 0xe1, 0x7c, 0x6e, 0xd7, 0xf6, 0x89, 0x29, 0xa2,
+	0xe7, 0xea, 0xe8, 0xdc, 0x8f, 0xa3, 0xce, 0x78, 0x6e, 0x3c, 0x1c, 0x5d, 0x83, 0x9a, 0xac, 0x4f,
+	0x89, 0x13, 0x34, 0xbf, 0x79, 0x76, 0xd4, 0x99, 0x46, 0xb3, 0xd4, 0x40, 0xfd, 0x4f, 0x25, 0xb8,
+	0x90, 0x00, 0x22, 0x3a, 0x4d, 0x51, 0x02, 0xf3, 0xfa, 0x6f, 0xdc, 0x4b, 0xb0, 0x28, 0x32, 0xa6,
+	0x24, 0x68, 0x93, 0x15, 0xd3, 0x1c, 0x55, 0xff, 0x5d, 0x09, 0x2e, 0x8e, 0xae, 0x43, 0x44, 0x59,
+	0xf1, 0xf6, 0x9e, 0xc4, 0x5a, 0xa2, 0x0b, 0x6f, 0x36, 0xb9, 0xf0, 0x32, 0xeb, 0x2b, 0x67, 0xd7,
+	0xa7, 0xff, 0x61, 0x16, 0xe6, 0x53, 0x00, 0x2a, 0xba, 0x30, 0x79, 0x64, 0x2c, 0x70, 0x2b, 0x72,
+	0x64, 0x95, 0x58, 0xa7, 0x28, 0xa8, 0x0f, 0x40, 0xb1, 0x8f, 0x07, 0x84, 0x11, 0x9f, 0x7b, 0x72,
+	0x7e, 0xe2, 0xef, 0x4e, 0xef, 0x5d, 0xf6, 0x23, 0x9e, 0x46, 0x8a, 0x3d, 0x8f, 0x33, 0x85, 0xe8,
+	0x40, 0xf9, 0x6f, 0xd5, 0x42, 0x5f, 0xc0, 0xe2, 0xa1, 0xed, 0x90, 0xfd, 0x44, 0x91, 0x9a, 0x50,
+	0xe4, 0xc1, 0xf4, 0x8a, 0xdc, 0x4e, 0xf3, 0x35, 0x72, 0x62, 0xf4, 0x2b, 0xd0, 0xcc, 0x9f, 0x27,
+	0x11, 0x0c, 0x0f, 0xb0, 0x15, 0x5b, 0x4b, 0xb5, 0x74, 0x04, 0xcd, 0xfc, 0xf9, 0xd1, 0xff, 0x31,
+	0x0b, 0x2b, 0x31, 0xbb, 0x2d, 0xd7, 0xf5, 0x42, 0xd7, 0x14, 0x25, 0xdf, 0xc2, 0xbd, 0x38, 0x0d,
+	0x55, 0x66, 0x33, 0x27, 0x0e, 0x7c, 0x44, 0x83, 0xdf, 0x5d, 0xcc, 0xf3, 0x1c, 0x66, 0x53, 0xb5,
+	0xc1, 0x51, 0x53, 0xee, 0xfd, 0xd3, 0xd0, 0xf6, 0x49, 0x57, 0x78, 0x82, 0xba, 0x11, 0xb7, 0x79,
+	0x1f, 0x8f, 0x6a, 0x44, 0xbe, 0x23, 0x8d, 0x19, 0xb7, 0x05, 0xee, 0x3d, 0xc7, 0x21, 0x26, 0x37,
+	0x47, 0x2a, 0x23, 0xca, 0x51, 0x45, 0x3a, 0xc0, 0x7c, 0xdb, 0xb5, 0x54, 0x3e, 0xa4, 0x5a, 0x5c,
+	0x4f, 0xec, 0xfb, 0x78, 0xa8, 0xd2, 0x20, 0xd9, 0x40, 0x1f, 0x40, 0x79, 0x80, 0xa9, 0xba, 0xe8,
+	0xae, 0x64, 0xbc, 0x43, 0x91, 0x05, 0xda, 0x7b, 0x98, 0xca, 0x9b, 0x80, 0x4f, 0x6b, 0xbd, 0x07,
+	0xf5, 0x88, 0xf0, 0xa5, 0x42, 0xc2, 0xcf, 0x61, 0x21, 0xe3, 0x7c, 0xd0, 0x63, 0x58, 0x4d, 0x10,
+	0x95, 0x16, 0xa8, 0x82, 0xc0, 0x0b, 0x2f, 0xd4, 0xcc, 0x18, 0xc3, 0x40, 0x7f, 0x0a, 0xcb, 0x71,
+	0xd6, 0x75, 0x42, 0xa9, 0xcd, 0xfb, 0xd0, 0x88, 0x45, 0x16, 0x62, 0xa6, 0x05, 0xf5, 0xa3, 0xa8,
+	0x14, 0x2f, 0x73, 0x9b, 0xb8, 0xad, 0x6f, 0x01, 0x4a, 0xeb, 0xab, 0x6e, 0xa0, 0xab, 0xd9, 0xa0,
+	0x78, 0xa5, 0x30, 0xa9, 0x8c, 0x62, 0xe2, 0x7f, 0x55, 0x60, 0x69, 0xc7, 0x16, 0x05, 0xa3, 0x13,
+	0x72, 0x72, 0x57, 0xa0, 0x19, 0x84, 0x9d, 0x81, 0xd7, 0x0d, 0x1d, 0xa2, 0x82, 0x02, 0x75, 0xd3,
+	0x8f, 0xd0, 0x27, 0x39, 0x3f, 0x6e, 0x2c, 0x8a, 0x59, 0x4f, 0xa5, 0xa6, 0xe2, 0x1b, 0x7d, 0x00,
+	0x67, 0xef, 0x93, 0x2f, 0xd4, 0x7a, 0x76, 0x1c, 0xaf, 0xd3, 0xb1, 0x5d, 0x2b, 0x12, 0x52, 0x15,
+	0x42, 0xc6, 0x0f, 0x28, 0x0a, 0x15, 0x6b, 0xc5, 0xa1, 0x62, 0x5c, 0x4e, 0x90, 0x49, 0xb7, 0x8a,
+	0x28, 0x33, 0x34, 0x34, 0x84, 0x66, 0x10, 0xf4, 0xe2, 0x4b, 0x46, 0xd4, 0x35, 0xeb, 0x62, 0x47,
+	0xf6, 0xa6, 0xb3, 0xe8, 0xc1, 0xc1, 0x6e, 0x9a, 0xab, 0x31, 0x22, 0x06, 0xfd, 0xaa, 0x04, 0xad,
+	0x3e, 0x19, 0x3a, 0x24, 0x08, 0xe2, 0x8e, 0x3b, 0x5d, 0xe2, 0x32, 0x9b, 0xd9, 0x71, 0xa8, 0xfa,
+	0x68, 0xca, 0x70, 0xbd, 0x98, 0xff, 0xd0, 0x98, 0x20, 0x59, 0xff, 0x59, 0x09, 0x9a, 0x09, 0xda,
+	0x14, 0x5e, 0x6f, 0x48, 0xbf, 0x22, 0xd1, 0x7a, 0x31, 0x8d, 0xd6, 0xfc, 0xd0, 0xff, 0xde, 0xa5,
+	0x9c, 0x4a, 0xbb, 0x94, 0xdf, 0x54, 0x60, 0x65, 0xc7, 0x66, 0x91, 0x33, 0xb7, 0xff, 0xd7, 0x90,
+	0x5f, 0x80, 0xd3, 0xca, 0xf1, 0x70, 0x5a, 0x3d, 0x26, 0x4e, 0x6b, 0x5f, 0x09, 0x9c, 0xce, 0xbd,
+	0x36, 0x9c, 0xb6, 0x61, 0x35, 0x0f, 0x10, 0x05, 0xd6, 0xd3, 0x50, 0xa5, 0xe2, 0x79, 0x49, 0xd6,
+	0x9f, 0x64, 0x43, 0xff, 0x7b, 0x0d, 0xce, 0x7f, 0x4a, 0xbb, 0x98, 0xc5, 0x85, 0xd6, 0xdb, 0x9e,
+	0x2f, 0x5e, 0x9a, 0x4e, 0x06, 0x59, 0xb9, 0x97, 0xff, 0xd9, 0x89, 0x2f, 0xff, 0xe5, 0x09, 0x2f,
+	0xff, 0x95, 0x63, 0xbd, 0xfc, 0x57, 0x4f, 0xec, 0xe5, 0x7f, 0x34, 0x27, 0xaf, 0x15, 0xe6, 0xe4,
+	0x8f, 0x33, 0x79, 0xab, 0x04, 0xce, 0xb7, 0xd2, 0xae, 0x64, 0xe2, 0xee, 0x4c, 0x7c, 0xb2, 0xcc,
+	0x3d, 0x98, 0xd7, 0x5f, 0xf8, 0x60, 0xde, 0x18, 0x7d, 0x30, 0x2f, 0x7e, 0x73, 0x85, 0xb1, 0x6f,
+	0xae, 0x97, 0x60, 0x31, 0x18, 0xba, 0x26, 0xe9, 0xc6, 0xe5, 0xf7, 0x79, 0xb9, 0xec, 0x2c, 0x35,
+	0xe3, 0x25, 0x4e, 0xe5, 0xbc, 0x44, 0x8c, 0xd4, 0x85, 0x14, 0x52, 0x8b, 0x7c, 0xc7, 0x62, 0xa1,
+	0xef, 0xf8, 0xea, 0x24, 0xdb, 0x8f, 0x60, 0x6d, 0xdc, 0xee, 0xa9, 0x43, 0xa9, 0xc1, 0x9c, 0xd9,
+	0xc3, 0xae, 0x25, 0xca, 0xc2, 0xa2, 0xfa, 0xa3, 0x9a, 0x93, 0xb2, 0xc3, 0xcd, 0x3f, 0x02, 0x2c,
+	0x27, 0x59, 0x1f, 0xff, 0x6b, 0x9b, 0x04, 0x3d, 0x80, 0x66, 0xf4, 0x54, 0x1c, 0xbd, 0x6a, 0xa0,
+	0x49, 0x0f, 0x89, 0xad, 0x73, 0xc5, 0x9d, 0x52, 0x35, 0x7d, 0x06, 0x99, 0x70, 0x36, 0xcf, 0x30,
+	0x79, 0xb3, 0xfc, 0xfa, 0x04, 0xce, 0xf1, 0xa8, 0x17, 0x89, 0xb8, 0x5c, 0x42, 0x8f, 0x61, 0x31,
+	0xfb, 0xb2, 0x86, 0x32, 0x61, 0x70, 0xe1, 0x63, 0x5f, 0x4b, 0x9f, 0x34, 0x24, 0xd6, 0xff, 0x09,
+	0x87, 0x41, 0xe6, 0x11, 0x09, 0xe9, 0xd9, 0x8a, 0x50, 0xd1, 0x33, 0x5c, 0xeb, 0x6b, 0x13, 0xc7,
+	0xc4, 0xdc, 0xdf, 0x87, 0x7a, 0xf4, 0x96, 0x90, 0x35, 0x73, 0xee, 0x85, 0xa1, 0xd5, 0xcc, 0xf2,
+	0x3b, 0x0c, 0xf4, 0x19, 0xf4, 0xa1, 0x9c, 0xbc, 0x45, 0x69, 0xc1, 0xe4, 0x54, 0x05, 0xbd, 0xf5,
+	0x46, 0x41, 0xd5, 0x5a, 0x9f, 0x41, 0xdf, 0x81, 0x79, 0xfe, 0xb5, 0xaf, 0x7e, 0x96, 0xb3, 0xda,
+	0x96, 0xbf, 0x02, 0x6b, 0x47, 0xbf, 0x02, 0x6b, 0xdf, 0x1a, 0x50, 0x36, 0x6c, 0x15, 0x94, 0x95,
+	0x15, 0x83, 0x27, 0xb0, 0xb0, 0x43, 0x58, 0x52, 0x05, 0x42, 0x17, 0x8f, 0x55, 0x2b, 0x6b, 0xe9,
+	0xf9, 0x61, 0xa3, 0x85, 0x24, 0x7d, 0x86, 0x5f, 0x8f, 0x6f, 0xec, 0x10, 0x96, 0xaf, 0xab, 0xa0,
+	0x77, 0x8a, 0x85, 0x8c, 0xa9, 0xbf, 0xb4, 0xee, 0x4f, 0x7b, 0x26, 0xb3, 0x6c, 0xf5, 0x19, 0xf4,
+	0xeb, 0x12, 0x9c, 0x49, 0x29, 0x96, 0x2e, 0x94, 0xa0, 0x6b, 0x93, 0x95, 0x2b, 0x28, 0xaa, 0xb4,
+	0x3e, 0x99, 0xf2, 0xd7, 0x56, 0x29, 0x96, 0xfa, 0x0c, 0xda, 0x17, 0x7b, 0x92, 0xe4, 0x45, 0xe8,
+	0x7c, 0x61, 0x02, 0x14, 0x4b, 0x5f, 0x1b, 0xd7, 0x1d, 0xef, 0xc3, 0x27, 0x30, 0xbf, 0x43, 0x58,
+	0x14, 0x8c, 0x66, 0x91, 0x96, 0xcb, 0x9d, 0xb2, 0x47, 0x35, 0x1f, 0xbf, 0x0a, 0xc4, 0x2c, 0x4b,
+	0x5e, 0xa9, 0xe0, 0x22, 0x7b, 0x56, 0x0b, 0x23, 0xd3, 0x2c, 0x62, 0x8a, 0x63, 0x13, 0x7d, 0x06,
+	0x3d, 0x85, 0xd5, 0x62, 0x57, 0x89, 0xde, 0x3a, 0xf6, 0x65, 0xd8, 0xba, 0x72, 0x9c, 0xa1, 0x91,
+	0xc8, 0x8f, 0xb6, 0xfe, 0xf2, 0x7c, 0xad, 0xf4, 0xd7, 0xe7, 0x6b, 0xa5, 0x7f, 0x3e, 0x5f, 0x2b,
+	0x7d, 0xef, 0xfa, 0x0b, 0x7e, 0x95, 0x99, 0xfa, 0xa1, 0x27, 0xa6, 0xb6, 0xe9, 0xd8, 0xc4, 0x65,
+	0x9d, 0x9a, 0x38, 0x6f, 0xd7, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x72, 0xc4, 0x8a, 0xbc, 0x07,
+	0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3233,6 +3464,31 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HelmChartVerification != nil {
+		{
+			size, err := m.HelmChartVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.ManifestGeneratePaths) > 0 {
+		for iNdEx := len(m.ManifestGeneratePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManifestGeneratePaths[iNdEx])
+			copy(dAtA[i:], m.ManifestGeneratePaths[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ManifestGeneratePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
 	if len(m.ProjectName) > 0 {
 		i -= len(m.ProjectName)
 		copy(dAtA[i:], m.ProjectName)
@@ -3501,6 +3757,61 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmChartVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChartVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmChartVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CosignPublicKeys) > 0 {
+		for iNdEx := len(m.CosignPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CosignPublicKeys[iNdEx])
+			copy(dAtA[i:], m.CosignPublicKeys[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.CosignPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProvenanceKeys) > 0 {
+		for iNdEx := len(m.ProvenanceKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProvenanceKeys[iNdEx])
+			copy(dAtA[i:], m.ProvenanceKeys[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ProvenanceKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Provenance {
+		i--
+		if m.Provenance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3877,8 +4188,32 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Commands) > 0 {
-		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
+	if m.HelmChartVerification != nil {
+		{
+			size, err := m.HelmChartVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CommitSignature != nil {
+		{
+			size, err := m.CommitSignature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Commands) > 0 {
+		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
 			copy(dAtA[i:], m.Commands[iNdEx])
 			i = encodeVarintRepository(dAtA, i, uint64(len(m.Commands[iNdEx])))
@@ -3933,6 +4268,61 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5004,6 +5394,34 @@ func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.KeylessSignatureIdentities) > 0 {
+		for iNdEx := len(m.KeylessSignatureIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeylessSignatureIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SshSignatureKeys) > 0 {
+		for iNdEx := len(m.SshSignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SshSignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.VerifyCommit {
 		i--
 		if m.VerifyCommit {
@@ -5145,6 +5563,34 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.KeylessSignatureIdentities) > 0 {
+		for iNdEx := len(m.KeylessSignatureIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeylessSignatureIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SshSignatureKeys) > 0 {
+		for iNdEx := len(m.SshSignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SshSignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.VerifyCommit {
 		i--
 		if m.VerifyCommit {
@@ -5565,6 +6011,43 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if len(m.ManifestGeneratePaths) > 0 {
+		for _, s := range m.ManifestGeneratePaths {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.HelmChartVerification != nil {
+		l = m.HelmChartVerification.Size()
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmChartVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provenance {
+		n += 2
+	}
+	if len(m.ProvenanceKeys) > 0 {
+		for _, s := range m.ProvenanceKeys {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.CosignPublicKeys) > 0 {
+		for _, s := range m.CosignPublicKeys {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5773,6 +6256,42 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if m.CommitSignature != nil {
+		l = m.CommitSignature.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.HelmChartVerification != nil {
+		l = m.HelmChartVerification.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6274,6 +6793,18 @@ func (m *GitFilesRequest) Size() (n int) {
 	if m.VerifyCommit {
 		n += 2
 	}
+	if len(m.SshSignatureKeys) > 0 {
+		for _, e := range m.SshSignatureKeys {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.KeylessSignatureIdentities) > 0 {
+		for _, e := range m.KeylessSignatureIdentities {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6327,6 +6858,18 @@ func (m *GitDirectoriesRequest) Size() (n int) {
 	if m.VerifyCommit {
 		n += 2
 	}
+	if len(m.SshSignatureKeys) > 0 {
+		for _, e := range m.SshSignatureKeys {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.KeylessSignatureIdentities) > 0 {
+		for _, e := range m.KeylessSignatureIdentities {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7342,6 +7885,74 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestGeneratePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestGeneratePaths = append(m.ManifestGeneratePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmChartVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmChartVerification == nil {
+				m.HelmChartVerification = &HelmChartVerification{}
+			}
+			if err := m.HelmChartVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -7364,7 +7975,7 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
+func (m *HelmChartVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7387,17 +7998,17 @@ func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestRequestWithFiles: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestRequestWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -7407,20 +8018,155 @@ func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.Provenance = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenanceKeys", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvenanceKeys = append(m.ProvenanceKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosignPublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosignPublicKeys = append(m.CosignPublicKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestRequestWithFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestRequestWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
 			v := &ManifestRequest{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -8091,20 +8837,363 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.CommitSignature == nil {
+				m.CommitSignature = &CommitSignature{}
+			}
+			if err := m.CommitSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmChartVerification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8114,23 +9203,27 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			if m.HelmChartVerification == nil {
+				m.HelmChartVerification = &HelmChartVerification{}
+			}
+			if err := m.HelmChartVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8154,7 +9247,7 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8177,15 +9270,15 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8213,11 +9306,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8245,11 +9338,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8277,107 +9370,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VerifyResult = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8405,7 +9402,7 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11657,6 +12654,74 @@ func (m *GitFilesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.VerifyCommit = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SshSignatureKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SshSignatureKeys = append(m.SshSignatureKeys, &v1alpha1.SSHSignatureKey{})
+			if err := m.SshSignatureKeys[len(m.SshSignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeylessSignatureIdentities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeylessSignatureIdentities = append(m.KeylessSignatureIdentities, &v1alpha1.KeylessSignatureIdentity{})
+			if err := m.KeylessSignatureIdentities[len(m.KeylessSignatureIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -12015,6 +13080,74 @@ func (m *GitDirectoriesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.VerifyCommit = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SshSignatureKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SshSignatureKeys = append(m.SshSignatureKeys, &v1alpha1.SSHSignatureKey{})
+			if err := m.SshSignatureKeys[len(m.SshSignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeylessSignatureIdentities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeylessSignatureIdentities = append(m.KeylessSignatureIdentities, &v1alpha1.KeylessSignatureIdentity{})
+			if err := m.KeylessSignatureIdentities[len(m.KeylessSignatureIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
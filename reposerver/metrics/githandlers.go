@@ -47,5 +47,8 @@ func NewGitClientEventHandlers(metricsServer *MetricsServer) git.EventHandlers {
 				metricsServer.ObserveGitRequestDuration(repo, GitRequestTypeLsRemote, time.Since(startTime))
 			}
 		},
+		OnMirror: func(repo string, hit bool) {
+			metricsServer.IncGitMirrorRequest(repo, hit)
+		},
 	}
 }
@@ -16,6 +16,7 @@ type MetricsServer struct {
 	gitLsRemoteFailCounter   *prometheus.CounterVec
 	gitRequestCounter        *prometheus.CounterVec
 	gitRequestHistogram      *prometheus.HistogramVec
+	gitMirrorRequestCounter  *prometheus.CounterVec
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
@@ -71,6 +72,15 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(gitRequestHistogram)
 
+	gitMirrorRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_mirror_request_total",
+			Help: "Number of revision lookups in repositories using a git mirror, by whether the revision was available without fetching it from the remote",
+		},
+		[]string{"repo", "result"},
+	)
+	registry.MustRegister(gitMirrorRequestCounter)
+
 	repoPendingRequestsGauge := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_repo_pending_request_total",
@@ -105,6 +115,7 @@ func NewMetricsServer() *MetricsServer {
 		gitLsRemoteFailCounter:   gitLsRemoteFailCounter,
 		gitRequestCounter:        gitRequestCounter,
 		gitRequestHistogram:      gitRequestHistogram,
+		gitMirrorRequestCounter:  gitMirrorRequestCounter,
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
@@ -128,6 +139,15 @@ func (m *MetricsServer) IncGitRequest(repo string, requestType GitRequestType) {
 	m.gitRequestCounter.WithLabelValues(repo, string(requestType)).Inc()
 }
 
+// IncGitMirrorRequest increments the git mirror requests counter
+func (m *MetricsServer) IncGitMirrorRequest(repo string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.gitMirrorRequestCounter.WithLabelValues(repo, result).Inc()
+}
+
 func (m *MetricsServer) IncPendingRepoRequest(repo string) {
 	m.repoPendingRequestsGauge.WithLabelValues(repo).Inc()
 }
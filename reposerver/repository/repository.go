@@ -3,6 +3,7 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -35,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/yaml"
 
@@ -60,6 +63,8 @@ import (
 	pathutil "github.com/argoproj/argo-cd/v2/util/io/path"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
 	"github.com/argoproj/argo-cd/v2/util/manifeststream"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+	"github.com/argoproj/argo-cd/v2/util/signature"
 	"github.com/argoproj/argo-cd/v2/util/text"
 )
 
@@ -79,12 +84,17 @@ var (
 	helmConcurrencyDefault = env.ParseBoolFromEnv("ARGOCD_HELM_ALLOW_CONCURRENCY", false)
 )
 
+// gitMirrorGCInterval is the interval at which unused git mirrors are garbage collected
+const gitMirrorGCInterval = 10 * time.Minute
+
 // Service implements ManifestService interface
 type Service struct {
 	gitCredsStore             git.CredsStore
 	rootDir                   string
 	gitRepoPaths              io.TempPaths
+	gitMirrors                *git.MirrorStore
 	chartPaths                io.TempPaths
+	ociArtifactPaths          io.TempPaths
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
 	cache                     *cache.Cache
@@ -93,6 +103,7 @@ type Service struct {
 	resourceTracking          argo.ResourceTracking
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, opts ...oci.ClientOpts) oci.Client
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -113,6 +124,22 @@ type RepoServerInitConstants struct {
 	HelmRegistryMaxIndexSize                     int64
 	DisableHelmManifestMaxExtractedSize          bool
 	IncludeHiddenDirectories                     bool
+	OCIManifestMaxExtractedSize                  int64
+	DisableOCIManifestMaxExtractedSize           bool
+	// GitMirrorPath is the directory of the git mirrors shared by all repo server replicas. Mirrors are disabled if
+	// it is empty.
+	GitMirrorPath    string
+	GitMirrorMaxSize int64
+	// SparseCheckout limits the working trees of git repositories to the files needed to generate manifests
+	SparseCheckout bool
+	// PartialCloneFilter is the filter used to fetch git repositories if SparseCheckout is enabled, e.g. blob:none
+	PartialCloneFilter string
+	// SigstoreTrustRoot is the path of a PEM file with the certificate authorities which X.509 commit signatures, e.g.
+	// keyless Sigstore signatures, are verified against
+	SigstoreTrustRoot string
+	// TimestampAuthorityTrustRoot is the path of a PEM file with the certificate authorities which the RFC 3161
+	// timestamps of X.509 commit signatures are verified against
+	TimestampAuthorityTrustRoot string
 }
 
 // NewService returns a new instance of the Manifest service
@@ -124,6 +151,11 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	repoLock := NewRepositoryLock()
 	gitRandomizedPaths := io.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := io.NewRandomizedTempPaths(rootDir)
+	ociRandomizedPaths := io.NewRandomizedTempPaths(rootDir)
+	var gitMirrors *git.MirrorStore
+	if initConstants.GitMirrorPath != "" {
+		gitMirrors = git.NewMirrorStore(initConstants.GitMirrorPath, initConstants.GitMirrorMaxSize)
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -134,17 +166,29 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, noProxy, opts...)
 		},
+		newOCIClient: func(repoURL string, creds oci.Creds, proxy string, noProxy string, opts ...oci.ClientOpts) oci.Client {
+			return oci.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), proxy, noProxy, opts...)
+		},
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
 		gitRepoPaths:       gitRandomizedPaths,
+		gitMirrors:         gitMirrors,
 		chartPaths:         helmRandomizedPaths,
+		ociArtifactPaths:   ociRandomizedPaths,
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
 	}
 }
 
 func (s *Service) Init() error {
+	if s.gitMirrors != nil {
+		go wait.Forever(func() {
+			if err := s.gitMirrors.GC(); err != nil {
+				log.Warnf("Failed to garbage collect git mirrors: %v", err)
+			}
+		}, gitMirrorGCInterval)
+	}
 	_, err := os.Stat(s.rootDir)
 	if os.IsNotExist(err) {
 		return os.MkdirAll(s.rootDir, 0o300)
@@ -216,9 +260,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled)
-	})
+	closer, err := s.lockCheckout(gitClient, commitSHA, true, s.initConstants.SubmoduleEnabled, fullCheckout)
 	if err != nil {
 		return nil, fmt.Errorf("error acquiring repository lock: %w", err)
 	}
@@ -261,6 +303,10 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// checkout describes the files needed in the working tree of a git repository
+	checkout sparseCheckout
+	// helmChartVerification holds the requirements a Helm chart must meet before it is used
+	helmChartVerification *apiclient.HelmChartVerification
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -271,6 +317,9 @@ type operationContext struct {
 
 	// output of 'git verify-(tag/commit)', if signature verification is enabled (otherwise "")
 	verificationResult string
+
+	// result of the verification of an SSH or X.509 signature, if signature verification is enabled (otherwise nil)
+	commitSignature *apiclient.CommitSignature
 }
 
 // The 'operation' function parameter of 'runRepoOperation' may call this function to retrieve
@@ -302,12 +351,19 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	gitClientOpts := git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache)
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	unresolvedRevision := revision
-	if source.IsHelm() {
-		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
+	isOCI := repo.Type == "oci"
+	if isOCI {
+		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision, settings.noCache || settings.noRevisionCache)
+		if err != nil {
+			return err
+		}
+	} else if source.IsHelm() {
+		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache, helm.WithChartVerification(newChartVerification(settings.helmChartVerification)))
 		if err != nil {
 			return err
 		}
@@ -340,7 +396,44 @@ func (s *Service) runRepoOperation(
 		defer settings.sem.Release(1)
 	}
 
-	if source.IsHelm() {
+	if isOCI {
+		if settings.noCache {
+			err = ociClient.CleanCache(revision, repo.Project)
+			if err != nil {
+				return err
+			}
+		}
+		artifactPath, closer, err := ociClient.Extract(ctx, revision, repo.Project, s.initConstants.OCIManifestMaxExtractedSize, s.initConstants.DisableOCIManifestMaxExtractedSize)
+		if err != nil {
+			return err
+		}
+		defer io.Close(closer)
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := argopath.CheckOutOfBoundsSymlinks(artifactPath)
+			if err != nil {
+				oobError := &argopath.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               repo.Repo,
+						"revision":           revision,
+						"file":               oobError.File,
+					}).Warn("OCI artifact contains out-of-bounds symlink")
+					return fmt.Errorf("OCI artifact contains out-of-bounds symlinks. file: %s", oobError.File)
+				} else {
+					return err
+				}
+			}
+		}
+		// The digest identifies the artifact content, so it is used both to generate manifests and as the cache key.
+		return operation(artifactPath, revision, revision, func() (*operationContext, error) {
+			appPath, err := argopath.Path(artifactPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, "", nil}, nil
+		})
+	} else if source.IsHelm() {
 		if settings.noCache {
 			err = helmClient.CleanChartCache(source.Chart, revision, repo.Project)
 			if err != nil {
@@ -374,12 +467,10 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, ""}, nil
+			return &operationContext{chartPath, "", nil}, nil
 		})
 	} else {
-		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
-		})
+		closer, err := s.lockCheckout(gitClient, revision, settings.allowConcurrent, s.initConstants.SubmoduleEnabled, settings.checkout)
 		if err != nil {
 			return err
 		}
@@ -425,7 +516,8 @@ func (s *Service) runRepoOperation(
 		// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
 		// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
 		return operation(gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
-			var signature string
+			var verifyResult string
+			var commitSignature *apiclient.CommitSignature
 			if verifyCommit {
 				// When the revision is an annotated tag, we need to pass the unresolved revision (i.e. the tag name)
 				// to the verification routine. For everything else, we work with the SHA that the target revision is
@@ -436,16 +528,23 @@ func (s *Service) runRepoOperation(
 				} else {
 					rev = revision
 				}
-				signature, err = gitClient.VerifyCommitSignature(rev)
+				var signatureType signature.Type
+				signatureType, commitSignature, err = verifySSHOrX509Signature(gitClient, rev, s.initConstants.SigstoreTrustRoot, s.initConstants.TimestampAuthorityTrustRoot)
 				if err != nil {
 					return nil, err
 				}
+				if signatureType == signature.TypeGPG {
+					verifyResult, err = gitClient.VerifyCommitSignature(rev)
+					if err != nil {
+						return nil, err
+					}
+				}
 			}
 			appPath, err := argopath.Path(gitClient.Root(), source.Path)
 			if err != nil {
 				return nil, err
 			}
-			return &operationContext{appPath, signature}, nil
+			return &operationContext{appPath, verifyResult, commitSignature}, nil
 		})
 	}
 }
@@ -564,20 +663,35 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
-	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
+	generate := func(settings operationSettings) (*apiclient.ManifestResponse, error) {
+		res, tarConcluded, promise = nil, false, nil
+		err := s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
-	// if the tarDoneCh message is sent it means that the manifest
-	// generation is being managed by the cmp-server. In this case
-	// we have to wait for the responseCh to send the manifest
-	// response.
-	if tarConcluded && res == nil {
-		select {
-		case resp := <-promise.responseCh:
-			res = resp
-		case err := <-promise.errCh:
-			return nil, err
+		// if the tarDoneCh message is sent it means that the manifest
+		// generation is being managed by the cmp-server. In this case
+		// we have to wait for the responseCh to send the manifest
+		// response.
+		if tarConcluded && res == nil {
+			select {
+			case resp := <-promise.responseCh:
+				res = resp
+			case err := <-promise.errCh:
+				return nil, err
+			}
 		}
+		return res, err
+	}
+
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), helmChartVerification: q.HelmChartVerification}
+	if s.initConstants.SparseCheckout {
+		settings.checkout = newSparseCheckout(q.ApplicationSource, q.ManifestGeneratePaths, q.RefSources)
+	}
+	res, err = generate(settings)
+	if settings.checkout.patterns != nil && isSparseCheckoutError(err) {
+		// the manifests might depend on files outside of the sparse checkout, e.g. Kustomize bases
+		log.WithFields(log.Fields{"application": q.AppName, "appNamespace": q.Namespace}).Infof("Failed to generate manifests using a sparse checkout of %v, retrying with a full checkout: %v", settings.checkout.patterns, err)
+		settings.checkout = fullCheckout
+		res, err = generate(settings)
 	}
 	return res, err
 }
@@ -621,7 +735,7 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 		if err != nil {
 			return nil, fmt.Errorf("failed to get app path: %w", err)
 		}
-		return &operationContext{appPath, ""}, nil
+		return &operationContext{appPath, "", nil}, nil
 	}, req)
 
 	var res *apiclient.ManifestResponse
@@ -762,9 +876,12 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 								ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
 								return
 							}
-							closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-								return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
-							})
+							// the checkout of the application's own repository already contains the referenced files
+							refCheckout := anyCheckout
+							if gitClient.Root() != repoRoot {
+								refCheckout = newRefSourceSparseCheckout(q.ApplicationSource, q.RefSources, refSourceMapping.Repo.Repo)
+							}
+							closer, err := s.lockCheckout(gitClient, referencedCommitSHA, true, s.initConstants.SubmoduleEnabled, refCheckout)
 							if err != nil {
 								log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
 								ch.errCh <- err
@@ -813,6 +930,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			refSourceCommitSHAs[normalizedURL] = repoRef.commitSHA
 		}
 	}
+	sparsePaths := s.manifestCacheSparsePaths(q.ApplicationSource, q.ManifestGeneratePaths, q.RefSources)
 	if err != nil {
 		logCtx := log.WithFields(log.Fields{
 			"application":  q.AppName,
@@ -821,12 +939,12 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 
 		// If manifest generation error caching is enabled
 		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
-			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs)
+			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, sparsePaths)
 			if cacheErr != nil && !errors.Is(cacheErr, cache.ErrCacheMiss) {
 				logCtx.Warnf("manifest cache get error %s: %v", appSourceCopy.String(), cacheErr)
 				ch.errCh <- cacheErr
@@ -839,12 +957,12 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 				innerRes.FirstFailureTimestamp = s.now().Unix()
 			}
 
-			cache.LogDebugManifestCacheKeyFields("setting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+			cache.LogDebugManifestCacheKeyFields("setting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs)
+			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, sparsePaths)
 
 			if cacheErr != nil {
 				logCtx.Warnf("manifest cache set error %s: %v", appSourceCopy.String(), cacheErr)
@@ -856,7 +974,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		return
 	}
 
-	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "fresh GenerateManifests response", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "fresh GenerateManifests response", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 	// Otherwise, no error occurred, so ensure the manifest generation error data in the cache entry is reset before we cache the value
 	manifestGenCacheEntry := cache.CachedManifestResponse{
@@ -868,7 +986,11 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
-	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs)
+	manifestGenResult.CommitSignature = opContext.commitSignature
+	if q.ApplicationSource.IsHelm() {
+		manifestGenResult.HelmChartVerification = q.HelmChartVerification
+	}
+	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, sparsePaths)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
@@ -882,10 +1004,11 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 // and returns true otherwise.
 // If true is returned, either the second or third parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
 func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRequest, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, *apiclient.ManifestResponse, error) {
-	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+	sparsePaths := s.manifestCacheSparsePaths(q.ApplicationSource, q.ManifestGeneratePaths, q.RefSources)
+	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, sparsePaths)
 	if err == nil {
 		// The cache contains an existing value
 
@@ -899,10 +1022,10 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 
 					// After X minutes, reset the cache and retry the operation (e.g. perhaps the error is ephemeral and has passed)
 					if elapsedTimeInMinutes >= s.initConstants.PauseGenerationOnFailureForMinutes {
-						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -914,10 +1037,10 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 				// Check if enough cached responses have been returned to try generation again (e.g. to exit the 'manifest generation caching' state)
 				if s.initConstants.PauseGenerationOnFailureForRequests > 0 && res.NumberOfCachedResponsesReturned > 0 {
 					if res.NumberOfCachedResponsesReturned >= s.initConstants.PauseGenerationOnFailureForRequests {
-						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -932,12 +1055,12 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 				cachedErrorResponse := fmt.Errorf(cachedManifestGenerationPrefix+": %s", res.MostRecentError)
 
 				if firstInvocation {
-					cache.LogDebugManifestCacheKeyFields("setting manifests cache", "update error count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+					cache.LogDebugManifestCacheKeyFields("setting manifests cache", "update error count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, sparsePaths)
 
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, sparsePaths)
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -952,6 +1075,12 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 			return false, res.ManifestResponse, nil
 		}
 
+		// the chart of a cached response might not have been verified against the current requirements of the project
+		if q.ApplicationSource.IsHelm() && !q.HelmChartVerification.IsSatisfiedBy(res.ManifestResponse.GetHelmChartVerification()) {
+			log.Infof("manifest cache hit, but the chart has not been verified as required: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return false, nil, nil
+		}
+
 		log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
 		return true, res.ManifestResponse, nil
 	}
@@ -2057,7 +2186,15 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 	}
 
 	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache}
+	if s.initConstants.SparseCheckout {
+		settings.checkout = newSparseCheckout(q.Source, nil, q.RefSources)
+	}
 	err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
+	if settings.checkout.patterns != nil && isSparseCheckoutError(err) {
+		log.Infof("Failed to get app details using a sparse checkout of %v, retrying with a full checkout: %v", settings.checkout.patterns, err)
+		settings.checkout = fullCheckout
+		err = s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
+	}
 
 	return res, err
 }
@@ -2285,9 +2422,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, q.Revision, s.initConstants.SubmoduleEnabled)
-	})
+	closer, err := s.lockCheckout(gitClient, q.Revision, true, s.initConstants.SubmoduleEnabled, anyCheckout)
 	if err != nil {
 		return nil, fmt.Errorf("error acquiring repo lock: %w", err)
 	}
@@ -2299,24 +2434,38 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 		return nil, err
 	}
 
-	// Run gpg verify-commit on the revision
 	signatureInfo := ""
-	if gpg.IsGPGEnabled() && q.CheckSignature {
-		cs, err := gitClient.VerifyCommitSignature(q.Revision)
+	if q.CheckSignature {
+		signatureType, commitSignature, err := verifySSHOrX509Signature(gitClient, q.Revision, s.initConstants.SigstoreTrustRoot, s.initConstants.TimestampAuthorityTrustRoot)
 		if err != nil {
 			log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", q.Revision, q.Repo.Repo, err)
 			return nil, err
 		}
+		switch {
+		case commitSignature != nil && commitSignature.Error != "":
+			signatureInfo = fmt.Sprintf("BAD signature: %s", commitSignature.Error)
+		case commitSignature != nil:
+			signatureInfo = fmt.Sprintf("Good signature from %s", commitSignature.Result())
+		case signatureType == "":
+			signatureInfo = "Revision is not signed."
+		case gpg.IsGPGEnabled():
+			// Run gpg verify-commit on the revision
+			cs, err := gitClient.VerifyCommitSignature(q.Revision)
+			if err != nil {
+				log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", q.Revision, q.Repo.Repo, err)
+				return nil, err
+			}
 
-		if cs != "" {
-			vr := gpg.ParseGitCommitVerification(cs)
-			if vr.Result == gpg.VerifyResultUnknown {
-				signatureInfo = fmt.Sprintf("UNKNOWN signature: %s", vr.Message)
+			if cs != "" {
+				vr := gpg.ParseGitCommitVerification(cs)
+				if vr.Result == gpg.VerifyResultUnknown {
+					signatureInfo = fmt.Sprintf("UNKNOWN signature: %s", vr.Message)
+				} else {
+					signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
+				}
 			} else {
-				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
+				signatureInfo = "Revision is not signed."
 			}
-		} else {
-			signatureInfo = "Revision is not signed."
 		}
 	}
 
@@ -2381,6 +2530,13 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 		return nil, err
 	}
 	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	if s.initConstants.SparseCheckout && s.initConstants.PartialCloneFilter != "" {
+		opts = append(opts, git.WithPartialCloneFilter(s.initConstants.PartialCloneFilter))
+	}
+	if s.gitMirrors != nil {
+		// repositories of different projects may use different credentials, so they must not share a mirror
+		opts = append(opts, git.WithMirror(s.gitMirrors, repo.Project))
+	}
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2399,9 +2555,10 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 	return gitClient, commitSHA, nil
 }
 
-func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
+func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool, opts ...helm.ClientOpts) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
+	opts = append([]helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}, opts...)
+	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, opts...)
 	if helm.IsVersion(revision) {
 		return helmClient, revision, nil
 	}
@@ -2438,6 +2595,48 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 	return helmClient, version.String(), nil
 }
 
+// newChartVerification converts the requirements of a manifest request for Helm charts into those of the Helm client
+func newChartVerification(verification *apiclient.HelmChartVerification) *helm.ChartVerification {
+	if verification == nil {
+		return nil
+	}
+	res := &helm.ChartVerification{CosignPublicKeys: verification.CosignPublicKeys}
+	if verification.Provenance {
+		res.VerifyProvenance = func(prov []byte) ([]byte, error) {
+			return verifyProvenanceSignature(prov, verification.ProvenanceKeys)
+		}
+	}
+	return res
+}
+
+// verifyProvenanceSignature verifies the signature of a Helm provenance file using the GnuPG keyring and returns the
+// signed text. The provenance file must be signed by one of the given keys, or by any key of the keyring if none are given.
+func verifyProvenanceSignature(prov []byte, keyIDs []string) ([]byte, error) {
+	if !gpg.IsGPGEnabled() {
+		return nil, errors.New("provenance files cannot be verified because GnuPG is disabled")
+	}
+	result, signed, err := gpg.VerifyClearSignedMessage(prov)
+	if err != nil {
+		return nil, err
+	}
+	if result.Result != gpg.VerifyResultGood {
+		return nil, fmt.Errorf("provenance file does not have a good signature: %s", result.Message)
+	}
+	if len(keyIDs) > 0 && !slices.ContainsFunc(keyIDs, func(keyID string) bool { return gpg.KeyID(keyID) == result.KeyID }) {
+		return nil, fmt.Errorf("provenance file is signed with key %s, which is not allowed in the project", result.KeyID)
+	}
+	return signed, nil
+}
+
+func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string, noRevisionCache bool) (oci.Client, string, error) {
+	ociClient := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, oci.WithArtifactPaths(s.ociArtifactPaths), oci.WithTagsCache(s.cache))
+	digest, err := ociClient.ResolveRevision(ctx, revision, noRevisionCache)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve revision %q: %w", revision, err)
+	}
+	return ociClient, digest, nil
+}
+
 // directoryPermissionInitializer ensures the directory has read/write/execute permissions and returns
 // a function that can be used to remove all permissions.
 func directoryPermissionInitializer(rootPath string) goio.Closer {
@@ -2462,9 +2661,22 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
 // nolint:unparam
-func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) (goio.Closer, error) {
+func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool, checkout sparseCheckout) (goio.Closer, error) {
 	closer := s.gitRepoInitializer(gitClient.Root())
-	err := checkoutRevision(gitClient, revision, submoduleEnabled)
+	var err error
+	if !checkout.any {
+		// the repository has to exist before its sparse checkout can be configured
+		if err = gitClient.Init(); err == nil {
+			if err = checkout.apply(gitClient); err != nil {
+				err = status.Errorf(codes.Internal, "%s: %v", sparseCheckoutFailedMessage, err)
+			}
+		} else {
+			err = status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+		}
+	}
+	if err == nil {
+		err = checkoutRevision(gitClient, revision, submoduleEnabled)
+	}
 	if err != nil {
 		s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
 	}
@@ -2541,6 +2753,10 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 		"git": func() error {
 			return git.TestRepo(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
 		},
+		"oci": func() error {
+			_, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy).TestRepo(ctx)
+			return err
+		},
 		"helm": func() error {
 			if repo.EnableOCI {
 				if !helm.IsHelmOciRepo(repo.Repo) {
@@ -2570,7 +2786,16 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 	ambiguousRevision := q.AmbiguousRevision
 	var revision string
 	source := app.Spec.GetSourcePtrByIndex(int(q.SourceIndex))
-	if source.IsHelm() {
+	if repo.Type == "oci" {
+		_, revision, err := s.newOCIClientResolveRevision(ctx, repo, ambiguousRevision, true)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          revision,
+			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, revision),
+		}, nil
+	} else if source.IsHelm() {
 		_, revision, err := s.newHelmClientResolveRevision(repo, ambiguousRevision, source.Chart, true)
 		if err != nil {
 			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
@@ -2615,7 +2840,7 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 		return nil, status.Errorf(codes.Internal, "unable to resolve git revision %s: %v", revision, err)
 	}
 
-	if err := verifyCommitSignature(request.VerifyCommit, gitClient, revision, repo); err != nil {
+	if err := verifyCommitSignature(request.VerifyCommit, gitClient, revision, repo, signingProject(request.SshSignatureKeys, request.KeylessSignatureIdentities), s.initConstants.SigstoreTrustRoot, s.initConstants.TimestampAuthorityTrustRoot); err != nil {
 		return nil, err
 	}
 
@@ -2631,9 +2856,7 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	// cache miss, generate the results
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
-	})
+	closer, err := s.lockCheckout(gitClient, revision, true, request.GetSubmoduleEnabled(), fullCheckout)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
 	}
@@ -2664,8 +2887,86 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 	}, nil
 }
 
-func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision string, repo *v1alpha1.Repository) error {
-	if gpg.IsGPGEnabled() && verifyCommit {
+// verifySSHOrX509Signature verifies the signature of the given revision if it is an SSH or X.509 signature. Returns the
+// type of the signature, which is empty if the revision is not signed, and the result of the verification, which is nil
+// unless it is an SSH or X.509 signature. All other signatures are supposed to be verified using GnuPG.
+func verifySSHOrX509Signature(gitClient git.Client, revision string, sigstoreTrustRoot string, timestampAuthorityTrustRoot string) (signature.Type, *apiclient.CommitSignature, error) {
+	sig, payload, err := gitClient.CommitSignature(revision)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get signature of revision %s: %w", revision, err)
+	}
+	if sig == nil {
+		return "", nil, nil
+	}
+	var result *signature.Result
+	signatureType := signature.DetectType(sig)
+	switch signatureType {
+	case signature.TypeSSH:
+		result, err = signature.VerifySSH(sig, payload)
+	case signature.TypeX509:
+		if sigstoreTrustRoot == "" {
+			err = errors.New("no trust root for X.509 signatures is configured")
+			break
+		}
+		var trustRoot []*x509.Certificate
+		trustRoot, err = signature.LoadTrustRoot(sigstoreTrustRoot)
+		if err != nil {
+			return "", nil, err
+		}
+		var tsaTrustRoot []*x509.Certificate
+		if timestampAuthorityTrustRoot != "" {
+			tsaTrustRoot, err = signature.LoadTrustRoot(timestampAuthorityTrustRoot)
+			if err != nil {
+				return "", nil, err
+			}
+		}
+		result, err = signature.VerifyX509(sig, payload, trustRoot, tsaTrustRoot)
+	default:
+		return signature.TypeGPG, nil, nil
+	}
+	if err != nil {
+		return signatureType, &apiclient.CommitSignature{Type: string(signatureType), Error: err.Error()}, nil
+	}
+	return signatureType, &apiclient.CommitSignature{Type: string(signatureType), Signer: result.Signer, Issuer: result.Issuer}, nil
+}
+
+// signingProject returns a project which permits the given SSH keys and keyless signature identities to sign commits
+func signingProject(sshSignatureKeys []*v1alpha1.SSHSignatureKey, keylessSignatureIdentities []*v1alpha1.KeylessSignatureIdentity) *v1alpha1.AppProject {
+	project := &v1alpha1.AppProject{}
+	for _, key := range sshSignatureKeys {
+		project.Spec.SSHSignatureKeys = append(project.Spec.SSHSignatureKeys, *key)
+	}
+	for _, identity := range keylessSignatureIdentities {
+		project.Spec.KeylessSignatureIdentities = append(project.Spec.KeylessSignatureIdentities, *identity)
+	}
+	return project
+}
+
+// verifyCommitSignature verifies the signature of the given revision if required. SSH and X.509 signatures must be
+// made by a signer permitted in the given project.
+func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision string, repo *v1alpha1.Repository, project *v1alpha1.AppProject, sigstoreTrustRoot string, timestampAuthorityTrustRoot string) error {
+	if !verifyCommit {
+		return nil
+	}
+	signatureType, commitSignature, err := verifySSHOrX509Signature(gitClient, revision, sigstoreTrustRoot, timestampAuthorityTrustRoot)
+	if err != nil {
+		log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", revision, repo.Repo, err)
+		return err
+	}
+	if commitSignature != nil {
+		if commitSignature.Error != "" {
+			return fmt.Errorf("BAD signature: %s", commitSignature.Error)
+		}
+		if result := commitSignature.Result(); !signature.IsPermitted(project, result) {
+			return fmt.Errorf("found good signature from %s on revision %s, but it is not allowed in the project", result, revision)
+		}
+		log.Debugf("Good signature from %s", commitSignature.Result())
+		return nil
+	}
+	if signatureType == "" {
+		return fmt.Errorf("revision %s is not signed", revision)
+	}
+	if gpg.IsGPGEnabled() {
 		cs, err := gitClient.VerifyCommitSignature(revision)
 		if err != nil {
 			log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", revision, repo.Repo, err)
@@ -2699,7 +3000,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 		return nil, status.Errorf(codes.Internal, "unable to resolve git revision %s: %v", revision, err)
 	}
 
-	if err := verifyCommitSignature(request.VerifyCommit, gitClient, revision, repo); err != nil {
+	if err := verifyCommitSignature(request.VerifyCommit, gitClient, revision, repo, signingProject(request.SshSignatureKeys, request.KeylessSignatureIdentities), s.initConstants.SigstoreTrustRoot, s.initConstants.TimestampAuthorityTrustRoot); err != nil {
 		return nil, err
 	}
 
@@ -2715,9 +3016,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	// cache miss, generate the results
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
-	})
+	closer, err := s.lockCheckout(gitClient, revision, true, request.GetSubmoduleEnabled(), fullCheckout)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
 	}
@@ -2785,6 +3084,11 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 		return &apiclient.UpdateRevisionForPathsResponse{}, nil
 	}
 
+	if repo.Type == "oci" {
+		// OCI artifacts have no history to compare paths against, always refresh
+		return &apiclient.UpdateRevisionForPathsResponse{}, nil
+	}
+
 	gitClientOpts := git.WithCache(s.cache, !request.NoRevisionCache)
 	gitClient, revision, err := s.newClientResolveRevision(repo, revision, gitClientOpts)
 	if err != nil {
@@ -2807,9 +3111,7 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, false)
-	})
+	closer, err := s.lockCheckout(gitClient, revision, true, false, anyCheckout)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
 	}
@@ -2864,7 +3166,7 @@ func (s *Service) updateCachedRevision(logCtx *log.Entry, oldRev string, newRev
 		}
 	}
 
-	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs)
+	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs, s.manifestCacheSparsePaths(request.ApplicationSource, request.Paths, request.RefSources))
 	if err != nil {
 		if errors.Is(err, cache.ErrCacheMiss) {
 			logCtx.Debugf("manifest cache miss during comparison for application %s in repo %s from revision %s", request.AppName, request.GetRepo().Repo, oldRev)
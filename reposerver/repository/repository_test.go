@@ -49,8 +49,12 @@ import (
 	helmmocks "github.com/argoproj/argo-cd/v2/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	iomocks "github.com/argoproj/argo-cd/v2/util/io/mocks"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v2/util/oci/mocks"
 )
 
+const testGPGSignature = "-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n"
+
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
 gpg:                using RSA key 4AEE18F83AFDEB23
 gpg: Good signature from "GitHub (web-flow commit signing) <noreply@github.com>" [ultimate]
@@ -114,8 +118,10 @@ func newServiceWithMocks(t *testing.T, root string, signed bool) (*Service, *git
 		gitClient.On("Root").Return(root)
 		gitClient.On("IsAnnotatedTag").Return(false)
 		if signed {
+			gitClient.On("CommitSignature", mock.Anything).Return([]byte(testGPGSignature), []byte{}, nil)
 			gitClient.On("VerifyCommitSignature", mock.Anything).Return(testSignature, nil)
 		} else {
+			gitClient.On("CommitSignature", mock.Anything).Return(nil, []byte{}, nil)
 			gitClient.On("VerifyCommitSignature", mock.Anything).Return("", nil)
 		}
 
@@ -313,7 +319,7 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 
 	cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}, Revision: mock.Anything}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil)
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, nil)
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -338,7 +344,7 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 		ProjectSourceRepos: []string{"*"},
 	}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil)
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, nil)
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -506,6 +512,72 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	gitMocks.AssertNotCalled(t, "LsRemote", mock.Anything)
 }
 
+// ensure manifests which were cached without chart verification are not used for requests requiring it
+func TestHelmManifestFromChartRepo_Verification(t *testing.T) {
+	root := t.TempDir()
+	service, _, _ := newServiceWithMocks(t, root, false)
+	source := &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: ">= 1.0.0"}
+	request := &apiclient.ManifestRequest{
+		Repo: &argoappv1.Repository{}, ApplicationSource: source, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	response, err := service.GenerateManifest(context.Background(), request)
+	require.NoError(t, err)
+	assert.Nil(t, response.HelmChartVerification)
+
+	verification := &apiclient.HelmChartVerification{CosignPublicKeys: []string{"key"}}
+	request.HelmChartVerification = verification
+	response, err = service.GenerateManifest(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, verification, response.HelmChartVerification)
+
+	// manifests verified against stricter requirements can be served from the cache
+	request.HelmChartVerification = nil
+	response, err = service.GenerateManifest(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, verification, response.HelmChartVerification)
+}
+
+func TestManifestFromOCIArtifact(t *testing.T) {
+	root := t.TempDir()
+	service, gitMocks, _ := newServiceWithMocks(t, root, false)
+	digest := "sha256:" + strings.Repeat("a", 64)
+	artifactPath, err := filepath.Abs("./testdata")
+	require.NoError(t, err)
+	ociClient := &ocimocks.Client{}
+	ociClient.On("ResolveRevision", mock.Anything, "1.0.0", true).Return(digest, nil)
+	ociClient.On("CleanCache", digest, "").Return(nil)
+	ociClient.On("Extract", mock.Anything, digest, "", int64(0), false).Return(artifactPath, io.NopCloser, nil)
+	service.newOCIClient = func(repoURL string, creds oci.Creds, proxy string, noProxy string, opts ...oci.ClientOpts) oci.Client {
+		return ociClient
+	}
+
+	source := &argoappv1.ApplicationSource{RepoURL: "oci://registry.example.com/org/bundle", Path: "several-files", TargetRevision: "1.0.0"}
+	request := &apiclient.ManifestRequest{
+		Repo: &argoappv1.Repository{Repo: "oci://registry.example.com/org/bundle", Type: "oci"}, ApplicationSource: source, NoCache: true, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	response, err := service.GenerateManifest(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, digest, response.Revision)
+	assert.Equal(t, "Directory", response.SourceType)
+	assert.Len(t, response.Manifests, 10)
+	gitMocks.AssertNotCalled(t, "LsRemote", mock.Anything)
+	ociClient.AssertExpectations(t)
+
+	t.Run("ResolveRevision", func(t *testing.T) {
+		ociClient.On("ResolveRevision", mock.Anything, "1.*", true).Return(digest, nil)
+		res, err := service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+			Repo:              request.Repo,
+			App:               &argoappv1.Application{Spec: argoappv1.ApplicationSpec{Source: source}},
+			AmbiguousRevision: "1.*",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, digest, res.Revision)
+		assert.Equal(t, fmt.Sprintf("1.* (%s)", digest), res.AmbiguousRevision)
+	})
+}
+
 func TestHelmChartReferencingExternalValues(t *testing.T) {
 	service := newService(t, ".")
 	spec := argoappv1.ApplicationSpec{
@@ -774,7 +846,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil)
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, nil)
 		require.NoError(t, err)
 		return cachedManifestResponse
 	}
@@ -2114,7 +2186,7 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 			// Try to pull from the cache with a `source` that does not include any overrides. Overrides should not be
 			// part of the cache key, because you can't get the overrides without a repo operation. And avoiding repo
 			// operations is the point of the cache.
-			err = service.cache.GetManifests(mock.Anything, source, argoappv1.RefTargetRevisionMapping{}, &argoappv1.ClusterInfo{}, "", "", "", "test", res, nil)
+			err = service.cache.GetManifests(mock.Anything, source, argoappv1.RefTargetRevisionMapping{}, &argoappv1.ClusterInfo{}, "", "", "", "test", res, nil, nil)
 			require.NoError(t, err)
 		})
 	})
@@ -4082,20 +4154,22 @@ func TestVerifyCommitSignature(t *testing.T) {
 	t.Run("VerifyCommitSignature with valid signature", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return([]byte(testGPGSignature), []byte{}, nil)
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(testSignature, nil)
 
-		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
 		require.NoError(t, err)
 	})
 
 	t.Run("VerifyCommitSignature with invalid signature", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return([]byte(testGPGSignature), []byte{}, nil)
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return("", nil)
 
-		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
 		require.Error(t, err)
 		assert.Equal(t, "revision abcd1234 is not signed", err.Error())
 	})
@@ -4103,10 +4177,11 @@ func TestVerifyCommitSignature(t *testing.T) {
 	t.Run("VerifyCommitSignature with unknown signature", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return([]byte(testGPGSignature), []byte{}, nil)
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return("", fmt.Errorf("UNKNOWN signature: gpg: Unknown signature from ABCDEFGH"))
 
-		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
 		require.Error(t, err)
 		assert.Equal(t, "UNKNOWN signature: gpg: Unknown signature from ABCDEFGH", err.Error())
 	})
@@ -4114,10 +4189,11 @@ func TestVerifyCommitSignature(t *testing.T) {
 	t.Run("VerifyCommitSignature with error verifying signature", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return([]byte(testGPGSignature), []byte{}, nil)
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return("", fmt.Errorf("error verifying signature of commit 'abcd1234' in repo 'https://github.com/example/repo.git': failed to verify signature"))
 
-		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
 		require.Error(t, err)
 		assert.Equal(t, "error verifying signature of commit 'abcd1234' in repo 'https://github.com/example/repo.git': failed to verify signature", err.Error())
 	})
@@ -4125,9 +4201,61 @@ func TestVerifyCommitSignature(t *testing.T) {
 	t.Run("VerifyCommitSignature with signature verification disabled", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "false")
 		mockGitClient := &gitmocks.Client{}
-		err := verifyCommitSignature(false, mockGitClient, "abcd1234", repo)
+		err := verifyCommitSignature(false, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
 		require.NoError(t, err)
 	})
+
+	sshSignature, err := os.ReadFile("../../util/signature/testdata/ssh_signature.txt")
+	require.NoError(t, err)
+	sshPayload, err := os.ReadFile("../../util/signature/testdata/ssh_payload.txt")
+	require.NoError(t, err)
+
+	sshPublicKey, err := os.ReadFile("../../util/signature/testdata/ssh_key.pub")
+	require.NoError(t, err)
+	sshProject := signingProject([]*v1alpha1.SSHSignatureKey{{PublicKey: string(sshPublicKey)}}, nil)
+
+	t.Run("VerifyCommitSignature with valid SSH signature", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return(sshSignature, sshPayload, nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, sshProject, "", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("VerifyCommitSignature with valid SSH signature of a key not permitted in the project", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return(sshSignature, sshPayload, nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
+		require.ErrorContains(t, err, "is not allowed in the project")
+	})
+
+	t.Run("VerifyCommitSignature with bad SSH signature", func(t *testing.T) {
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return(sshSignature, []byte("tampered"), nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
+		require.ErrorContains(t, err, "BAD signature: bad SSH signature")
+	})
+
+	t.Run("VerifyCommitSignature with X.509 signature and no trust root", func(t *testing.T) {
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return([]byte("-----BEGIN SIGNED MESSAGE-----\n-----END SIGNED MESSAGE-----\n"), []byte{}, nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
+		require.ErrorContains(t, err, "no trust root")
+	})
+
+	t.Run("VerifyCommitSignature with unsigned revision and GPG disabled", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("CommitSignature", mock.Anything).Return(nil, []byte{}, nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo, &v1alpha1.AppProject{}, "", "")
+		require.EqualError(t, err, "revision abcd1234 is not signed")
+	})
 }
 
 func Test_GenerateManifests_Commands(t *testing.T) {
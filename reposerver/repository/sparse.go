@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"errors"
+	goio "io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// sparseCheckout describes the files of a repository an operation needs in the working tree
+type sparseCheckout struct {
+	// any is set if the operation does not need any particular files, e.g. because it only reads commits
+	any bool
+	// patterns are the sparse checkout patterns of the needed files, or nil if all files are needed
+	patterns []string
+}
+
+// sparseCheckoutFailedMessage is the message of errors returned if the sparse checkout of a repository cannot be configured
+const sparseCheckoutFailedMessage = "Failed to configure sparse checkout"
+
+var (
+	// fullCheckout is used by operations which need all files of the repository
+	fullCheckout = sparseCheckout{}
+	// anyCheckout is used by operations which do not need any particular files
+	anyCheckout = sparseCheckout{any: true}
+)
+
+// apply configures the sparse checkout of the repository of the given client
+func (c sparseCheckout) apply(gitClient git.Client) error {
+	if c.any {
+		return nil
+	}
+	return gitClient.SparseCheckout(c.patterns)
+}
+
+// coveredBy returns whether a working tree limited to the given patterns, or containing all files if the patterns are
+// nil, contains all files needed
+func (c sparseCheckout) coveredBy(current []string) bool {
+	if c.any || current == nil {
+		return true
+	}
+	if c.patterns == nil {
+		return false
+	}
+	for _, pattern := range c.patterns {
+		covered := false
+		for _, currentPattern := range current {
+			if pattern == currentPattern || (!strings.ContainsAny(currentPattern, "*?[") && strings.HasPrefix(pattern, currentPattern+"/")) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// newSparseCheckout returns a sparse checkout of the files needed to generate the manifests of the given source: the
+// source path, the paths of the manifest-generate-paths annotation, and the Helm value and parameter files, including
+// those of ref sources which point to the same repository. Returns fullCheckout if the whole repository is needed.
+func newSparseCheckout(source *v1alpha1.ApplicationSource, manifestGeneratePaths []string, refSources map[string]*v1alpha1.RefTarget) sparseCheckout {
+	if source == nil {
+		return fullCheckout
+	}
+	paths := append([]string{source.Path}, manifestGeneratePaths...)
+	if source.Helm != nil {
+		files := append([]string{}, source.Helm.ValueFiles...)
+		for _, param := range source.Helm.FileParameters {
+			files = append(files, param.Path)
+		}
+		for _, file := range files {
+			if strings.HasPrefix(file, "$") {
+				refVar, refPath, _ := strings.Cut(file, "/")
+				if ref, ok := refSources[refVar]; ok {
+					if git.SameURL(ref.Repo.Repo, source.RepoURL) {
+						paths = append(paths, refPath)
+					}
+					continue
+				}
+			}
+			if strings.Contains(file, "$") || strings.Contains(file, "://") {
+				// files which depend on environment variables, or are not in the repository at all
+				return fullCheckout
+			}
+			paths = append(paths, path.Join(source.Path, file))
+		}
+	}
+	return sparseCheckoutOf(paths)
+}
+
+// manifestCacheSparsePaths returns the sparse checkout patterns the manifests of the given source are generated from, or
+// nil if sparse checkout is disabled. The patterns are part of the manifest cache key, so that manifests generated from
+// differently limited working trees are not mixed up.
+func (s *Service) manifestCacheSparsePaths(source *v1alpha1.ApplicationSource, manifestGeneratePaths []string, refSources map[string]*v1alpha1.RefTarget) []string {
+	if !s.initConstants.SparseCheckout {
+		return nil
+	}
+	return newSparseCheckout(source, manifestGeneratePaths, refSources).patterns
+}
+
+// isSparseCheckoutError returns whether the given error might be caused by a sparse checkout, i.e. because a file
+// outside of the sparse checkout is missing or because git does not support the sparse checkout of the repository.
+// Other errors, e.g. invalid manifests or unavailable repositories, are not retried with a full checkout.
+func isSparseCheckoutError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return true
+	}
+	message := err.Error()
+	return strings.Contains(message, "no such file or directory") || strings.Contains(message, sparseCheckoutFailedMessage)
+}
+
+// newRefSourceSparseCheckout returns a sparse checkout of the Helm value and parameter files of the given source which
+// are referenced using ref sources pointing to the given repository
+func newRefSourceSparseCheckout(source *v1alpha1.ApplicationSource, refSources map[string]*v1alpha1.RefTarget, repoURL string) sparseCheckout {
+	if source == nil || source.Helm == nil {
+		return fullCheckout
+	}
+	var paths []string
+	files := append([]string{}, source.Helm.ValueFiles...)
+	for _, param := range source.Helm.FileParameters {
+		files = append(files, param.Path)
+	}
+	for _, file := range files {
+		refVar, refPath, _ := strings.Cut(file, "/")
+		if ref, ok := refSources[refVar]; ok && strings.HasPrefix(file, "$") && git.SameURL(ref.Repo.Repo, repoURL) {
+			paths = append(paths, refPath)
+		}
+	}
+	if len(paths) == 0 {
+		return fullCheckout
+	}
+	return sparseCheckoutOf(paths)
+}
+
+// sparseCheckoutOf returns a sparse checkout of the given repository relative paths, or fullCheckout if one of them
+// refers to the root of the repository
+func sparseCheckoutOf(paths []string) sparseCheckout {
+	seen := map[string]bool{}
+	patterns := []string{}
+	for _, p := range paths {
+		pattern := path.Clean("/" + p)
+		if pattern == "/" {
+			return fullCheckout
+		}
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	return sparseCheckout{patterns: patterns}
+}
+
+// lockCheckout acquires the lock of the repository of the given client and checks out the given revision with the
+// files needed by the operation. If the checkout of a concurrent operation is joined, but it does not contain all
+// needed files, the lock is acquired exclusively in order to check out the revision again.
+func (s *Service) lockCheckout(gitClient git.Client, revision string, allowConcurrent bool, submoduleEnabled bool, checkout sparseCheckout) (goio.Closer, error) {
+	if !s.initConstants.SparseCheckout {
+		checkout = anyCheckout
+	}
+	init := func() (goio.Closer, error) {
+		return s.checkoutRevision(gitClient, revision, submoduleEnabled, checkout)
+	}
+	closer, err := s.repoLock.Lock(gitClient.Root(), revision, allowConcurrent, init)
+	if err != nil || !allowConcurrent || checkout.any {
+		return closer, err
+	}
+	current, err := gitClient.SparseCheckoutPatterns()
+	if err == nil && checkout.coveredBy(current) {
+		return closer, nil
+	}
+	log.Debugf("Checkout of %s does not contain all files matching %v, checking out again", gitClient.Root(), checkout.patterns)
+	io.Close(closer)
+	return s.repoLock.Lock(gitClient.Root(), revision, false, init)
+}
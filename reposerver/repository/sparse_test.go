@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestNewSparseCheckout(t *testing.T) {
+	refSources := map[string]*v1alpha1.RefTarget{
+		"$same":  {Repo: v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}},
+		"$other": {Repo: v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}},
+	}
+	source := func(helm *v1alpha1.ApplicationSourceHelm) *v1alpha1.ApplicationSource {
+		return &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "apps/guestbook", Helm: helm}
+	}
+
+	t.Run("Path", func(t *testing.T) {
+		checkout := newSparseCheckout(source(nil), []string{"common", "apps/guestbook"}, refSources)
+		assert.Equal(t, []string{"/apps/guestbook", "/common"}, checkout.patterns)
+		assert.False(t, checkout.any)
+		assert.Equal(t, fullCheckout, newSparseCheckout(source(nil), []string{"."}, refSources))
+	})
+	t.Run("HelmFiles", func(t *testing.T) {
+		checkout := newSparseCheckout(source(&v1alpha1.ApplicationSourceHelm{
+			ValueFiles:     []string{"values.yaml", "../shared/values.yaml", "$same/env/prod.yaml", "$other/values.yaml"},
+			FileParameters: []v1alpha1.HelmFileParameter{{Name: "config", Path: "files/config.json"}},
+		}), nil, refSources)
+		assert.Equal(t, []string{"/apps/guestbook", "/apps/guestbook/files/config.json", "/apps/guestbook/values.yaml", "/apps/shared/values.yaml", "/env/prod.yaml"}, checkout.patterns)
+	})
+	t.Run("RemoteValueFile", func(t *testing.T) {
+		checkout := newSparseCheckout(source(&v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"https://example.com/values.yaml"}}), nil, refSources)
+		assert.Equal(t, fullCheckout, checkout)
+	})
+	t.Run("RepositoryRoot", func(t *testing.T) {
+		checkout := newSparseCheckout(&v1alpha1.ApplicationSource{Path: "."}, nil, refSources)
+		assert.Equal(t, fullCheckout, checkout)
+	})
+	t.Run("RefSource", func(t *testing.T) {
+		checkout := newRefSourceSparseCheckout(source(&v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{"values.yaml", "$same/env/prod.yaml", "$other/values.yaml"},
+		}), refSources, "https://github.com/argoproj/argo-cd.git")
+		assert.Equal(t, []string{"/values.yaml"}, checkout.patterns)
+	})
+}
+
+func TestSparseCheckout_CoveredBy(t *testing.T) {
+	checkout := sparseCheckout{patterns: []string{"/apps/guestbook", "/common/values.yaml"}}
+	assert.True(t, checkout.coveredBy(nil))
+	assert.True(t, checkout.coveredBy([]string{"/apps/guestbook", "/common/values.yaml", "/other"}))
+	assert.True(t, checkout.coveredBy([]string{"/apps", "/common"}))
+	assert.False(t, checkout.coveredBy([]string{"/apps/guestbook"}))
+	assert.False(t, checkout.coveredBy([]string{"/apps/guest", "/common"}))
+	assert.False(t, checkout.coveredBy([]string{"/apps/*", "/common"}))
+	assert.False(t, fullCheckout.coveredBy([]string{"/apps"}))
+	assert.True(t, anyCheckout.coveredBy([]string{"/apps"}))
+}
+
+func TestIsSparseCheckoutError(t *testing.T) {
+	assert.False(t, isSparseCheckoutError(nil))
+	assert.True(t, isSparseCheckoutError(fmt.Errorf("failed to read values: %w", fs.ErrNotExist)))
+	assert.True(t, isSparseCheckoutError(errors.New("accumulating resources from '../base': lstat /tmp/_argocd-repo/apps/base: no such file or directory")))
+	assert.True(t, isSparseCheckoutError(status.Errorf(codes.Internal, "%s: %v", sparseCheckoutFailedMessage, errors.New("unknown option `no-cone'"))))
+	assert.False(t, isSparseCheckoutError(status.Errorf(codes.Unknown, "authentication required")))
+	assert.False(t, isSparseCheckoutError(errors.New("error unmarshaling JSON: invalid character")))
+}
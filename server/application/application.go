@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -445,6 +446,18 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	var history *appv1.RevisionHistory
+	if q.HistoryId != nil {
+		if q.GetRevision() != "" || len(q.Revisions) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "history ID cannot be combined with revisions")
+		}
+		h, ok := getRevisionHistoryByVersionId(a.Status.History, q.GetHistoryId())
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "application %s has no history entry with ID %d", a.QualifiedName(), q.GetHistoryId())
+		}
+		history = &h
+	}
+
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, enableGenerateManifests map[string]bool,
@@ -471,7 +484,15 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 
 		sources := make([]appv1.ApplicationSource, 0)
 		appSpec := a.Spec.DeepCopy()
-		if a.Spec.HasMultipleSources() {
+		hasMultipleSources := a.Spec.HasMultipleSources()
+		if history != nil {
+			sources, hasMultipleSources = getRevisionHistorySources(history)
+			for _, pos := range q.SourcePositions {
+				if pos <= 0 || pos > int64(len(sources)) {
+					return fmt.Errorf("source position is out of range")
+				}
+			}
+		} else if a.Spec.HasMultipleSources() {
 			numOfSources := int64(len(a.Spec.GetSources()))
 			for i, pos := range q.SourcePositions {
 				if pos <= 0 || pos > numOfSources {
@@ -494,7 +515,10 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
 
-		for _, source := range sources {
+		for i, source := range sources {
+			if history != nil && len(q.SourcePositions) > 0 && !slices.Contains(q.SourcePositions, int64(i+1)) {
+				continue
+			}
 			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
 			if err != nil {
 				return fmt.Errorf("error getting repository: %w", err)
@@ -511,24 +535,25 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			}
 
 			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
-				Repo:               repo,
-				Revision:           source.TargetRevision,
-				AppLabelKey:        appInstanceLabelKey,
-				AppName:            a.InstanceName(s.ns),
-				Namespace:          a.Spec.Destination.Namespace,
-				ApplicationSource:  &source,
-				Repos:              helmRepos,
-				KustomizeOptions:   kustomizeOptions,
-				KubeVersion:        serverVersion,
-				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-				HelmRepoCreds:      helmCreds,
-				HelmOptions:        helmOptions,
-				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
-				EnabledSourceTypes: enableGenerateManifests,
-				ProjectName:        proj.Name,
-				ProjectSourceRepos: proj.Spec.SourceRepos,
-				HasMultipleSources: a.Spec.HasMultipleSources(),
-				RefSources:         refSources,
+				Repo:                  repo,
+				Revision:              source.TargetRevision,
+				AppLabelKey:           appInstanceLabelKey,
+				AppName:               a.InstanceName(s.ns),
+				Namespace:             a.Spec.Destination.Namespace,
+				ApplicationSource:     &source,
+				Repos:                 helmRepos,
+				KustomizeOptions:      kustomizeOptions,
+				KubeVersion:           serverVersion,
+				ApiVersions:           argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:         helmCreds,
+				HelmOptions:           helmOptions,
+				TrackingMethod:        string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes:    enableGenerateManifests,
+				ProjectName:           proj.Name,
+				ProjectSourceRepos:    proj.Spec.SourceRepos,
+				HasMultipleSources:    hasMultipleSources,
+				RefSources:            refSources,
+				HelmChartVerification: apiclient.NewHelmChartVerification(proj),
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -538,7 +563,17 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		// the snapshot holds the manifests of all sources, so it cannot be used if only some of them are requested
+		if history == nil || len(history.ManifestsSnapshot) == 0 || len(q.SourcePositions) > 0 || !isRepoUnavailableError(err) {
+			return nil, err
+		}
+		// the revision is not available anymore, fall back to the manifests which were stored when the history entry was created
+		log.WithField("application", a.QualifiedName()).Warnf("Failed to generate manifests of history entry %d, using its snapshot: %v", history.ID, err)
+		snapshot, err := argo.GetManifestsFromSnapshot(history.ManifestsSnapshot)
+		if err != nil {
+			return nil, fmt.Errorf("error reading manifests snapshot of history entry %d: %w", history.ID, err)
+		}
+		return &apiclient.ManifestResponse{Manifests: snapshot}, nil
 	}
 
 	manifests := &apiclient.ManifestResponse{}
@@ -1247,6 +1282,19 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
+	if schedule := app.Spec.SyncPolicy.GetSchedule(); schedule != nil {
+		if err := schedule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "application sync schedule for %s is invalid: %s", app.Name, err.Error())
+		}
+	}
+	if freeze := app.Spec.SyncPolicy.GetFreeze(); freeze != nil {
+		var currFreeze *appv1.SyncFreeze
+		if currApp != nil {
+			currFreeze = currApp.Spec.SyncPolicy.GetFreeze()
+		}
+		setFreezeAuthor(ctx, freeze, currFreeze)
+	}
+
 	var conditions []appv1.ApplicationCondition
 
 	if validate {
@@ -1512,7 +1560,7 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:           repo,
 		Revision:       q.GetRevision(),
-		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+		CheckSignature: proj.Spec.HasSignatureKeys(),
 	})
 }
 
@@ -1585,6 +1633,61 @@ func getAppSourceBySourceIndexAndVersionId(a *appv1.Application, sourceIndexMayb
 	return source, nil
 }
 
+// setFreezeAuthor records the user who set the given maintenance freeze and when. The recorded user and time of the
+// current freeze are kept if the freeze is unchanged, so they cannot be forged by clients.
+func setFreezeAuthor(ctx context.Context, freeze *appv1.SyncFreeze, currFreeze *appv1.SyncFreeze) {
+	if currFreeze != nil && currFreeze.Reason == freeze.Reason && currFreeze.ExpiresAt.Equal(freeze.ExpiresAt) {
+		freeze.SetBy = currFreeze.SetBy
+		freeze.SetAt = currFreeze.SetAt
+		return
+	}
+	now := metav1.Now()
+	freeze.SetBy = session.Username(ctx)
+	freeze.SetAt = &now
+}
+
+// repoUnavailableErrors are parts of the messages of errors returned if the repository or the revision of a source
+// cannot be fetched
+var repoUnavailableErrors = []string{
+	"unable to resolve",
+	"failed to fetch",
+	"failed to checkout",
+	"repository not found",
+	"could not read from remote repository",
+	"couldn't find remote ref",
+	"authentication required",
+}
+
+// isRepoUnavailableError returns whether the given error of a manifest generation was caused by the repository or the
+// revision not being available, rather than by the manifests of the revision
+func isRepoUnavailableError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, part := range repoUnavailableErrors {
+		if strings.Contains(message, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRevisionHistorySources returns the sources of the given revision history entry, pinned to the revisions which
+// were synced, and whether the entry has multiple sources
+func getRevisionHistorySources(history *appv1.RevisionHistory) ([]appv1.ApplicationSource, bool) {
+	if len(history.Sources) > 0 {
+		sources := make([]appv1.ApplicationSource, 0, len(history.Sources))
+		for i, source := range history.Sources {
+			if i < len(history.Revisions) {
+				source.TargetRevision = history.Revisions[i]
+			}
+			sources = append(sources, source)
+		}
+		return sources, true
+	}
+	source := history.Source
+	source.TargetRevision = history.Revision
+	return []appv1.ApplicationSource{source}, false
+}
+
 // getRevisionHistoryByVersionId returns the revision history for a specific version ID.
 // If the version ID is not found, it returns an empty revision history and false.
 func getRevisionHistoryByVersionId(histories v1alpha1.RevisionHistories, versionId int64) (appv1.RevisionHistory, bool) {
@@ -1886,6 +1989,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
+	if freeze := a.Spec.SyncPolicy.GetFreeze(); freeze.IsActive() {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by maintenance freeze: %s", freeze.Reason)
+	}
 	if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
@@ -1925,7 +2031,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	if syncReq.Manifests != nil && proj.Spec.HasSignatureKeys() {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
@@ -2036,6 +2142,9 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
+	if freeze := a.Spec.SyncPolicy.GetFreeze(); freeze.IsActive() {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot rollback: blocked by maintenance freeze: %s", freeze.Reason)
+	}
 
 	var deploymentInfo *appv1.RevisionHistory
 	for _, info := range a.Status.History {
@@ -2603,17 +2712,41 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	}
 
 	windows := proj.Spec.SyncWindows.Matches(a)
-	sync := windows.CanSync(true)
+	freeze := a.Spec.SyncPolicy.GetFreeze()
+	sync := !freeze.IsActive() && windows.CanSync(true)
 
 	res := &application.ApplicationSyncWindowsResponse{
 		ActiveWindows:   convertSyncWindows(windows.Active()),
 		AssignedWindows: convertSyncWindows(windows),
 		CanSync:         &sync,
 	}
+	if freeze.IsActive() {
+		res.Freeze = convertSyncFreeze(freeze)
+	}
+	if schedule := a.Spec.SyncPolicy.GetSchedule(); schedule != nil {
+		if next, err := schedule.Next(time.Now()); err == nil {
+			nextScheduledSync := next.Format(time.RFC3339)
+			res.NextScheduledSync = &nextScheduledSync
+		}
+	}
 
 	return res, nil
 }
 
+func convertSyncFreeze(f *appv1.SyncFreeze) *application.ApplicationSyncFreeze {
+	freeze := &application.ApplicationSyncFreeze{
+		Reason: ptr.To(f.Reason),
+		SetBy:  ptr.To(f.SetBy),
+	}
+	if f.SetAt != nil {
+		freeze.SetAt = ptr.To(f.SetAt.Format(time.RFC3339))
+	}
+	if f.ExpiresAt != nil {
+		freeze.ExpiresAt = ptr.To(f.ExpiresAt.Format(time.RFC3339))
+	}
+	return freeze
+}
+
 func (s *Server) inferResourcesStatusHealth(app *appv1.Application) {
 	if app.Status.ResourceHealthSource == appv1.ResourceHealthLocationAppTree {
 		tree := &appv1.ApplicationTree{}
@@ -1874,6 +1874,86 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestGetManifests_HistoryId(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.History = []appsv1.RevisionHistory{{
+		ID:       1,
+		Revision: "abc",
+		Source:   *testApp.Spec.Source.DeepCopy(),
+	}}
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("Existing entry", func(t *testing.T) {
+		_, err := appServer.GetManifests(context.Background(), &application.ApplicationManifestQuery{
+			Name:      &testApp.Name,
+			HistoryId: ptr.To(int64(1)),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Missing entry", func(t *testing.T) {
+		_, err := appServer.GetManifests(context.Background(), &application.ApplicationManifestQuery{
+			Name:      &testApp.Name,
+			HistoryId: ptr.To(int64(2)),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Combined with revision", func(t *testing.T) {
+		_, err := appServer.GetManifests(context.Background(), &application.ApplicationManifestQuery{
+			Name:      &testApp.Name,
+			HistoryId: ptr.To(int64(1)),
+			Revision:  ptr.To("HEAD"),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Source position out of range", func(t *testing.T) {
+		_, err := appServer.GetManifests(context.Background(), &application.ApplicationManifestQuery{
+			Name:            &testApp.Name,
+			HistoryId:       ptr.To(int64(1)),
+			SourcePositions: []int64{2},
+		})
+		require.ErrorContains(t, err, "source position is out of range")
+	})
+}
+
+func Test_isRepoUnavailableError(t *testing.T) {
+	assert.True(t, isRepoUnavailableError(status.Errorf(codes.Internal, "unable to resolve git revision abc: Unable to resolve 'abc' to a commit SHA")))
+	assert.True(t, isRepoUnavailableError(coreerrors.New("error generating manifests: rpc error: code = Internal desc = Failed to checkout revision abc: couldn't find remote ref abc")))
+	assert.True(t, isRepoUnavailableError(coreerrors.New("rpc error: code = Unknown desc = repository not found")))
+	assert.False(t, isRepoUnavailableError(coreerrors.New("error generating manifests: `kustomize build` failed: accumulating resources")))
+	assert.False(t, isRepoUnavailableError(coreerrors.New("error getting application cluster config: cluster not found")))
+}
+
+func Test_getRevisionHistorySources(t *testing.T) {
+	t.Run("Single source", func(t *testing.T) {
+		sources, multiple := getRevisionHistorySources(&appsv1.RevisionHistory{
+			Revision: "abc",
+			Source:   appsv1.ApplicationSource{RepoURL: "https://example.com/repo.git", TargetRevision: "HEAD"},
+		})
+		assert.False(t, multiple)
+		require.Len(t, sources, 1)
+		assert.Equal(t, "abc", sources[0].TargetRevision)
+	})
+
+	t.Run("Multiple sources", func(t *testing.T) {
+		sources, multiple := getRevisionHistorySources(&appsv1.RevisionHistory{
+			Revisions: []string{"abc", "1.0.0"},
+			Sources: appsv1.ApplicationSources{
+				{RepoURL: "https://example.com/repo.git", TargetRevision: "HEAD"},
+				{RepoURL: "https://example.com/charts", Chart: "chart", TargetRevision: "1.*"},
+			},
+		})
+		assert.True(t, multiple)
+		require.Len(t, sources, 2)
+		assert.Equal(t, "abc", sources[0].TargetRevision)
+		assert.Equal(t, "1.0.0", sources[1].TargetRevision)
+	})
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -2006,6 +2086,87 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 		assert.Contains(t, err.Error(), "not exist")
 		assert.Nil(t, active)
 	})
+	t.Run("Frozen", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "default"
+		testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Freeze: &appsv1.SyncFreeze{Reason: "maintenance", SetBy: "admin"}}
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetApplicationSyncWindows(context.Background(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.False(t, res.GetCanSync())
+		require.NotNil(t, res.Freeze)
+		assert.Equal(t, "maintenance", res.Freeze.GetReason())
+		assert.Equal(t, "admin", res.Freeze.GetSetBy())
+	})
+	t.Run("Scheduled", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "default"
+		testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Schedule: &appsv1.SyncSchedule{Cron: "0 2 * * *"}}
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetApplicationSyncWindows(context.Background(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.True(t, res.GetCanSync())
+		assert.Nil(t, res.Freeze)
+		next, err := time.Parse(time.RFC3339, res.GetNextScheduledSync())
+		require.NoError(t, err)
+		assert.Equal(t, 2, next.UTC().Hour())
+	})
+}
+
+func TestSyncFreeze(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Sync blocked", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Freeze: &appsv1.SyncFreeze{Reason: "maintenance"}}
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "maintenance freeze")
+	})
+
+	t.Run("Expired freeze does not block sync", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Freeze: &appsv1.SyncFreeze{Reason: "maintenance", ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Hour)}}}
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+	})
+
+	t.Run("Author recorded by the server", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		userCtx := context.WithValue(ctx, "claims", &jwt.MapClaims{"iss": "argocd", "sub": "alice", "groups": []string{"admin"}})
+
+		spec := testApp.Spec.DeepCopy()
+		spec.SyncPolicy = &appsv1.SyncPolicy{Freeze: &appsv1.SyncFreeze{Reason: "maintenance", SetBy: "mallory"}}
+		updated, err := appServer.UpdateSpec(userCtx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		require.NoError(t, err)
+		assert.Equal(t, "alice", updated.SyncPolicy.Freeze.SetBy)
+		assert.NotNil(t, updated.SyncPolicy.Freeze.SetAt)
+
+		// an unchanged freeze keeps its author
+		otherCtx := context.WithValue(ctx, "claims", &jwt.MapClaims{"iss": "argocd", "sub": "bob", "groups": []string{"admin"}})
+		updated, err = appServer.UpdateSpec(otherCtx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "alice", updated.SyncPolicy.Freeze.SetBy)
+	})
+
+	t.Run("Invalid schedule", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		spec := testApp.Spec.DeepCopy()
+		spec.SyncPolicy = &appsv1.SyncPolicy{Schedule: &appsv1.SyncSchedule{Cron: "invalid"}}
+		_, err := appServer.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sync schedule")
+	})
 }
 
 func TestGetCachedAppState(t *testing.T) {
@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
@@ -10,6 +11,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/sync"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -17,17 +20,21 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsetcontrollers "github.com/argoproj/argo-cd/v2/applicationset/controllers"
 	appsettemplate "github.com/argoproj/argo-cd/v2/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v2/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -38,6 +45,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/github_app"
+	ioutil "github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/argo-cd/v2/util/session"
@@ -274,7 +282,12 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 		return nil, fmt.Errorf("error creating ArgoCDService: %w", err)
 	}
 
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig)
+	// the HTTP and KubernetesResource generators are only enabled in the ApplicationSet controller, so that the API
+	// server neither requests arbitrary endpoints nor lists the objects of clusters on behalf of its users
+	httpConfig := generators.NewHTTPConfig(false, nil)
+	kubernetesResourceConfig := generators.NewKubernetesResourceConfig(false, nil)
+
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig, httpConfig, kubernetesResourceConfig)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -365,6 +378,47 @@ func (s *Server) ResourceTree(ctx context.Context, q *applicationset.Application
 	return s.buildApplicationSetTree(a)
 }
 
+// Rollout promotes or aborts the RollingSync rollout of an ApplicationSet
+func (s *Server) Rollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	if q.Action != common.ApplicationSetRolloutActionPromote && q.Action != common.ApplicationSetRolloutActionAbort {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown rollout action '%s', must be either %s or %s", q.Action, common.ApplicationSetRolloutActionPromote, common.ApplicationSetRolloutActionAbort)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	if appset.Spec.Strategy == nil || appset.Spec.Strategy.Type != "RollingSync" || appset.Spec.Strategy.RollingSync == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s does not use the RollingSync strategy", appset.Name)
+	}
+
+	// the ApplicationSet controller handles the request and removes the annotation
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				common.AnnotationApplicationSetRollout: q.Action,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling rollout patch: %w", err)
+	}
+	appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, appset.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(appset, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("requested to %s the rollout", q.Action))
+	return appset, nil
+}
+
 func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetGenerateResponse, error) {
 	appset := q.GetApplicationSet()
 
@@ -399,6 +453,224 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	return res, nil
 }
 
+func (s *Server) Preview(ctx context.Context, q *applicationset.ApplicationSetPreviewRequest) (*applicationset.ApplicationSetPreviewResponse, error) {
+	appset := q.GetApplicationSet()
+
+	if appset == nil {
+		return nil, fmt.Errorf("error previewing ApplicationSets: ApplicationSets is nil in request")
+	}
+	namespace := s.appsetNamespaceOrDefault(appset.Namespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	projectName, err := s.validateAppSet(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+	if err := s.checkCreatePermissions(ctx, appset, projectName); err != nil {
+		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
+	}
+
+	logs := bytes.NewBuffer(nil)
+	logger := log.New()
+	logger.SetOutput(logs)
+
+	apps, err := s.generateApplicationSetApps(ctx, logger.WithField("applicationset", appset.Name), *appset, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w\n%s", err, logs.String())
+	}
+	validationErrors, err := appsetcontrollers.ValidateGeneratedApplications(ctx, s.appclientset, s.k8sClient, s.ns, apps, *appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating Applications of ApplicationSet: %w", err)
+	}
+	existing, err := s.appclientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+
+	// The controller policy cannot be known by the API server, the default one is assumed.
+	policy := appsetutils.DefaultPolicy(appset.Spec.SyncPolicy, v1alpha1.ApplicationsSyncPolicySync, true)
+	changes, err := appsetcontrollers.PreviewApplicationChanges(*appset, apps, validationErrors, existing.Items, policy, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error previewing changes of Applications: %w", err)
+	}
+
+	res := &applicationset.ApplicationSetPreviewResponse{}
+	for _, change := range changes {
+		// the live applications are returned, which requires reading them
+		if change.Live != nil {
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, change.Live.RBACName(s.ns)); err != nil {
+				return nil, err
+			}
+		}
+		resChange := &applicationset.ApplicationSetApplicationChange{
+			Name:       change.Name,
+			Action:     string(change.Action),
+			SkipReason: change.SkipReason,
+			Desired:    change.Desired,
+			Live:       change.Live,
+		}
+		for _, fieldDiff := range change.Diffs {
+			resDiff, err := toFieldDiff(fieldDiff)
+			if err != nil {
+				return nil, err
+			}
+			resChange.Diffs = append(resChange.Diffs, resDiff)
+		}
+		if q.RenderManifests && change.SkipReason == "" && (change.Action == appsetcontrollers.ApplicationChangeActionCreate || change.Action == appsetcontrollers.ApplicationChangeActionUpdate) {
+			resChange.Manifests, err = s.renderManifests(ctx, change.Desired)
+			if err != nil {
+				resChange.ManifestsError = err.Error()
+			}
+		}
+		res.Changes = append(res.Changes, resChange)
+	}
+	return res, nil
+}
+
+func toFieldDiff(fieldDiff appsetcontrollers.FieldDiff) (*applicationset.ApplicationSetFieldDiff, error) {
+	res := &applicationset.ApplicationSetFieldDiff{Path: fieldDiff.Path}
+	if fieldDiff.Live != nil {
+		live, err := json.Marshal(fieldDiff.Live)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling live value of %s: %w", fieldDiff.Path, err)
+		}
+		res.Live = string(live)
+	}
+	if fieldDiff.Desired != nil {
+		desired, err := json.Marshal(fieldDiff.Desired)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling desired value of %s: %w", fieldDiff.Path, err)
+		}
+		res.Desired = string(desired)
+	}
+	return res, nil
+}
+
+// renderManifests generates the manifests of the application with the repo server, hiding the data of Secrets
+func (s *Server) renderManifests(ctx context.Context, app *v1alpha1.Application) ([]string, error) {
+	proj, err := s.projLister.Get(app.Spec.GetProject())
+	if err != nil {
+		return nil, fmt.Errorf("error getting project %s: %w", app.Spec.GetProject(), err)
+	}
+
+	var sources v1alpha1.ApplicationSources
+	if app.Spec.HasMultipleSources() {
+		sources = app.Spec.GetSources()
+	} else {
+		sources = append(sources, app.Spec.GetSource())
+	}
+	// the generated applications are not validated like applications which are created, so the repositories are
+	// checked before the repo server fetches them with the credentials of the project
+	for _, source := range sources {
+		if !proj.IsSourcePermitted(source) {
+			return nil, fmt.Errorf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name)
+		}
+	}
+
+	closer, client, err := s.repoClientSet.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer ioutil.Close(closer)
+
+	helmRepos, err := s.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing helm repositories: %w", err)
+	}
+	permittedHelmRepos, err := argo.GetPermittedRepos(proj, helmRepos)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving permitted repos: %w", err)
+	}
+	helmRepositoryCredentials, err := s.db.GetAllHelmRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting helm repository credentials: %w", err)
+	}
+	permittedHelmCredentials, err := argo.GetPermittedReposCredentials(proj, helmRepositoryCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("error getting permitted repos credentials: %w", err)
+	}
+	helmOptions, err := s.settings.GetHelmSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting helm settings: %w", err)
+	}
+	enabledSourceTypes, err := s.settings.GetEnabledSourceTypes()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings enabled source types: %w", err)
+	}
+	appInstanceLabelKey, err := s.settings.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	kustomizeSettings, err := s.settings.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+
+	refSources, err := argo.GetRefSources(ctx, sources, app.Spec.Project, s.db.GetRepository, []string{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ref sources: %w", err)
+	}
+
+	var manifests []string
+	for _, source := range sources {
+		if source.Ref != "" && source.Path == "" && source.Chart == "" {
+			// sources only referenced by other sources have no manifests
+			continue
+		}
+		repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repository: %w", err)
+		}
+		kustomizeOptions, err := kustomizeSettings.GetOptions(source)
+		if err != nil {
+			return nil, fmt.Errorf("error getting kustomize settings options: %w", err)
+		}
+		manifestInfo, err := client.GenerateManifest(ctx, &repoapiclient.ManifestRequest{
+			Repo:                  repo,
+			Revision:              source.TargetRevision,
+			AppLabelKey:           appInstanceLabelKey,
+			AppName:               app.InstanceName(s.ns),
+			Namespace:             app.Spec.Destination.Namespace,
+			ApplicationSource:     &source,
+			Repos:                 permittedHelmRepos,
+			KustomizeOptions:      kustomizeOptions,
+			HelmRepoCreds:         permittedHelmCredentials,
+			HelmOptions:           helmOptions,
+			TrackingMethod:        string(argo.GetTrackingMethod(s.settings)),
+			EnabledSourceTypes:    enabledSourceTypes,
+			ProjectName:           proj.Name,
+			ProjectSourceRepos:    proj.Spec.SourceRepos,
+			HasMultipleSources:    app.Spec.HasMultipleSources(),
+			RefSources:            refSources,
+			HelmChartVerification: repoapiclient.NewHelmChartVerification(proj),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating manifests: %w", err)
+		}
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+				obj, _, err = diff.HideSecretData(obj, nil)
+				if err != nil {
+					return nil, fmt.Errorf("error hiding secret data: %w", err)
+				}
+				data, err := json.Marshal(obj)
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling manifest: %w", err)
+				}
+				manifest = string(data)
+			}
+			manifests = append(manifests, manifest)
+		}
+	}
+	return manifests, nil
+}
+
 func (s *Server) buildApplicationSetTree(a *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSetTree, error) {
 	var tree v1alpha1.ApplicationSetTree
 
@@ -109,7 +109,15 @@ func newTestAppSetServerWithEnforcerConfigure(f func(*rbac.Enforcer), namespace
 		},
 	}
 
-	objects = append(objects, defaultProj, myProj)
+	restrictedProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"https://git.example.com/restricted/*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+
+	objects = append(objects, defaultProj, myProj, restrictedProj)
 
 	fakeAppsClientset := apps.NewSimpleClientset(objects...)
 	factory := appinformer.NewSharedInformerFactoryWithOptions(fakeAppsClientset, 0, appinformer.WithNamespace(namespace), appinformer.WithTweakListOptions(func(options *metav1.ListOptions) {}))
@@ -499,6 +507,67 @@ func TestDeleteAppSet(t *testing.T) {
 	})
 }
 
+func TestRolloutAppSet(t *testing.T) {
+	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+			Type:        "RollingSync",
+			RollingSync: &appsv1.ApplicationSetRolloutStrategy{},
+		}
+	})
+
+	appSet2 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet2"
+	})
+
+	t.Run("Promote in default namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appSet1, appSet2)
+
+		appsetQuery := applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Action: common.ApplicationSetRolloutActionPromote}
+
+		res, err := appSetServer.Rollout(context.Background(), &appsetQuery)
+		require.NoError(t, err)
+		assert.Equal(t, common.ApplicationSetRolloutActionPromote, res.Annotations[common.AnnotationApplicationSetRollout])
+	})
+
+	t.Run("Abort in named namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appSet1, appSet2)
+
+		appsetQuery := applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", AppsetNamespace: testNamespace, Action: common.ApplicationSetRolloutActionAbort}
+
+		res, err := appSetServer.Rollout(context.Background(), &appsetQuery)
+		require.NoError(t, err)
+		assert.Equal(t, common.ApplicationSetRolloutActionAbort, res.Annotations[common.AnnotationApplicationSetRollout])
+	})
+
+	t.Run("Rollout with unknown action", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appSet1, appSet2)
+
+		appsetQuery := applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Action: "resume"}
+
+		_, err := appSetServer.Rollout(context.Background(), &appsetQuery)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = unknown rollout action 'resume', must be either promote or abort")
+	})
+
+	t.Run("Rollout without RollingSync strategy", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appSet1, appSet2)
+
+		appsetQuery := applicationset.ApplicationSetRolloutRequest{Name: "AppSet2", Action: common.ApplicationSetRolloutActionPromote}
+
+		_, err := appSetServer.Rollout(context.Background(), &appsetQuery)
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = ApplicationSet AppSet2 does not use the RollingSync strategy")
+	})
+
+	t.Run("Rollout in not allowed namespace", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appSet1, appSet2)
+
+		appsetQuery := applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", AppsetNamespace: "NOT-ALLOWED", Action: common.ApplicationSetRolloutActionPromote}
+
+		_, err := appSetServer.Rollout(context.Background(), &appsetQuery)
+		assert.Equal(t, "namespace 'NOT-ALLOWED' is not permitted", err.Error())
+	})
+}
+
 func TestUpdateAppSet(t *testing.T) {
 	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.ObjectMeta.Annotations = map[string]string{
@@ -634,3 +703,106 @@ func TestResourceTree(t *testing.T) {
 		assert.Equal(t, "namespace 'NOT-ALLOWED' is not permitted", err.Error())
 	})
 }
+
+func TestPreviewAppSet(t *testing.T) {
+	isController := true
+	newApp := func(name string, revision string) *appsv1.Application {
+		return &appsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  testNamespace,
+				Finalizers: []string{appsv1.ResourcesFinalizerName},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         "argoproj.io/v1alpha1",
+					Kind:               "ApplicationSet",
+					Name:               "AppSet1",
+					Controller:         &isController,
+					BlockOwnerDeletion: &isController,
+				}},
+			},
+			Spec: appsv1.ApplicationSpec{
+				Project:     "default",
+				Source:      &appsv1.ApplicationSource{RepoURL: fakeRepoURL, Path: name, TargetRevision: revision},
+				Destination: appsv1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: name},
+			},
+		}
+	}
+	testAppSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Name = "{{name}}"
+		appset.Spec.Template.Spec.Source = &appsv1.ApplicationSource{RepoURL: fakeRepoURL, Path: "{{name}}", TargetRevision: "v1.0.0"}
+		appset.Spec.Template.Spec.Destination = appsv1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "{{name}}"}
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{
+				List: &appsv1.ListGenerator{
+					Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"name": "a"}`)}, {Raw: []byte(`{"name": "b"}`)}},
+				},
+			},
+		}
+	})
+
+	t.Run("Preview changes", func(t *testing.T) {
+		appServer := newTestAppSetServer(newApp("a", "HEAD"), newApp("c", "HEAD"))
+
+		result, err := appServer.Preview(context.Background(), &applicationset.ApplicationSetPreviewRequest{ApplicationSet: testAppSet})
+		require.NoError(t, err)
+
+		require.Len(t, result.Changes, 3)
+		assert.Equal(t, "a", result.Changes[0].Name)
+		assert.Equal(t, "update", result.Changes[0].Action)
+		assert.Equal(t, []*applicationset.ApplicationSetFieldDiff{
+			{Path: "spec.source.targetRevision", Live: `"HEAD"`, Desired: `"v1.0.0"`},
+		}, result.Changes[0].Diffs)
+		assert.Equal(t, "b", result.Changes[1].Name)
+		assert.Equal(t, "create", result.Changes[1].Action)
+		assert.Equal(t, "v1.0.0", result.Changes[1].Desired.Spec.Source.TargetRevision)
+		assert.Nil(t, result.Changes[1].Live)
+		assert.Equal(t, "c", result.Changes[2].Name)
+		assert.Equal(t, "delete", result.Changes[2].Action)
+		assert.Nil(t, result.Changes[2].Desired)
+	})
+
+	t.Run("Preview changes with create-only policy", func(t *testing.T) {
+		appServer := newTestAppSetServer(newApp("a", "HEAD"), newApp("c", "HEAD"))
+		createOnlyAppSet := testAppSet.DeepCopy()
+		policy := appsv1.ApplicationsSyncPolicyCreateOnly
+		createOnlyAppSet.Spec.SyncPolicy = &appsv1.ApplicationSetSyncPolicy{ApplicationsSync: &policy}
+
+		result, err := appServer.Preview(context.Background(), &applicationset.ApplicationSetPreviewRequest{ApplicationSet: createOnlyAppSet})
+		require.NoError(t, err)
+
+		require.Len(t, result.Changes, 3)
+		assert.NotEmpty(t, result.Changes[0].SkipReason)
+		assert.Empty(t, result.Changes[1].SkipReason)
+		assert.NotEmpty(t, result.Changes[2].SkipReason)
+	})
+
+	t.Run("Preview invalid applications", func(t *testing.T) {
+		appServer := newTestAppSetServer()
+		invalidAppSet := testAppSet.DeepCopy()
+		invalidAppSet.Spec.Template.Spec.Destination = appsv1.ApplicationDestination{Name: "unknown", Namespace: "{{name}}"}
+
+		result, err := appServer.Preview(context.Background(), &applicationset.ApplicationSetPreviewRequest{ApplicationSet: invalidAppSet})
+		require.NoError(t, err)
+
+		require.Len(t, result.Changes, 2)
+		assert.Equal(t, "none", result.Changes[0].Action)
+		assert.Contains(t, result.Changes[0].SkipReason, "application destination spec is invalid")
+	})
+
+	t.Run("Render manifests of sources which are not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServer()
+		restrictedAppSet := testAppSet.DeepCopy()
+		restrictedAppSet.Spec.Template.Spec.Project = "restricted"
+
+		result, err := appServer.Preview(context.Background(), &applicationset.ApplicationSetPreviewRequest{ApplicationSet: restrictedAppSet, RenderManifests: true})
+		require.NoError(t, err)
+
+		require.Len(t, result.Changes, 2)
+		for _, change := range result.Changes {
+			assert.Equal(t, "create", change.Action)
+			assert.Empty(t, change.Manifests)
+			assert.Equal(t, "application repo "+fakeRepoURL+" is not permitted in project 'restricted'", change.ManifestsError)
+		}
+	})
+}
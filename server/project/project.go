@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/sync"
@@ -39,6 +41,11 @@ import (
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+
+	// defaultSyncWindowsTimelineRange is the time range of the sync windows timeline if no end time is given
+	defaultSyncWindowsTimelineRange = 7 * 24 * time.Hour
+	// maxSyncWindowsTimelineRange is the maximum time range of the sync windows timeline
+	maxSyncWindowsTimelineRange = 92 * 24 * time.Hour
 )
 
 // Server provides a Project service
@@ -532,9 +539,145 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 		res.Windows = []*v1alpha1.SyncWindow{}
 	}
 
+	if q.From == "" && q.To == "" && q.Application == "" {
+		return res, nil
+	}
+	if err := s.populateSyncWindowsTimeline(ctx, proj, q, res); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
+// populateSyncWindowsTimeline expands the sync windows of the project into the time ranges during which they are
+// active, and computes the overlaps between allow and deny windows and the next allowed sync time of the application.
+func (s *Server) populateSyncWindowsTimeline(ctx context.Context, proj *v1alpha1.AppProject, q *project.SyncWindowsQuery, res *project.SyncWindowsResponse) error {
+	from := time.Now().UTC().Truncate(time.Minute)
+	if q.From != "" {
+		t, err := time.Parse(time.RFC3339, q.From)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid from time '%s': %v", q.From, err)
+		}
+		from = t
+	}
+	to := from.Add(defaultSyncWindowsTimelineRange)
+	if q.To != "" {
+		t, err := time.Parse(time.RFC3339, q.To)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid to time '%s': %v", q.To, err)
+		}
+		to = t
+	}
+	if !to.After(from) {
+		return status.Errorf(codes.InvalidArgument, "to time must be after from time")
+	}
+	if to.Sub(from) > maxSyncWindowsTimelineRange {
+		return status.Errorf(codes.InvalidArgument, "time range must not be longer than %s", maxSyncWindowsTimelineRange)
+	}
+
+	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var apps []v1alpha1.Application
+	for _, a := range argo.FilterByProjects(appsList.Items, []string{proj.Name}) {
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)) {
+			apps = append(apps, a)
+		}
+	}
+
+	windows := &proj.Spec.SyncWindows
+	var app *v1alpha1.Application
+	if q.Application != "" {
+		for i := range apps {
+			if apps[i].Name == q.Application {
+				app = &apps[i]
+				break
+			}
+		}
+		if app == nil {
+			return status.Errorf(codes.NotFound, "application '%s' not found in project '%s'", q.Application, proj.Name)
+		}
+		apps = []v1alpha1.Application{*app}
+		windows = proj.Spec.SyncWindows.Matches(app)
+	}
+
+	timeline, err := windows.Timeline(from, to)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid sync window: %v", err)
+	}
+
+	ids := map[*v1alpha1.SyncWindow]int32{}
+	matchedApps := map[*v1alpha1.SyncWindow][]string{}
+	for i, w := range proj.Spec.SyncWindows {
+		ids[w] = int32(i)
+	}
+	for i := range apps {
+		matched := proj.Spec.SyncWindows.Matches(&apps[i])
+		if !matched.HasWindows() {
+			continue
+		}
+		for _, w := range *matched {
+			if names := matchedApps[w]; len(names) == 0 || names[len(names)-1] != apps[i].Name {
+				matchedApps[w] = append(names, apps[i].Name)
+			}
+		}
+	}
+
+	res.Timeline = []*project.SyncWindowOccurrence{}
+	for _, o := range timeline {
+		res.Timeline = append(res.Timeline, &project.SyncWindowOccurrence{
+			WindowID:     ids[o.Window],
+			Kind:         o.Window.Kind,
+			Start:        o.Start.Format(time.RFC3339),
+			End:          o.End.Format(time.RFC3339),
+			Applications: matchedApps[o.Window],
+		})
+	}
+	res.Overlaps = []*project.SyncWindowOverlap{}
+	for _, o := range timeline.Overlaps() {
+		var affected []string
+		for _, name := range matchedApps[o.Allow] {
+			if slices.Contains(matchedApps[o.Deny], name) {
+				affected = append(affected, name)
+			}
+		}
+		res.Overlaps = append(res.Overlaps, &project.SyncWindowOverlap{
+			AllowWindowID: ids[o.Allow],
+			DenyWindowID:  ids[o.Deny],
+			Start:         o.Start.Format(time.RFC3339),
+			End:           o.End.Format(time.RFC3339),
+			Applications:  affected,
+		})
+	}
+
+	if app == nil {
+		return nil
+	}
+	// Syncs of a frozen application are denied until the freeze expires
+	if freeze := app.Spec.SyncPolicy.GetFreeze(); freeze.IsActive() {
+		if freeze.ExpiresAt == nil {
+			return nil
+		}
+		if freeze.ExpiresAt.After(from) {
+			from = freeze.ExpiresAt.UTC()
+		}
+		if !to.After(from) {
+			return nil
+		}
+	}
+	if next, ok, err := windows.NextSyncTime(from, to, false); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid sync window: %v", err)
+	} else if ok {
+		res.NextSyncTime = next.Format(time.RFC3339)
+	}
+	if next, ok, err := windows.NextSyncTime(from, to, true); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid sync window: %v", err)
+	} else if ok {
+		res.NextManualSyncTime = next.Format(time.RFC3339)
+	}
+	return nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
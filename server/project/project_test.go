@@ -673,6 +673,39 @@ p, role:admin, projects, update, *, allow`)
 		assert.Len(t, res.Windows, 1)
 	})
 
+	t.Run("TestGetSyncWindowsStateTimeline", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: "0 8 * * *", Duration: "10h", Namespaces: []string{"*"}},
+			{Kind: "deny", Schedule: "0 12 * * *", Duration: "2h", Applications: []string{"test"}, ManualSync: true},
+			{Kind: "deny", Schedule: "0 20 * * *", Duration: "1h", Applications: []string{"other"}},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows, &existingApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB)
+
+		res, err := projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name, From: "2024-03-01T00:00:00Z", To: "2024-03-02T00:00:00Z"})
+		require.NoError(t, err)
+		require.Len(t, res.Timeline, 3)
+		assert.Equal(t, &project.SyncWindowOccurrence{WindowID: 0, Kind: "allow", Start: "2024-03-01T08:00:00Z", End: "2024-03-01T18:00:00Z", Applications: []string{"test"}}, res.Timeline[0])
+		assert.Equal(t, int32(1), res.Timeline[1].WindowID)
+		assert.Empty(t, res.Timeline[2].Applications)
+		require.Len(t, res.Overlaps, 1)
+		assert.Equal(t, &project.SyncWindowOverlap{AllowWindowID: 0, DenyWindowID: 1, Start: "2024-03-01T12:00:00Z", End: "2024-03-01T14:00:00Z", Applications: []string{"test"}}, res.Overlaps[0])
+		assert.Empty(t, res.NextSyncTime)
+
+		res, err = projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name, From: "2024-03-01T12:30:00Z", To: "2024-03-02T00:00:00Z", Application: "test"})
+		require.NoError(t, err)
+		assert.Len(t, res.Timeline, 2)
+		assert.Equal(t, "2024-03-01T14:00:00Z", res.NextSyncTime)
+		assert.Equal(t, "2024-03-01T12:30:00Z", res.NextManualSyncTime)
+
+		_, err = projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name, Application: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name, From: "2024-03-02T00:00:00Z", To: "2024-03-01T00:00:00Z"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("TestGetSyncWindowsStateCannotGetProjectDetails", func(t *testing.T) {
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
 		projectWithSyncWindows := existingProj.DeepCopy()
@@ -413,6 +413,14 @@ export interface ResourceDiff extends ResourceID {
     predictedLiveState: State;
     normalizedLiveState: State;
     hook: boolean;
+    fieldChanges?: ResourceFieldChange[];
+}
+
+export interface ResourceFieldChange {
+    path: string;
+    manager?: string;
+    operation?: string;
+    changedAt?: models.Time;
 }
 
 export interface SyncStatus {
@@ -541,10 +541,39 @@ func validateSourcePermissions(source argoappv1.ApplicationSource, hasMultipleSo
 	return conditions
 }
 
+// validateSourceHydrator validates the source hydrator configuration of an application spec. The sync source itself
+// is validated like any other source, since it is what GetSource returns for hydrated applications.
+func validateSourceHydrator(spec *argoappv1.ApplicationSpec) []argoappv1.ApplicationCondition {
+	var message string
+	hydrator := spec.SourceHydrator
+	switch {
+	case spec.Source != nil || len(spec.Sources) > 0:
+		message = "spec.source and spec.sources must be empty if spec.sourceHydrator is set"
+	case hydrator.DrySource.RepoURL == "":
+		message = "spec.sourceHydrator.drySource.repoURL is required"
+	case hydrator.SyncSource.TargetBranch == "":
+		message = "spec.sourceHydrator.syncSource.targetBranch is required"
+	case hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == "":
+		message = "spec.sourceHydrator.hydrateTo.targetBranch is required if spec.sourceHydrator.hydrateTo is set"
+	default:
+		return nil
+	}
+	return []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: message,
+	}}
+}
+
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
 
+	if spec.SourceHydrator != nil {
+		if condition := validateSourceHydrator(spec); len(condition) > 0 {
+			return condition, nil
+		}
+	}
+
 	if spec.HasMultipleSources() {
 		for _, source := range spec.Sources {
 			condition := validateSourcePermissions(source, spec.HasMultipleSources())
@@ -761,23 +790,24 @@ func verifyGenerateManifests(
 				Proxy:   repoRes.Proxy,
 				NoProxy: repoRes.NoProxy,
 			},
-			Repos:              helmRepos,
-			Revision:           source.TargetRevision,
-			AppName:            name,
-			Namespace:          dest.Namespace,
-			ApplicationSource:  &source,
-			KustomizeOptions:   kustomizeOptions,
-			KubeVersion:        kubeVersion,
-			ApiVersions:        apiVersions,
-			HelmOptions:        helmOptions,
-			HelmRepoCreds:      repositoryCredentials,
-			TrackingMethod:     string(GetTrackingMethod(settingsMgr)),
-			EnabledSourceTypes: enableGenerateManifests,
-			NoRevisionCache:    true,
-			HasMultipleSources: hasMultipleSources,
-			RefSources:         refSources,
-			ProjectName:        proj.Name,
-			ProjectSourceRepos: proj.Spec.SourceRepos,
+			Repos:                 helmRepos,
+			Revision:              source.TargetRevision,
+			AppName:               name,
+			Namespace:             dest.Namespace,
+			ApplicationSource:     &source,
+			KustomizeOptions:      kustomizeOptions,
+			KubeVersion:           kubeVersion,
+			ApiVersions:           apiVersions,
+			HelmOptions:           helmOptions,
+			HelmRepoCreds:         repositoryCredentials,
+			TrackingMethod:        string(GetTrackingMethod(settingsMgr)),
+			EnabledSourceTypes:    enableGenerateManifests,
+			NoRevisionCache:       true,
+			HasMultipleSources:    hasMultipleSources,
+			RefSources:            refSources,
+			ProjectName:           proj.Name,
+			ProjectSourceRepos:    proj.Spec.SourceRepos,
+			HelmChartVerification: apiclient.NewHelmChartVerification(proj),
 		}
 		req.Repo.CopyCredentialsFromRepo(repoRes)
 		req.Repo.CopySettingsFrom(repoRes)
@@ -678,6 +678,64 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "is required if the manifest source is a helm chart")
 	})
 
+	t.Run("Source hydrator cannot be combined with source", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{RepoURL: "http://some/where", Path: "."},
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource:  argoappv1.DrySource{RepoURL: "http://some/where", TargetRevision: "HEAD", Path: "dry"},
+				SyncSource: argoappv1.SyncSource{TargetBranch: "env/dev", Path: "app"},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "spec.source and spec.sources must be empty if spec.sourceHydrator is set", conditions[0].Message)
+	})
+
+	t.Run("Source hydrator requires sync branch", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource:  argoappv1.DrySource{RepoURL: "http://some/where", TargetRevision: "HEAD", Path: "dry"},
+				SyncSource: argoappv1.SyncSource{Path: "app"},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.syncSource.targetBranch is required", conditions[0].Message)
+	})
+
+	t.Run("Source hydrator sync source is validated against project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource:  argoappv1.DrySource{RepoURL: "http://some/where", TargetRevision: "HEAD", Path: "dry"},
+				SyncSource: argoappv1.SyncSource{TargetBranch: "env/dev", Path: "app"},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"http://some/where/else"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
+	})
+
 	t.Run("Application source is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
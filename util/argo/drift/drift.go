@@ -0,0 +1,368 @@
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// maxTrackedFields is the maximum number of drifted fields tracked per application
+const maxTrackedFields = 200
+
+// FieldDrift describes a field of a managed resource whose live value differs from the desired value
+type FieldDrift struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// JSONPointer is the JSON pointer of the drifted field
+	JSONPointer string `json:"jsonPointer"`
+	// JQPathExpression selects the drifted field using the name of the list items instead of their index. It is
+	// empty if the field does not belong to a list item identified by name.
+	JQPathExpression string `json:"jqPathExpression,omitempty"`
+	// Manager is the field manager owning the live value of the field, if any
+	Manager string `json:"manager,omitempty"`
+	// Reconciliations is the number of consecutive reconciliations the field has drifted
+	Reconciliations int64 `json:"reconciliations"`
+	// Since is the time of the first reconciliation the field has drifted
+	Since time.Time `json:"since"`
+}
+
+func (f *FieldDrift) key() string {
+	return fmt.Sprintf("%s/%s/%s/%s%s", f.Group, f.Kind, f.Namespace, f.Name, f.JSONPointer)
+}
+
+// IsPersistent returns true if the field has drifted for at least the given number of reconciliations
+func (f *FieldDrift) IsPersistent(minReconciliations int64) bool {
+	return f.Reconciliations >= minReconciliations
+}
+
+// AppDrift holds the fields of the resources managed by an application which continuously differ from their
+// desired values.
+type AppDrift struct {
+	Fields []FieldDrift `json:"fields,omitempty"`
+}
+
+// Track returns the drift resulting from a reconciliation which observed the given drifted fields: the reconciliation
+// count of the fields which already drifted is incremented while the fields which no longer drift are forgotten.
+func (d *AppDrift) Track(observed []FieldDrift, now time.Time) *AppDrift {
+	previous := map[string]FieldDrift{}
+	if d != nil {
+		for _, f := range d.Fields {
+			previous[f.key()] = f
+		}
+	}
+	res := &AppDrift{}
+	for _, f := range observed {
+		if prev, ok := previous[f.key()]; ok {
+			f.Reconciliations = prev.Reconciliations + 1
+			f.Since = prev.Since
+		} else {
+			f.Reconciliations = 1
+			f.Since = now
+		}
+		res.Fields = append(res.Fields, f)
+	}
+	sort.SliceStable(res.Fields, func(i, j int) bool {
+		return res.Fields[i].key() < res.Fields[j].key()
+	})
+	if len(res.Fields) > maxTrackedFields {
+		res.Fields = res.Fields[:maxTrackedFields]
+	}
+	return res
+}
+
+// PersistentFields returns the fields which have drifted for at least the given number of reconciliations
+func (d *AppDrift) PersistentFields(minReconciliations int64) []FieldDrift {
+	var res []FieldDrift
+	if d == nil {
+		return res
+	}
+	for _, f := range d.Fields {
+		if f.IsPersistent(minReconciliations) {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// pathElement is an element of the path of a field: a map key, or a list item identified by its index and, if the
+// item has one, its name.
+type pathElement struct {
+	key   string
+	index int
+	name  string
+	isKey bool
+}
+
+// DriftedFields returns the fields which differ between the normalized live state and the predicted live state of
+// the given resource. The live object is used to find the field manager owning each field.
+func DriftedFields(live *unstructured.Unstructured, normalizedLive, predictedLive []byte) ([]FieldDrift, error) {
+	if live == nil {
+		return nil, nil
+	}
+	paths, err := getDriftedPaths(normalizedLive, predictedLive)
+	if err != nil {
+		return nil, err
+	}
+	managers, err := getManagedFieldSets(live)
+	if err != nil {
+		return nil, err
+	}
+	gvk := live.GroupVersionKind()
+	var res []FieldDrift
+	for _, path := range paths {
+		res = append(res, FieldDrift{
+			Group:            gvk.Group,
+			Kind:             gvk.Kind,
+			Namespace:        live.GetNamespace(),
+			Name:             live.GetName(),
+			JSONPointer:      toJSONPointer(path),
+			JQPathExpression: toJQPathExpression(path),
+			Manager:          getFieldManager(managers, path),
+		})
+	}
+	return res, nil
+}
+
+// getDriftedPaths returns the paths of the leaf fields which differ between the normalized and predicted live states
+func getDriftedPaths(normalizedLive, predictedLive []byte) ([][]pathElement, error) {
+	var liveObj, predictedObj interface{}
+	if err := json.Unmarshal(normalizedLive, &liveObj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+	}
+	if err := json.Unmarshal(predictedLive, &predictedObj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	var paths [][]pathElement
+	diffPaths(nil, liveObj, predictedObj, &paths)
+	return paths, nil
+}
+
+// diffPaths appends the paths of the leaf fields which differ between live and predicted
+func diffPaths(path []pathElement, live, predicted interface{}, paths *[][]pathElement) {
+	appendPath := func(elem pathElement) {
+		*paths = append(*paths, append(append([]pathElement{}, path...), elem))
+	}
+	switch liveVal := live.(type) {
+	case map[string]interface{}:
+		predictedVal, ok := predicted.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := map[string]bool{}
+		for k := range liveVal {
+			keys[k] = true
+		}
+		for k := range predictedVal {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		for _, k := range sortedKeys {
+			elem := pathElement{key: k, isKey: true}
+			l, liveOk := liveVal[k]
+			p, predictedOk := predictedVal[k]
+			if !liveOk || !predictedOk {
+				appendPath(elem)
+				continue
+			}
+			diffPaths(append(path, elem), l, p, paths)
+		}
+		return
+	case []interface{}:
+		predictedVal, ok := predicted.([]interface{})
+		if !ok {
+			break
+		}
+		liveNames, liveNamed := getItemNames(liveVal)
+		predictedNames, predictedNamed := getItemNames(predictedVal)
+		if liveNamed && predictedNamed {
+			// list items identified by name are matched by name rather than by index
+			for i, name := range predictedNames {
+				elem := pathElement{index: i, name: name}
+				j, ok := indexOf(liveNames, name)
+				if !ok {
+					appendPath(elem)
+					continue
+				}
+				diffPaths(append(path, elem), liveVal[j], predictedVal[i], paths)
+			}
+			for i, name := range liveNames {
+				if _, ok := indexOf(predictedNames, name); !ok {
+					appendPath(pathElement{index: i, name: name})
+				}
+			}
+			return
+		}
+		for i := 0; i < len(liveVal) || i < len(predictedVal); i++ {
+			elem := pathElement{index: i}
+			if i >= len(liveVal) || i >= len(predictedVal) {
+				appendPath(elem)
+				continue
+			}
+			diffPaths(append(path, elem), liveVal[i], predictedVal[i], paths)
+		}
+		return
+	}
+	if !reflect.DeepEqual(live, predicted) {
+		*paths = append(*paths, append([]pathElement{}, path...))
+	}
+}
+
+// getItemNames returns the names of the list items if every item is a map with a unique name
+func getItemNames(items []interface{}) ([]string, bool) {
+	names := make([]string, len(items))
+	seen := map[string]bool{}
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || seen[name] {
+			return nil, false
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names, len(items) > 0
+}
+
+func indexOf(names []string, name string) (int, bool) {
+	for i := range names {
+		if names[i] == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func toJSONPointer(path []pathElement) string {
+	var sb strings.Builder
+	for _, elem := range path {
+		sb.WriteString("/")
+		if elem.isKey {
+			sb.WriteString(jsonPointerEscaper.Replace(elem.key))
+		} else {
+			sb.WriteString(strconv.Itoa(elem.index))
+		}
+	}
+	return sb.String()
+}
+
+var jqIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func toJQPathExpression(path []pathElement) string {
+	named := false
+	afterPipe := false
+	var sb strings.Builder
+	for _, elem := range path {
+		switch {
+		case elem.isKey && jqIdentifier.MatchString(elem.key):
+			sb.WriteString("." + elem.key)
+		case elem.isKey:
+			sb.WriteString("." + strconv.Quote(elem.key))
+		case elem.name != "":
+			named = true
+			sb.WriteString(fmt.Sprintf("[] | select(.name == %s) | ", strconv.Quote(elem.name)))
+		case afterPipe:
+			sb.WriteString(fmt.Sprintf(".[%d]", elem.index))
+		default:
+			sb.WriteString(fmt.Sprintf("[%d]", elem.index))
+		}
+		afterPipe = !elem.isKey && elem.name != ""
+	}
+	if !named {
+		return ""
+	}
+	return strings.TrimSuffix(sb.String(), " | ")
+}
+
+// managedFieldSet holds the fields owned by a field manager and the last operation which changed them
+type managedFieldSet struct {
+	manager   string
+	operation metav1.ManagedFieldsOperationType
+	time      *metav1.Time
+	fields    *fieldpath.Set
+}
+
+func getManagedFieldSets(live *unstructured.Unstructured) ([]managedFieldSet, error) {
+	var res []managedFieldSet
+	for _, mf := range live.GetManagedFields() {
+		if mf.FieldsV1 == nil {
+			continue
+		}
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error parsing managed fields of manager %s: %w", mf.Manager, err)
+		}
+		res = append(res, managedFieldSet{manager: mf.Manager, operation: mf.Operation, time: mf.Time, fields: fields})
+	}
+	return res, nil
+}
+
+// getFieldManager returns the manager owning the field at the given path or one of its children
+func getFieldManager(managers []managedFieldSet, path []pathElement) string {
+	if owner := getFieldOwner(managers, path); owner != nil {
+		return owner.manager
+	}
+	return ""
+}
+
+// getFieldOwner returns the managed field set owning the field at the given path or one of its children, or nil if
+// the field is not owned by any field manager
+func getFieldOwner(managers []managedFieldSet, path []pathElement) *managedFieldSet {
+	fieldPath := make(fieldpath.Path, len(path))
+	for i, elem := range path {
+		switch {
+		case elem.isKey:
+			key := elem.key
+			fieldPath[i] = fieldpath.PathElement{FieldName: &key}
+		case elem.name != "":
+			fieldPath[i] = fieldpath.PathElement{Key: &value.FieldList{{Name: "name", Value: value.NewValueInterface(elem.name)}}}
+		default:
+			index := elem.index
+			fieldPath[i] = fieldpath.PathElement{Index: &index}
+		}
+	}
+	for i := range managers {
+		if ownsField(managers[i].fields, fieldPath) {
+			return &managers[i]
+		}
+	}
+	return nil
+}
+
+func ownsField(set *fieldpath.Set, path fieldpath.Path) bool {
+	if len(path) == 0 {
+		return false
+	}
+	for i, elem := range path {
+		if i == len(path)-1 && set.Members.Has(elem) {
+			return true
+		}
+		child, ok := set.Children.Get(elem)
+		if !ok {
+			return false
+		}
+		set = child
+	}
+	return !set.Empty()
+}
@@ -0,0 +1,165 @@
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+const liveDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  managedFields:
+  - manager: argocd-controller
+    operation: Apply
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"guestbook"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: sidecar
+        image: sidecar:1
+      - name: guestbook
+        image: guestbook:2
+`
+
+const predictedDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:1
+      - name: sidecar
+        image: sidecar:1
+`
+
+func toJSON(t *testing.T, manifest string) []byte {
+	t.Helper()
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	require.NoError(t, err)
+	return data
+}
+
+func TestDriftedFields(t *testing.T) {
+	live := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(liveDeployment), &live.Object))
+	normalizedLive := live.DeepCopy()
+	unstructured.RemoveNestedField(normalizedLive.Object, "metadata", "managedFields")
+	normalizedLiveJSON, err := normalizedLive.MarshalJSON()
+	require.NoError(t, err)
+
+	fields, err := DriftedFields(live, normalizedLiveJSON, toJSON(t, predictedDeployment))
+	require.NoError(t, err)
+	assert.Equal(t, []FieldDrift{{
+		Group:       "apps",
+		Kind:        "Deployment",
+		Namespace:   "default",
+		Name:        "guestbook",
+		JSONPointer: "/spec/replicas",
+		Manager:     "kube-controller-manager",
+	}, {
+		Group:            "apps",
+		Kind:             "Deployment",
+		Namespace:        "default",
+		Name:             "guestbook",
+		JSONPointer:      "/spec/template/spec/containers/0/image",
+		JQPathExpression: `.spec.template.spec.containers[] | select(.name == "guestbook") | .image`,
+		Manager:          common.ArgoCDSSAManager,
+	}}, fields)
+
+	fields, err = DriftedFields(nil, []byte("null"), toJSON(t, predictedDeployment))
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+}
+
+func TestDiffPaths(t *testing.T) {
+	diff := func(live, predicted string) []string {
+		var liveObj, predictedObj interface{}
+		require.NoError(t, yaml.Unmarshal([]byte(live), &liveObj))
+		require.NoError(t, yaml.Unmarshal([]byte(predicted), &predictedObj))
+		var paths [][]pathElement
+		diffPaths(nil, liveObj, predictedObj, &paths)
+		var res []string
+		for _, path := range paths {
+			res = append(res, toJSONPointer(path))
+		}
+		return res
+	}
+	assert.Empty(t, diff(`{a: 1}`, `{a: 1}`))
+	assert.Equal(t, []string{"/a", "/b"}, diff(`{a: 1}`, `{a: 2, b: 3}`))
+	assert.Equal(t, []string{"/a~1b"}, diff(`{a/b: 1}`, `{a/b: 2}`))
+	assert.Equal(t, []string{"/a/1", "/a/2"}, diff(`{a: [1, 2, 3]}`, `{a: [1, 3]}`))
+	assert.Equal(t, []string{"/a/0/value", "/a/1"}, diff(`{a: [{name: x, value: 1}]}`, `{a: [{name: x, value: 2}, {name: y}]}`))
+	assert.Equal(t, []string{"/a"}, diff(`{a: [1]}`, `{a: {b: 1}}`))
+}
+
+func TestToJQPathExpression(t *testing.T) {
+	assert.Empty(t, toJQPathExpression([]pathElement{{key: "spec", isKey: true}, {index: 1}}))
+	assert.Equal(t, `.spec.containers[] | select(.name == "app") | .ports[0]`, toJQPathExpression([]pathElement{
+		{key: "spec", isKey: true}, {key: "containers", isKey: true}, {name: "app"}, {key: "ports", isKey: true}, {index: 0},
+	}))
+	assert.Equal(t, `.metadata."app.kubernetes.io/name".items[] | select(.name == "app") | .[0]`, toJQPathExpression([]pathElement{
+		{key: "metadata", isKey: true}, {key: "app.kubernetes.io/name", isKey: true}, {key: "items", isKey: true}, {name: "app"}, {index: 0},
+	}))
+	assert.Equal(t, `.items[] | select(.name == "app")`, toJQPathExpression([]pathElement{{key: "items", isKey: true}, {name: "app"}}))
+}
+
+func TestAppDrift_Track(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	replicas := FieldDrift{Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/replicas"}
+	image := FieldDrift{Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/template/spec/containers/0/image"}
+
+	var appDrift *AppDrift
+	appDrift = appDrift.Track([]FieldDrift{replicas}, start)
+	assert.Equal(t, int64(1), appDrift.Fields[0].Reconciliations)
+	assert.Equal(t, start, appDrift.Fields[0].Since)
+
+	appDrift = appDrift.Track([]FieldDrift{replicas, image}, start.Add(time.Minute))
+	require.Len(t, appDrift.Fields, 2)
+	assert.Equal(t, "/spec/replicas", appDrift.Fields[0].JSONPointer)
+	assert.Equal(t, int64(2), appDrift.Fields[0].Reconciliations)
+	assert.Equal(t, start, appDrift.Fields[0].Since)
+	assert.Equal(t, int64(1), appDrift.Fields[1].Reconciliations)
+	assert.Equal(t, start.Add(time.Minute), appDrift.Fields[1].Since)
+	assert.Equal(t, []FieldDrift{appDrift.Fields[0]}, appDrift.PersistentFields(2))
+
+	// fields which no longer drift are forgotten
+	appDrift = appDrift.Track([]FieldDrift{image}, start.Add(2*time.Minute))
+	require.Len(t, appDrift.Fields, 1)
+	assert.Equal(t, int64(2), appDrift.Fields[0].Reconciliations)
+	assert.Empty(t, appDrift.Track(nil, start).Fields)
+}
@@ -0,0 +1,35 @@
+package drift
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// GetFieldChanges returns the fields which differ between the normalized live state and the predicted live state of
+// the given resource, each attributed to the field manager which last changed it according to the managed fields of
+// the live object.
+func GetFieldChanges(live *unstructured.Unstructured, normalizedLive, predictedLive []byte) ([]v1alpha1.ResourceFieldChange, error) {
+	if live == nil {
+		return nil, nil
+	}
+	paths, err := getDriftedPaths(normalizedLive, predictedLive)
+	if err != nil {
+		return nil, err
+	}
+	managers, err := getManagedFieldSets(live)
+	if err != nil {
+		return nil, err
+	}
+	var res []v1alpha1.ResourceFieldChange
+	for _, path := range paths {
+		change := v1alpha1.ResourceFieldChange{Path: toJSONPointer(path)}
+		if owner := getFieldOwner(managers, path); owner != nil {
+			change.Manager = owner.manager
+			change.Operation = string(owner.operation)
+			change.ChangedAt = owner.time
+		}
+		res = append(res, change)
+	}
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestGetFieldChanges(t *testing.T) {
+	live := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(liveDeployment), &live.Object))
+	changedAt := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	managedFields := live.GetManagedFields()
+	managedFields[1].Time = &changedAt
+	live.SetManagedFields(managedFields)
+
+	normalizedLive := live.DeepCopy()
+	unstructured.RemoveNestedField(normalizedLive.Object, "metadata", "managedFields")
+	normalizedLiveJSON, err := normalizedLive.MarshalJSON()
+	require.NoError(t, err)
+	predicted := toJSON(t, predictedDeployment)
+
+	changes, err := GetFieldChanges(live, normalizedLiveJSON, predicted)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.NotNil(t, changes[0].ChangedAt)
+	assert.True(t, changedAt.Equal(changes[0].ChangedAt))
+	changes[0].ChangedAt = nil
+	assert.Equal(t, []v1alpha1.ResourceFieldChange{{
+		Path:      "/spec/replicas",
+		Manager:   "kube-controller-manager",
+		Operation: "Update",
+	}, {
+		Path:      "/spec/template/spec/containers/0/image",
+		Manager:   common.ArgoCDSSAManager,
+		Operation: "Apply",
+	}}, changes)
+
+	// fields which are not owned by any manager are not attributed
+	live.SetManagedFields(nil)
+	changes, err = GetFieldChanges(live, normalizedLiveJSON, predicted)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ResourceFieldChange{
+		{Path: "/spec/replicas"},
+		{Path: "/spec/template/spec/containers/0/image"},
+	}, changes)
+
+	changes, err = GetFieldChanges(nil, []byte("null"), predicted)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
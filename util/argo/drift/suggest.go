@@ -0,0 +1,124 @@
+package drift
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// SuggestOptions controls how ignore differences rules are derived from drifted fields
+type SuggestOptions struct {
+	// MinReconciliations is the number of consecutive reconciliations a field must have drifted to be ignored
+	MinReconciliations int64
+	// UseManagers ignores the fields owned by a field manager using managedFieldsManagers rather than their path
+	UseManagers bool
+}
+
+// ignoredBy returns the fields which should be ignored for the given drifted field
+func (opts SuggestOptions) ignoredBy(f FieldDrift) v1alpha1.OverrideIgnoreDiff {
+	switch {
+	case opts.UseManagers && f.Manager != "" && f.Manager != common.ArgoCDSSAManager:
+		return v1alpha1.OverrideIgnoreDiff{ManagedFieldsManagers: []string{f.Manager}}
+	case f.JQPathExpression != "":
+		return v1alpha1.OverrideIgnoreDiff{JQPathExpressions: []string{f.JQPathExpression}}
+	default:
+		return v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{f.JSONPointer}}
+	}
+}
+
+// SuggestIgnoreDifferences returns the application level rules ignoring the persistently drifted fields of the
+// application merged into its existing rules.
+func SuggestIgnoreDifferences(existing []v1alpha1.ResourceIgnoreDifferences, d *AppDrift, opts SuggestOptions) []v1alpha1.ResourceIgnoreDifferences {
+	res := slices.Clone(existing)
+	for _, f := range d.PersistentFields(opts.MinReconciliations) {
+		ignored := opts.ignoredBy(f)
+		i := slices.IndexFunc(res, func(rule v1alpha1.ResourceIgnoreDifferences) bool {
+			return rule.Group == f.Group && rule.Kind == f.Kind && rule.Name == "" && rule.Namespace == ""
+		})
+		if i < 0 {
+			res = append(res, v1alpha1.ResourceIgnoreDifferences{Group: f.Group, Kind: f.Kind})
+			i = len(res) - 1
+		}
+		res[i].JSONPointers = mergeStrings(res[i].JSONPointers, ignored.JSONPointers)
+		res[i].JQPathExpressions = mergeStrings(res[i].JQPathExpressions, ignored.JQPathExpressions)
+		res[i].ManagedFieldsManagers = mergeStrings(res[i].ManagedFieldsManagers, ignored.ManagedFieldsManagers)
+	}
+	return res
+}
+
+// SuggestResourceOverrides returns the system level rules, keyed by group kind, ignoring the persistently drifted
+// fields of the given applications merged into the existing resource overrides.
+func SuggestResourceOverrides(existing map[string]v1alpha1.ResourceOverride, drifts []*AppDrift, opts SuggestOptions) map[string]v1alpha1.OverrideIgnoreDiff {
+	res := map[string]v1alpha1.OverrideIgnoreDiff{}
+	for _, d := range drifts {
+		for _, f := range d.PersistentFields(opts.MinReconciliations) {
+			key := GetResourceOverrideKey(schema.GroupKind{Group: f.Group, Kind: f.Kind})
+			rule, ok := res[key]
+			if !ok {
+				rule = existing[key].IgnoreDifferences
+			}
+			ignored := opts.ignoredBy(f)
+			rule.JSONPointers = mergeStrings(rule.JSONPointers, ignored.JSONPointers)
+			rule.JQPathExpressions = mergeStrings(rule.JQPathExpressions, ignored.JQPathExpressions)
+			rule.ManagedFieldsManagers = mergeStrings(rule.ManagedFieldsManagers, ignored.ManagedFieldsManagers)
+			res[key] = rule
+		}
+	}
+	return res
+}
+
+// GetResourceOverrideKey returns the key of the resource overrides of the given group kind
+func GetResourceOverrideKey(gk schema.GroupKind) string {
+	if gk.Group == "" {
+		return gk.Kind
+	}
+	return fmt.Sprintf("%s/%s", gk.Group, gk.Kind)
+}
+
+// WouldBeSynced returns true if the application is out of sync only because of persistently drifted fields, which
+// means that it would be synced once the suggested rules are applied.
+func WouldBeSynced(app *v1alpha1.Application, d *AppDrift, minReconciliations int64) bool {
+	if app.Status.Sync.Status != v1alpha1.SyncStatusCodeOutOfSync || d == nil {
+		return false
+	}
+	outOfSync := false
+	for _, res := range app.Status.Resources {
+		if res.Status != v1alpha1.SyncStatusCodeOutOfSync {
+			continue
+		}
+		if res.RequiresPruning {
+			return false
+		}
+		outOfSync = true
+		drifted := false
+		for _, f := range d.Fields {
+			if f.Group != res.Group || f.Kind != res.Kind || f.Namespace != res.Namespace || f.Name != res.Name {
+				continue
+			}
+			if !f.IsPersistent(minReconciliations) {
+				return false
+			}
+			drifted = true
+		}
+		// resources which are missing do not have drifted fields
+		if !drifted {
+			return false
+		}
+	}
+	return outOfSync
+}
+
+// mergeStrings returns a copy of existing with the given values appended unless already present
+func mergeStrings(existing []string, values []string) []string {
+	res := slices.Clone(existing)
+	for _, v := range values {
+		if !slices.Contains(res, v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
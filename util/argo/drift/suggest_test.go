@@ -0,0 +1,85 @@
+package drift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestSuggestIgnoreDifferences(t *testing.T) {
+	appDrift := &AppDrift{Fields: []FieldDrift{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/replicas", Manager: "kube-controller-manager", Reconciliations: 10},
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/template/spec/containers/0/image", JQPathExpression: `.spec.template.spec.containers[] | select(.name == "guestbook") | .image`, Manager: common.ArgoCDSSAManager, Reconciliations: 10},
+		{Kind: "Service", Name: "guestbook", JSONPointer: "/spec/ports/0/nodePort", Reconciliations: 2},
+	}}
+	existing := []v1alpha1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/paused"}}}
+
+	rules := SuggestIgnoreDifferences(existing, appDrift, SuggestOptions{MinReconciliations: 10})
+	assert.Equal(t, []v1alpha1.ResourceIgnoreDifferences{{
+		Group:             "apps",
+		Kind:              "Deployment",
+		JSONPointers:      []string{"/spec/paused", "/spec/replicas"},
+		JQPathExpressions: []string{`.spec.template.spec.containers[] | select(.name == "guestbook") | .image`},
+	}}, rules)
+	// the existing rules are not modified
+	assert.Equal(t, []string{"/spec/paused"}, existing[0].JSONPointers)
+
+	rules = SuggestIgnoreDifferences(nil, appDrift, SuggestOptions{MinReconciliations: 2, UseManagers: true})
+	assert.Equal(t, []v1alpha1.ResourceIgnoreDifferences{{
+		Group:                 "apps",
+		Kind:                  "Deployment",
+		JQPathExpressions:     []string{`.spec.template.spec.containers[] | select(.name == "guestbook") | .image`},
+		ManagedFieldsManagers: []string{"kube-controller-manager"},
+	}, {
+		Kind:         "Service",
+		JSONPointers: []string{"/spec/ports/0/nodePort"},
+	}}, rules)
+}
+
+func TestSuggestResourceOverrides(t *testing.T) {
+	drifts := []*AppDrift{
+		{Fields: []FieldDrift{{Group: "apps", Kind: "Deployment", Name: "app1", JSONPointer: "/spec/replicas", Reconciliations: 5}}},
+		{Fields: []FieldDrift{
+			{Group: "apps", Kind: "Deployment", Name: "app2", JSONPointer: "/spec/replicas", Reconciliations: 5},
+			{Kind: "Service", Name: "app2", JSONPointer: "/spec/clusterIP", Reconciliations: 5},
+			{Kind: "ConfigMap", Name: "app2", JSONPointer: "/data/key", Reconciliations: 1},
+		}},
+	}
+	existing := map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{ManagedFieldsManagers: []string{"kube-controller-manager"}}},
+	}
+	assert.Equal(t, map[string]v1alpha1.OverrideIgnoreDiff{
+		"apps/Deployment": {JSONPointers: []string{"/spec/replicas"}, ManagedFieldsManagers: []string{"kube-controller-manager"}},
+		"Service":         {JSONPointers: []string{"/spec/clusterIP"}},
+	}, SuggestResourceOverrides(existing, drifts, SuggestOptions{MinReconciliations: 5}))
+}
+
+func TestWouldBeSynced(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+		Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+		Resources: []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync},
+			{Kind: "Service", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced},
+		},
+	}}
+	appDrift := &AppDrift{Fields: []FieldDrift{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/replicas", Reconciliations: 10},
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", JSONPointer: "/spec/paused", Reconciliations: 3},
+	}}
+	assert.True(t, WouldBeSynced(app, appDrift, 3))
+	// some fields did not persistently drift
+	assert.False(t, WouldBeSynced(app, appDrift, 5))
+	assert.False(t, WouldBeSynced(app, nil, 3))
+
+	// missing resources do not have drifted fields
+	missing := app.DeepCopy()
+	missing.Status.Resources = append(missing.Status.Resources, v1alpha1.ResourceStatus{Kind: "ConfigMap", Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync})
+	assert.False(t, WouldBeSynced(missing, appDrift, 3))
+
+	synced := app.DeepCopy()
+	synced.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	assert.False(t, WouldBeSynced(synced, appDrift, 3))
+}
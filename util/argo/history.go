@@ -0,0 +1,95 @@
+package argo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// MaxManifestsSnapshotSize is the maximum size of a compressed manifests snapshot stored in the revision history.
+	// Snapshots are stored in the application, which must not exceed the size limit of Kubernetes objects.
+	MaxManifestsSnapshotSize = 64 * 1024
+	// MaxManifestsSnapshotsTotalSize is the maximum size of all manifests snapshots stored in the revision history of an
+	// application
+	MaxManifestsSnapshotsTotalSize = 256 * 1024
+	// maxManifestsSnapshotUncompressedSize limits the size of decompressed snapshots
+	maxManifestsSnapshotUncompressedSize = 16 * 1024 * 1024
+)
+
+// NewManifestsSnapshot returns the gzip compressed JSON list of the given manifests, with the data of secrets hidden,
+// to be stored in the revision history
+func NewManifestsSnapshot(manifests []*unstructured.Unstructured) ([]byte, error) {
+	items := make([]string, 0, len(manifests))
+	for _, obj := range manifests {
+		if obj == nil {
+			continue
+		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			var err error
+			obj, _, err = diff.HideSecretData(obj, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling manifest: %w", err)
+		}
+		items = append(items, string(data))
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GetManifestsFromSnapshot returns the manifests stored in a snapshot created by NewManifestsSnapshot
+func GetManifestsFromSnapshot(snapshot []byte) ([]string, error) {
+	r, err := gzip.NewReader(bytes.NewReader(snapshot))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing manifests snapshot: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxManifestsSnapshotUncompressedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing manifests snapshot: %w", err)
+	}
+	if len(data) > maxManifestsSnapshotUncompressedSize {
+		return nil, fmt.Errorf("manifests snapshot exceeds maximum size of %d bytes", maxManifestsSnapshotUncompressedSize)
+	}
+	var manifests []string
+	if err := json.Unmarshal(data, &manifests); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifests snapshot: %w", err)
+	}
+	return manifests, nil
+}
+
+// TrimManifestsSnapshots removes the manifests snapshots of the oldest entries of the given revision history until the
+// total size of the snapshots does not exceed MaxManifestsSnapshotsTotalSize
+func TrimManifestsSnapshots(history v1alpha1.RevisionHistories) {
+	total := 0
+	for i := len(history) - 1; i >= 0; i-- {
+		total += len(history[i].ManifestsSnapshot)
+		if total > MaxManifestsSnapshotsTotalSize {
+			total -= len(history[i].ManifestsSnapshot)
+			history[i].ManifestsSnapshot = nil
+		}
+	}
+}
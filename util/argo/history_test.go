@@ -0,0 +1,59 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestManifestsSnapshot(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "my-deployment", "namespace": "default"},
+	}}
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "my-secret", "namespace": "default"},
+		"data":       map[string]interface{}{"password": "c2VjcmV0"},
+	}}
+
+	snapshot, err := NewManifestsSnapshot([]*unstructured.Unstructured{deployment, nil, secret})
+	require.NoError(t, err)
+
+	manifests, err := GetManifestsFromSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	assert.Contains(t, manifests[0], `"name":"my-deployment"`)
+	assert.Contains(t, manifests[1], `"name":"my-secret"`)
+	assert.NotContains(t, manifests[1], "c2VjcmV0")
+}
+
+func TestGetManifestsFromSnapshot_Invalid(t *testing.T) {
+	_, err := GetManifestsFromSnapshot([]byte("not a snapshot"))
+	require.Error(t, err)
+}
+
+func TestTrimManifestsSnapshots(t *testing.T) {
+	snapshot := make([]byte, MaxManifestsSnapshotSize)
+	history := v1alpha1.RevisionHistories{}
+	for i := 0; i < 6; i++ {
+		history = append(history, v1alpha1.RevisionHistory{ID: int64(i), ManifestsSnapshot: snapshot})
+	}
+	history[4].ManifestsSnapshot = nil
+
+	TrimManifestsSnapshots(history)
+	var kept []int64
+	for _, h := range history {
+		if h.ManifestsSnapshot != nil {
+			kept = append(kept, h.ID)
+		}
+	}
+	// the snapshots of the newest entries are kept
+	assert.Equal(t, []int64{1, 2, 3, 5}, kept)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/env"
 )
@@ -71,6 +72,18 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+func appDriftKey(appName string) string {
+	return fmt.Sprintf("app|drift|%s", appName)
+}
+
+func (c *Cache) GetAppDrift(appName string, res *drift.AppDrift) error {
+	return c.GetItem(appDriftKey(appName), res)
+}
+
+func (c *Cache) SetAppDrift(appName string, appDrift *drift.AppDrift) error {
+	return c.SetItem(appDriftKey(appName), appDrift, c.appStateCacheExpiration, appDrift == nil)
+}
+
 func appResourcesTreeKey(appName string, shard int64) string {
 	key := fmt.Sprintf("app|resources-tree|%s", appName)
 	if shard > 0 {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	. "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo/drift"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 )
 
@@ -41,6 +42,27 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppDrift(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &drift.AppDrift{}
+	err := cache.GetAppDrift("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	appDrift := &drift.AppDrift{Fields: []drift.FieldDrift{{Kind: "Deployment", Name: "my-name", JSONPointer: "/spec/replicas", Reconciliations: 2}}}
+	err = cache.SetAppDrift("my-appname", appDrift)
+	require.NoError(t, err)
+	// cache hit
+	err = cache.GetAppDrift("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, appDrift, value)
+	// delete
+	err = cache.SetAppDrift("my-appname", nil)
+	require.NoError(t, err)
+	err = cache.GetAppDrift("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
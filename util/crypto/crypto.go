@@ -15,14 +15,25 @@ import (
 func KeyFromPassphrase(passphrase string) ([]byte, error) {
 	// salt is just a hash of a passphrase (effectively no salt)
 	salt := sha256.Sum256([]byte(passphrase))
+	return KeyFromPassphraseAndSalt(passphrase, salt[:])
+}
+
+// KeyFromPassphraseAndSalt generates 32 byte key from the passphrase and the given salt
+func KeyFromPassphraseAndSalt(passphrase string, salt []byte) ([]byte, error) {
 	// These defaults will consume approximately 16MB of memory (128 * r * N)
 	const N = 16384
 	const r = 8
-	return scrypt.Key([]byte(passphrase), salt[:], N, r, 1, 32)
+	return scrypt.Key([]byte(passphrase), salt, N, r, 1, 32)
 }
 
 // Encrypt encrypts the given data with the given passphrase.
 func Encrypt(data []byte, key []byte) ([]byte, error) {
+	return EncryptWithAdditionalData(data, key, nil)
+}
+
+// EncryptWithAdditionalData encrypts the given data with the given passphrase. The additional data is not encrypted,
+// but authenticated, i.e. the data can only be decrypted using the same additional data.
+func EncryptWithAdditionalData(data []byte, key []byte, additionalData []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -35,12 +46,18 @@ func Encrypt(data []byte, key []byte) ([]byte, error) {
 	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
 		return nil, err
 	}
-	ciphertext := gcm.Seal(nonce, nonce, data, nil)
+	ciphertext := gcm.Seal(nonce, nonce, data, additionalData)
 	return ciphertext, nil
 }
 
 // Decrypt decrypts the given data using the given passphrase.
 func Decrypt(data []byte, key []byte) ([]byte, error) {
+	return DecryptWithAdditionalData(data, key, nil)
+}
+
+// DecryptWithAdditionalData decrypts the given data using the given passphrase, and verifies that it was encrypted
+// with the given additional data.
+func DecryptWithAdditionalData(data []byte, key []byte, additionalData []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -54,7 +71,7 @@ func Decrypt(data []byte, key []byte) ([]byte, error) {
 		return nil, errors.New("data length is less than nonce size")
 	}
 	nonce, ciphertext := data[:nonceSize], data[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, additionalData)
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +41,19 @@ func TestEncryptDecrypt_Failed(t *testing.T) {
 	_, err = Decrypt(encrypted, wrongKey)
 	assert.Error(t, err)
 }
+
+func TestEncryptDecrypt_AdditionalData(t *testing.T) {
+	key, err := newKey()
+	require.NoError(t, err)
+	encrypted, err := EncryptWithAdditionalData([]byte("test"), key, []byte("header"))
+	require.NoError(t, err)
+
+	decrypted, err := DecryptWithAdditionalData(encrypted, key, []byte("header"))
+	require.NoError(t, err)
+	assert.Equal(t, "test", string(decrypted))
+
+	_, err = DecryptWithAdditionalData(encrypted, key, []byte("tampered header"))
+	require.Error(t, err)
+	_, err = Decrypt(encrypted, key)
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"bytes"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -77,14 +78,39 @@ type Client interface {
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
 	VerifyCommitSignature(string) (string, error)
+	// CommitSignature returns the armored signature of the given commit or annotated tag and the payload it signs, or
+	// a nil signature if it is not signed.
+	CommitSignature(revision string) ([]byte, []byte, error)
 	IsAnnotatedTag(string) bool
 	ChangedFiles(revision string, targetRevision string) ([]string, error)
 	IsRevisionPresent(revision string) bool
+	// SparseCheckout limits the working tree to the files matching the given gitignore style patterns. Sparse
+	// checkout is disabled if no patterns are given. Takes effect on the next checkout at the latest.
+	SparseCheckout(patterns []string) error
+	// SparseCheckoutPatterns returns the patterns the working tree is limited to, or nil if sparse checkout is
+	// disabled.
+	SparseCheckoutPatterns() ([]string, error)
+	// SetAuthor sets the author name and email in the git configuration.
+	SetAuthor(name, email string) (string, error)
+	// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
+	CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error)
+	// CheckoutOrNew checks out the given branch. If the branch does not exist, it creates an empty branch based on
+	// the base branch.
+	CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error)
+	// RemoveContents removes the given paths from the git repository.
+	RemoveContents(paths []string) (string, error)
+	// CommitAndPush commits and pushes changes to the target branch. Nothing is pushed if there are no changes and the
+	// remote branch is up to date.
+	CommitAndPush(branch, message string) (string, error)
 }
 
 type EventHandlers struct {
 	OnLsRemote func(repo string) func()
 	OnFetch    func(repo string) func()
+	OnPush     func(repo string) func()
+	// OnMirror is called when a revision is looked up in a repository which uses a mirror, with hit set to whether
+	// the revision was available without fetching it from the remote
+	OnMirror func(repo string, hit bool)
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -109,6 +135,13 @@ type nativeGitClient struct {
 	proxy string
 	// list of targets that shouldn't use the proxy, applies only if the proxy is set
 	noProxy string
+	// store of the mirror the repository borrows objects from and fetches through, if any
+	mirror *MirrorStore
+	// scope of the mirror, see MirrorStore
+	mirrorScope string
+	// filter used to fetch only some objects from the remote, e.g. blob:none. Objects which are missing locally are
+	// fetched on demand.
+	partialCloneFilter string
 }
 
 type runOpts struct {
@@ -154,6 +187,24 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithMirror makes the client borrow objects from, and fetch through, a mirror of the repository in the given store.
+// LFS enabled repositories never use a mirror.
+func WithMirror(store *MirrorStore, scope string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.mirror = store
+		c.mirrorScope = scope
+	}
+}
+
+// WithPartialCloneFilter makes the client fetch only the objects matching the given filter, e.g. blob:none. Missing
+// objects, such as the blobs of files in a sparse checkout, are fetched on demand during checkout. Not used with mirrors,
+// which always contain all objects.
+func WithPartialCloneFilter(filter string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialCloneFilter = filter
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile("(/|:)")
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -180,6 +231,12 @@ func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, en
 	for i := range opts {
 		opts[i](client)
 	}
+	if client.enableLfs {
+		client.mirror = nil
+	}
+	if client.mirror != nil {
+		client.partialCloneFilter = ""
+	}
 	return client, nil
 }
 
@@ -318,14 +375,24 @@ func (m *nativeGitClient) Root() string {
 // Init initializes a local git repository and sets the remote origin
 func (m *nativeGitClient) Init() error {
 	_, err := git.PlainOpen(m.root)
-	if err == nil {
-		return nil
+	if err != nil {
+		if !errors.Is(err, git.ErrRepositoryNotExists) {
+			return err
+		}
+		if err := m.initLocal(); err != nil {
+			return err
+		}
 	}
-	if !errors.Is(err, git.ErrRepositoryNotExists) {
-		return err
+	if m.mirror != nil {
+		return m.initMirror()
 	}
+	return nil
+}
+
+// initLocal initializes the local git repository
+func (m *nativeGitClient) initLocal() error {
 	log.Infof("Initializing %s to %s", m.repoURL, m.root)
-	err = os.RemoveAll(m.root)
+	err := os.RemoveAll(m.root)
 	if err != nil {
 		return fmt.Errorf("unable to clean repo at %s: %w", m.root, err)
 	}
@@ -350,13 +417,18 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 }
 
 func (m *nativeGitClient) fetch(revision string) error {
-	var err error
+	if m.mirror != nil {
+		return m.fetchMirror(revision)
+	}
+	args := []string{"fetch", "origin"}
 	if revision != "" {
-		err = m.runCredentialedCmd("fetch", "origin", revision, "--tags", "--force", "--prune")
-	} else {
-		err = m.runCredentialedCmd("fetch", "origin", "--tags", "--force", "--prune")
+		args = append(args, revision)
 	}
-	return err
+	args = append(args, "--tags", "--force", "--prune")
+	if m.partialCloneFilter != "" {
+		args = append(args, "--filter="+m.partialCloneFilter)
+	}
+	return m.runCredentialedCmd(args...)
 }
 
 // IsRevisionPresent checks to see if the given revision already exists locally.
@@ -367,11 +439,11 @@ func (m *nativeGitClient) IsRevisionPresent(revision string) bool {
 
 	cmd := exec.Command("git", "cat-file", "-t", revision)
 	out, err := m.runCmdOutput(cmd, runOpts{SkipErrorLogging: true})
-	if out == "commit" && err == nil {
-		return true
-	} else {
-		return false
+	present := out == "commit" && err == nil
+	if m.mirror != nil && m.OnMirror != nil {
+		m.OnMirror(m.repoURL, present)
 	}
+	return present
 }
 
 // Fetch fetches latest updates from origin
@@ -464,7 +536,12 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) error
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("checkout", "--force", revision); err != nil {
+	if m.partialCloneFilter != "" {
+		// the checkout fetches missing objects from the remote
+		if err := m.runCredentialedCmd("checkout", "--force", revision); err != nil {
+			return err
+		}
+	} else if _, err := m.runCmd("checkout", "--force", revision); err != nil {
 		return err
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -498,6 +575,47 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) error
 	return nil
 }
 
+// SparseCheckout limits the working tree to the files matching the given patterns, or disables sparse checkout if no
+// patterns are given
+func (m *nativeGitClient) SparseCheckout(patterns []string) error {
+	if len(patterns) == 0 {
+		current, err := m.SparseCheckoutPatterns()
+		if err != nil || current == nil {
+			return err
+		}
+		_, err = m.runCmd("sparse-checkout", "disable")
+		return err
+	}
+	// git stores the sparse checkout settings in the worktree specific configuration, which git ignores unless the
+	// repository format version is set, and go-git does not set it when initializing a repository
+	if _, err := m.runCmd("config", "core.repositoryformatversion", "1"); err != nil {
+		return err
+	}
+	_, err := m.runCmd(append([]string{"sparse-checkout", "set", "--no-cone"}, patterns...)...)
+	return err
+}
+
+// SparseCheckoutPatterns returns the patterns the working tree is limited to, or nil if sparse checkout is disabled
+func (m *nativeGitClient) SparseCheckoutPatterns() ([]string, error) {
+	// go-git does not read the worktree specific configuration
+	out, err := m.runCmdOutput(exec.Command("git", "config", "--type", "bool", "core.sparseCheckout"), runOpts{SkipErrorLogging: true})
+	if err != nil || strings.TrimSpace(out) != "true" {
+		// git config fails if the option is not set
+		return nil, nil
+	}
+	data, err := os.ReadFile(filepath.Join(m.root, ".git", "info", "sparse-checkout"))
+	if err != nil {
+		return nil, err
+	}
+	patterns := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			patterns = append(patterns, line)
+		}
+	}
+	return patterns, nil
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -768,6 +886,71 @@ func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error)
 	return out, nil
 }
 
+// CommitSignature returns the armored signature of the given commit or annotated tag and the payload it signs
+func (m *nativeGitClient) CommitSignature(revision string) ([]byte, []byte, error) {
+	objectType, err := m.runCmd("cat-file", "-t", revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectType = strings.TrimSpace(objectType)
+	if objectType != "tag" {
+		objectType = "commit"
+	}
+	out, err := m.runCmd("cat-file", objectType, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the trailing newline of the object, which is part of the signed payload, is stripped from the output
+	out += "\n"
+	if objectType == "tag" {
+		signature, payload := parseSignedTag([]byte(out))
+		return signature, payload, nil
+	}
+	signature, payload := parseSignedCommit([]byte(out))
+	return signature, payload, nil
+}
+
+// parseSignedCommit returns the signature of the given raw commit object, which is stored in its gpgsig header, and
+// the commit object without that header, which is the payload the signature was made over
+func parseSignedCommit(commit []byte) ([]byte, []byte) {
+	var signature, payload bytes.Buffer
+	inHeaders, inSignature := true, false
+	for _, line := range strings.SplitAfter(string(commit), "\n") {
+		if inHeaders {
+			if inSignature && strings.HasPrefix(line, " ") {
+				signature.WriteString(line[1:])
+				continue
+			}
+			inSignature = false
+			if strings.HasPrefix(line, "gpgsig ") {
+				inSignature = true
+				signature.WriteString(strings.TrimPrefix(line, "gpgsig "))
+				continue
+			}
+			if line == "\n" {
+				inHeaders = false
+			}
+		}
+		payload.WriteString(line)
+	}
+	if signature.Len() == 0 {
+		return nil, commit
+	}
+	return signature.Bytes(), payload.Bytes()
+}
+
+// parseSignedTag returns the signature of the given raw tag object, which is appended to its message, and the tag
+// object without the signature, which is the payload the signature was made over
+func parseSignedTag(tag []byte) ([]byte, []byte) {
+	for _, marker := range []string{"-----BEGIN PGP SIGNATURE-----", "-----BEGIN SSH SIGNATURE-----", "-----BEGIN SIGNED MESSAGE-----"} {
+		idx := bytes.LastIndex(tag, []byte("\n"+marker))
+		if idx >= 0 {
+			return tag[idx+1:], tag[:idx+1]
+		}
+	}
+	return nil, tag
+}
+
 // IsAnnotatedTag returns true if the revision points to an annotated tag
 func (m *nativeGitClient) IsAnnotatedTag(revision string) bool {
 	cmd := exec.Command("git", "describe", "--exact-match", revision)
@@ -802,6 +985,134 @@ func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) (
 	return files, nil
 }
 
+// SetAuthor sets the author name and email in the git configuration.
+func (m *nativeGitClient) SetAuthor(name, email string) (string, error) {
+	if name != "" {
+		out, err := m.runCmd("config", "--local", "user.name", name)
+		if err != nil {
+			return out, err
+		}
+	}
+	if email != "" {
+		out, err := m.runCmd("config", "--local", "user.email", email)
+		if err != nil {
+			return out, err
+		}
+	}
+	return "", nil
+}
+
+// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch holding an
+// empty initial commit and pushes it to the remote.
+func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error) {
+	err := m.Checkout(branch, submoduleEnabled)
+	if err == nil {
+		return "", nil
+	}
+	// If the branch doesn't exist, create it as an orphan branch.
+	if !strings.Contains(err.Error(), "did not match any file(s) known to git") {
+		return "", fmt.Errorf("failed to checkout branch: %w", err)
+	}
+	out, err := m.runCmd("switch", "--orphan", branch)
+	if err != nil {
+		return out, fmt.Errorf("failed to create orphan branch: %w", err)
+	}
+
+	// Make an empty initial commit.
+	out, err = m.runCmd("commit", "--allow-empty", "-m", "Initial commit")
+	if err != nil {
+		return out, fmt.Errorf("failed to commit initial commit: %w", err)
+	}
+
+	// Push the commit.
+	err = m.runCredentialedCmd("push", "origin", branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to push to branch: %w", err)
+	}
+	return "", nil
+}
+
+// CheckoutOrNew checks out the given branch. If the branch does not exist, it creates an empty branch based on the
+// base branch.
+func (m *nativeGitClient) CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error) {
+	err := m.Checkout(branch, submoduleEnabled)
+	if err == nil {
+		return "", nil
+	}
+	if !strings.Contains(err.Error(), "did not match any file(s) known to git") {
+		return "", fmt.Errorf("failed to checkout branch: %w", err)
+	}
+	// If the branch does not exist, create any empty branch based on the base branch.
+	out, err := m.runCmd("checkout", "-b", branch, base)
+	if err != nil {
+		return out, fmt.Errorf("failed to checkout new branch: %w", err)
+	}
+	return "", nil
+}
+
+// RemoveContents removes the given paths from the git repository.
+func (m *nativeGitClient) RemoveContents(paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+	out, err := m.runCmd(append([]string{"rm", "-r", "--ignore-unmatch", "--"}, paths...)...)
+	if err != nil {
+		return out, fmt.Errorf("failed to clear repo contents: %w", err)
+	}
+	return "", nil
+}
+
+// CommitAndPush commits and pushes changes to the target branch. If there is nothing to commit, the branch is only
+// pushed if the remote branch does not point to the current commit yet, e.g. because the branch was newly created.
+func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error) {
+	out, err := m.runCmd("add", ".")
+	if err != nil {
+		return out, fmt.Errorf("failed to add files: %w", err)
+	}
+
+	out, err = m.runCmd("status", "--porcelain")
+	if err != nil {
+		return out, fmt.Errorf("failed to get git status: %w", err)
+	}
+	if strings.TrimSpace(out) != "" {
+		out, err = m.runCmd("commit", "-m", message)
+		if err != nil {
+			return out, fmt.Errorf("failed to commit: %w", err)
+		}
+	} else {
+		log.Debugf("No changes to commit to branch %s", branch)
+		if m.isPushed(branch) {
+			log.Debugf("Branch %s is up to date, skipping push", branch)
+			return "", nil
+		}
+	}
+
+	if m.OnPush != nil {
+		done := m.OnPush(m.repoURL)
+		defer done()
+	}
+
+	err = m.runCredentialedCmd("push", "origin", branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to push: %w", err)
+	}
+
+	return "", nil
+}
+
+// isPushed returns whether the remote branch, as last fetched, points to the current commit
+func (m *nativeGitClient) isPushed(branch string) bool {
+	remoteSha, err := m.runCmdOutput(exec.Command("git", "rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+branch), runOpts{SkipErrorLogging: true})
+	if err != nil {
+		return false
+	}
+	sha, err := m.runCmd("rev-parse", "HEAD")
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(remoteSha) == strings.TrimSpace(sha)
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
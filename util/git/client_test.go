@@ -6,6 +6,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -296,3 +297,155 @@ func Test_IsRevisionPresent(t *testing.T) {
 	revisionPresent = client.IsRevisionPresent("invalid-revision")
 	assert.False(t, revisionPresent)
 }
+
+func Test_nativeGitClient_CommitAndPush(t *testing.T) {
+	remoteDir := t.TempDir()
+	err := runCmd(remoteDir, "git", "init", "--bare")
+	require.NoError(t, err)
+
+	pushes := 0
+	client, err := NewClientExt(fmt.Sprintf("file://%s", remoteDir), t.TempDir(), NopCreds{}, true, false, "", "", WithEventHandlers(EventHandlers{
+		OnPush: func(_ string) func() {
+			pushes++
+			return func() {}
+		},
+	}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+	err = client.Fetch("")
+	require.NoError(t, err)
+	_, err = client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err)
+
+	// The remote repository is empty, so the branch is created as an orphan.
+	_, err = client.CheckoutOrOrphan("env/dev", false)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(client.Root(), "other"), 0o755))
+	err = os.WriteFile(filepath.Join(client.Root(), "other", "manifest.yaml"), []byte("kind: Secret\n"), 0o644)
+	require.NoError(t, err)
+	_, err = client.CommitAndPush("env/dev", "hydrate")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pushes)
+
+	sha, err := client.CommitSHA()
+	require.NoError(t, err)
+	remoteSha, err := client.LsRemote("env/dev")
+	require.NoError(t, err)
+	assert.Equal(t, sha, remoteSha)
+
+	// Committing without changes must neither create a new commit nor push the up to date branch.
+	_, err = client.CommitAndPush("env/dev", "hydrate again")
+	require.NoError(t, err)
+	newSha, err := client.CommitSHA()
+	require.NoError(t, err)
+	assert.Equal(t, sha, newSha)
+	assert.Equal(t, 1, pushes)
+
+	// A new branch is based on the given base branch, and is pushed even without changes.
+	_, err = client.CheckoutOrNew("env/dev-new", "env/dev", false)
+	require.NoError(t, err)
+	_, err = client.CommitAndPush("env/dev-new", "no changes")
+	require.NoError(t, err)
+	assert.Equal(t, 2, pushes)
+	newBranchSha, err := client.LsRemote("env/dev-new")
+	require.NoError(t, err)
+	assert.Equal(t, sha, newBranchSha)
+
+	// Only the given paths are removed.
+	_, err = client.CheckoutOrNew("env/dev-next", "env/dev", false)
+	require.NoError(t, err)
+	_, err = client.RemoveContents([]string{"manifest.yaml"})
+	require.NoError(t, err)
+	_, err = client.CommitAndPush("env/dev-next", "clear")
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(client.Root(), "manifest.yaml"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(client.Root(), "other", "manifest.yaml"))
+	require.NoError(t, err)
+
+	nextSha, err := client.LsRemote("env/dev-next")
+	require.NoError(t, err)
+	assert.NotEqual(t, sha, nextSha)
+}
+
+func Test_nativeGitClient_SparseCheckout(t *testing.T) {
+	remoteDir := t.TempDir()
+	require.NoError(t, runCmd(remoteDir, "git", "init"))
+	require.NoError(t, os.MkdirAll(path.Join(remoteDir, "apps", "guestbook"), 0o755))
+	require.NoError(t, os.MkdirAll(path.Join(remoteDir, "apps", "guestbook-ui"), 0o755))
+	require.NoError(t, os.WriteFile(path.Join(remoteDir, "apps", "guestbook", "deployment.yaml"), []byte("kind: Deployment"), 0o644))
+	require.NoError(t, os.WriteFile(path.Join(remoteDir, "apps", "guestbook-ui", "service.yaml"), []byte("kind: Service"), 0o644))
+	require.NoError(t, runCmd(remoteDir, "git", "add", "."))
+	require.NoError(t, runCmd(remoteDir, "git", "commit", "-m", "Initial commit"))
+	require.NoError(t, runCmd(remoteDir, "git", "config", "uploadpack.allowFilter", "true"))
+
+	client, err := NewClientExt(fmt.Sprintf("file://%s", remoteDir), t.TempDir(), NopCreds{}, true, false, "", "", WithPartialCloneFilter("blob:none"))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+
+	patterns, err := client.SparseCheckoutPatterns()
+	require.NoError(t, err)
+	assert.Nil(t, patterns)
+
+	require.NoError(t, client.SparseCheckout([]string{"/apps/guestbook"}))
+	patterns, err = client.SparseCheckoutPatterns()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/apps/guestbook"}, patterns)
+
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	require.NoError(t, client.Fetch(""))
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Checkout(commitSHA, false))
+	assert.FileExists(t, path.Join(client.Root(), "apps", "guestbook", "deployment.yaml"))
+	assert.NoFileExists(t, path.Join(client.Root(), "apps", "guestbook-ui", "service.yaml"))
+
+	require.NoError(t, client.SparseCheckout(nil))
+	patterns, err = client.SparseCheckoutPatterns()
+	require.NoError(t, err)
+	assert.Nil(t, patterns)
+	require.NoError(t, client.Checkout(commitSHA, false))
+	assert.FileExists(t, path.Join(client.Root(), "apps", "guestbook-ui", "service.yaml"))
+}
+
+func Test_nativeGitClient_CommitSignature(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+	tempDir := t.TempDir()
+	keyFile := path.Join(tempDir, "id_ed25519")
+	require.NoError(t, runCmd(tempDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyFile))
+
+	repoDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	require.NoError(t, runCmd(repoDir, "git", "-c", "gpg.format=ssh", "-c", "user.signingkey="+keyFile, "commit", "-S", "-m", "Signed commit\n\nwith a body", "--allow-empty"))
+	require.NoError(t, runCmd(repoDir, "git", "-c", "gpg.format=ssh", "-c", "user.signingkey="+keyFile, "tag", "-s", "-m", "Signed tag", "v1.0.0"))
+
+	client, err := NewClient(fmt.Sprintf("file://%s", repoDir), NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(""))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+
+	for _, revision := range []string{commitSHA, "v1.0.0"} {
+		signature, payload, err := client.CommitSignature(revision)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(signature), "-----BEGIN SSH SIGNATURE-----"), revision)
+		sigFile := path.Join(tempDir, "signature")
+		require.NoError(t, os.WriteFile(sigFile, signature, 0o600))
+		cmd := exec.Command("ssh-keygen", "-Y", "check-novalidate", "-n", "git", "-s", sigFile)
+		cmd.Stdin = strings.NewReader(string(payload))
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "signature of %s must be valid: %s", revision, out)
+	}
+
+	signature, _, err := client.CommitSignature(commitSHA + "~1")
+	require.NoError(t, err)
+	assert.Nil(t, signature)
+}
@@ -0,0 +1,400 @@
+package git
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/rand"
+)
+
+// DefaultMirrorMinIdle is the default amount of time a mirror has to be unused before it may be garbage collected
+const DefaultMirrorMinIdle = 10 * time.Minute
+
+// mirrorIDFile is the file in a mirror which contains the random ID of the mirror. Local repositories record the ID of
+// the mirror they borrow objects from, so that they notice if the mirror was removed and created again.
+const mirrorIDFile = "argocd-mirror-id"
+
+// errMirrorLocked is returned when a mirror could not be locked without blocking
+var errMirrorLocked = errors.New("mirror is locked")
+
+// MirrorStore manages bare mirrors of remote repositories in a directory which can be shared by multiple processes,
+// e.g. all replicas of the repo server using a ReadWriteMany persistent volume. Local repositories borrow the objects
+// of their mirror using git alternates, so only objects which are not in the mirror yet have to be fetched from the
+// remote, even after a restart. Local repositories are checked out in place, there are no per-revision worktrees.
+//
+// Two lock files next to each mirror protect it: the exclusive lock of the ".lock" file serializes fetches into the
+// mirror, and every process which uses local repositories borrowing objects from the mirror holds a shared lock of the
+// ".users" file. The shared lock is acquired whenever a local repository is initialized or fetched, and released by GC
+// once the process has not used the mirror for the minimum idle time. GC only removes mirrors whose ".users" file it
+// can lock exclusively, i.e. which no running process uses. Local repositories whose mirror was removed in the meantime
+// are initialized again when they are used next. Since the kernel releases the locks of a process when it exits,
+// crashed processes do not keep mirrors alive.
+type MirrorStore struct {
+	root    string
+	maxSize int64
+	minIdle time.Duration
+
+	usersLock sync.Mutex
+	// users contains the shared locks of the mirrors used by this process, keyed by mirror path
+	users map[string]*mirrorUser
+}
+
+// mirrorUser is the shared lock of a mirror used by this process
+type mirrorUser struct {
+	lock     *os.File
+	lastUsed time.Time
+}
+
+// NewMirrorStore returns a store for mirrors in the given directory. If maxSize is positive, GC removes the least
+// recently used mirrors until the total size of the store is below maxSize.
+func NewMirrorStore(root string, maxSize int64) *MirrorStore {
+	return &MirrorStore{root: root, maxSize: maxSize, minIdle: DefaultMirrorMinIdle, users: map[string]*mirrorUser{}}
+}
+
+// path returns the path of the mirror of the given repository. Mirrors are keyed by the normalized repository URL and
+// the given scope, which separates mirrors of the same repository that must not share objects, e.g. because they are
+// accessed using different credentials.
+func (s *MirrorStore) path(repoURL string, scope string) string {
+	key := NormalizeGitURL(repoURL)
+	if scope != "" {
+		key = key + "|" + scope
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.root, hex.EncodeToString(sum[:])+".git")
+}
+
+// lock blocks until it acquired the lock of the mirror at the given path. The lock is released by closing the
+// returned file.
+func (s *MirrorStore) lock(path string) (*os.File, error) {
+	if err := os.MkdirAll(s.root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mirror directory %s: %w", s.root, err)
+	}
+	return lockFile(path+".lock", true, true)
+}
+
+// use records that this process uses local repositories which borrow objects from the mirror at the given path, which
+// prevents GC from removing the mirror until the process has not used it for the minimum idle time. Must be called
+// with the lock of the mirror held, so that GC cannot remove the mirror in between.
+func (s *MirrorStore) use(path string) error {
+	s.usersLock.Lock()
+	defer s.usersLock.Unlock()
+	if user, ok := s.users[path]; ok {
+		user.lastUsed = time.Now()
+		return nil
+	}
+	lock, err := lockFile(path+".users", false, true)
+	if err != nil {
+		return err
+	}
+	s.users[path] = &mirrorUser{lock: lock, lastUsed: time.Now()}
+	return nil
+}
+
+// releaseIdle releases the shared locks of the mirrors which this process has not used for the minimum idle time, so
+// that they can be removed by GC
+func (s *MirrorStore) releaseIdle() {
+	s.usersLock.Lock()
+	defer s.usersLock.Unlock()
+	for path, user := range s.users {
+		if time.Since(user.lastUsed) < s.minIdle {
+			continue
+		}
+		if err := user.lock.Close(); err != nil {
+			log.Warnf("Failed to release mirror %s: %v", path, err)
+		}
+		delete(s.users, path)
+	}
+}
+
+// ensure creates the mirror of the given repository at path unless it already exists, and returns whether it was
+// created. The mirror is initialized in a temporary directory first, so that a partially initialized mirror is never
+// used. Must be called with the lock of the mirror held.
+func (s *MirrorStore) ensure(path string, repoURL string) (bool, error) {
+	if _, err := os.Stat(filepath.Join(path, "objects")); err == nil {
+		return false, nil
+	}
+	log.Infof("Initializing mirror of %s at %s", repoURL, path)
+	if err := os.RemoveAll(path); err != nil {
+		return false, fmt.Errorf("unable to clean mirror at %s: %w", path, err)
+	}
+	tmpPath, err := os.MkdirTemp(s.root, filepath.Base(path)+".tmp-")
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	repo, err := git.PlainInit(tmpPath, true)
+	if err != nil {
+		return false, err
+	}
+	_, err = repo.CreateRemote(&config.RemoteConfig{
+		Name:  git.DefaultRemoteName,
+		URLs:  []string{repoURL},
+		Fetch: []config.RefSpec{"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"},
+	})
+	if err != nil {
+		return false, err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return false, err
+	}
+	// local repositories fetch commits from the mirror by SHA, and may still reference objects which are no longer
+	// reachable in the mirror, so git must never prune objects of the mirror on its own
+	cfg.Raw.Section("uploadpack").SetOption("allowAnySHA1InWant", "true")
+	cfg.Raw.Section("gc").SetOption("auto", "0")
+	if err := repo.SetConfig(cfg); err != nil {
+		return false, err
+	}
+	id, err := rand.String(16)
+	if err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, mirrorIDFile), []byte(id), 0o644); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return false, fmt.Errorf("failed to move mirror to %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// touch records that the mirror at the given path has been used
+func (s *MirrorStore) touch(path string) {
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		log.Warnf("Failed to update last use of mirror %s: %v", path, err)
+	}
+}
+
+type mirrorInfo struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// GC removes the least recently used mirrors until the total size of the store is below its maximum size. Mirrors
+// which are locked, which a running process used recently, or which have been used recently are never removed.
+// Leftovers of mirrors which failed to initialize are removed as well.
+func (s *MirrorStore) GC() error {
+	s.releaseIdle()
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list mirrors: %w", err)
+	}
+	var mirrors []mirrorInfo
+	var total int64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(s.root, entry.Name())
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if strings.Contains(entry.Name(), ".tmp-") {
+			if time.Since(info.ModTime()) > s.minIdle {
+				_ = os.RemoveAll(path)
+			}
+			continue
+		}
+		if !strings.HasSuffix(entry.Name(), ".git") {
+			continue
+		}
+		size, err := dirSize(path)
+		if err != nil {
+			log.Warnf("Failed to get size of mirror %s: %v", path, err)
+			continue
+		}
+		mirrors = append(mirrors, mirrorInfo{path: path, size: size, lastUsed: info.ModTime()})
+		total += size
+	}
+	if s.maxSize <= 0 || total <= s.maxSize {
+		return nil
+	}
+	sort.Slice(mirrors, func(i, j int) bool {
+		return mirrors[i].lastUsed.Before(mirrors[j].lastUsed)
+	})
+	for _, mirror := range mirrors {
+		if total <= s.maxSize || time.Since(mirror.lastUsed) < s.minIdle {
+			break
+		}
+		if s.remove(mirror) {
+			total -= mirror.size
+		}
+	}
+	if total > s.maxSize {
+		log.Warnf("Mirrors use %d bytes which exceeds the maximum of %d bytes, but all remaining mirrors are in use", total, s.maxSize)
+	}
+	return nil
+}
+
+// remove removes the given mirror unless it is locked, or in use, or has been used since it was listed, and returns
+// whether it was removed
+func (s *MirrorStore) remove(mirror mirrorInfo) bool {
+	lock, err := lockFile(mirror.path+".lock", true, false)
+	if err != nil {
+		if !errors.Is(err, errMirrorLocked) {
+			log.Warnf("Failed to lock mirror %s: %v", mirror.path, err)
+		}
+		return false
+	}
+	defer func() { _ = lock.Close() }()
+	// fails if any process, including this one, holds a shared lock because it used the mirror recently
+	users, err := lockFile(mirror.path+".users", true, false)
+	if err != nil {
+		if !errors.Is(err, errMirrorLocked) {
+			log.Warnf("Failed to lock users of mirror %s: %v", mirror.path, err)
+		}
+		return false
+	}
+	defer func() { _ = users.Close() }()
+	if info, err := os.Stat(mirror.path); err != nil || time.Since(info.ModTime()) < s.minIdle {
+		return false
+	}
+	if err := os.RemoveAll(mirror.path); err != nil {
+		log.Warnf("Failed to remove mirror %s: %v", mirror.path, err)
+		return false
+	}
+	log.Infof("Removed mirror %s of %d bytes which was last used at %v", mirror.path, mirror.size, mirror.lastUsed)
+	return true
+}
+
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// mirrorPath returns the path of the mirror used by the client
+func (m *nativeGitClient) mirrorPath() string {
+	return m.mirror.path(m.repoURL, m.mirrorScope)
+}
+
+// initMirror creates the mirror of the repository if needed and makes the local repository borrow its objects. If
+// the mirror was removed and created again since the local repository started to borrow objects from it, e.g. by an
+// administrator, the local repository may reference objects which no longer exist and is initialized again.
+func (m *nativeGitClient) initMirror() error {
+	path := m.mirrorPath()
+	lock, err := m.mirror.lock(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Close() }()
+	if _, err := m.mirror.ensure(path, m.repoURL); err != nil {
+		return fmt.Errorf("failed to initialize mirror of %s: %w", m.repoURL, err)
+	}
+	if err := m.mirror.use(path); err != nil {
+		return err
+	}
+	m.mirror.touch(path)
+	mirrorID, err := os.ReadFile(filepath.Join(path, mirrorIDFile))
+	if err != nil {
+		return fmt.Errorf("failed to read ID of mirror %s: %w", path, err)
+	}
+
+	alternates := filepath.Join(m.root, ".git", "objects", "info", "alternates")
+	localMirrorID := filepath.Join(m.root, ".git", mirrorIDFile)
+	if _, err := os.Stat(alternates); err == nil {
+		if data, err := os.ReadFile(localMirrorID); err == nil && string(data) == string(mirrorID) {
+			return nil
+		}
+		log.Infof("Mirror of %s has been recreated, initializing %s again", m.repoURL, m.root)
+		if err := os.RemoveAll(m.root); err != nil {
+			return fmt.Errorf("unable to clean repo at %s: %w", m.root, err)
+		}
+		if err := m.initLocal(); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(alternates), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(alternates, []byte(filepath.Join(path, "objects")+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(localMirrorID, mirrorID, 0o644)
+}
+
+// fetchMirror updates the mirror from the remote, and then fetches the given revision, or all branches and tags if no
+// revision is given, from the mirror into the local repository. Commits which are already in the mirror are not fetched
+// from the remote again.
+func (m *nativeGitClient) fetchMirror(revision string) error {
+	path := m.mirrorPath()
+	lock, err := m.mirror.lock(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Close() }()
+	created, err := m.mirror.ensure(path, m.repoURL)
+	if err != nil {
+		return fmt.Errorf("failed to initialize mirror of %s: %w", m.repoURL, err)
+	}
+	if created {
+		// the objects the local repository borrowed from the removed mirror are gone
+		return fmt.Errorf("mirror of %s has been recreated, the repository at %s has to be initialized again", m.repoURL, m.root)
+	}
+	if err := m.mirror.use(path); err != nil {
+		return err
+	}
+	m.mirror.touch(path)
+
+	if revision == "" {
+		if err := m.runCredentialedCmd("-C", path, "fetch", "origin", "--tags", "--force", "--prune"); err != nil {
+			return err
+		}
+		_, err = m.runCmd("fetch", "--force", "--prune", path, "+refs/heads/*:refs/remotes/origin/*", "+refs/tags/*:refs/tags/*")
+		return err
+	}
+	if !IsCommitSHA(revision) || !m.isRevisionInMirror(path, revision) {
+		if err := m.runCredentialedCmd("-C", path, "fetch", "origin", revision, "--tags", "--force", "--prune"); err != nil {
+			return err
+		}
+		if !IsCommitSHA(revision) {
+			// refs which are not covered by the refspecs of the mirror, e.g. pull request refs, are only recorded in
+			// FETCH_HEAD of the mirror
+			sha, err := m.runCmd("-C", path, "rev-parse", "FETCH_HEAD")
+			if err != nil {
+				return err
+			}
+			revision = strings.TrimSpace(sha)
+		}
+	}
+	_, err = m.runCmd("fetch", "--force", path, revision)
+	return err
+}
+
+// isRevisionInMirror returns whether the given commit exists in the mirror at the given path
+func (m *nativeGitClient) isRevisionInMirror(path string, revision string) bool {
+	out, err := m.runCmdOutput(exec.Command("git", "-C", path, "cat-file", "-t", revision), runOpts{SkipErrorLogging: true})
+	return err == nil && out == "commit"
+}
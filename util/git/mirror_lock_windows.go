@@ -0,0 +1,12 @@
+//go:build windows
+
+package git
+
+import (
+	"errors"
+	"os"
+)
+
+func lockFile(_ string, _ bool, _ bool) (*os.File, error) {
+	return nil, errors.New("git mirrors are not supported on Windows")
+}
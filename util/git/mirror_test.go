@@ -0,0 +1,152 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMirrorClient(t *testing.T, repoURL string, store *MirrorStore, hits *[]bool) Client {
+	t.Helper()
+	client, err := NewClientExt(repoURL, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror(store, ""), WithEventHandlers(EventHandlers{
+		OnMirror: func(_ string, hit bool) {
+			*hits = append(*hits, hit)
+		},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	return client
+}
+
+func Test_nativeGitClient_Mirror(t *testing.T) {
+	remoteDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	repoURL := fmt.Sprintf("file://%s", remoteDir)
+	store := NewMirrorStore(t.TempDir(), 0)
+
+	var hits []bool
+	first := newMirrorClient(t, repoURL, store, &hits)
+	mirrorPath := first.(*nativeGitClient).mirrorPath()
+	alternates, err := os.ReadFile(filepath.Join(first.Root(), ".git", "objects", "info", "alternates"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(mirrorPath, "objects")+"\n", string(alternates))
+
+	commitSHA, err := first.LsRemote("HEAD")
+	require.NoError(t, err)
+	assert.False(t, first.IsRevisionPresent(commitSHA))
+	require.NoError(t, first.Fetch(""))
+	require.NoError(t, first.Checkout(commitSHA, false))
+
+	// a second repository finds the revision in the mirror without fetching it
+	second := newMirrorClient(t, repoURL, store, &hits)
+	assert.Equal(t, mirrorPath, second.(*nativeGitClient).mirrorPath())
+	assert.True(t, second.IsRevisionPresent(commitSHA))
+	assert.True(t, second.(*nativeGitClient).isRevisionInMirror(mirrorPath, commitSHA))
+	require.NoError(t, second.Checkout(commitSHA, false))
+	assert.Equal(t, []bool{false, true}, hits)
+
+	// a specific commit is fetched through the mirror
+	require.NoError(t, runCmd(remoteDir, "git", "commit", "-m", "Second commit", "--allow-empty"))
+	commitSHA, err = second.LsRemote("HEAD")
+	require.NoError(t, err)
+	require.NoError(t, second.Fetch(commitSHA))
+	require.NoError(t, second.Checkout("FETCH_HEAD", false))
+	sha, err := second.CommitSHA()
+	require.NoError(t, err)
+	assert.Equal(t, commitSHA, sha)
+
+	// mirrors which local repositories borrow objects from are not garbage collected
+	gcStore := NewMirrorStore(store.root, 1)
+	gcStore.minIdle = 0
+	require.NoError(t, gcStore.GC())
+	assert.DirExists(t, mirrorPath)
+
+	// a repository which borrowed objects from a mirror which was removed and created again is initialized again
+	require.NoError(t, os.RemoveAll(mirrorPath))
+	_, err = store.ensure(mirrorPath, repoURL)
+	require.NoError(t, err)
+	require.NoError(t, first.Init())
+	assert.False(t, first.IsRevisionPresent(commitSHA))
+	require.NoError(t, first.Fetch(""))
+	assert.True(t, first.IsRevisionPresent(commitSHA))
+}
+
+func Test_nativeGitClient_MirrorScope(t *testing.T) {
+	store := NewMirrorStore(t.TempDir(), 0)
+	assert.Equal(t, store.path("https://github.com/argoproj/argo-cd", ""), store.path("https://github.com/argoproj/argo-cd.git", ""))
+	assert.NotEqual(t, store.path("https://github.com/argoproj/argo-cd", ""), store.path("https://github.com/argoproj/argo-cd", "my-project"))
+
+	client, err := NewClientExt("https://github.com/argoproj/argo-cd", t.TempDir(), NopCreds{}, true, true, "", "", WithMirror(store, ""))
+	require.NoError(t, err)
+	assert.Nil(t, client.(*nativeGitClient).mirror, "LFS enabled repositories must not use a mirror")
+}
+
+func TestMirrorStore_GC(t *testing.T) {
+	root := t.TempDir()
+	store := NewMirrorStore(root, 1)
+	store.minIdle = 0
+
+	unused := store.path("https://github.com/argoproj/argo-cd", "")
+	_, err := store.ensure(unused, "https://github.com/argoproj/argo-cd")
+	require.NoError(t, err)
+	locked := store.path("https://github.com/argoproj/argocd-example-apps", "")
+	_, err = store.ensure(locked, "https://github.com/argoproj/argocd-example-apps")
+	require.NoError(t, err)
+	lock, err := store.lock(locked)
+	require.NoError(t, err)
+	defer func() { _ = lock.Close() }()
+	// local repositories of another process borrow objects from this mirror
+	used := store.path("https://github.com/argoproj/gitops-engine", "")
+	_, err = store.ensure(used, "https://github.com/argoproj/gitops-engine")
+	require.NoError(t, err)
+	require.NoError(t, NewMirrorStore(root, 1).use(used))
+
+	require.NoError(t, store.GC())
+	assert.NoDirExists(t, unused)
+	assert.DirExists(t, locked)
+	assert.DirExists(t, used)
+
+	// recently used mirrors are kept
+	require.NoError(t, lock.Close())
+	store.minIdle = DefaultMirrorMinIdle
+	store.touch(locked)
+	require.NoError(t, store.GC())
+	assert.DirExists(t, locked)
+}
+
+func TestMirrorStore_GCIdleMirror(t *testing.T) {
+	remoteDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	repoURL := fmt.Sprintf("file://%s", remoteDir)
+	store := NewMirrorStore(t.TempDir(), 1)
+
+	var hits []bool
+	client := newMirrorClient(t, repoURL, store, &hits)
+	mirrorPath := client.(*nativeGitClient).mirrorPath()
+	require.NoError(t, client.Fetch(""))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+
+	// the mirror is kept while the process uses it
+	require.NoError(t, store.GC())
+	assert.DirExists(t, mirrorPath)
+
+	// but removed once neither this nor any other process used it for the minimum idle time
+	store.minIdle = 0
+	require.NoError(t, store.GC())
+	assert.NoDirExists(t, mirrorPath)
+	assert.Empty(t, store.users)
+
+	// the repository which borrowed objects from the removed mirror is initialized again when it is used next
+	store.minIdle = DefaultMirrorMinIdle
+	require.NoError(t, client.Init())
+	assert.DirExists(t, mirrorPath)
+	assert.False(t, client.IsRevisionPresent(commitSHA))
+	require.NoError(t, client.Fetch(""))
+	require.NoError(t, client.Checkout(commitSHA, false))
+	assert.Contains(t, store.users, mirrorPath)
+}
@@ -60,6 +60,90 @@ func (_m *Client) Checkout(revision string, submoduleEnabled bool) error {
 	return r0
 }
 
+// CheckoutOrNew provides a mock function with given fields: branch, base, submoduleEnabled
+func (_m *Client) CheckoutOrNew(branch string, base string, submoduleEnabled bool) (string, error) {
+	ret := _m.Called(branch, base, submoduleEnabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckoutOrNew")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (string, error)); ok {
+		return rf(branch, base, submoduleEnabled)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) string); ok {
+		r0 = rf(branch, base, submoduleEnabled)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(branch, base, submoduleEnabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckoutOrOrphan provides a mock function with given fields: branch, submoduleEnabled
+func (_m *Client) CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error) {
+	ret := _m.Called(branch, submoduleEnabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckoutOrOrphan")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (string, error)); ok {
+		return rf(branch, submoduleEnabled)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) string); ok {
+		r0 = rf(branch, submoduleEnabled)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(branch, submoduleEnabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommitAndPush provides a mock function with given fields: branch, message
+func (_m *Client) CommitAndPush(branch string, message string) (string, error) {
+	ret := _m.Called(branch, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitAndPush")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(branch, message)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(branch, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(branch, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CommitSHA provides a mock function with given fields:
 func (_m *Client) CommitSHA() (string, error) {
 	ret := _m.Called()
@@ -88,6 +172,45 @@ func (_m *Client) CommitSHA() (string, error) {
 	return r0, r1
 }
 
+// CommitSignature provides a mock function with given fields: revision
+func (_m *Client) CommitSignature(revision string) ([]byte, []byte, error) {
+	ret := _m.Called(revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitSignature")
+	}
+
+	var r0 []byte
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) ([]byte, []byte, error)); ok {
+		return rf(revision)
+	}
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) []byte); ok {
+		r1 = rf(revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(revision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Fetch provides a mock function with given fields: revision
 func (_m *Client) Fetch(revision string) error {
 	ret := _m.Called(revision)
@@ -278,6 +401,34 @@ func (_m *Client) LsRemote(revision string) (string, error) {
 	return r0, r1
 }
 
+// RemoveContents provides a mock function with given fields: paths
+func (_m *Client) RemoveContents(paths []string) (string, error) {
+	ret := _m.Called(paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveContents")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return rf(paths)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(paths)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevisionMetadata provides a mock function with given fields: revision
 func (_m *Client) RevisionMetadata(revision string) (*git.RevisionMetadata, error) {
 	ret := _m.Called(revision)
@@ -326,6 +477,82 @@ func (_m *Client) Root() string {
 	return r0
 }
 
+// SetAuthor provides a mock function with given fields: name, email
+func (_m *Client) SetAuthor(name string, email string) (string, error) {
+	ret := _m.Called(name, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAuthor")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(name, email)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(name, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SparseCheckout provides a mock function with given fields: patterns
+func (_m *Client) SparseCheckout(patterns []string) error {
+	ret := _m.Called(patterns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SparseCheckout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(patterns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SparseCheckoutPatterns provides a mock function with given fields:
+func (_m *Client) SparseCheckoutPatterns() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SparseCheckoutPatterns")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Submodule provides a mock function with given fields:
 func (_m *Client) Submodule() error {
 	ret := _m.Called()
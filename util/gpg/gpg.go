@@ -2,7 +2,9 @@ package gpg
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -684,6 +686,26 @@ func ParseGitCommitVerification(signature string) PGPVerifyResult {
 	}
 }
 
+// VerifyClearSignedMessage verifies the signature of a clear signed message, e.g. the provenance file of a Helm chart,
+// against the keys in the keyring. It returns the result of the verification and the signed text.
+func VerifyClearSignedMessage(message []byte) (PGPVerifyResult, []byte, error) {
+	cmd := exec.Command("gpg", "--no-permission-warning", "--batch", "--decrypt")
+	cmd.Env = getGPGEnviron()
+	cmd.Stdin = bytes.NewReader(message)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return PGPVerifyResult{}, nil, fmt.Errorf("error running gpg: %w", err)
+		}
+		// gpg exits with a non-zero code for bad signatures or unknown keys, which is reflected by the result
+		log.Debugf("gpg exited with code %d while verifying clear signed message: %s", exitErr.ExitCode(), stderr.String())
+	}
+	return ParseGitCommitVerification(stderr.String()), stdout.Bytes(), nil
+}
+
 // SyncKeyRingFromDirectory will sync the GPG keyring with files in a directory. This is a one-way sync,
 // with the configuration being the leading information.
 // Files must have a file name matching their Key ID. Keys that are found in the directory but are not
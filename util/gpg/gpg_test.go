@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -451,6 +452,41 @@ func Test_GPG_ParseGitCommitVerification(t *testing.T) {
 	}
 }
 
+func Test_VerifyClearSignedMessage(t *testing.T) {
+	p := initTempDir(t)
+	err := InitializeGnuPG()
+	require.NoError(t, err)
+	keys, err := GetInstalledPGPKeys(nil)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	cmd := exec.Command("gpg", "--batch", "--clearsign", "--local-user", keys[0].KeyID)
+	cmd.Env = []string{fmt.Sprintf("GNUPGHOME=%s", p)}
+	cmd.Stdin = strings.NewReader("name: guestbook\nversion: 0.1.0\n")
+	message, err := cmd.Output()
+	require.NoError(t, err)
+
+	t.Run("Good signature", func(t *testing.T) {
+		res, text, err := VerifyClearSignedMessage(message)
+		require.NoError(t, err)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, keys[0].KeyID, res.KeyID)
+		assert.Equal(t, "name: guestbook\nversion: 0.1.0\n", string(text))
+	})
+
+	t.Run("Tampered message", func(t *testing.T) {
+		res, _, err := VerifyClearSignedMessage([]byte(strings.Replace(string(message), "0.1.0", "0.2.0", 1)))
+		require.NoError(t, err)
+		assert.Equal(t, VerifyResultBad, res.Result)
+	})
+
+	t.Run("Not signed", func(t *testing.T) {
+		res, _, err := VerifyClearSignedMessage([]byte("name: guestbook\n"))
+		require.NoError(t, err)
+		assert.Equal(t, VerifyResultUnknown, res.Result)
+	})
+}
+
 func Test_GetGnuPGHomePath(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		t.Setenv(common.EnvGnuPGHome, "")
@@ -75,6 +75,13 @@ func WithChartPaths(chartPaths argoio.TempPaths) ClientOpts {
 	}
 }
 
+// WithChartVerification requires charts to meet the given requirements before they are extracted
+func WithChartVerification(verification *ChartVerification) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.verification = verification
+	}
+}
+
 func NewClient(repoURL string, creds Creds, enableOci bool, proxy string, noProxy string, opts ...ClientOpts) Client {
 	return NewClientWithLock(repoURL, creds, globalLock, enableOci, proxy, noProxy, opts...)
 }
@@ -106,6 +113,7 @@ type nativeHelmChart struct {
 	indexCache      indexCache
 	proxy           string
 	noProxy         string
+	verification    *ChartVerification
 }
 
 func fileExist(filePath string) (bool, error) {
@@ -127,6 +135,9 @@ func (c *nativeHelmChart) CleanChartCache(chart string, version string, project
 	if err := os.RemoveAll(cachePath); err != nil {
 		return fmt.Errorf("error removing chart cache at %s: %w", cachePath, err)
 	}
+	if err := os.RemoveAll(provenancePath(cachePath)); err != nil {
+		return fmt.Errorf("error removing chart provenance cache at %s: %w", cachePath, err)
+	}
 	return nil
 }
 
@@ -176,6 +187,15 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		_ = os.RemoveAll(tempDir)
 		return "", nil, fmt.Errorf("error checking existence of cached chart path: %w", err)
 	}
+	// charts which have been downloaded before provenance verification was required lack the provenance file
+	fetchProvenance := c.verification != nil && c.verification.VerifyProvenance != nil
+	if exists && fetchProvenance {
+		exists, err = fileExist(provenancePath(cachedChartPath))
+		if err != nil {
+			_ = os.RemoveAll(tempDir)
+			return "", nil, fmt.Errorf("error checking existence of cached chart provenance: %w", err)
+		}
+	}
 
 	if !exists {
 		// create empty temp directory to extract chart from the registry
@@ -200,13 +220,13 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 			}
 
 			// 'helm pull' ensures that chart is downloaded into temp directory
-			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds)
+			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds, fetchProvenance)
 			if err != nil {
 				_ = os.RemoveAll(tempDir)
 				return "", nil, fmt.Errorf("error pulling OCI chart: %w", err)
 			}
 		} else {
-			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, c.creds, passCredentials)
+			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, c.creds, passCredentials, fetchProvenance)
 			if err != nil {
 				_ = os.RemoveAll(tempDir)
 				return "", nil, fmt.Errorf("error fetching chart: %w", err)
@@ -218,11 +238,29 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		if err != nil {
 			return "", nil, fmt.Errorf("error reading directory %s: %w", tempDest, err)
 		}
-		if len(infos) != 1 {
-			return "", nil, fmt.Errorf("expected 1 file, found %v", len(infos))
+		var chartFiles, provFiles []string
+		for _, info := range infos {
+			if filepath.Ext(info.Name()) == provenanceExtension {
+				provFiles = append(provFiles, filepath.Join(tempDest, info.Name()))
+			} else {
+				chartFiles = append(chartFiles, filepath.Join(tempDest, info.Name()))
+			}
+		}
+		if len(chartFiles) != 1 {
+			return "", nil, fmt.Errorf("expected 1 file, found %v", len(chartFiles))
+		}
+		if fetchProvenance && len(provFiles) != 1 {
+			return "", nil, fmt.Errorf("expected 1 provenance file, found %v", len(provFiles))
 		}
 
-		chartFilePath := filepath.Join(tempDest, infos[0].Name())
+		// the provenance file is moved first, so that a cached chart always has its provenance file next to it
+		for _, provFilePath := range provFiles {
+			err = os.Rename(provFilePath, provenancePath(cachedChartPath))
+			if err != nil {
+				return "", nil, fmt.Errorf("error renaming file from %s to %s: %w", provFilePath, provenancePath(cachedChartPath), err)
+			}
+		}
+		chartFilePath := chartFiles[0]
 
 		err = os.Rename(chartFilePath, cachedChartPath)
 		if err != nil {
@@ -230,6 +268,13 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		}
 	}
 
+	// cached charts are verified again, since the requirements might have changed since they were downloaded
+	err = c.verifyChart(chart, version, cachedChartPath)
+	if err != nil {
+		_ = os.RemoveAll(tempDir)
+		return "", nil, fmt.Errorf("error verifying chart: %w", err)
+	}
+
 	err = untarChart(tempDir, cachedChartPath, manifestMaxExtractedSize, disableManifestMaxExtractedSize)
 	if err != nil {
 		_ = os.RemoveAll(tempDir)
@@ -217,11 +217,14 @@ func writeToTmp(data []byte) (string, argoio.Closer, error) {
 	}), nil
 }
 
-func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, passCredentials bool) (string, error) {
+func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, passCredentials bool, prov bool) (string, error) {
 	args := []string{"pull", "--destination", destination}
 	if version != "" {
 		args = append(args, "--version", version)
 	}
+	if prov {
+		args = append(args, "--prov")
+	}
 	if creds.Username != "" {
 		args = append(args, "--username", creds.Username)
 	}
@@ -264,13 +267,16 @@ func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, p
 	return out, nil
 }
 
-func (c *Cmd) PullOCI(repo string, chart string, version string, destination string, creds Creds) (string, error) {
+func (c *Cmd) PullOCI(repo string, chart string, version string, destination string, creds Creds, prov bool) (string, error) {
 	args := []string{
 		"pull", fmt.Sprintf("oci://%s/%s", repo, chart), "--version",
 		version,
 		"--destination",
 		destination,
 	}
+	if prov {
+		args = append(args, "--prov")
+	}
 	if creds.CAPath != "" {
 		args = append(args, "--ca-file", creds.CAPath)
 	}
@@ -0,0 +1,158 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/util/oci"
+)
+
+const (
+	// provenanceExtension is the extension of the provenance file Helm downloads next to a chart
+	provenanceExtension = ".prov"
+	// chartLayerMediaType is the media type of the layer of an OCI chart holding the chart archive
+	chartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// ProvenanceVerifier verifies the signature of a clear signed provenance file and returns the signed text
+type ProvenanceVerifier func(prov []byte) ([]byte, error)
+
+// ChartVerification holds the requirements a chart must meet before it is extracted
+type ChartVerification struct {
+	// VerifyProvenance requires charts to have a provenance file, whose signature is verified by the given function
+	VerifyProvenance ProvenanceVerifier
+	// CosignPublicKeys are PEM encoded public keys. If set, charts from OCI registries must have a cosign signature
+	// made by one of them.
+	CosignPublicKeys []string
+}
+
+// provenanceMetadata is the part of the chart metadata in a provenance file which identifies the chart
+type provenanceMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// provenanceFiles is the part of a provenance file holding the digests of the chart archive
+type provenanceFiles struct {
+	Files map[string]string `json:"files"`
+}
+
+func provenancePath(chartPath string) string {
+	return chartPath + provenanceExtension
+}
+
+func (c *nativeHelmChart) verifyChart(chart string, version string, chartPath string) error {
+	if c.verification == nil {
+		return nil
+	}
+	if c.verification.VerifyProvenance != nil {
+		if err := verifyProvenance(chartPath, provenancePath(chartPath), normalizeChartName(chart), version, c.verification.VerifyProvenance); err != nil {
+			return fmt.Errorf("provenance verification of chart %s %s failed: %w", chart, version, err)
+		}
+	}
+	if c.enableOci && len(c.verification.CosignPublicKeys) > 0 {
+		if err := c.verifyCosignSignature(chart, version, chartPath); err != nil {
+			return fmt.Errorf("cosign signature verification of chart %s %s failed: %w", chart, version, err)
+		}
+	}
+	return nil
+}
+
+// fileDigest returns the SHA256 digest of the given file
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyProvenance verifies the chart archive at chartPath against the provenance file at provPath like
+// `helm verify` does. The provenance file must be made for the chart with the given name and version.
+func verifyProvenance(chartPath string, provPath string, name string, version string, verifySignature ProvenanceVerifier) error {
+	prov, err := os.ReadFile(provPath)
+	if err != nil {
+		return fmt.Errorf("error reading provenance file: %w", err)
+	}
+	signed, err := verifySignature(prov)
+	if err != nil {
+		return err
+	}
+
+	// the signed message holds the chart metadata and the digests of the chart archive, separated by a YAML document end marker
+	parts := bytes.Split(signed, []byte("\n...\n"))
+	if len(parts) < 2 {
+		return fmt.Errorf("provenance file is malformed")
+	}
+	var metadata provenanceMetadata
+	if err := yaml.Unmarshal(parts[0], &metadata); err != nil {
+		return fmt.Errorf("error parsing chart metadata of provenance file: %w", err)
+	}
+	if metadata.Name != name || metadata.Version != version {
+		return fmt.Errorf("provenance file is made for chart %s %s", metadata.Name, metadata.Version)
+	}
+	var files provenanceFiles
+	if err := yaml.Unmarshal(parts[1], &files); err != nil {
+		return fmt.Errorf("error parsing files of provenance file: %w", err)
+	}
+	chartDigest, err := fileDigest(chartPath)
+	if err != nil {
+		return fmt.Errorf("error computing digest of chart: %w", err)
+	}
+	for _, d := range files.Files {
+		if d == chartDigest {
+			return nil
+		}
+	}
+	return fmt.Errorf("chart digest %s does not match the provenance file", chartDigest)
+}
+
+// verifyCosignSignature verifies that the OCI manifest of the chart has a cosign signature made with one of the
+// configured keys, and that the chart archive at chartPath is the one referenced by the manifest
+func (c *nativeHelmChart) verifyCosignSignature(chart string, version string, chartPath string) error {
+	ociClient := oci.NewClient(fmt.Sprintf("%s/%s", c.repoURL, chart), oci.Creds{
+		Username:           c.creds.Username,
+		Password:           c.creds.Password,
+		CAPath:             c.creds.CAPath,
+		CertData:           c.creds.CertData,
+		KeyData:            c.creds.KeyData,
+		InsecureSkipVerify: c.creds.InsecureSkipVerify,
+	}, c.proxy, c.noProxy)
+	ctx := context.Background()
+	// Helm stores chart versions as tags, replacing the '+' of build metadata which is not allowed in tags
+	manifestDigest, err := ociClient.ResolveRevision(ctx, strings.ReplaceAll(version, "+", "_"), true)
+	if err != nil {
+		return err
+	}
+	manifest, err := ociClient.VerifyCosignSignature(ctx, manifestDigest, c.verification.CosignPublicKeys)
+	if err != nil {
+		return err
+	}
+	chartDigest, err := fileDigest(chartPath)
+	if err != nil {
+		return fmt.Errorf("error computing digest of chart: %w", err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == chartLayerMediaType {
+			if layer.Digest != digest.Digest(chartDigest) {
+				return fmt.Errorf("chart digest %s does not match the signed manifest %s", chartDigest, manifestDigest)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("signed manifest %s does not contain a chart", manifestDigest)
+}
@@ -0,0 +1,72 @@
+package helm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProvenance = `apiVersion: v2
+description: A Helm chart for Kubernetes
+name: my-chart
+version: 1.1.0
+
+...
+files:
+  my-chart-1.1.0.tgz: %s
+`
+
+// fakeClearSign wraps the given text like a clear signed message whose signature is checked by fakeVerifier
+func fakeClearSign(text string) string {
+	return "-----BEGIN PGP SIGNED MESSAGE-----\n" + text + "-----BEGIN PGP SIGNATURE-----\ngood\n-----END PGP SIGNATURE-----\n"
+}
+
+func fakeVerifier(prov []byte) ([]byte, error) {
+	text, signature, ok := strings.Cut(strings.TrimPrefix(string(prov), "-----BEGIN PGP SIGNED MESSAGE-----\n"), "-----BEGIN PGP SIGNATURE-----\n")
+	if !ok || !strings.HasPrefix(signature, "good\n") {
+		return nil, errors.New("bad signature")
+	}
+	return []byte(text), nil
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	dir := t.TempDir()
+	chartPath := filepath.Join(dir, "chart.tgz")
+	require.NoError(t, os.WriteFile(chartPath, []byte("chart"), 0o600))
+	chartDigest, err := fileDigest(chartPath)
+	require.NoError(t, err)
+
+	writeProvenance := func(t *testing.T, prov string) string {
+		t.Helper()
+		provPath := filepath.Join(t.TempDir(), "chart.tgz.prov")
+		require.NoError(t, os.WriteFile(provPath, []byte(prov), 0o600))
+		return provPath
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		provPath := writeProvenance(t, fakeClearSign(strings.Replace(testProvenance, "%s", chartDigest, 1)))
+		require.NoError(t, verifyProvenance(chartPath, provPath, "my-chart", "1.1.0", fakeVerifier))
+	})
+	t.Run("BadSignature", func(t *testing.T) {
+		provPath := writeProvenance(t, strings.Replace(fakeClearSign(strings.Replace(testProvenance, "%s", chartDigest, 1)), "good", "bad", 1))
+		require.ErrorContains(t, verifyProvenance(chartPath, provPath, "my-chart", "1.1.0", fakeVerifier), "bad signature")
+	})
+	t.Run("DigestMismatch", func(t *testing.T) {
+		provPath := writeProvenance(t, fakeClearSign(strings.Replace(testProvenance, "%s", "sha256:0000", 1)))
+		require.ErrorContains(t, verifyProvenance(chartPath, provPath, "my-chart", "1.1.0", fakeVerifier), "does not match the provenance file")
+	})
+	t.Run("OtherChart", func(t *testing.T) {
+		provPath := writeProvenance(t, fakeClearSign(strings.Replace(testProvenance, "%s", chartDigest, 1)))
+		err := verifyProvenance(chartPath, provPath, "other-chart", "1.1.0", fakeVerifier)
+		require.ErrorContains(t, err, "provenance file is made for chart my-chart 1.1.0")
+	})
+	t.Run("Missing", func(t *testing.T) {
+		err := verifyProvenance(chartPath, filepath.Join(dir, "missing.prov"), "my-chart", "1.1.0", fakeVerifier)
+		assert.ErrorContains(t, err, "error reading provenance file")
+	})
+}
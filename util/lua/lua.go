@@ -132,6 +132,19 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
+// ExecutePredicateLua runs the lua script which evaluates a boolean predicate on a resource
+func (vm VM) ExecutePredicateLua(obj *unstructured.Unstructured, script string) (bool, error) {
+	l, err := vm.runLua(obj, script)
+	if err != nil {
+		return false, err
+	}
+	returnValue := l.Get(-1)
+	if returnValue.Type() != lua.LTBool {
+		return false, fmt.Errorf(incorrectReturnType, "boolean", returnValue.Type().String())
+	}
+	return lua.LVAsBool(returnValue), nil
+}
+
 // GetHealthScript attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, error) {
 	// first, search the gvk as is in the ResourceOverrides
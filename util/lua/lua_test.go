@@ -120,6 +120,21 @@ func TestFailLuaReturnNonTable(t *testing.T) {
 	assert.Equal(t, fmt.Errorf(incorrectReturnType, "table", "number"), err)
 }
 
+func TestExecutePredicateLua(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	result, err := vm.ExecutePredicateLua(testObj, `return obj.metadata.name == "helm-guestbook"`)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	result, err = vm.ExecutePredicateLua(testObj, `return obj.metadata.namespace == "kube-system"`)
+	require.NoError(t, err)
+	assert.False(t, result)
+
+	_, err = vm.ExecutePredicateLua(testObj, returnInt)
+	assert.Equal(t, fmt.Errorf(incorrectReturnType, "boolean", "number"), err)
+}
+
 const invalidHealthStatusStatus = `local healthStatus = {}
 healthStatus.status = "test"
 return healthStatus
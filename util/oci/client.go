@@ -0,0 +1,549 @@
+package oci
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/argoproj/pkg/sync"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+)
+
+const (
+	// annotationUnpack is set by the ORAS CLI on layers holding a tarred and gzipped directory
+	annotationUnpack = "io.deis.oras.content.unpack"
+
+	// maxManifestSize is the maximum size of an artifact manifest which is accepted from a registry
+	maxManifestSize = 4 * 1024 * 1024
+)
+
+var (
+	globalLock = sync.NewKeyLock()
+
+	// supportedArchiveMediaTypes are the layer media types which are extracted as gzipped tarballs
+	supportedArchiveMediaTypes = map[string]bool{
+		ocispec.MediaTypeImageLayerGzip:                     true,
+		"application/vnd.docker.image.rootfs.diff.tar.gzip": true,
+		"application/tar+gzip":                              true,
+	}
+)
+
+type Creds struct {
+	Username           string
+	Password           string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+// Client is a client for OCI artifacts holding plain manifests, e.g. YAML files or Kustomize directories.
+type Client interface {
+	// ResolveRevision resolves the given tag, semver constraint or digest into the digest of the artifact manifest.
+	ResolveRevision(ctx context.Context, revision string, noCache bool) (string, error)
+	// Extract downloads the artifact with the given digest and extracts its layers into a temporary directory. The
+	// returned closer removes the directory.
+	Extract(ctx context.Context, digest string, project string, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, argoio.Closer, error)
+	// CleanCache removes the cached layers of the artifact with the given digest.
+	CleanCache(digest string, project string) error
+	// TestRepo checks whether the repository is accessible with the configured credentials.
+	TestRepo(ctx context.Context) (bool, error)
+	// VerifyCosignSignature verifies that the manifest with the given digest has a cosign signature made by one of the
+	// given PEM encoded public keys and returns the manifest.
+	VerifyCosignSignature(ctx context.Context, digest string, publicKeys []string) (*ocispec.Manifest, error)
+}
+
+type ClientOpts func(c *nativeOCIClient)
+
+func WithArtifactPaths(artifactPaths argoio.TempPaths) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.artifactCachePaths = artifactPaths
+	}
+}
+
+func WithTagsCache(tagsCache tagsCache) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.tagsCache = tagsCache
+	}
+}
+
+type tagsCache interface {
+	SetOCITags(repo string, tags []string) error
+	GetOCITags(repo string, tags *[]string) error
+}
+
+func NewClient(repoURL string, creds Creds, proxy string, noProxy string, opts ...ClientOpts) Client {
+	return NewClientWithLock(repoURL, creds, globalLock, proxy, noProxy, opts...)
+}
+
+func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy string, noProxy string, opts ...ClientOpts) Client {
+	c := &nativeOCIClient{
+		repoURL:            repoURL,
+		creds:              creds,
+		repoLock:           repoLock,
+		proxy:              proxy,
+		noProxy:            noProxy,
+		artifactCachePaths: argoio.NewRandomizedTempPaths(os.TempDir()),
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+var _ Client = &nativeOCIClient{}
+
+type nativeOCIClient struct {
+	artifactCachePaths argoio.TempPaths
+	repoURL            string
+	creds              Creds
+	repoLock           sync.KeyLock
+	tagsCache          tagsCache
+	proxy              string
+	noProxy            string
+}
+
+// IsDigest returns true if the given revision is an OCI content digest, e.g. sha256:abc...
+func IsDigest(revision string) bool {
+	_, err := digest.Parse(revision)
+	return err == nil
+}
+
+// NormalizeRepoURL strips the oci:// scheme from the given repository URL, since the registry client expects a plain
+// reference of the form <registry>/<repository>.
+func NormalizeRepoURL(repoURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(repoURL, "oci://"), "/")
+}
+
+func (c *nativeOCIClient) newRepository() (*remote.Repository, error) {
+	reference := NormalizeRepoURL(c.repoURL)
+	repo, err := remote.NewRepository(reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize repository %s: %w", reference, err)
+	}
+	tlsConf, err := newTLSConfig(c.creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
+	}
+	client := &http.Client{Transport: &http.Transport{
+		Proxy:             proxy.GetCallback(c.proxy, c.noProxy),
+		TLSClientConfig:   tlsConf,
+		DisableKeepAlives: true,
+	}}
+	repo.Client = &auth.Client{
+		Client: client,
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username: c.creds.Username,
+			Password: c.creds.Password,
+		}),
+	}
+	return repo, nil
+}
+
+func (c *nativeOCIClient) ResolveRevision(ctx context.Context, revision string, noCache bool) (string, error) {
+	if IsDigest(revision) {
+		return revision, nil
+	}
+	repo, err := c.newRepository()
+	if err != nil {
+		return "", err
+	}
+
+	tag := revision
+	// A tag can also be a semver constraint, in which case we resolve the highest matching tag first.
+	if _, err := semver.NewVersion(revision); err != nil {
+		if constraints, err := semver.NewConstraint(revision); err == nil {
+			tags, err := c.getTags(ctx, repo, noCache)
+			if err != nil {
+				return "", fmt.Errorf("unable to get tags: %w", err)
+			}
+			tag, err = maxVersion(tags, constraints)
+			if err != nil {
+				return "", fmt.Errorf("no version for constraints: %w", err)
+			}
+		}
+	}
+
+	desc, err := repo.Resolve(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve revision %q: %w", tag, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+func (c *nativeOCIClient) getTags(ctx context.Context, repo *remote.Repository, noCache bool) ([]string, error) {
+	var tags []string
+	if !noCache && c.tagsCache != nil {
+		if err := c.tagsCache.GetOCITags(c.repoURL, &tags); err == nil && len(tags) > 0 {
+			return tags, nil
+		}
+	}
+
+	start := time.Now()
+	err := repo.Tags(ctx, "", func(result []string) error {
+		tags = append(tags, result...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	log.WithFields(
+		log.Fields{"seconds": time.Since(start).Seconds(), "repo": c.repoURL},
+	).Info("took to get tags")
+
+	if c.tagsCache != nil {
+		if err := c.tagsCache.SetOCITags(c.repoURL, tags); err != nil {
+			log.Warnf("Failed to store tags list cache for repo: %s: %v", c.repoURL, err)
+		}
+	}
+	return tags, nil
+}
+
+func maxVersion(tags []string, constraints *semver.Constraints) (string, error) {
+	var maxTag string
+	var maxVersion *semver.Version
+	for _, tag := range tags {
+		v, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if constraints.Check(v) && (maxVersion == nil || v.GreaterThan(maxVersion)) {
+			maxVersion = v
+			maxTag = tag
+		}
+	}
+	if maxVersion == nil {
+		return "", fmt.Errorf("constraint not found in %v tags", len(tags))
+	}
+	return maxTag, nil
+}
+
+func (c *nativeOCIClient) getCachedArtifactPath(digest string, project string) (string, error) {
+	keyData, err := json.Marshal(map[string]string{"url": NormalizeRepoURL(c.repoURL), "digest": digest, "project": project})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling cache key data: %w", err)
+	}
+	return c.artifactCachePaths.GetPath(string(keyData))
+}
+
+func (c *nativeOCIClient) CleanCache(digest string, project string) error {
+	cachePath, err := c.getCachedArtifactPath(digest, project)
+	if err != nil {
+		return fmt.Errorf("error getting cached artifact path: %w", err)
+	}
+	if err := os.RemoveAll(cachePath); err != nil {
+		return fmt.Errorf("error removing artifact cache at %s: %w", cachePath, err)
+	}
+	return nil
+}
+
+func (c *nativeOCIClient) Extract(ctx context.Context, revision string, project string, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, argoio.Closer, error) {
+	if !IsDigest(revision) {
+		return "", nil, fmt.Errorf("revision %q must be resolved to a digest before extracting", revision)
+	}
+
+	cachedArtifactPath, err := c.getCachedArtifactPath(revision, project)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting cached artifact path: %w", err)
+	}
+
+	c.repoLock.Lock(cachedArtifactPath)
+	defer c.repoLock.Unlock(cachedArtifactPath)
+
+	manifest, err := c.pull(ctx, revision, cachedArtifactPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// throw away temp directory that stores extracted artifact and should be deleted as soon as no longer needed by returned closer
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	maxSize := manifestMaxExtractedSize
+	if disableManifestMaxExtractedSize {
+		maxSize = -1
+	}
+	if err := extractLayers(tempDir, cachedArtifactPath, manifest.Layers, maxSize); err != nil {
+		_ = os.RemoveAll(tempDir)
+		return "", nil, fmt.Errorf("error extracting artifact %s: %w", revision, err)
+	}
+	return tempDir, argoio.NewCloser(func() error {
+		return os.RemoveAll(tempDir)
+	}), nil
+}
+
+// pull downloads the manifest and the layers of the artifact with the given digest into the cache path, unless they
+// are already there. Layers are stored by their digest and are only moved into the cache once their content has
+// been verified, so the presence of a layer file guarantees its integrity.
+func (c *nativeOCIClient) pull(ctx context.Context, revision string, cachePath string) (*ocispec.Manifest, error) {
+	if err := os.MkdirAll(cachePath, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating artifact cache directory: %w", err)
+	}
+
+	var repo *remote.Repository
+	getRepo := func() (*remote.Repository, error) {
+		if repo != nil {
+			return repo, nil
+		}
+		var err error
+		repo, err = c.newRepository()
+		return repo, err
+	}
+
+	manifestPath := filepath.Join(cachePath, "manifest.json")
+	manifestData, err := os.ReadFile(manifestPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("error reading cached artifact manifest: %w", err)
+		}
+		r, err := getRepo()
+		if err != nil {
+			return nil, err
+		}
+		desc, rc, err := r.FetchReference(ctx, revision)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching artifact manifest %s: %w", revision, err)
+		}
+		defer func() { _ = rc.Close() }()
+		if desc.MediaType != ocispec.MediaTypeImageManifest {
+			return nil, fmt.Errorf("unsupported artifact manifest media type %q", desc.MediaType)
+		}
+		if desc.Size > maxManifestSize {
+			return nil, fmt.Errorf("artifact manifest size %d exceeds maximum of %d bytes", desc.Size, maxManifestSize)
+		}
+		manifestData, err = content.ReadAll(rc, desc)
+		if err != nil {
+			return nil, fmt.Errorf("error reading artifact manifest: %w", err)
+		}
+		if err := writeFileAtomic(manifestPath, manifestData); err != nil {
+			return nil, fmt.Errorf("error caching artifact manifest: %w", err)
+		}
+	}
+
+	manifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshaling artifact manifest: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		layerPath := filepath.Join(cachePath, layer.Digest.Encoded())
+		if _, err := os.Stat(layerPath); err == nil {
+			continue
+		}
+		r, err := getRepo()
+		if err != nil {
+			return nil, err
+		}
+		if err := fetchLayer(ctx, r, layer, layerPath); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+func fetchLayer(ctx context.Context, repo *remote.Repository, layer ocispec.Descriptor, layerPath string) error {
+	if err := layer.Digest.Validate(); err != nil {
+		return fmt.Errorf("invalid layer digest %q: %w", layer.Digest, err)
+	}
+	rc, err := repo.Fetch(ctx, layer)
+	if err != nil {
+		return fmt.Errorf("error fetching layer %s: %w", layer.Digest, err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(layerPath), "layer-")
+	if err != nil {
+		return fmt.Errorf("error creating temporary layer file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	vr := content.NewVerifyReader(rc, layer)
+	if _, err := io.Copy(tmpFile, vr); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("error downloading layer %s: %w", layer.Digest, err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("error writing layer %s: %w", layer.Digest, err)
+	}
+	if err := vr.Verify(); err != nil {
+		return fmt.Errorf("error verifying layer %s: %w", layer.Digest, err)
+	}
+	if err := os.Rename(tmpFile.Name(), layerPath); err != nil {
+		return fmt.Errorf("error caching layer %s: %w", layer.Digest, err)
+	}
+	return nil
+}
+
+// extractLayers extracts the given layers from the cache path into dstPath. Gzipped tarballs are unpacked, any other
+// layer is written as a single file named after its title annotation. maxSize limits the combined size of the
+// extracted content, a negative value disables the limit.
+func extractLayers(dstPath string, cachePath string, layers []ocispec.Descriptor, maxSize int64) error {
+	remaining := maxSize
+	for _, layer := range layers {
+		if maxSize >= 0 && remaining <= 0 {
+			return fmt.Errorf("artifact exceeds maximum extracted size of %d bytes", maxSize)
+		}
+		layerPath := filepath.Join(cachePath, layer.Digest.Encoded())
+		title := layer.Annotations[ocispec.AnnotationTitle]
+		if supportedArchiveMediaTypes[layer.MediaType] || layer.Annotations[annotationUnpack] == "true" {
+			if err := untgzLayer(dstPath, layerPath, remaining); err != nil {
+				return fmt.Errorf("error extracting layer %s: %w", layer.Digest, err)
+			}
+		} else if title != "" {
+			if maxSize >= 0 && layer.Size > remaining {
+				return fmt.Errorf("artifact exceeds maximum extracted size of %d bytes", maxSize)
+			}
+			if err := copyLayer(dstPath, layerPath, title); err != nil {
+				return fmt.Errorf("error extracting layer %s: %w", layer.Digest, err)
+			}
+		} else {
+			log.Debugf("Skipping layer %s with unsupported media type %q", layer.Digest, layer.MediaType)
+			continue
+		}
+		if maxSize >= 0 {
+			extracted, err := dirSize(dstPath)
+			if err != nil {
+				return err
+			}
+			remaining = maxSize - extracted
+		}
+	}
+	if maxSize >= 0 && remaining < 0 {
+		return fmt.Errorf("artifact exceeds maximum extracted size of %d bytes", maxSize)
+	}
+	return nil
+}
+
+func untgzLayer(dstPath string, layerPath string, maxSize int64) error {
+	reader, err := os.Open(layerPath)
+	if err != nil {
+		return fmt.Errorf("error opening layer %s: %w", layerPath, err)
+	}
+	defer func() { _ = reader.Close() }()
+	if maxSize < 0 {
+		maxSize = int64(^uint64(0) >> 1)
+	}
+	return files.Untgz(dstPath, reader, maxSize, false)
+}
+
+func copyLayer(dstPath string, layerPath string, title string) error {
+	target := filepath.Join(dstPath, title)
+	// Sanity check to protect against path traversal via the title annotation
+	if !files.Inbound(target, dstPath) {
+		return fmt.Errorf("illegal file path in layer title: %s", title)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("error creating nested folders: %w", err)
+	}
+	src, err := os.Open(layerPath)
+	if err != nil {
+		return fmt.Errorf("error opening layer %s: %w", layerPath, err)
+	}
+	defer func() { _ = src.Close() }()
+	dst, err := os.OpenFile(target, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("error creating file %q: %w", target, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("error writing file %q: %w", target, err)
+	}
+	return dst.Close()
+}
+
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error computing extracted size: %w", err)
+	}
+	return size, nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+"-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (c *nativeOCIClient) TestRepo(ctx context.Context) (bool, error) {
+	repo, err := c.newRepository()
+	if err != nil {
+		return false, err
+	}
+	err = repo.Tags(ctx, "", func(_ []string) error {
+		// We only need to know the repository is accessible, so stop after the first page.
+		return errStopIteration
+	})
+	if err != nil && !errors.Is(err, errStopIteration) {
+		return false, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return true, nil
+}
+
+var errStopIteration = errors.New("stop iteration")
+
+func newTLSConfig(creds Creds) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if creds.CAPath != "" {
+		caData, err := os.ReadFile(creds.CAPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA file %s: %w", creds.CAPath, err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, fmt.Errorf("error creating X509 key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
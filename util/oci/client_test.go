@@ -0,0 +1,245 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+type fakeRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	tags      []string
+	requests  int
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	t.Helper()
+	r := &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.requests++
+		switch {
+		case req.URL.Path == "/v2/org/bundle/tags/list":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "org/bundle", "tags": r.tags})
+		case strings.HasPrefix(req.URL.Path, "/v2/org/bundle/manifests/"):
+			ref := strings.TrimPrefix(req.URL.Path, "/v2/org/bundle/manifests/")
+			data, ok := r.manifests[ref]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", digest.FromBytes(data).String())
+			w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+			if req.Method != http.MethodHead {
+				_, _ = w.Write(data)
+			}
+		case strings.HasPrefix(req.URL.Path, "/v2/org/bundle/blobs/"):
+			data, ok := r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/org/bundle/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+			_, _ = w.Write(data)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *fakeRegistry) repoURL() string {
+	return "oci://" + strings.TrimPrefix(r.server.URL, "https://") + "/org/bundle"
+}
+
+func (r *fakeRegistry) addBlob(data []byte) digest.Digest {
+	d := digest.FromBytes(data)
+	r.blobs[d.String()] = data
+	return d
+}
+
+// push stores an artifact with the given layers under the given tags and returns its digest.
+func (r *fakeRegistry) push(t *testing.T, layers []ocispec.Descriptor, tags ...string) string {
+	t.Helper()
+	config := []byte("{}")
+	manifest := ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: "application/vnd.oci.empty.v1+json", Digest: r.addBlob(config), Size: int64(len(config))},
+		Layers:    layers,
+	}
+	manifest.SchemaVersion = 2
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	d := digest.FromBytes(data).String()
+	r.manifests[d] = data
+	for _, tag := range tags {
+		r.manifests[tag] = data
+		r.tags = append(r.tags, tag)
+	}
+	return d
+}
+
+func (r *fakeRegistry) fileLayer(title string, data []byte) ocispec.Descriptor {
+	return ocispec.Descriptor{
+		MediaType:   "application/vnd.oci.image.layer.v1.tar",
+		Digest:      r.addBlob(data),
+		Size:        int64(len(data)),
+		Annotations: map[string]string{ocispec.AnnotationTitle: title},
+	}
+}
+
+func (r *fakeRegistry) tgzLayer(t *testing.T, files map[string]string) ocispec.Descriptor {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	data := buf.Bytes()
+	return ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: r.addBlob(data), Size: int64(len(data))}
+}
+
+func newTestClient(t *testing.T, repoURL string) Client {
+	t.Helper()
+	return NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", "", WithArtifactPaths(io.NewRandomizedTempPaths(t.TempDir())))
+}
+
+func TestIsDigest(t *testing.T) {
+	assert.True(t, IsDigest("sha256:"+strings.Repeat("a", 64)))
+	assert.False(t, IsDigest("1.0.0"))
+	assert.False(t, IsDigest("sha256:abc"))
+}
+
+func TestNormalizeRepoURL(t *testing.T) {
+	assert.Equal(t, "registry.example.com/org/bundle", NormalizeRepoURL("oci://registry.example.com/org/bundle/"))
+	assert.Equal(t, "registry.example.com/org/bundle", NormalizeRepoURL("registry.example.com/org/bundle"))
+}
+
+func TestResolveRevision(t *testing.T) {
+	registry := newFakeRegistry(t)
+	d1 := registry.push(t, []ocispec.Descriptor{registry.fileLayer("a.yaml", []byte("a: 1"))}, "1.0.0")
+	d2 := registry.push(t, []ocispec.Descriptor{registry.fileLayer("a.yaml", []byte("a: 2"))}, "1.1.0", "latest")
+	client := newTestClient(t, registry.repoURL())
+
+	t.Run("tag", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "1.0.0", true)
+		require.NoError(t, err)
+		assert.Equal(t, d1, resolved)
+	})
+	t.Run("non-semver tag", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "latest", true)
+		require.NoError(t, err)
+		assert.Equal(t, d2, resolved)
+	})
+	t.Run("semver constraint", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "1.*", true)
+		require.NoError(t, err)
+		assert.Equal(t, d2, resolved)
+	})
+	t.Run("digest", func(t *testing.T) {
+		requests := registry.requests
+		resolved, err := client.ResolveRevision(context.Background(), d1, true)
+		require.NoError(t, err)
+		assert.Equal(t, d1, resolved)
+		assert.Equal(t, requests, registry.requests, "digests should not be resolved against the registry")
+	})
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := client.ResolveRevision(context.Background(), "2.0.0", true)
+		require.Error(t, err)
+	})
+}
+
+func TestExtract(t *testing.T) {
+	registry := newFakeRegistry(t)
+	d := registry.push(t, []ocispec.Descriptor{
+		registry.tgzLayer(t, map[string]string{"base/kustomization.yaml": "resources: [cm.yaml]", "base/cm.yaml": "kind: ConfigMap"}),
+		registry.fileLayer("deploy.yaml", []byte("kind: Deployment")),
+	}, "1.0.0")
+	client := newTestClient(t, registry.repoURL())
+
+	path, closer, err := client.Extract(context.Background(), d, "", 1024*1024, false)
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(path, "base", "cm.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap", string(data))
+	data, err = os.ReadFile(filepath.Join(path, "deploy.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Deployment", string(data))
+	require.NoError(t, closer.Close())
+	assert.NoDirExists(t, path)
+
+	t.Run("layers are cached by digest", func(t *testing.T) {
+		requests := registry.requests
+		path, closer, err := client.Extract(context.Background(), d, "", 1024*1024, false)
+		require.NoError(t, err)
+		defer io.Close(closer)
+		assert.FileExists(t, filepath.Join(path, "deploy.yaml"))
+		assert.Equal(t, requests, registry.requests)
+	})
+	t.Run("exceeds max extracted size", func(t *testing.T) {
+		_, _, err := client.Extract(context.Background(), d, "", 10, false)
+		require.Error(t, err)
+	})
+	t.Run("max extracted size disabled", func(t *testing.T) {
+		_, closer, err := client.Extract(context.Background(), d, "", 10, true)
+		require.NoError(t, err)
+		io.Close(closer)
+	})
+	t.Run("requires digest", func(t *testing.T) {
+		_, _, err := client.Extract(context.Background(), "1.0.0", "", 1024*1024, false)
+		require.ErrorContains(t, err, "must be resolved to a digest")
+	})
+}
+
+func TestExtract_PathTraversal(t *testing.T) {
+	registry := newFakeRegistry(t)
+	d := registry.push(t, []ocispec.Descriptor{registry.fileLayer("../escape.yaml", []byte("kind: ConfigMap"))})
+	client := newTestClient(t, registry.repoURL())
+
+	_, _, err := client.Extract(context.Background(), d, "", 1024*1024, false)
+	require.ErrorContains(t, err, "illegal file path")
+}
+
+func TestExtract_CorruptedLayer(t *testing.T) {
+	registry := newFakeRegistry(t)
+	layer := registry.fileLayer("a.yaml", []byte("kind: ConfigMap"))
+	registry.blobs[layer.Digest.String()] = []byte("kind: Secret!!!")
+	d := registry.push(t, []ocispec.Descriptor{layer})
+	client := newTestClient(t, registry.repoURL())
+
+	_, _, err := client.Extract(context.Background(), d, "", 1024*1024, false)
+	require.Error(t, err)
+}
+
+func TestTestRepo(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.push(t, []ocispec.Descriptor{registry.fileLayer("a.yaml", []byte("a: 1"))}, "1.0.0")
+
+	ok, err := newTestClient(t, registry.repoURL()).TestRepo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,174 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+const (
+	// cosignSignatureMediaType is the media type of the layers of a cosign signature, holding the signed payload
+	cosignSignatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// cosignSignatureAnnotation is the layer annotation holding the base64 encoded signature of the payload
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// dockerManifestMediaType is the media type of Docker image manifests, which cosign uses for signatures by default
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	// maxCosignPayloadSize is the maximum size of a signed payload which is accepted from a registry
+	maxCosignPayloadSize = 64 * 1024
+)
+
+// cosignPayload is the signed payload of a cosign signature in the simple signing format
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// ParseCosignPublicKey parses a PEM encoded public key as generated by `cosign generate-key-pair`
+func ParseCosignPublicKey(key string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// cosignSignatureTag returns the tag cosign stores the signatures of the manifest with the given digest under
+func cosignSignatureTag(d digest.Digest) string {
+	return fmt.Sprintf("%s-%s.sig", d.Algorithm(), d.Encoded())
+}
+
+// verifyCosignPayload verifies the signature of a payload with the given public key, like `cosign verify --key` does
+func verifyCosignPayload(publicKey crypto.PublicKey, payload []byte, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, signature)
+	}
+	return false
+}
+
+// VerifyCosignSignature verifies that the manifest with the given digest has a cosign signature made by one of the given
+// PEM encoded public keys and returns the manifest. Signatures are looked up in the same repository, using the tag
+// scheme of cosign. Transparency log entries are not checked.
+func (c *nativeOCIClient) VerifyCosignSignature(ctx context.Context, revision string, publicKeys []string) (*ocispec.Manifest, error) {
+	d, err := digest.Parse(revision)
+	if err != nil {
+		return nil, fmt.Errorf("revision %q must be resolved to a digest before verifying its signature", revision)
+	}
+	keys := make([]crypto.PublicKey, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		key, err := ParseCosignPublicKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign public key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no cosign public keys configured")
+	}
+
+	repo, err := c.newRepository()
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := fetchManifest(ctx, repo, cosignSignatureTag(d))
+	if err != nil {
+		return nil, fmt.Errorf("no cosign signature found for %s: %w", revision, err)
+	}
+	if !c.hasValidCosignSignature(ctx, repo, signatures, d, keys) {
+		return nil, fmt.Errorf("no valid cosign signature for %s found for any of the configured public keys", revision)
+	}
+
+	manifest, err := fetchManifest(ctx, repo, revision)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching artifact manifest %s: %w", revision, err)
+	}
+	return manifest, nil
+}
+
+// hasValidCosignSignature returns true if any of the layers of the given signature manifest is a signature of the
+// manifest with the given digest made with one of the given keys
+func (c *nativeOCIClient) hasValidCosignSignature(ctx context.Context, repo *remote.Repository, signatures *ocispec.Manifest, d digest.Digest, keys []crypto.PublicKey) bool {
+	for _, layer := range signatures.Layers {
+		if layer.MediaType != cosignSignatureMediaType || layer.Size > maxCosignPayloadSize {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+		payload, err := content.FetchAll(ctx, repo, layer)
+		if err != nil {
+			log.Warnf("Failed to fetch cosign signature payload %s of %s: %v", layer.Digest, c.repoURL, err)
+			continue
+		}
+		for _, key := range keys {
+			if !verifyCosignPayload(key, payload, signature) {
+				continue
+			}
+			var signed cosignPayload
+			if err := json.Unmarshal(payload, &signed); err != nil {
+				continue
+			}
+			// the signature must be made for this manifest rather than another one of the repository
+			if signed.Critical.Image.DockerManifestDigest == d.String() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fetchManifest fetches and parses the manifest with the given tag or digest, making sure its content matches the
+// digest if a digest is given
+func fetchManifest(ctx context.Context, repo *remote.Repository, reference string) (*ocispec.Manifest, error) {
+	desc, rc, err := repo.FetchReference(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+	if desc.MediaType != ocispec.MediaTypeImageManifest && desc.MediaType != dockerManifestMediaType {
+		return nil, fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+	}
+	if desc.Size > maxManifestSize {
+		return nil, fmt.Errorf("manifest size %d exceeds maximum of %d bytes", desc.Size, maxManifestSize)
+	}
+	data, err := content.ReadAll(rc, desc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	if IsDigest(reference) && digest.FromBytes(data).String() != reference {
+		return nil, fmt.Errorf("manifest does not match digest %s", reference)
+	}
+	manifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,91 @@
+package oci
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCosignKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// sign pushes a cosign signature of the manifest with the given digest like `cosign sign --key` does
+func (r *fakeRegistry) sign(t *testing.T, key *ecdsa.PrivateKey, signedDigest string, manifestDigest string) {
+	t.Helper()
+	payload, err := json.Marshal(map[string]interface{}{
+		"critical": map[string]interface{}{
+			"identity": map[string]string{"docker-reference": "registry.example.com/org/bundle"},
+			"image":    map[string]string{"docker-manifest-digest": signedDigest},
+			"type":     "cosign container image signature",
+		},
+		"optional": nil,
+	})
+	require.NoError(t, err)
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	layer := ocispec.Descriptor{
+		MediaType:   cosignSignatureMediaType,
+		Digest:      r.addBlob(payload),
+		Size:        int64(len(payload)),
+		Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+	}
+	r.push(t, []ocispec.Descriptor{layer}, cosignSignatureTag(digest.Digest(manifestDigest)))
+}
+
+func TestVerifyCosignSignature(t *testing.T) {
+	registry := newFakeRegistry(t)
+	d := registry.push(t, []ocispec.Descriptor{registry.fileLayer("a.yaml", []byte("a: 1"))}, "1.0.0")
+	other := registry.push(t, []ocispec.Descriptor{registry.fileLayer("a.yaml", []byte("a: 2"))}, "2.0.0")
+	key, publicKey := newCosignKey(t)
+	_, otherPublicKey := newCosignKey(t)
+	registry.sign(t, key, d, d)
+	client := newTestClient(t, registry.repoURL())
+
+	t.Run("Valid signature", func(t *testing.T) {
+		manifest, err := client.VerifyCosignSignature(context.Background(), d, []string{otherPublicKey, publicKey})
+		require.NoError(t, err)
+		require.Len(t, manifest.Layers, 1)
+		assert.Equal(t, "a.yaml", manifest.Layers[0].Annotations[ocispec.AnnotationTitle])
+	})
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := client.VerifyCosignSignature(context.Background(), d, []string{otherPublicKey})
+		require.ErrorContains(t, err, "no valid cosign signature")
+	})
+	t.Run("Not signed", func(t *testing.T) {
+		_, err := client.VerifyCosignSignature(context.Background(), other, []string{publicKey})
+		require.ErrorContains(t, err, "no cosign signature found")
+	})
+	t.Run("Signature of another manifest", func(t *testing.T) {
+		// a signature of another artifact which is copied to the signature tag of this one must not be accepted
+		registry.sign(t, key, d, other)
+		_, err := client.VerifyCosignSignature(context.Background(), other, []string{publicKey})
+		require.ErrorContains(t, err, "no valid cosign signature")
+	})
+	t.Run("Tag instead of digest", func(t *testing.T) {
+		_, err := client.VerifyCosignSignature(context.Background(), "1.0.0", []string{publicKey})
+		require.ErrorContains(t, err, "must be resolved to a digest")
+	})
+	t.Run("Invalid key", func(t *testing.T) {
+		_, err := client.VerifyCosignSignature(context.Background(), d, []string{"not a key"})
+		require.ErrorContains(t, err, "invalid cosign public key")
+	})
+}
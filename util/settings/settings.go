@@ -136,6 +136,15 @@ type GoogleAnalytics struct {
 	AnonymizeUsers bool   `json:"anonymizeUsers,omitempty"`
 }
 
+// SyncGateSettings holds the endpoints which sync gates may check. Sync gates refer to the endpoints by name, so that
+// application manifests cannot make the application controller send requests to arbitrary URLs.
+type SyncGateSettings struct {
+	// Prometheus maps names to the base URLs of Prometheus compatible APIs
+	Prometheus map[string]string `json:"prometheus,omitempty"`
+	// Webhooks maps names to the URLs of webhooks
+	Webhooks map[string]string `json:"webhooks,omitempty"`
+}
+
 type GlobalProjectSettings struct {
 	ProjectName   string               `json:"projectName,omitempty"`
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
@@ -459,6 +468,8 @@ const (
 	resourceInclusionsKey = "resource.inclusions"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+	// historyManifestSnapshotsEnabledKey is the key to a boolean determining whether the synced manifests are stored in the revision history of applications
+	historyManifestSnapshotsEnabledKey = "application.history.manifestSnapshotsEnabled"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
 	resourceCustomLabelsKey = "resource.customLabels"
 	// resourceIncludeEventLabelKeys is the key to labels to be added onto Application k8s events if present on an Application or it's AppProject. Supports wildcard.
@@ -491,6 +502,8 @@ const (
 	settingsBinaryUrlsKey = "help.download"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
+	// syncGatesKey designates the key for the endpoints which sync gates may check
+	syncGatesKey = "sync.gates"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
 	initialPasswordSecretName = "argocd-initial-admin-secret"
 	// initialPasswordSecretField is the name of the field in initialPasswordSecretName to store the password
@@ -899,6 +912,21 @@ func (mgr *SettingsManager) GetIgnoreResourceUpdatesOverrides() (map[string]v1al
 	return resourceOverrides, nil
 }
 
+// GetIsHistoryManifestSnapshotsEnabled returns whether a snapshot of the synced manifests is stored in the revision
+// history of applications
+func (mgr *SettingsManager) GetIsHistoryManifestSnapshotsEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	if argoCDCM.Data[historyManifestSnapshotsEnabledKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[historyManifestSnapshotsEnabledKey])
+}
+
 func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -1021,6 +1049,13 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			overrideVal.UseOpenLibs = useOpenLibs
 		case "actions":
 			overrideVal.Actions = v
+		case "healthPlugin":
+			healthPlugin := &v1alpha1.ResourceHealthPlugin{}
+			err := yaml.Unmarshal([]byte(v), healthPlugin)
+			if err != nil {
+				return err
+			}
+			overrideVal.HealthPlugin = healthPlugin
 		case "ignoreDifferences":
 			overrideIgnoreDiff := v1alpha1.OverrideIgnoreDiff{}
 			err := yaml.Unmarshal([]byte(v), &overrideIgnoreDiff)
@@ -2269,6 +2304,21 @@ func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings
 	return globalProjectSettings, nil
 }
 
+// GetSyncGateSettings returns the endpoints which sync gates may check
+func (mgr *SettingsManager) GetSyncGateSettings() (*SyncGateSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	syncGateSettings := &SyncGateSettings{}
+	if value := argoCDCM.Data[syncGatesKey]; value != "" {
+		if err := yaml.Unmarshal([]byte(value), syncGateSettings); err != nil {
+			return nil, fmt.Errorf("error unmarshalling sync gate settings: %w", err)
+		}
+	}
+	return syncGateSettings, nil
+}
+
 func (mgr *SettingsManager) GetNamespace() string {
 	return mgr.namespace
 }
@@ -215,6 +215,20 @@ func TestGetIsIgnoreResourceUpdatesEnabledDefaultFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetIsHistoryManifestSnapshotsEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"application.history.manifestSnapshotsEnabled": "true",
+	})
+	enabled, err := settingsManager.GetIsHistoryManifestSnapshotsEnabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, settingsManager = fixtures(nil)
+	enabled, err = settingsManager.GetIsHistoryManifestSnapshotsEnabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+}
+
 func TestGetServerRBACLogEnforceEnableKey(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"server.rbac.log.enforce.enable": "true",
@@ -498,6 +512,25 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 	})
 }
 
+func TestGetResourceOverrides_HealthPlugin(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.customizations": `
+    db.example.com/Migration:
+      health.plugin:
+        address: unix:///plugins/migrations.sock`,
+		"resource.customizations.healthPlugin.db.example.com_Schema": `address: schema-health.argocd.svc:8080
+timeout: 2s`,
+	})
+
+	overrides, err := settingsManager.GetResourceOverrides()
+	require.NoError(t, err)
+	require.NotNil(t, overrides["db.example.com/Migration"].HealthPlugin)
+	assert.Equal(t, "unix:///plugins/migrations.sock", overrides["db.example.com/Migration"].HealthPlugin.Address)
+	require.NotNil(t, overrides["db.example.com/Schema"].HealthPlugin)
+	assert.Equal(t, "schema-health.argocd.svc:8080", overrides["db.example.com/Schema"].HealthPlugin.Address)
+	assert.Equal(t, "2s", overrides["db.example.com/Schema"].HealthPlugin.Timeout)
+}
+
 func mergemaps(mapA map[string]string, mapB map[string]string) map[string]string {
 	for k, v := range mapA {
 		mapB[k] = v
@@ -817,6 +850,26 @@ func TestGetGoogleAnalytics(t *testing.T) {
 	assert.True(t, ga.AnonymizeUsers)
 }
 
+func TestGetSyncGateSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"sync.gates": `
+prometheus:
+  monitoring: http://prometheus.monitoring:9090
+webhooks:
+  smoke-test: https://tests.example.com/smoke`,
+	})
+	syncGates, err := settingsManager.GetSyncGateSettings()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"monitoring": "http://prometheus.monitoring:9090"}, syncGates.Prometheus)
+	assert.Equal(t, map[string]string{"smoke-test": "https://tests.example.com/smoke"}, syncGates.Webhooks)
+
+	_, settingsManager = fixtures(nil)
+	syncGates, err = settingsManager.GetSyncGateSettings()
+	require.NoError(t, err)
+	assert.Empty(t, syncGates.Prometheus)
+	assert.Empty(t, syncGates.Webhooks)
+}
+
 func TestSettingsManager_GetHelp(t *testing.T) {
 	t.Run("Default", func(t *testing.T) {
 		_, settingsManager := fixtures(nil)
@@ -0,0 +1,92 @@
+package signature
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+)
+
+// Type is the type of a commit signature
+type Type string
+
+const (
+	// TypeGPG is the type of OpenPGP signatures, which are verified using GnuPG
+	TypeGPG Type = "gpg"
+	// TypeSSH is the type of SSH signatures
+	TypeSSH Type = "ssh"
+	// TypeX509 is the type of X.509 signatures, e.g. keyless Sigstore signatures made with gitsign
+	TypeX509 Type = "x509"
+)
+
+// DetectType returns the type of the given armored signature, or the empty string if the type is not known
+func DetectType(signature []byte) Type {
+	switch {
+	case bytes.HasPrefix(signature, []byte("-----BEGIN PGP SIGNATURE-----")):
+		return TypeGPG
+	case bytes.HasPrefix(signature, []byte(sshSignatureArmorStart)):
+		return TypeSSH
+	case bytes.HasPrefix(signature, []byte("-----BEGIN SIGNED MESSAGE-----")):
+		return TypeX509
+	}
+	return ""
+}
+
+// Result is the result of a successful verification of an SSH or X.509 signature
+type Result struct {
+	// Type is the type of the signature
+	Type Type
+	// Signer is the SHA256 fingerprint of the key of an SSH signature, or the subject of the certificate of an X.509
+	// signature, e.g. the email address of the signer
+	Signer string
+	// Issuer is the OIDC issuer which authenticated the signer of a keyless X.509 signature
+	Issuer string
+}
+
+// String returns a human readable description of the signer
+func (r *Result) String() string {
+	switch r.Type {
+	case TypeSSH:
+		return fmt.Sprintf("SSH key %s", r.Signer)
+	case TypeX509:
+		if r.Issuer != "" {
+			return fmt.Sprintf("%s issued by %s", r.Signer, r.Issuer)
+		}
+		return r.Signer
+	}
+	return r.Signer
+}
+
+// IsVerificationRequired returns whether commits need to be signed in order to be deployed by applications of the
+// given project. Signatures made with GnuPG keys are only required if GnuPG is enabled.
+func IsVerificationRequired(project *v1alpha1.AppProject) bool {
+	return (len(project.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()) || len(project.Spec.SSHSignatureKeys) > 0 || len(project.Spec.KeylessSignatureIdentities) > 0
+}
+
+// IsPermitted returns whether the signer of a verified signature is allowed to sign commits in the given project
+func IsPermitted(project *v1alpha1.AppProject, result *Result) bool {
+	switch result.Type {
+	case TypeSSH:
+		for _, key := range project.Spec.SSHSignatureKeys {
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+			if err == nil && ssh.FingerprintSHA256(publicKey) == result.Signer {
+				return true
+			}
+		}
+	case TypeX509:
+		for _, identity := range project.Spec.KeylessSignatureIdentities {
+			if identity.Issuer != result.Issuer {
+				continue
+			}
+			subjectRegex, err := regexp.Compile("^(?:" + identity.SubjectRegex + ")$")
+			if err == nil && subjectRegex.MatchString(result.Signer) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,312 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// oidSigningTime is the signed attribute which contains the signing time chosen by the signer
+var oidSigningTime = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+
+const testPayload = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor A <a@example.com> 1700000000 +0000\ncommitter A <a@example.com> 1700000000 +0000\n\nSigned commit\n"
+
+// signSSH creates an armored SSH signature of the given payload like ssh-keygen -Y sign
+func signSSH(t *testing.T, signer ssh.Signer, namespace string, payload string) []byte {
+	t.Helper()
+	hash := sha256.Sum256([]byte(payload))
+	signedData := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{Namespace: namespace, HashAlgorithm: "sha256", Hash: hash[:]})...)
+	sig, err := signer.Sign(rand.Reader, signedData)
+	require.NoError(t, err)
+	blob := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignature{
+		Version:       1,
+		PublicKey:     signer.PublicKey().Marshal(),
+		Namespace:     namespace,
+		HashAlgorithm: "sha256",
+		Signature:     ssh.Marshal(sig),
+	})...)
+	return []byte(sshSignatureArmorStart + "\n" + base64.StdEncoding.EncodeToString(blob) + "\n" + sshSignatureArmorEnd + "\n")
+}
+
+func newSSHSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestVerifySSH(t *testing.T) {
+	signer := newSSHSigner(t)
+	signature := signSSH(t, signer, "git", testPayload)
+	assert.Equal(t, TypeSSH, DetectType(signature))
+
+	result, err := VerifySSH(signature, []byte(testPayload))
+	require.NoError(t, err)
+	assert.Equal(t, TypeSSH, result.Type)
+	assert.Equal(t, ssh.FingerprintSHA256(signer.PublicKey()), result.Signer)
+
+	_, err = VerifySSH(signature, []byte(testPayload+"tampered"))
+	require.ErrorContains(t, err, "bad SSH signature")
+
+	_, err = VerifySSH(signSSH(t, signer, "file", testPayload), []byte(testPayload))
+	require.ErrorContains(t, err, "namespace")
+
+	_, err = VerifySSH([]byte("-----BEGIN SSH SIGNATURE-----\nnot base64\n-----END SSH SIGNATURE-----"), []byte(testPayload))
+	require.ErrorContains(t, err, "malformed SSH signature")
+}
+
+func TestVerifySSH_SSHKeygen(t *testing.T) {
+	signature, err := os.ReadFile("testdata/ssh_signature.txt")
+	require.NoError(t, err)
+	payload, err := os.ReadFile("testdata/ssh_payload.txt")
+	require.NoError(t, err)
+
+	result, err := VerifySSH(signature, payload)
+	require.NoError(t, err)
+	assert.Equal(t, "SHA256:lfzVOftJWJaj6v0q7J0Dj1r5nHAXZu+EJ6g/d/Hw61Y", result.Signer)
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sigstore"},
+		NotBefore:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// issue returns a short-lived certificate for the given email address like Fulcio
+func (ca *testCA) issue(t *testing.T, email string, issuer string, notBefore time.Time) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	issuerExt, err := asn1.MarshalWithParams(issuer, "utf8")
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		EmailAddresses:  []string{email},
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: oidFulcioIssuerV2, Value: issuerExt}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// issueTSA returns a certificate of a timestamp authority
+func (ca *testCA) issueTSA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "timestamp authority"},
+		NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// timestamp returns an RFC 3161 timestamp token of the given signature value
+func (tsa *testCA) timestamp(t *testing.T, signature []byte, genTime time.Time) []byte {
+	t.Helper()
+	imprint := sha256.Sum256(signature)
+	info, err := asn1.Marshal(tstInfo{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+		MessageImprint: messageImprint{HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256}, HashedMessage: imprint[:]},
+		SerialNumber:   big.NewInt(1),
+		GenTime:        genTime.UTC(),
+	})
+	require.NoError(t, err)
+	return signCMS(t, tsa.cert, tsa.key, oidTSTInfo, info, true, time.Time{}, nil)
+}
+
+// signCMS returns a DER encoded CMS signature of the given content, which contains the content if embed is set. The
+// signing time is added to the signed attributes unless it is zero, and the timestamp function is called with the
+// signature value to get a timestamp token for the unsigned attributes.
+func signCMS(t *testing.T, cert *x509.Certificate, key *ecdsa.PrivateKey, contentType asn1.ObjectIdentifier, content []byte, embed bool, signingTime time.Time, timestamp func(signature []byte) []byte) []byte {
+	t.Helper()
+	attr := func(oid asn1.ObjectIdentifier, value []byte) []byte {
+		a, err := asn1.Marshal(attribute{Type: oid, Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value}})
+		require.NoError(t, err)
+		return a
+	}
+	marshal := func(value any) []byte {
+		v, err := asn1.Marshal(value)
+		require.NoError(t, err)
+		return v
+	}
+	digest := sha256.Sum256(content)
+	var attrs []byte
+	attrs = append(attrs, attr(oidContentType, marshal(contentType))...)
+	if !signingTime.IsZero() {
+		attrs = append(attrs, attr(oidSigningTime, marshal(signingTime.UTC()))...)
+	}
+	attrs = append(attrs, attr(oidMessageDigest, marshal(digest[:]))...)
+	attrsSet, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: attrs})
+	require.NoError(t, err)
+	attrsDigest := sha256.Sum256(attrsSet)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, attrsDigest[:])
+	require.NoError(t, err)
+
+	var unsignedAttrs asn1.RawValue
+	if timestamp != nil {
+		unsignedAttrs = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 1, IsCompound: true, Bytes: attr(oidTimeStampToken, timestamp(sig))}
+	}
+	encapContent := encapContentInfo{EContentType: contentType}
+	if embed {
+		// raw values are marshaled as is, so the explicit tag has to be added here
+		encapContent.EContent = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: marshal(content)}
+	}
+	sid, err := asn1.Marshal(issuerAndSerialNumber{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, SerialNumber: cert.SerialNumber})
+	require.NoError(t, err)
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidSHA256}},
+		EncapContentInfo: encapContent,
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: cert.Raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrs},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+			Signature:          sig,
+			UnsignedAttrs:      unsignedAttrs,
+		}},
+	})
+	require.NoError(t, err)
+	ci, err := asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd}})
+	require.NoError(t, err)
+	return ci
+}
+
+// signX509 creates an armored detached CMS signature of the given payload like gitsign, which is timestamped using the
+// given function unless it is nil
+func signX509(t *testing.T, cert *x509.Certificate, key *ecdsa.PrivateKey, signingTime time.Time, payload string, timestamp func(signature []byte) []byte) []byte {
+	t.Helper()
+	ci := signCMS(t, cert, key, asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}, []byte(payload), false, signingTime, timestamp)
+	return pem.EncodeToMemory(&pem.Block{Type: "SIGNED MESSAGE", Bytes: ci})
+}
+
+func TestVerifyX509(t *testing.T) {
+	ca := newTestCA(t)
+	tsa := ca.issueTSA(t)
+	trustRoot := []*x509.Certificate{ca.cert}
+	signingTime := time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC)
+	timestampAt := func(genTime time.Time) func(signature []byte) []byte {
+		return func(signature []byte) []byte {
+			return tsa.timestamp(t, signature, genTime)
+		}
+	}
+	cert, key := ca.issue(t, "alice@example.com", "https://accounts.example.com", signingTime.Add(-time.Minute))
+	signature := signX509(t, cert, key, signingTime, testPayload, timestampAt(signingTime))
+	assert.Equal(t, TypeX509, DetectType(signature))
+
+	result, err := VerifyX509(signature, []byte(testPayload), trustRoot, trustRoot)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Type: TypeX509, Signer: "alice@example.com", Issuer: "https://accounts.example.com"}, result)
+
+	t.Run("Tampered", func(t *testing.T) {
+		_, err := VerifyX509(signature, []byte(testPayload+"tampered"), trustRoot, trustRoot)
+		require.ErrorContains(t, err, "message digest does not match")
+	})
+	t.Run("UntrustedRoot", func(t *testing.T) {
+		_, err := VerifyX509(signature, []byte(testPayload), []*x509.Certificate{newTestCA(t).cert}, trustRoot)
+		require.ErrorContains(t, err, "not trusted")
+	})
+	t.Run("TimestampedAfterExpiry", func(t *testing.T) {
+		signature := signX509(t, cert, key, signingTime, testPayload, timestampAt(signingTime.Add(time.Hour)))
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, trustRoot)
+		require.ErrorContains(t, err, "certificate of X.509 signature is not trusted")
+	})
+	t.Run("SigningTimeIsNotTrusted", func(t *testing.T) {
+		signature := signX509(t, cert, key, signingTime, testPayload, nil)
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, trustRoot)
+		require.ErrorContains(t, err, "does not contain an RFC 3161 timestamp")
+	})
+	t.Run("TimestampOfOtherSignature", func(t *testing.T) {
+		signature := signX509(t, cert, key, signingTime, testPayload, func([]byte) []byte {
+			return tsa.timestamp(t, []byte("other signature"), signingTime)
+		})
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, trustRoot)
+		require.ErrorContains(t, err, "timestamp of X.509 signature does not match the signature")
+	})
+	t.Run("UntrustedTimestampAuthority", func(t *testing.T) {
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, []*x509.Certificate{newTestCA(t).cert})
+		require.ErrorContains(t, err, "timestamp authority of X.509 signature is not trusted")
+	})
+	t.Run("NoTimestampAuthorityTrustRoot", func(t *testing.T) {
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, nil)
+		require.ErrorContains(t, err, "no trust root for timestamp authorities is configured")
+	})
+	t.Run("ValidCertificateWithoutTimestamp", func(t *testing.T) {
+		cert, key := ca.issue(t, "alice@example.com", "https://accounts.example.com", time.Now().Add(-time.Minute))
+		signature := signX509(t, cert, key, time.Now(), testPayload, nil)
+		_, err := VerifyX509(signature, []byte(testPayload), trustRoot, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestIsPermitted(t *testing.T) {
+	signer := newSSHSigner(t)
+	project := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{
+		SSHSignatureKeys: []v1alpha1.SSHSignatureKey{{Principals: "alice@example.com", PublicKey: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))}},
+		KeylessSignatureIdentities: []v1alpha1.KeylessSignatureIdentity{
+			{Issuer: "https://token.actions.githubusercontent.com", SubjectRegex: `https://github\.com/argoproj/.*`},
+		},
+	}}
+	assert.True(t, IsPermitted(project, &Result{Type: TypeSSH, Signer: ssh.FingerprintSHA256(signer.PublicKey())}))
+	assert.False(t, IsPermitted(project, &Result{Type: TypeSSH, Signer: ssh.FingerprintSHA256(newSSHSigner(t).PublicKey())}))
+	assert.True(t, IsPermitted(project, &Result{Type: TypeX509, Signer: "https://github.com/argoproj/argo-cd/.github/workflows/release.yaml@refs/heads/master", Issuer: "https://token.actions.githubusercontent.com"}))
+	assert.False(t, IsPermitted(project, &Result{Type: TypeX509, Signer: "https://github.com/argoproj/argo-cd/.github/workflows/release.yaml@refs/heads/master", Issuer: "https://accounts.google.com"}))
+	assert.False(t, IsPermitted(project, &Result{Type: TypeX509, Signer: "https://evil.com/https://github.com/argoproj/x", Issuer: "https://token.actions.githubusercontent.com"}))
+}
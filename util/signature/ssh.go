@@ -0,0 +1,95 @@
+package signature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshSignatureArmorStart = "-----BEGIN SSH SIGNATURE-----"
+	sshSignatureArmorEnd   = "-----END SSH SIGNATURE-----"
+	sshSignatureMagic      = "SSHSIG"
+	// sshSignatureNamespace is the namespace git uses for SSH signatures of commits and tags
+	sshSignatureNamespace = "git"
+)
+
+// sshSignature is the blob of an SSH signature as specified in
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      []byte
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data which is actually signed by an SSH signature
+type sshSignedData struct {
+	Namespace     string
+	Reserved      []byte
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// VerifySSH verifies the given armored SSH signature of the given payload, and returns the fingerprint of the key it
+// was made with. Whether the key is allowed to sign commits has to be checked by the caller.
+func VerifySSH(signature []byte, payload []byte) (*Result, error) {
+	armored := strings.TrimSpace(string(signature))
+	if !strings.HasPrefix(armored, sshSignatureArmorStart) || !strings.HasSuffix(armored, sshSignatureArmorEnd) {
+		return nil, errors.New("malformed SSH signature")
+	}
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(armored[len(sshSignatureArmorStart):len(armored)-len(sshSignatureArmorEnd)]), ""))
+	if err != nil {
+		return nil, fmt.Errorf("malformed SSH signature: %w", err)
+	}
+	if !bytes.HasPrefix(blob, []byte(sshSignatureMagic)) {
+		return nil, errors.New("malformed SSH signature: invalid magic preamble")
+	}
+	var sig sshSignature
+	if err := ssh.Unmarshal(blob[len(sshSignatureMagic):], &sig); err != nil {
+		return nil, fmt.Errorf("malformed SSH signature: %w", err)
+	}
+	if sig.Version != 1 {
+		return nil, fmt.Errorf("unsupported SSH signature version %d", sig.Version)
+	}
+	if sig.Namespace != sshSignatureNamespace {
+		return nil, fmt.Errorf("SSH signature has namespace %q instead of %q", sig.Namespace, sshSignatureNamespace)
+	}
+	publicKey, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("malformed public key of SSH signature: %w", err)
+	}
+	var h hash.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %q of SSH signature", sig.HashAlgorithm)
+	}
+	h.Write(payload)
+	signedData := ssh.Marshal(sshSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})
+	var sshSig ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &sshSig); err != nil {
+		return nil, fmt.Errorf("malformed SSH signature: %w", err)
+	}
+	if err := publicKey.Verify(append([]byte(sshSignatureMagic), signedData...), &sshSig); err != nil {
+		return nil, fmt.Errorf("bad SSH signature made with key %s: %w", ssh.FingerprintSHA256(publicKey), err)
+	}
+	return &Result{Type: TypeSSH, Signer: ssh.FingerprintSHA256(publicKey)}, nil
+}
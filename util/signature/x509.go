@@ -0,0 +1,415 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+	// oidTimeStampToken is the unsigned attribute which contains the RFC 3161 timestamp of a signature
+	oidTimeStampToken = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 14}
+	// oidTSTInfo is the content type of RFC 3161 timestamps
+	oidTSTInfo = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	// oidFulcioIssuer is the extension of Fulcio certificates which contains the OIDC issuer as raw string
+	oidFulcioIssuer = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidFulcioIssuerV2 is the extension of Fulcio certificates which contains the OIDC issuer as DER encoded UTF8String
+	oidFulcioIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// The following types are the subset of the CMS SignedData structure (RFC 5652) used by X.509 signatures of git
+// commits, e.g. created by gitsign or smimesign
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue `asn1:"optional,explicit,tag:0"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+// tstInfo is the subset of the TSTInfo structure (RFC 3161) used to verify timestamps
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time `asn1:"generalized"`
+}
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+// LoadTrustRoot reads the PEM encoded certificates of the certificate authorities which are trusted to issue
+// certificates of X.509 signatures, e.g. the Fulcio root and intermediate certificates of a Sigstore instance
+func LoadTrustRoot(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust root: %w", err)
+	}
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate of trust root: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("trust root %s does not contain any certificates", path)
+	}
+	return certs, nil
+}
+
+// VerifyX509 verifies the given armored X.509 signature of the given payload, and returns the identity of the signer.
+// The certificate of the signer has to chain up to one of the self-signed certificates of the trust root. The signing
+// time contained in the signature is chosen by the signer, so it is not trusted: if the signature contains an RFC 3161
+// timestamp of a timestamp authority which chains up to the timestamp authority trust root, the certificate has to be
+// valid at the time of the timestamp, otherwise it has to be valid now. Since the certificates of keyless signatures
+// are only valid for a few minutes, keyless signatures have to be timestamped. The transparency log is not consulted,
+// so the verification works offline. Whether the identity is allowed to sign commits has to be checked by the caller.
+func VerifyX509(signature []byte, payload []byte, trustRoot []*x509.Certificate, tsaTrustRoot []*x509.Certificate) (*Result, error) {
+	block, _ := pem.Decode(signature)
+	if block == nil || block.Type != "SIGNED MESSAGE" {
+		return nil, errors.New("malformed X.509 signature")
+	}
+	sd, certs, err := parseSignedData(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("malformed X.509 signature: %w", err)
+	}
+	if len(sd.SignerInfos) != 1 {
+		return nil, fmt.Errorf("X.509 signature has %d signers instead of one", len(sd.SignerInfos))
+	}
+	if len(sd.EncapContentInfo.EContent.Bytes) > 0 {
+		return nil, errors.New("X.509 signature is not detached")
+	}
+	si := sd.SignerInfos[0]
+	cert, err := findSignerCertificate(si, certs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySignerInfo(si, cert, payload); err != nil {
+		return nil, err
+	}
+
+	timestamp, err := verifyTimestamp(si, tsaTrustRoot)
+	if err != nil {
+		return nil, err
+	}
+	verifyTime := timestamp
+	if verifyTime.IsZero() {
+		verifyTime = time.Now()
+		if verifyTime.After(cert.NotAfter) {
+			return nil, fmt.Errorf("certificate of X.509 signature expired at %v and the signature does not contain an RFC 3161 timestamp", cert.NotAfter)
+		}
+	}
+	err = verifyChain(cert, trustRoot, certs, verifyTime, x509.ExtKeyUsageCodeSigning, x509.ExtKeyUsageEmailProtection)
+	if err != nil {
+		return nil, fmt.Errorf("certificate of X.509 signature is not trusted: %w", err)
+	}
+
+	result := &Result{Type: TypeX509}
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		result.Signer = cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		result.Signer = cert.URIs[0].String()
+	default:
+		result.Signer = cert.Subject.String()
+	}
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidFulcioIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				result.Issuer = issuer
+			}
+		case ext.Id.Equal(oidFulcioIssuer) && result.Issuer == "":
+			result.Issuer = string(ext.Value)
+		}
+	}
+	return result, nil
+}
+
+// parseSignedData parses the given DER encoded CMS SignedData structure and the certificates it contains
+func parseSignedData(der []byte) (*signedData, []*x509.Certificate, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, nil, err
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, nil, fmt.Errorf("unsupported content type %v", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, nil, err
+	}
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("malformed certificates: %w", err)
+	}
+	return &sd, certs, nil
+}
+
+// verifyChain verifies that the given certificate chains up to one of the self-signed certificates of the trust root
+// at the given time, using the other certificates of the trust root and the given certificates as intermediates
+func verifyChain(cert *x509.Certificate, trustRoot []*x509.Certificate, certs []*x509.Certificate, at time.Time, keyUsages ...x509.ExtKeyUsage) error {
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	for _, c := range trustRoot {
+		if bytes.Equal(c.RawIssuer, c.RawSubject) {
+			roots.AddCert(c)
+		} else {
+			intermediates.AddCert(c)
+		}
+	}
+	for _, c := range certs {
+		if c != cert {
+			intermediates.AddCert(c)
+		}
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     keyUsages,
+	})
+	return err
+}
+
+// verifyTimestamp verifies the RFC 3161 timestamp of the signature of the given signer info, and returns the time at
+// which the timestamp authority attested the existence of the signature. Returns the zero time if the signature is not
+// timestamped.
+func verifyTimestamp(si signerInfo, tsaTrustRoot []*x509.Certificate) (time.Time, error) {
+	var token []byte
+	for rest := si.UnsignedAttrs.Bytes; len(rest) > 0; {
+		var attr attribute
+		var err error
+		rest, err = asn1.Unmarshal(rest, &attr)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("malformed unsigned attributes of X.509 signature: %w", err)
+		}
+		if attr.Type.Equal(oidTimeStampToken) {
+			var value asn1.RawValue
+			if _, err := asn1.Unmarshal(attr.Values.Bytes, &value); err != nil {
+				return time.Time{}, fmt.Errorf("malformed timestamp of X.509 signature: %w", err)
+			}
+			token = value.FullBytes
+		}
+	}
+	if token == nil {
+		return time.Time{}, nil
+	}
+	if len(tsaTrustRoot) == 0 {
+		return time.Time{}, errors.New("X.509 signature is timestamped, but no trust root for timestamp authorities is configured")
+	}
+
+	sd, certs, err := parseSignedData(token)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed timestamp of X.509 signature: %w", err)
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) || len(sd.SignerInfos) != 1 {
+		return time.Time{}, errors.New("malformed timestamp of X.509 signature")
+	}
+	// raw values are unmarshaled including the explicit tag, so the content is the encoded OCTET STRING
+	var content []byte
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent.Bytes, &content); err != nil {
+		return time.Time{}, fmt.Errorf("malformed timestamp of X.509 signature: %w", err)
+	}
+	var info tstInfo
+	if _, err := asn1.Unmarshal(content, &info); err != nil {
+		return time.Time{}, fmt.Errorf("malformed timestamp of X.509 signature: %w", err)
+	}
+	hash, err := digestAlgorithm(info.MessageImprint.HashAlgorithm)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// the timestamp is calculated over the signature value of the signer
+	h := hash.New()
+	h.Write(si.Signature)
+	if !bytes.Equal(h.Sum(nil), info.MessageImprint.HashedMessage) {
+		return time.Time{}, errors.New("timestamp of X.509 signature does not match the signature")
+	}
+
+	tsaCert, err := findSignerCertificate(sd.SignerInfos[0], append(certs, tsaTrustRoot...))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp of X.509 signature: %w", err)
+	}
+	if err := verifySignerInfo(sd.SignerInfos[0], tsaCert, content); err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp of X.509 signature: %w", err)
+	}
+	if err := verifyChain(tsaCert, tsaTrustRoot, certs, info.GenTime, x509.ExtKeyUsageTimeStamping); err != nil {
+		return time.Time{}, fmt.Errorf("timestamp authority of X.509 signature is not trusted: %w", err)
+	}
+	return info.GenTime, nil
+}
+
+// findSignerCertificate returns the certificate identified by the given signer info
+func findSignerCertificate(si signerInfo, certs []*x509.Certificate) (*x509.Certificate, error) {
+	if si.SID.Class == asn1.ClassUniversal && si.SID.Tag == asn1.TagSequence {
+		var ias issuerAndSerialNumber
+		if _, err := asn1.Unmarshal(si.SID.FullBytes, &ias); err != nil {
+			return nil, fmt.Errorf("malformed signer of X.509 signature: %w", err)
+		}
+		for _, cert := range certs {
+			if cert.SerialNumber.Cmp(ias.SerialNumber) == 0 && bytes.Equal(cert.RawIssuer, ias.Issuer.FullBytes) {
+				return cert, nil
+			}
+		}
+	} else if si.SID.Class == asn1.ClassContextSpecific && si.SID.Tag == 0 {
+		for _, cert := range certs {
+			if bytes.Equal(cert.SubjectKeyId, si.SID.Bytes) {
+				return cert, nil
+			}
+		}
+	}
+	return nil, errors.New("X.509 signature does not contain the certificate of its signer")
+}
+
+// digestAlgorithm returns the hash of the given digest algorithm
+func digestAlgorithm(algorithm pkix.AlgorithmIdentifier) (crypto.Hash, error) {
+	switch {
+	case algorithm.Algorithm.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case algorithm.Algorithm.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case algorithm.Algorithm.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unsupported digest algorithm %v of X.509 signature", algorithm.Algorithm)
+}
+
+// verifySignerInfo verifies the signature of the given signer info over the given content
+func verifySignerInfo(si signerInfo, cert *x509.Certificate, content []byte) error {
+	hash, err := digestAlgorithm(si.DigestAlgorithm)
+	if err != nil {
+		return err
+	}
+	if len(si.SignedAttrs.FullBytes) == 0 {
+		return errors.New("X.509 signature does not contain signed attributes")
+	}
+
+	var digest []byte
+	contentTypeFound := false
+	for rest := si.SignedAttrs.Bytes; len(rest) > 0; {
+		var attr attribute
+		var err error
+		rest, err = asn1.Unmarshal(rest, &attr)
+		if err != nil {
+			return fmt.Errorf("malformed signed attributes of X.509 signature: %w", err)
+		}
+		switch {
+		case attr.Type.Equal(oidContentType):
+			contentTypeFound = true
+		case attr.Type.Equal(oidMessageDigest):
+			if _, err := asn1.Unmarshal(attr.Values.Bytes, &digest); err != nil {
+				return fmt.Errorf("malformed message digest of X.509 signature: %w", err)
+			}
+		}
+	}
+	if !contentTypeFound || digest == nil {
+		return errors.New("X.509 signature does not contain the content type and message digest")
+	}
+	h := hash.New()
+	h.Write(content)
+	if !bytes.Equal(h.Sum(nil), digest) {
+		return errors.New("bad X.509 signature: message digest does not match")
+	}
+
+	// the signature is calculated over the DER encoding of the signed attributes as SET OF instead of the implicit tag
+	signedAttrs := append([]byte{}, si.SignedAttrs.FullBytes...)
+	signedAttrs[0] = asn1.TagSet | 0x20
+	algorithm, err := signatureAlgorithm(cert, hash)
+	if err != nil {
+		return err
+	}
+	if err := cert.CheckSignature(algorithm, signedAttrs, si.Signature); err != nil {
+		return fmt.Errorf("bad X.509 signature: %w", err)
+	}
+	return nil
+}
+
+// signatureAlgorithm returns the algorithm of a signature made with the key of the given certificate and the given hash
+func signatureAlgorithm(cert *x509.Certificate, hash crypto.Hash) (x509.SignatureAlgorithm, error) {
+	switch cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return x509.ECDSAWithSHA256, nil
+		case crypto.SHA384:
+			return x509.ECDSAWithSHA384, nil
+		case crypto.SHA512:
+			return x509.ECDSAWithSHA512, nil
+		}
+	case *rsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		case crypto.SHA512:
+			return x509.SHA512WithRSA, nil
+		}
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported public key of X.509 signature certificate")
+}